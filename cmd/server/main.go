@@ -1,10 +1,17 @@
 package main
 
 import (
+	"fmt"
 	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 
+	"github.com/axopadyani/billing-engine/internal/common/clock"
+	"github.com/axopadyani/billing-engine/internal/entity"
 	"github.com/axopadyani/billing-engine/internal/interface/grpc"
 	postgres2 "github.com/axopadyani/billing-engine/internal/repository/adapter/db/postgres"
 	"github.com/axopadyani/billing-engine/internal/service"
@@ -15,13 +22,32 @@ func main() {
 		log.Fatalf("Error loading .env file: %v", err)
 	}
 
+	billingCalendar, err := loadBillingCalendar()
+	if err != nil {
+		log.Fatalf("error loading billing calendar: %v", err)
+	}
+
+	delinquencyBuckets, err := loadDelinquencyBuckets()
+	if err != nil {
+		log.Fatalf("error loading delinquency buckets: %v", err)
+	}
+
+	delinquencyRule, err := loadDelinquencyRule()
+	if err != nil {
+		log.Fatalf("error loading delinquency rule: %v", err)
+	}
+
 	postgresConn, err := postgres2.InitConnection()
 	if err != nil {
 		log.Fatalf("error initializing postgres connection: %v", err)
 	}
 
 	loanRepo := postgres2.NewRepository(postgresConn)
-	svc := service.NewService(loanRepo)
+	svc := service.NewService(loanRepo, clock.New(), service.Config{
+		BillingCalendar:    billingCalendar,
+		DelinquencyBuckets: delinquencyBuckets,
+		DelinquencyRule:    delinquencyRule,
+	})
 
 	grpcServer := grpc.NewServer(svc)
 	listener, err := grpc.InitListener()
@@ -31,3 +57,120 @@ func main() {
 
 	grpcServer.Serve(listener)
 }
+
+// loadBillingCalendar loads the default billing calendar of the loans from the configuration.
+//
+// It uses the BILLING_TIMEZONE and BILLING_WEEK_START_DAY environment variables, taking the
+// entity.DefaultBillingCalendar values for the one that is not set.
+//
+// Returns:
+//   - *entity.BillingCalendar: The billing calendar used for the loans whose product does not define one,
+//     or nil if neither environment variable is set.
+//   - error: An error if the timezone is unknown or the week start day is not a weekday name.
+func loadBillingCalendar() (*entity.BillingCalendar, error) {
+	timezone := os.Getenv("BILLING_TIMEZONE")
+	weekStartDay := os.Getenv("BILLING_WEEK_START_DAY")
+	if timezone == "" && weekStartDay == "" {
+		return nil, nil
+	}
+
+	calendar := entity.DefaultBillingCalendar()
+	if timezone != "" {
+		calendar.Timezone = timezone
+	}
+
+	if weekStartDay != "" {
+		found := false
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if strings.EqualFold(day.String(), weekStartDay) {
+				calendar.WeekStartDay = day
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("invalid week start day %q", weekStartDay)
+		}
+	}
+
+	if !calendar.IsValid() {
+		return nil, fmt.Errorf("invalid timezone %q", calendar.Timezone)
+	}
+
+	return &calendar, nil
+}
+
+// loadDelinquencyBuckets loads the boundaries of the delinquency buckets of the loans from the configuration.
+//
+// It uses the DELINQUENCY_BUCKETS environment variable, a comma-separated list of the highest days past due
+// of each bucket, e.g. "0,7,30,60,90".
+//
+// Returns:
+//   - entity.DelinquencyBuckets: The boundaries of the buckets the loans are classified in by their days past due,
+//     or nil if the environment variable is not set, leaving the service to use entity.DefaultDelinquencyBuckets.
+//   - error: An error if a boundary is not a number, or the boundaries are negative or not in ascending order.
+func loadDelinquencyBuckets() (entity.DelinquencyBuckets, error) {
+	value := os.Getenv("DELINQUENCY_BUCKETS")
+	if value == "" {
+		return nil, nil
+	}
+
+	var buckets entity.DelinquencyBuckets
+	for _, boundary := range strings.Split(value, ",") {
+		days, err := strconv.ParseInt(strings.TrimSpace(boundary), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid delinquency bucket boundary %q", boundary)
+		}
+		buckets = append(buckets, int32(days))
+	}
+
+	if !buckets.IsValid() {
+		return nil, fmt.Errorf("invalid delinquency buckets %q", value)
+	}
+
+	return buckets, nil
+}
+
+// loadDelinquencyRule loads the delinquency rule of the deployment from the configuration.
+//
+// It uses the DELINQUENCY_THRESHOLD environment variable, the number of missed installments a loan can have
+// without being delinquent, and the DELINQUENCY_PARTIAL_PAYMENT_RULE environment variable, either "missed" or
+// "paid" for how a partially paid installment is counted, taking the entity.DefaultDelinquencyRule values
+// for the one that is not set.
+//
+// Returns:
+//   - *entity.DelinquencyRule: The delinquency rule the new loans are created with, taking precedence over the rule
+//     of their product, or nil if neither environment variable is set.
+//   - error: An error if the threshold is not a number or is negative, or the partial payment rule is unknown.
+func loadDelinquencyRule() (*entity.DelinquencyRule, error) {
+	threshold := os.Getenv("DELINQUENCY_THRESHOLD")
+	partialPaymentRule := os.Getenv("DELINQUENCY_PARTIAL_PAYMENT_RULE")
+	if threshold == "" && partialPaymentRule == "" {
+		return nil, nil
+	}
+
+	rule := entity.DefaultDelinquencyRule()
+	if threshold != "" {
+		installments, err := strconv.ParseInt(strings.TrimSpace(threshold), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid delinquency threshold %q", threshold)
+		}
+		rule.MissedInstallmentThreshold = int32(installments)
+	}
+
+	switch strings.ToLower(partialPaymentRule) {
+	case "":
+	case "missed":
+		rule.PartialPaymentRule = entity.PartialPaymentCountsAsMissed
+	case "paid":
+		rule.PartialPaymentRule = entity.PartialPaymentCountsAsPaid
+	default:
+		return nil, fmt.Errorf("invalid delinquency partial payment rule %q", partialPaymentRule)
+	}
+
+	if !rule.IsValid() {
+		return nil, fmt.Errorf("invalid delinquency threshold %d", rule.MissedInstallmentThreshold)
+	}
+
+	return &rule, nil
+}
@@ -19,4 +19,8 @@ const (
 
 	// KindAlreadyExists indicates that an attempt to create an entity failed because it already exists.
 	KindAlreadyExists
+
+	// KindConflict indicates that the request conflicts with a previous request, such as reusing
+	// an idempotency key for a different request.
+	KindConflict
 )
@@ -0,0 +1,37 @@
+package clock
+
+import (
+	"time"
+)
+
+// Clock provides the current time.
+//
+// It is injected wherever the current time drives the billing behavior, so that the time can be controlled
+// when testing or replaying the billing of a loan.
+type Clock interface {
+	// Now returns the current time.
+	//
+	// Returns:
+	//   - time.Time: The current time.
+	Now() time.Time
+}
+
+// systemClock is a Clock that reads the time of the system.
+type systemClock struct{}
+
+// New creates and returns a Clock that reads the time of the system.
+//
+// Returns:
+//
+//	A Clock returning the current system time.
+func New() Clock {
+	return systemClock{}
+}
+
+// Now returns the current system time.
+//
+// Returns:
+//   - time.Time: The current system time.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
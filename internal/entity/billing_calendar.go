@@ -0,0 +1,144 @@
+package entity
+
+import (
+	"sync"
+	"time"
+	_ "time/tzdata" // embeds the timezone database so billing timezones load on hosts without one
+)
+
+// DefaultBillingCalendar returns the billing calendar of the loans whose product does not define one, unless the
+// deployment sets another one: billing weeks start at midnight UTC on Monday.
+//
+// Returns:
+//   - BillingCalendar: The default billing calendar.
+func DefaultBillingCalendar() BillingCalendar {
+	return BillingCalendar{Timezone: "UTC", WeekStartDay: time.Monday}
+}
+
+var locationCache sync.Map // timezone name to *time.Location
+
+// BillingCalendar represents the calendar the billing weeks of a loan are calculated in.
+type BillingCalendar struct {
+	// Timezone is the IANA name of the timezone the billing weeks start in, e.g. "Asia/Jakarta".
+	Timezone string
+
+	// WeekStartDay is the day of the week the billing weeks start on, at midnight in the timezone.
+	WeekStartDay time.Weekday
+}
+
+// IsValid checks if the BillingCalendar has a known timezone and a valid week start day.
+//
+// Returns:
+//   - bool: true if the timezone can be loaded and the week start day is between Sunday and Saturday, false otherwise.
+func (c BillingCalendar) IsValid() bool {
+	if c.WeekStartDay < time.Sunday || c.WeekStartDay > time.Saturday {
+		return false
+	}
+
+	_, err := c.loadLocation()
+	return err == nil
+}
+
+// loadLocation loads the location of the calendar's timezone, caching it for the subsequent calls.
+//
+// Returns:
+//   - *time.Location: The location of the timezone.
+//   - error: An error if the timezone is empty or unknown, nil otherwise.
+func (c BillingCalendar) loadLocation() (*time.Location, error) {
+	if c.Timezone == "" {
+		return nil, ErrLoanInvalidBillingCalendar
+	}
+
+	if location, ok := locationCache.Load(c.Timezone); ok {
+		return location.(*time.Location), nil
+	}
+
+	location, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	locationCache.Store(c.Timezone, location)
+
+	return location, nil
+}
+
+// location returns the location of the calendar's timezone.
+//
+// Returns:
+//   - *time.Location: The location of the timezone, or UTC if the timezone cannot be loaded.
+func (c BillingCalendar) location() *time.Location {
+	location, err := c.loadLocation()
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+// beginningOfWeek returns the start of the billing week the given time is in.
+//
+// Parameters:
+//   - t: The time to find the billing week of.
+//
+// Returns:
+//   - time.Time: Midnight of the week start day of the billing week, in the calendar's timezone.
+func (c BillingCalendar) beginningOfWeek(t time.Time) time.Time {
+	local := t.In(c.location())
+
+	daysSinceWeekStart := int(local.Weekday()-c.WeekStartDay+7) % 7
+	beginningOfWeek := local.AddDate(0, 0, -daysSinceWeekStart)
+
+	return time.Date(beginningOfWeek.Year(), beginningOfWeek.Month(), beginningOfWeek.Day(), 0, 0, 0, 0, c.location())
+}
+
+// beginningOfDay returns the midnight of the day the given time is in.
+//
+// Parameters:
+//   - t: The time to find the day of.
+//
+// Returns:
+//   - time.Time: Midnight of the day, in the calendar's timezone.
+func (c BillingCalendar) beginningOfDay(t time.Time) time.Time {
+	local := t.In(c.location())
+
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.location())
+}
+
+// daysBetween calculates the number of calendar days from a day to the given time.
+//
+// The days are counted in calendar dates of the calendar's timezone, so a billing period is never shortened
+// or lengthened by a daylight saving time change.
+//
+// Parameters:
+//   - beginning: The start of the day to count from.
+//   - t: The time to count to.
+//
+// Returns:
+//   - int32: The number of whole days between the two times, never negative.
+func (c BillingCalendar) daysBetween(beginning, t time.Time) int32 {
+	from := beginning.In(c.location())
+	to := t.In(c.location())
+
+	// compare the calendar dates only, as a day is not always 24 hours long in the timezone
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	days := int32(toDate.Sub(fromDate).Hours() / 24)
+	if days < 0 {
+		// the time before the beginning is not part of any billing period yet
+		return 0
+	}
+
+	return days
+}
+
+// billingCalendar returns the billing calendar of the loan.
+//
+// Returns:
+//   - BillingCalendar: The loan's billing calendar, or DefaultBillingCalendar if the loan has none.
+func (l *Loan) billingCalendar() BillingCalendar {
+	if l.BillingCalendar == nil {
+		return DefaultBillingCalendar()
+	}
+
+	return *l.BillingCalendar
+}
@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBillingCalendar_IsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar BillingCalendar
+		want     bool
+	}{
+		{
+			name:     "default calendar",
+			calendar: DefaultBillingCalendar(),
+			want:     true,
+		},
+		{
+			name:     "IANA timezone",
+			calendar: BillingCalendar{Timezone: "Asia/Jakarta", WeekStartDay: time.Sunday},
+			want:     true,
+		},
+		{
+			name:     "empty timezone",
+			calendar: BillingCalendar{WeekStartDay: time.Monday},
+			want:     false,
+		},
+		{
+			name:     "unknown timezone",
+			calendar: BillingCalendar{Timezone: "Mars/Olympus_Mons", WeekStartDay: time.Monday},
+			want:     false,
+		},
+		{
+			name:     "invalid week start day",
+			calendar: BillingCalendar{Timezone: "UTC", WeekStartDay: time.Weekday(7)},
+			want:     false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.calendar.IsValid(); got != test.want {
+				t.Fatalf("expecting %t, got %t", test.want, got)
+			}
+		})
+	}
+}
+
+func TestBillingCalendar_beginningOfWeek(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		calendar BillingCalendar
+		time     time.Time
+		want     time.Time
+	}{
+		{
+			name:     "default calendar",
+			calendar: DefaultBillingCalendar(),
+			time:     time.Date(2023, 5, 3, 12, 0, 0, 0, time.UTC), // Wednesday
+			want:     time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "already on the week start day",
+			calendar: DefaultBillingCalendar(),
+			time:     time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), // Monday
+			want:     time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "local date differs from the UTC date",
+			calendar: BillingCalendar{Timezone: "Asia/Jakarta", WeekStartDay: time.Monday},
+			time:     time.Date(2023, 4, 30, 18, 0, 0, 0, time.UTC), // Monday 01:00 in UTC+7
+			want:     time.Date(2023, 5, 1, 0, 0, 0, 0, jakarta),
+		},
+		{
+			name:     "week starting on Saturday",
+			calendar: BillingCalendar{Timezone: "UTC", WeekStartDay: time.Saturday},
+			time:     time.Date(2023, 5, 5, 12, 0, 0, 0, time.UTC), // Friday
+			want:     time.Date(2023, 4, 29, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.calendar.beginningOfWeek(test.time); !got.Equal(test.want) {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"time"
+)
+
+// BillingFrequency represents how often the installments of a loan are due.
+type BillingFrequency int
+
+const (
+	// BillingFrequencyWeekly bills an installment every week, at the start of the billing week.
+	BillingFrequencyWeekly BillingFrequency = iota
+
+	// BillingFrequencyDaily bills an installment every day, at midnight.
+	BillingFrequencyDaily
+
+	// BillingFrequencyBiWeekly bills an installment every two weeks, at the start of the billing week.
+	BillingFrequencyBiWeekly
+
+	// BillingFrequencyMonthly bills an installment every calendar month, on the day of the month the loan
+	// was created on, or on the last day of the month for the months that are shorter.
+	BillingFrequencyMonthly
+)
+
+// IsValid checks if the BillingFrequency is a valid billing frequency.
+//
+// Returns:
+//   - bool: true if the frequency is one of the predefined billing frequencies, false otherwise.
+func (f BillingFrequency) IsValid() bool {
+	return f == BillingFrequencyWeekly || f == BillingFrequencyDaily || f == BillingFrequencyBiWeekly || f == BillingFrequencyMonthly
+}
+
+// beginningOfPeriod returns the start of the billing period the given time is in.
+//
+// Parameters:
+//   - calendar: The billing calendar the period is calculated in.
+//   - t: The time to find the billing period of.
+//
+// Returns:
+//   - time.Time: The start of the billing week for the weekly and bi-weekly frequencies,
+//     or the midnight of the day for the daily and monthly frequencies, in the calendar's timezone.
+func (f BillingFrequency) beginningOfPeriod(calendar BillingCalendar, t time.Time) time.Time {
+	if f == BillingFrequencyWeekly || f == BillingFrequencyBiWeekly {
+		return calendar.beginningOfWeek(t)
+	}
+
+	return calendar.beginningOfDay(t)
+}
+
+// dueDate returns the time the installment with the given number becomes due.
+//
+// Parameters:
+//   - beginning: The start of the loan's first billing period.
+//   - number: The 1-based number of the installment.
+//
+// Returns:
+//   - time.Time: The start of the billing period following the installment's period.
+func (f BillingFrequency) dueDate(beginning time.Time, number int32) time.Time {
+	switch f {
+	case BillingFrequencyDaily:
+		return beginning.AddDate(0, 0, int(number))
+	case BillingFrequencyBiWeekly:
+		return beginning.AddDate(0, 0, 14*int(number))
+	case BillingFrequencyMonthly:
+		return addMonthsClamped(beginning, int(number), beginning.Day())
+	default:
+		return beginning.AddDate(0, 0, 7*int(number))
+	}
+}
+
+// periodsBetween calculates the number of whole billing periods from the start of the first billing period
+// to the given time.
+//
+// Parameters:
+//   - calendar: The billing calendar the periods are calculated in.
+//   - beginning: The start of the loan's first billing period.
+//   - t: The time to count to.
+//
+// Returns:
+//   - int32: The number of billing periods that have ended at the given time, never negative.
+func (f BillingFrequency) periodsBetween(calendar BillingCalendar, beginning, t time.Time) int32 {
+	days := calendar.daysBetween(beginning, t)
+
+	switch f {
+	case BillingFrequencyDaily:
+		return days
+	case BillingFrequencyBiWeekly:
+		return days / 14
+	case BillingFrequencyMonthly:
+		if days == 0 {
+			return 0
+		}
+
+		from := beginning.In(calendar.location())
+		to := t.In(calendar.location())
+		months := int32((to.Year()-from.Year())*12 + int(to.Month()-from.Month()))
+		if to.Before(f.dueDate(from, months)) {
+			months--
+		}
+
+		return max(months, 0)
+	default:
+		return days / 7
+	}
+}
+
+// addMonthsClamped adds calendar months to a time, keeping the anchor day of the month
+// unless the resulting month is shorter, in which case the last day of the month is used.
+//
+// Parameters:
+//   - t: The time to add the months to.
+//   - months: The number of months to add.
+//   - anchorDay: The day of the month the result should be on.
+//
+// Returns:
+//   - time.Time: The resulting time, with the same time of day and location as t.
+func addMonthsClamped(t time.Time, months int, anchorDay int) time.Time {
+	firstOfMonth := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	return firstOfMonth.AddDate(0, 0, min(anchorDay, lastDay)-1)
+}
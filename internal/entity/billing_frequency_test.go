@@ -0,0 +1,185 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBillingFrequency_IsValid(t *testing.T) {
+	tests := []struct {
+		name      string
+		frequency BillingFrequency
+		want      bool
+	}{
+		{
+			name:      "weekly",
+			frequency: BillingFrequencyWeekly,
+			want:      true,
+		},
+		{
+			name:      "daily",
+			frequency: BillingFrequencyDaily,
+			want:      true,
+		},
+		{
+			name:      "bi-weekly",
+			frequency: BillingFrequencyBiWeekly,
+			want:      true,
+		},
+		{
+			name:      "monthly",
+			frequency: BillingFrequencyMonthly,
+			want:      true,
+		},
+		{
+			name:      "unknown",
+			frequency: BillingFrequency(999),
+			want:      false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.frequency.IsValid(); got != test.want {
+				t.Fatalf("expecting %t, got %t", test.want, got)
+			}
+		})
+	}
+}
+
+func TestBillingFrequency_dueDate(t *testing.T) {
+	tests := []struct {
+		name      string
+		frequency BillingFrequency
+		beginning time.Time
+		number    int32
+		want      time.Time
+	}{
+		{
+			name:      "weekly",
+			frequency: BillingFrequencyWeekly,
+			beginning: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			number:    2,
+			want:      time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "daily",
+			frequency: BillingFrequencyDaily,
+			beginning: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			number:    2,
+			want:      time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "bi-weekly",
+			frequency: BillingFrequencyBiWeekly,
+			beginning: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			number:    2,
+			want:      time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "monthly",
+			frequency: BillingFrequencyMonthly,
+			beginning: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			number:    2,
+			want:      time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "monthly in a shorter month",
+			frequency: BillingFrequencyMonthly,
+			beginning: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			number:    1,
+			want:      time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "monthly after a shorter month",
+			frequency: BillingFrequencyMonthly,
+			beginning: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			number:    2,
+			want:      time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "monthly across a year",
+			frequency: BillingFrequencyMonthly,
+			beginning: time.Date(2024, 11, 30, 0, 0, 0, 0, time.UTC),
+			number:    3,
+			want:      time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.frequency.dueDate(test.beginning, test.number); !got.Equal(test.want) {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestBillingFrequency_periodsBetween(t *testing.T) {
+	tests := []struct {
+		name      string
+		frequency BillingFrequency
+		beginning time.Time
+		now       time.Time
+		want      int32
+	}{
+		{
+			name:      "weekly",
+			frequency: BillingFrequencyWeekly,
+			beginning: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			now:       time.Date(2024, 1, 21, 23, 59, 59, 0, time.UTC),
+			want:      2,
+		},
+		{
+			name:      "daily",
+			frequency: BillingFrequencyDaily,
+			beginning: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			now:       time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC),
+			want:      2,
+		},
+		{
+			name:      "bi-weekly",
+			frequency: BillingFrequencyBiWeekly,
+			beginning: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			now:       time.Date(2024, 1, 28, 23, 59, 59, 0, time.UTC),
+			want:      1,
+		},
+		{
+			name:      "monthly before the due date",
+			frequency: BillingFrequencyMonthly,
+			beginning: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			now:       time.Date(2024, 2, 28, 23, 59, 59, 0, time.UTC),
+			want:      0,
+		},
+		{
+			name:      "monthly on the end of a shorter month",
+			frequency: BillingFrequencyMonthly,
+			beginning: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			now:       time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			want:      1,
+		},
+		{
+			name:      "monthly before the anchor day of the next month",
+			frequency: BillingFrequencyMonthly,
+			beginning: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			now:       time.Date(2024, 3, 30, 0, 0, 0, 0, time.UTC),
+			want:      1,
+		},
+		{
+			name:      "before the beginning",
+			frequency: BillingFrequencyMonthly,
+			beginning: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			now:       time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC),
+			want:      0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.frequency.periodsBetween(DefaultBillingCalendar(), test.beginning, test.now)
+			if got != test.want {
+				t.Fatalf("expecting %d, got %d", test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreditEntryType represents the kind of change made to the credit balance of a user.
+type CreditEntryType int
+
+const (
+	// CreditEntryTypeOverpayment adds the excess of a payment to the credit balance.
+	CreditEntryTypeOverpayment CreditEntryType = iota
+
+	// CreditEntryTypeApplication deducts the credit applied to the installments of a loan from the credit balance.
+	CreditEntryTypeApplication
+
+	// CreditEntryTypeReversal undoes the credit balance change of a payment that is reversed.
+	CreditEntryTypeReversal
+)
+
+// CreditEntry represents a change to the credit balance of a user. The credit balance of a user
+// is the sum of the amounts of all their credit entries.
+type CreditEntry struct {
+	// ID is the unique identifier for the credit entry.
+	ID uuid.UUID
+
+	// UserID is the unique identifier of the user whose credit balance is changed.
+	UserID uuid.UUID
+
+	// LoanID is the unique identifier of the loan the change is made through.
+	LoanID uuid.UUID
+
+	// PaymentID is the unique identifier of the payment the change is made by.
+	PaymentID uuid.UUID
+
+	// Type is the kind of the change.
+	Type CreditEntryType
+
+	// Amount is the amount added to the credit balance, negative when it is deducted.
+	Amount decimal.Decimal
+
+	// CreatedAt is the timestamp when the credit entry was recorded.
+	CreatedAt time.Time
+}
+
+// newCreditEntry creates a new change to the credit balance of the loan's user.
+//
+// Parameters:
+//   - loan: A pointer to the loan the change is made through.
+//   - paymentID: The unique identifier of the payment the change is made by.
+//   - entryType: The kind of the change.
+//   - amount: The amount added to the credit balance, negative when it is deducted.
+//   - now: The time the change is made at.
+//
+// Returns:
+//   - *CreditEntry: The newly created credit entry.
+//   - error: An error if there was a problem creating the UUID.
+func newCreditEntry(loan *Loan, paymentID uuid.UUID, entryType CreditEntryType, amount decimal.Decimal, now time.Time) (*CreditEntry, error) {
+	entryID, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreditEntry{
+		ID:        entryID,
+		UserID:    loan.UserID,
+		LoanID:    loan.ID,
+		PaymentID: paymentID,
+		Type:      entryType,
+		Amount:    amount,
+		CreatedAt: now.UTC(),
+	}, nil
+}
+
+// ApplyCredit pays the current bill of the loan from the credit balance of its user.
+//
+// As much of the current bill as the credit balance covers is paid by a payment of PaymentModeCredit,
+// allocated like a regular payment, and a credit entry deducting the amount from the credit balance
+// is attached to the payment.
+//
+// Parameters:
+//   - now: The time the credit is applied at, used to calculate the current bill amount.
+//   - paidAmount: The total amount already paid towards the loan.
+//   - creditBalance: The credit balance of the loan's user.
+//
+// Returns:
+//   - loanPayment: The payment made from the credit balance, or nil if the loan is not being repaid,
+//     there is no credit balance or there is no bill to be paid.
+//   - shouldUpdateLoan: A boolean indicating whether the payment updates the loan status.
+//   - err: ErrLoanNotFound if the loan is nil, or an error if the payment cannot be created, nil otherwise.
+func (l *Loan) ApplyCredit(now time.Time, paidAmount, creditBalance decimal.Decimal) (loanPayment *LoanPayment, shouldUpdateLoan bool, err error) {
+	if l == nil {
+		return nil, false, ErrLoanNotFound
+	}
+	if !l.Status.isRepaying() || !creditBalance.IsPositive() {
+		return nil, false, nil
+	}
+
+	billAmount := l.CurrentBillAmount(now, paidAmount)
+	if billAmount.IsZero() {
+		return nil, false, nil
+	}
+	amount := decimal.Min(billAmount, creditBalance)
+
+	loanPayment, err = CreateLoanPayment(l.ID, amount, PaymentDetails{}, now)
+	if err != nil {
+		return nil, false, err
+	}
+	loanPayment.Mode = PaymentModeCredit
+	loanPayment.CreditEntry, err = newCreditEntry(l, loanPayment.ID, CreditEntryTypeApplication, amount.Neg(), now)
+	if err != nil {
+		return nil, false, err
+	}
+	loanPayment.Allocations, loanPayment.ChargeAllocations = l.allocatePayment(amount, loanPayment.CreatedAt)
+	shouldUpdateLoan, err = l.updateStatusAfterPayment(paidAmount.Add(amount), loanPayment.CreatedAt)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return loanPayment, shouldUpdateLoan, nil
+}
@@ -0,0 +1,144 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestLoan_ApplyCredit(t *testing.T) {
+	now := time.Now().UTC()
+	loanID := uuid.New()
+	userID := uuid.New()
+
+	newLoan := func(status LoanStatus) *Loan {
+		return &Loan{
+			ID:               loanID,
+			UserID:           userID,
+			PaymentAmount:    decimal.NewFromInt(300),
+			InstallmentCount: 3,
+			Status:           status,
+			CreatedAt:        now.Add(-time.Hour * 24 * 14), // now is loan week 2
+			Installments: []*LoanInstallment{
+				{Number: 1, AmountDue: decimal.NewFromInt(100), AmountPaid: decimal.NewFromInt(100), Status: LoanInstallmentStatusPaid},
+				{Number: 2, AmountDue: decimal.NewFromInt(100), AmountPaid: decimal.Zero},
+				{Number: 3, AmountDue: decimal.NewFromInt(100), AmountPaid: decimal.Zero},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		loan            *Loan
+		paidAmount      decimal.Decimal
+		creditBalance   decimal.Decimal
+		wantLoanPayment *LoanPayment
+		wantUpdateLoan  bool
+		wantErr         error
+	}{
+		{
+			name:          "nil loan",
+			loan:          nil,
+			paidAmount:    decimal.Zero,
+			creditBalance: decimal.NewFromInt(50),
+			wantErr:       ErrLoanNotFound,
+		},
+		{
+			name:            "no credit balance",
+			loan:            newLoan(LoanStatusOngoing),
+			paidAmount:      decimal.NewFromInt(100),
+			creditBalance:   decimal.Zero,
+			wantLoanPayment: nil,
+			wantErr:         nil,
+		},
+		{
+			name:            "paid loan",
+			loan:            newLoan(LoanStatusPaid),
+			paidAmount:      decimal.NewFromInt(300),
+			creditBalance:   decimal.NewFromInt(50),
+			wantLoanPayment: nil,
+			wantErr:         nil,
+		},
+		{
+			name:            "current bill already paid",
+			loan:            newLoan(LoanStatusOngoing),
+			paidAmount:      decimal.NewFromInt(200),
+			creditBalance:   decimal.NewFromInt(50),
+			wantLoanPayment: nil,
+			wantErr:         nil,
+		},
+		{
+			name:          "credit balance covers part of the bill",
+			loan:          newLoan(LoanStatusOngoing),
+			paidAmount:    decimal.NewFromInt(100),
+			creditBalance: decimal.NewFromInt(40),
+			wantLoanPayment: &LoanPayment{
+				LoanID: loanID,
+				Mode:   PaymentModeCredit,
+				Amount: decimal.NewFromInt(40),
+				Allocations: []InstallmentAllocation{
+					{InstallmentNumber: 2, Amount: decimal.NewFromInt(40)},
+				},
+				CreditEntry: &CreditEntry{
+					UserID: userID,
+					LoanID: loanID,
+					Type:   CreditEntryTypeApplication,
+					Amount: decimal.NewFromInt(-40),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:          "credit balance covers the whole bill",
+			loan:          newLoan(LoanStatusOngoing),
+			paidAmount:    decimal.NewFromInt(100),
+			creditBalance: decimal.NewFromInt(500),
+			wantLoanPayment: &LoanPayment{
+				LoanID: loanID,
+				Mode:   PaymentModeCredit,
+				Amount: decimal.NewFromInt(100),
+				Allocations: []InstallmentAllocation{
+					{InstallmentNumber: 2, Amount: decimal.NewFromInt(100)},
+				},
+				CreditEntry: &CreditEntry{
+					UserID: userID,
+					LoanID: loanID,
+					Type:   CreditEntryTypeApplication,
+					Amount: decimal.NewFromInt(-100),
+				},
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loanPayment, shouldUpdateLoan, err := tt.loan.ApplyCredit(now, tt.paidAmount, tt.creditBalance)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ApplyCredit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(
+				tt.wantLoanPayment, loanPayment,
+				cmpopts.IgnoreFields(LoanPayment{}, "ID", "Details", "CreatedAt", "UpdatedAt"),
+				cmpopts.IgnoreFields(CreditEntry{}, "ID", "PaymentID", "CreatedAt"),
+			); diff != "" {
+				t.Fatalf("LoanPayment mismatch (-want +got):\n%s", diff)
+			}
+			if loanPayment != nil && loanPayment.CreditEntry.PaymentID != loanPayment.ID {
+				t.Errorf("ApplyCredit() credit entry PaymentID = %v, want %v", loanPayment.CreditEntry.PaymentID, loanPayment.ID)
+			}
+			if shouldUpdateLoan != tt.wantUpdateLoan {
+				t.Errorf("ApplyCredit() shouldUpdateLoan = %v, want %v", shouldUpdateLoan, tt.wantUpdateLoan)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultDelinquencyRule returns the delinquency rule of the loans whose product does not define one: a loan is
+// delinquent when it has missed more than two installments, counting a partially paid installment as missed.
+//
+// Returns:
+//   - DelinquencyRule: The default delinquency rule.
+func DefaultDelinquencyRule() DelinquencyRule {
+	return DelinquencyRule{MissedInstallmentThreshold: 2, PartialPaymentRule: PartialPaymentCountsAsMissed}
+}
+
+// DefaultDelinquencyBuckets returns the delinquency buckets the loans are classified in, unless the deployment sets
+// other ones: current, 1-7, 8-30, 31-60, 61-90 and 90+ days past due.
+//
+// Returns:
+//   - DelinquencyBuckets: The default delinquency buckets.
+func DefaultDelinquencyBuckets() DelinquencyBuckets {
+	return DelinquencyBuckets{0, 7, 30, 60, 90}
+}
+
+// PartialPaymentRule represents how an overdue installment that is partially paid is counted for delinquency.
+type PartialPaymentRule int
+
+const (
+	// PartialPaymentCountsAsMissed counts a partially paid installment as a missed installment.
+	PartialPaymentCountsAsMissed PartialPaymentRule = iota
+
+	// PartialPaymentCountsAsPaid does not count a partially paid installment as a missed installment.
+	PartialPaymentCountsAsPaid
+)
+
+// IsValid checks if the PartialPaymentRule is a valid rule.
+//
+// Returns:
+//   - bool: true if the rule is one of the predefined partial payment rules, false otherwise.
+func (r PartialPaymentRule) IsValid() bool {
+	return r == PartialPaymentCountsAsMissed || r == PartialPaymentCountsAsPaid
+}
+
+// DelinquencyRule represents the rule a loan is considered delinquent by.
+type DelinquencyRule struct {
+	// MissedInstallmentThreshold is the number of missed installments a loan can have without being delinquent.
+	MissedInstallmentThreshold int32
+
+	// PartialPaymentRule is how an overdue installment that is partially paid is counted.
+	PartialPaymentRule PartialPaymentRule
+}
+
+// IsValid checks if the DelinquencyRule has a threshold that is not negative and a valid partial payment rule.
+//
+// Returns:
+//   - bool: true if the rule is valid, false otherwise.
+func (r DelinquencyRule) IsValid() bool {
+	return r.MissedInstallmentThreshold >= 0 && r.PartialPaymentRule.IsValid()
+}
+
+// delinquencyRule returns the delinquency rule of the loan.
+//
+// Returns:
+//   - DelinquencyRule: The loan's delinquency rule, or DefaultDelinquencyRule if the loan has none.
+func (l *Loan) delinquencyRule() DelinquencyRule {
+	if l.DelinquencyRule == nil {
+		return DefaultDelinquencyRule()
+	}
+
+	return *l.DelinquencyRule
+}
+
+// DelinquencyBuckets represents the boundaries of the buckets loans are classified in by their days past due.
+//
+// Each boundary is the highest number of days past due of a bucket, in ascending order. The first bucket starts
+// at zero days past due, each following bucket starts the day after the boundary of the previous one, and a last,
+// unbounded bucket holds the loans past the last boundary.
+type DelinquencyBuckets []int32
+
+// IsValid checks if the DelinquencyBuckets has at least one boundary, and its boundaries are not negative
+// and in strictly ascending order.
+//
+// Returns:
+//   - bool: true if the boundaries are valid, false otherwise.
+func (b DelinquencyBuckets) IsValid() bool {
+	if len(b) == 0 || b[0] < 0 {
+		return false
+	}
+
+	for i := 1; i < len(b); i++ {
+		if b[i] <= b[i-1] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Bucket returns the label of the bucket the given days past due falls in.
+//
+// The bucket of zero days past due only is labelled "current", a bucket of a single day is labelled with
+// the day, e.g. "1", the other bounded buckets are labelled with their range, e.g. "8-30", and the unbounded
+// bucket is labelled with the last boundary followed by a plus sign, e.g. "90+".
+//
+// Parameters:
+//   - daysPastDue: The number of days the loan is past due.
+//
+// Returns:
+//   - string: The label of the bucket.
+func (b DelinquencyBuckets) Bucket(daysPastDue int32) string {
+	lowerBound := int32(0)
+	for _, upperBound := range b {
+		if daysPastDue <= upperBound {
+			switch {
+			case upperBound == 0:
+				return "current"
+			case lowerBound == upperBound:
+				return fmt.Sprintf("%d", upperBound)
+			default:
+				return fmt.Sprintf("%d-%d", lowerBound, upperBound)
+			}
+		}
+
+		lowerBound = upperBound + 1
+	}
+
+	if len(b) == 0 {
+		return "current"
+	}
+
+	return fmt.Sprintf("%d+", b[len(b)-1])
+}
+
+// oldestUnpaidInstallment finds the oldest due installment that is not fully paid.
+//
+// Parameters:
+//   - now: The current time used to determine the due installments.
+//
+// Returns:
+//   - *LoanInstallment: The oldest due installment with an outstanding amount, or nil if the loan is paid
+//     or all its due installments are paid.
+func (l *Loan) oldestUnpaidInstallment(now time.Time) *LoanInstallment {
+	if l == nil || l.Status == LoanStatusPaid {
+		return nil
+	}
+
+	for _, installment := range l.dueInstallments(now) {
+		if installment.OutstandingAmount().IsPositive() {
+			return installment
+		}
+	}
+
+	return nil
+}
+
+// OldestUnpaidInstallmentDate determines the due date of the oldest due installment that is not fully paid.
+//
+// Parameters:
+//   - now: The current time used to determine the due installments.
+//
+// Returns:
+//   - time.Time: The due date of the oldest due installment not fully paid, or the zero time if there is none.
+func (l *Loan) OldestUnpaidInstallmentDate(now time.Time) time.Time {
+	installment := l.oldestUnpaidInstallment(now)
+	if installment == nil {
+		return time.Time{}
+	}
+
+	return installment.DueDate
+}
+
+// DaysPastDue calculates the number of days past due (DPD) of the loan.
+//
+// The days are counted in calendar dates of the loan's billing calendar, from the due date of the oldest
+// due installment that is not fully paid. The grace period of the loan is not deducted, so an installment
+// within its grace period is already past due. The days within a payment holiday of the loan are not counted.
+//
+// Parameters:
+//   - now: The current time used to determine the due installments.
+//
+// Returns:
+//   - int32: The number of days past due, or zero if all the due installments are paid.
+func (l *Loan) DaysPastDue(now time.Time) int32 {
+	installment := l.oldestUnpaidInstallment(now)
+	if installment == nil {
+		return 0
+	}
+
+	daysPastDue := l.billingCalendar().daysBetween(installment.DueDate, now)
+	return max(0, daysPastDue-l.holidayDaysBetween(installment.DueDate, now))
+}
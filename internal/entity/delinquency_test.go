@@ -0,0 +1,194 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestDelinquencyRule_IsValid(t *testing.T) {
+	tests := []struct {
+		name string
+		rule DelinquencyRule
+		want bool
+	}{
+		{name: "default rule", rule: DefaultDelinquencyRule(), want: true},
+		{name: "zero threshold", rule: DelinquencyRule{MissedInstallmentThreshold: 0, PartialPaymentRule: PartialPaymentCountsAsPaid}, want: true},
+		{name: "negative threshold", rule: DelinquencyRule{MissedInstallmentThreshold: -1}, want: false},
+		{name: "unknown partial payment rule", rule: DelinquencyRule{MissedInstallmentThreshold: 2, PartialPaymentRule: PartialPaymentRule(2)}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.IsValid(); got != tt.want {
+				t.Fatalf("expecting %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDelinquencyBuckets_IsValid(t *testing.T) {
+	tests := []struct {
+		name    string
+		buckets DelinquencyBuckets
+		want    bool
+	}{
+		{name: "default buckets", buckets: DefaultDelinquencyBuckets(), want: true},
+		{name: "single boundary", buckets: DelinquencyBuckets{30}, want: true},
+		{name: "no boundaries", buckets: DelinquencyBuckets{}, want: false},
+		{name: "negative boundary", buckets: DelinquencyBuckets{-1, 7}, want: false},
+		{name: "repeated boundary", buckets: DelinquencyBuckets{0, 7, 7}, want: false},
+		{name: "descending boundaries", buckets: DelinquencyBuckets{0, 30, 7}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.buckets.IsValid(); got != tt.want {
+				t.Fatalf("expecting %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDelinquencyBuckets_Bucket(t *testing.T) {
+	tests := []struct {
+		name        string
+		buckets     DelinquencyBuckets
+		daysPastDue int32
+		want        string
+	}{
+		{name: "current", buckets: DefaultDelinquencyBuckets(), daysPastDue: 0, want: "current"},
+		{name: "first day past due", buckets: DefaultDelinquencyBuckets(), daysPastDue: 1, want: "1-7"},
+		{name: "last day of a bucket", buckets: DefaultDelinquencyBuckets(), daysPastDue: 7, want: "1-7"},
+		{name: "first day of a bucket", buckets: DefaultDelinquencyBuckets(), daysPastDue: 8, want: "8-30"},
+		{name: "sixty one days past due", buckets: DefaultDelinquencyBuckets(), daysPastDue: 61, want: "61-90"},
+		{name: "past the last boundary", buckets: DefaultDelinquencyBuckets(), daysPastDue: 91, want: "90+"},
+		{name: "single day bucket", buckets: DelinquencyBuckets{0, 1, 30}, daysPastDue: 1, want: "1"},
+		{name: "no current bucket", buckets: DelinquencyBuckets{30, 90}, daysPastDue: 0, want: "0-30"},
+		{name: "no boundaries", buckets: DelinquencyBuckets{}, daysPastDue: 10, want: "current"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.buckets.Bucket(tt.daysPastDue); got != tt.want {
+				t.Fatalf("expecting %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLoan_DaysPastDue(t *testing.T) {
+	createdAt := time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC) // Monday
+	now := time.Date(2024, time.March, 20, 10, 0, 0, 0, time.UTC)      // Wednesday two weeks later
+
+	// installments 1 and 2 are due on March 11 and 18, installment 3 is not due yet
+	newLoan := func(status LoanStatus) *Loan {
+		loan := &Loan{
+			Status:           status,
+			PaymentAmount:    decimal.NewFromInt(300),
+			InstallmentCount: 3,
+			GracePeriodDays:  3,
+			CreatedAt:        createdAt,
+			DisbursedAt:      createdAt,
+		}
+		loan.Installments = loan.generateSchedule()
+
+		return loan
+	}
+
+	tests := []struct {
+		name           string
+		loan           *Loan
+		paidAmount     decimal.Decimal
+		wantDays       int32
+		wantOldestDate time.Time
+		wantBucket     string
+	}{
+		{
+			name:           "nil loan",
+			loan:           nil,
+			paidAmount:     decimal.Zero,
+			wantDays:       0,
+			wantOldestDate: time.Time{},
+			wantBucket:     "current",
+		},
+		{
+			name:           "paid loan",
+			loan:           newLoan(LoanStatusPaid),
+			paidAmount:     decimal.Zero,
+			wantDays:       0,
+			wantOldestDate: time.Time{},
+			wantBucket:     "current",
+		},
+		{
+			name:           "loan not disbursed yet",
+			loan:           newLoan(LoanStatusApproved),
+			paidAmount:     decimal.Zero,
+			wantDays:       0,
+			wantOldestDate: time.Time{},
+			wantBucket:     "current",
+		},
+		{
+			name:           "oldest installment unpaid",
+			loan:           newLoan(LoanStatusDisbursed),
+			paidAmount:     decimal.Zero,
+			wantDays:       9,
+			wantOldestDate: time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC),
+			wantBucket:     "8-30",
+		},
+		{
+			name:           "oldest installment partially paid",
+			loan:           newLoan(LoanStatusOngoing),
+			paidAmount:     decimal.NewFromInt(50),
+			wantDays:       9,
+			wantOldestDate: time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC),
+			wantBucket:     "8-30",
+		},
+		{
+			name:           "oldest installment covered",
+			loan:           newLoan(LoanStatusOngoing),
+			paidAmount:     decimal.NewFromInt(100),
+			wantDays:       2,
+			wantOldestDate: time.Date(2024, time.March, 18, 0, 0, 0, 0, time.UTC),
+			wantBucket:     "1-7",
+		},
+		{
+			name: "payment allocated to a charge does not cover an installment",
+			loan: func() *Loan {
+				loan := newLoan(LoanStatusOngoing)
+				loan.Installments[0].pay(decimal.NewFromInt(100), createdAt)
+				loan.Charges = []*LoanCharge{{Amount: decimal.NewFromInt(50), AmountPaid: decimal.NewFromInt(50)}}
+				return loan
+			}(),
+			paidAmount:     decimal.Zero,
+			wantDays:       2,
+			wantOldestDate: time.Date(2024, time.March, 18, 0, 0, 0, 0, time.UTC),
+			wantBucket:     "1-7",
+		},
+		{
+			name:           "every due installment covered",
+			loan:           newLoan(LoanStatusOngoing),
+			paidAmount:     decimal.NewFromInt(200),
+			wantDays:       0,
+			wantOldestDate: time.Time{},
+			wantBucket:     "current",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payLoan(tt.loan, tt.paidAmount, now)
+
+			if got := tt.loan.DaysPastDue(now); got != tt.wantDays {
+				t.Errorf("expecting %d days past due, got %d", tt.wantDays, got)
+			}
+			if got := tt.loan.OldestUnpaidInstallmentDate(now); !got.Equal(tt.wantOldestDate) {
+				t.Errorf("expecting oldest unpaid installment date %v, got %v", tt.wantOldestDate, got)
+			}
+			if got := DefaultDelinquencyBuckets().Bucket(tt.loan.DaysPastDue(now)); got != tt.wantBucket {
+				t.Errorf("expecting delinquency bucket %q, got %q", tt.wantBucket, got)
+			}
+		})
+	}
+}
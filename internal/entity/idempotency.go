@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/common/businesserror"
+)
+
+const maxIdempotencyKeyLength = 255 // Maximum length of a client-supplied idempotency key
+
+var (
+	ErrInvalidIdempotencyKey  = businesserror.New("idempotency key must be at most 255 characters", businesserror.KindBadRequest)
+	ErrIdempotencyKeyConflict = businesserror.New("idempotency key has already been used for a different request", businesserror.KindConflict)
+)
+
+// ValidateIdempotencyKey checks that a client-supplied idempotency key can be stored.
+//
+// Parameters:
+//   - key: The idempotency key of the request, empty if the request is not idempotent.
+//
+// Returns:
+//   - error: ErrInvalidIdempotencyKey if the key is too long, nil otherwise.
+func ValidateIdempotencyKey(key string) error {
+	if len(key) > maxIdempotencyKeyLength {
+		return ErrInvalidIdempotencyKey
+	}
+
+	return nil
+}
+
+// ValidateReplay checks that a request replayed with the idempotency key of the loan has the same payload
+// as the request the loan was created by.
+//
+// Parameters:
+//   - userID: The unique identifier of the user of the replayed request.
+//   - productID: The unique identifier of the loan product of the replayed request.
+//   - amount: The principal amount of the replayed request.
+//   - installmentCount: The number of installments of the replayed request.
+//
+// Returns:
+//   - error: ErrIdempotencyKeyConflict if the payloads differ, nil otherwise.
+func (l *Loan) ValidateReplay(userID, productID uuid.UUID, amount decimal.Decimal, installmentCount int32) error {
+	if l == nil {
+		return ErrLoanNotFound
+	}
+
+	if l.UserID != userID || l.ProductID != productID || !l.Amount.Equal(amount) || l.InstallmentCount != installmentCount {
+		return ErrIdempotencyKeyConflict
+	}
+
+	return nil
+}
+
+// ValidateReplay checks that a request replayed with the idempotency key of the payment has the same payload
+// as the request the payment was made by.
+//
+// Parameters:
+//   - loanID: The unique identifier of the loan of the replayed request.
+//   - amount: The payment amount of the replayed request.
+//   - mode: The payment mode of the replayed request.
+//   - details: The payment details of the replayed request.
+//
+// Returns:
+//   - error: ErrIdempotencyKeyConflict if the payloads differ, nil otherwise.
+func (lp *LoanPayment) ValidateReplay(loanID uuid.UUID, amount decimal.Decimal, mode PaymentMode, details PaymentDetails) error {
+	if lp.LoanID != loanID || !lp.ReceivedAmount().Equal(amount) || lp.Mode != mode || !lp.Details.sameAs(details) {
+		return ErrIdempotencyKeyConflict
+	}
+
+	return nil
+}
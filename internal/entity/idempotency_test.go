@@ -0,0 +1,221 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestValidateIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		wantErr error
+	}{
+		{
+			name:    "empty key",
+			key:     "",
+			wantErr: nil,
+		},
+		{
+			name:    "key at max length",
+			key:     strings.Repeat("k", maxIdempotencyKeyLength),
+			wantErr: nil,
+		},
+		{
+			name:    "key too long",
+			key:     strings.Repeat("k", maxIdempotencyKeyLength+1),
+			wantErr: ErrInvalidIdempotencyKey,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := ValidateIdempotencyKey(test.key); !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestLoan_ValidateReplay(t *testing.T) {
+	loan := &Loan{
+		UserID:           uuid.New(),
+		ProductID:        uuid.New(),
+		Amount:           decimal.NewFromInt(5_000_000),
+		InstallmentCount: 5,
+		IdempotencyKey:   "create-loan-1",
+	}
+
+	tests := []struct {
+		name             string
+		loan             *Loan
+		userID           uuid.UUID
+		productID        uuid.UUID
+		amount           decimal.Decimal
+		installmentCount int32
+		wantErr          error
+	}{
+		{
+			name:             "nil loan",
+			loan:             nil,
+			userID:           loan.UserID,
+			productID:        loan.ProductID,
+			amount:           loan.Amount,
+			installmentCount: loan.InstallmentCount,
+			wantErr:          ErrLoanNotFound,
+		},
+		{
+			name:             "same request",
+			loan:             loan,
+			userID:           loan.UserID,
+			productID:        loan.ProductID,
+			amount:           decimal.RequireFromString("5000000.00"),
+			installmentCount: loan.InstallmentCount,
+			wantErr:          nil,
+		},
+		{
+			name:             "different user",
+			loan:             loan,
+			userID:           uuid.New(),
+			productID:        loan.ProductID,
+			amount:           loan.Amount,
+			installmentCount: loan.InstallmentCount,
+			wantErr:          ErrIdempotencyKeyConflict,
+		},
+		{
+			name:             "different amount",
+			loan:             loan,
+			userID:           loan.UserID,
+			productID:        loan.ProductID,
+			amount:           decimal.NewFromInt(4_000_000),
+			installmentCount: loan.InstallmentCount,
+			wantErr:          ErrIdempotencyKeyConflict,
+		},
+		{
+			name:             "different installment count",
+			loan:             loan,
+			userID:           loan.UserID,
+			productID:        loan.ProductID,
+			amount:           loan.Amount,
+			installmentCount: 10,
+			wantErr:          ErrIdempotencyKeyConflict,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.loan.ValidateReplay(test.userID, test.productID, test.amount, test.installmentCount)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestLoanPayment_ValidateReplay(t *testing.T) {
+	payment := &LoanPayment{
+		ID:             uuid.New(),
+		LoanID:         uuid.New(),
+		Amount:         decimal.NewFromInt(110_000),
+		Mode:           PaymentModeRegular,
+		IdempotencyKey: "payment-1",
+		Details: PaymentDetails{
+			ExternalReference: "TRX-001",
+			Channel:           PaymentChannelBankTransfer,
+			PaidAt:            time.Date(2024, time.January, 3, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	tests := []struct {
+		name    string
+		loanID  uuid.UUID
+		amount  decimal.Decimal
+		mode    PaymentMode
+		details PaymentDetails
+		wantErr error
+	}{
+		{
+			name:    "same request",
+			loanID:  payment.LoanID,
+			amount:  payment.Amount,
+			mode:    PaymentModeRegular,
+			details: payment.Details,
+			wantErr: nil,
+		},
+		{
+			name:    "same request without value date",
+			loanID:  payment.LoanID,
+			amount:  payment.Amount,
+			mode:    PaymentModeRegular,
+			details: PaymentDetails{ExternalReference: "TRX-001", Channel: PaymentChannelBankTransfer},
+			wantErr: nil,
+		},
+		{
+			name:    "different external reference",
+			loanID:  payment.LoanID,
+			amount:  payment.Amount,
+			mode:    PaymentModeRegular,
+			details: PaymentDetails{ExternalReference: "TRX-002", Channel: PaymentChannelBankTransfer},
+			wantErr: ErrIdempotencyKeyConflict,
+		},
+		{
+			name:   "different metadata",
+			loanID: payment.LoanID,
+			amount: payment.Amount,
+			mode:   PaymentModeRegular,
+			details: PaymentDetails{
+				ExternalReference: "TRX-001",
+				Channel:           PaymentChannelBankTransfer,
+				Metadata:          map[string]string{"note": "retry"},
+			},
+			wantErr: ErrIdempotencyKeyConflict,
+		},
+		{
+			name:    "different loan",
+			loanID:  uuid.New(),
+			amount:  payment.Amount,
+			mode:    PaymentModeRegular,
+			details: payment.Details,
+			wantErr: ErrIdempotencyKeyConflict,
+		},
+		{
+			name:    "different amount",
+			loanID:  payment.LoanID,
+			amount:  decimal.NewFromInt(220_000),
+			mode:    PaymentModeRegular,
+			details: payment.Details,
+			wantErr: ErrIdempotencyKeyConflict,
+		},
+		{
+			name:    "different mode",
+			loanID:  payment.LoanID,
+			amount:  payment.Amount,
+			mode:    PaymentModePrepayment,
+			details: payment.Details,
+			wantErr: ErrIdempotencyKeyConflict,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := payment.ValidateReplay(test.loanID, test.amount, test.mode, test.details)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+		})
+	}
+	t.Run("same request over the accepted amount", func(t *testing.T) {
+		overpayment := *payment
+		overpayment.Amount = decimal.NewFromInt(100_000)
+		overpayment.ExcessAmount = decimal.NewFromInt(10_000)
+
+		if err := overpayment.ValidateReplay(payment.LoanID, payment.Amount, PaymentModeRegular, payment.Details); err != nil {
+			t.Fatalf("expecting error to be nil, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+var defaultFlatInterestRate = decimal.NewFromFloat(0.1)
+
+// InterestModel represents the method used to calculate the interest of a loan.
+type InterestModel int
+
+const (
+	// InterestModelFlat charges the interest rate once on the principal amount,
+	// regardless of the loan duration.
+	InterestModelFlat InterestModel = iota
+
+	// InterestModelDecliningBalance charges the interest rate on the outstanding principal
+	// on every installment period, resulting in an equal (annuity) installment amount.
+	InterestModelDecliningBalance
+
+	// InterestModelZeroInterest charges no interest at all, e.g. for promotional loans.
+	InterestModelZeroInterest
+)
+
+// IsValid checks if the InterestModel is a valid interest model.
+//
+// Returns:
+//   - bool: true if the model is one of the predefined interest models, false otherwise.
+func (m InterestModel) IsValid() bool {
+	return m == InterestModelFlat || m == InterestModelDecliningBalance || m == InterestModelZeroInterest
+}
+
+// DefaultRate returns the interest rate used when none is specified for the interest model.
+//
+// Returns:
+//   - decimal.Decimal: 10% for the flat model, zero for the others.
+func (m InterestModel) DefaultRate() decimal.Decimal {
+	if m == InterestModelFlat {
+		return defaultFlatInterestRate
+	}
+
+	return decimal.Zero
+}
+
+// validateRate checks whether the given interest rate can be used with the interest model.
+//
+// Parameters:
+//   - rate: The interest rate to be validated.
+//
+// Returns:
+//   - bool: true if the rate is not negative, and is zero for the zero interest model.
+func (m InterestModel) validateRate(rate decimal.Decimal) bool {
+	if rate.IsNegative() {
+		return false
+	}
+
+	if m == InterestModelZeroInterest && !rate.IsZero() {
+		return false
+	}
+
+	return true
+}
+
+// totalInterest calculates the total interest to be paid for a loan using the interest model.
+//
+// For the flat model, the rate is applied once to the principal amount. For the declining balance model,
+// the rate is applied per installment period to the outstanding principal, and the interest is the
+// difference between the total of the equal installments and the principal amount.
+//
+// Parameters:
+//   - principal: The principal amount of the loan.
+//   - rate: The interest rate of the loan.
+//   - periods: The number of installment periods of the loan.
+//
+// Returns:
+//   - decimal.Decimal: The total interest, rounded up to the nearest whole number.
+func (m InterestModel) totalInterest(principal, rate decimal.Decimal, periods int32) decimal.Decimal {
+	switch m {
+	case InterestModelFlat:
+		return principal.Mul(rate).RoundUp(0)
+	case InterestModelDecliningBalance:
+		if rate.IsZero() || periods <= 0 {
+			return decimal.Zero
+		}
+
+		// annuity installment: P * r / (1 - (1 + r)^-n)
+		growth := decimal.NewFromInt(1).Add(rate).Pow(decimal.NewFromInt32(periods))
+		installment := principal.Mul(rate).Mul(growth).Div(growth.Sub(decimal.NewFromInt(1)))
+		return installment.Mul(decimal.NewFromInt32(periods)).Sub(principal).RoundUp(0)
+	default:
+		return decimal.Zero
+	}
+}
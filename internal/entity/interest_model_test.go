@@ -0,0 +1,179 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestInterestModel_IsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		model InterestModel
+		want  bool
+	}{
+		{
+			name:  "flat",
+			model: InterestModelFlat,
+			want:  true,
+		},
+		{
+			name:  "declining balance",
+			model: InterestModelDecliningBalance,
+			want:  true,
+		},
+		{
+			name:  "zero interest",
+			model: InterestModelZeroInterest,
+			want:  true,
+		},
+		{
+			name:  "unknown model",
+			model: InterestModel(-1),
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.model.IsValid(); got != tt.want {
+				t.Fatalf("expecting %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestInterestModel_DefaultRate(t *testing.T) {
+	tests := []struct {
+		name  string
+		model InterestModel
+		want  decimal.Decimal
+	}{
+		{
+			name:  "flat",
+			model: InterestModelFlat,
+			want:  decimal.NewFromFloat(0.1),
+		},
+		{
+			name:  "declining balance",
+			model: InterestModelDecliningBalance,
+			want:  decimal.Zero,
+		},
+		{
+			name:  "zero interest",
+			model: InterestModelZeroInterest,
+			want:  decimal.Zero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.model.DefaultRate(); !got.Equal(tt.want) {
+				t.Fatalf("expecting %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestInterestModel_validateRate(t *testing.T) {
+	tests := []struct {
+		name  string
+		model InterestModel
+		rate  decimal.Decimal
+		want  bool
+	}{
+		{
+			name:  "negative rate",
+			model: InterestModelFlat,
+			rate:  decimal.NewFromFloat(-0.1),
+			want:  false,
+		},
+		{
+			name:  "flat with positive rate",
+			model: InterestModelFlat,
+			rate:  decimal.NewFromFloat(0.1),
+			want:  true,
+		},
+		{
+			name:  "zero interest with positive rate",
+			model: InterestModelZeroInterest,
+			rate:  decimal.NewFromFloat(0.1),
+			want:  false,
+		},
+		{
+			name:  "zero interest with zero rate",
+			model: InterestModelZeroInterest,
+			rate:  decimal.Zero,
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.model.validateRate(tt.rate); got != tt.want {
+				t.Fatalf("expecting %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestInterestModel_totalInterest(t *testing.T) {
+	tests := []struct {
+		name      string
+		model     InterestModel
+		principal decimal.Decimal
+		rate      decimal.Decimal
+		periods   int32
+		want      decimal.Decimal
+	}{
+		{
+			name:      "flat",
+			model:     InterestModelFlat,
+			principal: decimal.NewFromInt(5_000_000),
+			rate:      decimal.NewFromFloat(0.1),
+			periods:   50,
+			want:      decimal.NewFromInt(500_000),
+		},
+		{
+			name:      "flat rounds up",
+			model:     InterestModelFlat,
+			principal: decimal.NewFromInt(1_001),
+			rate:      decimal.NewFromFloat(0.1),
+			periods:   5,
+			want:      decimal.NewFromInt(101),
+		},
+		{
+			name:      "declining balance",
+			model:     InterestModelDecliningBalance,
+			principal: decimal.NewFromInt(1_000_000),
+			rate:      decimal.NewFromFloat(0.1),
+			periods:   2,
+			want:      decimal.NewFromInt(152_381),
+		},
+		{
+			name:      "declining balance with zero rate",
+			model:     InterestModelDecliningBalance,
+			principal: decimal.NewFromInt(1_000_000),
+			rate:      decimal.Zero,
+			periods:   2,
+			want:      decimal.Zero,
+		},
+		{
+			name:      "zero interest",
+			model:     InterestModelZeroInterest,
+			principal: decimal.NewFromInt(1_000_000),
+			rate:      decimal.Zero,
+			periods:   10,
+			want:      decimal.Zero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.model.totalInterest(tt.principal, tt.rate, tt.periods)
+			if !got.Equal(tt.want) {
+				t.Fatalf("expecting total interest to be %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
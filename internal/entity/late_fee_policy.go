@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// LateFeeMethod represents how the late fee of a missed installment is calculated.
+type LateFeeMethod int
+
+const (
+	// LateFeeMethodNone charges no late fee at all.
+	LateFeeMethodNone LateFeeMethod = iota
+
+	// LateFeeMethodFixedPerMissedInstallment charges a fixed fee for every missed installment.
+	LateFeeMethodFixedPerMissedInstallment
+
+	// LateFeeMethodPercentageOfOverdue charges a rate of the overdue amount of every missed installment.
+	LateFeeMethodPercentageOfOverdue
+)
+
+// IsValid checks if the LateFeeMethod is a valid late fee method.
+//
+// Returns:
+//   - bool: true if the method is one of the predefined late fee methods, false otherwise.
+func (m LateFeeMethod) IsValid() bool {
+	return m == LateFeeMethodNone || m == LateFeeMethodFixedPerMissedInstallment || m == LateFeeMethodPercentageOfOverdue
+}
+
+// LateFeePolicy represents the late fees charged for the missed installments of a loan.
+type LateFeePolicy struct {
+	// Method is the method used to calculate the late fee of a missed installment.
+	Method LateFeeMethod
+
+	// Amount is the fixed fee of a missed installment for LateFeeMethodFixedPerMissedInstallment,
+	// or the rate of the overdue amount for LateFeeMethodPercentageOfOverdue.
+	Amount decimal.Decimal
+
+	// Cap is the maximum total late fees charged on a loan. There is no cap when it is zero.
+	Cap decimal.Decimal
+}
+
+// IsValid checks if the LateFeePolicy has a valid method, amount and cap.
+//
+// Returns:
+//   - bool: true if the method is valid, the amount and cap are not negative,
+//     and the rate is at most 1 for LateFeeMethodPercentageOfOverdue, false otherwise.
+func (p LateFeePolicy) IsValid() bool {
+	if !p.Method.IsValid() || p.Amount.IsNegative() || p.Cap.IsNegative() {
+		return false
+	}
+
+	if p.Method == LateFeeMethodPercentageOfOverdue && p.Amount.GreaterThan(decimal.NewFromInt(1)) {
+		return false
+	}
+
+	return true
+}
+
+// fee calculates the late fee of a missed installment, limited by the cap.
+//
+// Parameters:
+//   - overdueAmount: The amount of the installment that is still unpaid.
+//   - chargedAmount: The total late fees already charged on the loan.
+//   - precision: The number of decimal places of the currency minor unit the fee is rounded down to.
+//
+// Returns:
+//   - decimal.Decimal: The late fee to be charged, zero if no fee is charged or the cap has been reached.
+func (p LateFeePolicy) fee(overdueAmount, chargedAmount decimal.Decimal, precision int32) decimal.Decimal {
+	var fee decimal.Decimal
+	switch p.Method {
+	case LateFeeMethodFixedPerMissedInstallment:
+		fee = p.Amount
+	case LateFeeMethodPercentageOfOverdue:
+		fee = overdueAmount.Mul(p.Amount).RoundDown(precision)
+	default:
+		return decimal.Zero
+	}
+
+	if p.Cap.IsPositive() {
+		fee = decimal.Min(fee, p.Cap.Sub(chargedAmount))
+	}
+
+	return decimal.Max(decimal.Zero, fee)
+}
@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestLateFeePolicy_IsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy LateFeePolicy
+		want   bool
+	}{
+		{
+			name:   "no late fee",
+			policy: LateFeePolicy{},
+			want:   true,
+		},
+		{
+			name:   "fixed per missed installment with cap",
+			policy: LateFeePolicy{Method: LateFeeMethodFixedPerMissedInstallment, Amount: decimal.NewFromInt(10_000), Cap: decimal.NewFromInt(50_000)},
+			want:   true,
+		},
+		{
+			name:   "percentage of overdue",
+			policy: LateFeePolicy{Method: LateFeeMethodPercentageOfOverdue, Amount: decimal.NewFromFloat(0.05)},
+			want:   true,
+		},
+		{
+			name:   "unknown method",
+			policy: LateFeePolicy{Method: LateFeeMethod(-1)},
+			want:   false,
+		},
+		{
+			name:   "negative amount",
+			policy: LateFeePolicy{Method: LateFeeMethodFixedPerMissedInstallment, Amount: decimal.NewFromInt(-1)},
+			want:   false,
+		},
+		{
+			name:   "negative cap",
+			policy: LateFeePolicy{Method: LateFeeMethodFixedPerMissedInstallment, Amount: decimal.NewFromInt(10_000), Cap: decimal.NewFromInt(-1)},
+			want:   false,
+		},
+		{
+			name:   "percentage over 100%",
+			policy: LateFeePolicy{Method: LateFeeMethodPercentageOfOverdue, Amount: decimal.NewFromFloat(1.5)},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.IsValid(); got != tt.want {
+				t.Fatalf("expecting %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLateFeePolicy_fee(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        LateFeePolicy
+		overdueAmount decimal.Decimal
+		chargedAmount decimal.Decimal
+		want          decimal.Decimal
+	}{
+		{
+			name:          "no late fee",
+			policy:        LateFeePolicy{Method: LateFeeMethodNone, Amount: decimal.NewFromInt(10_000)},
+			overdueAmount: decimal.NewFromInt(100_000),
+			chargedAmount: decimal.Zero,
+			want:          decimal.Zero,
+		},
+		{
+			name:          "fixed per missed installment",
+			policy:        LateFeePolicy{Method: LateFeeMethodFixedPerMissedInstallment, Amount: decimal.NewFromInt(10_000)},
+			overdueAmount: decimal.NewFromInt(100_000),
+			chargedAmount: decimal.NewFromInt(30_000),
+			want:          decimal.NewFromInt(10_000),
+		},
+		{
+			name:          "percentage of overdue, rounded down",
+			policy:        LateFeePolicy{Method: LateFeeMethodPercentageOfOverdue, Amount: decimal.NewFromFloat(0.05)},
+			overdueAmount: decimal.NewFromInt(100_010),
+			chargedAmount: decimal.Zero,
+			want:          decimal.NewFromInt(5_000),
+		},
+		{
+			name:          "limited by the cap",
+			policy:        LateFeePolicy{Method: LateFeeMethodFixedPerMissedInstallment, Amount: decimal.NewFromInt(10_000), Cap: decimal.NewFromInt(25_000)},
+			overdueAmount: decimal.NewFromInt(100_000),
+			chargedAmount: decimal.NewFromInt(20_000),
+			want:          decimal.NewFromInt(5_000),
+		},
+		{
+			name:          "cap already reached",
+			policy:        LateFeePolicy{Method: LateFeeMethodFixedPerMissedInstallment, Amount: decimal.NewFromInt(10_000), Cap: decimal.NewFromInt(25_000)},
+			overdueAmount: decimal.NewFromInt(100_000),
+			chargedAmount: decimal.NewFromInt(25_000),
+			want:          decimal.Zero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.fee(tt.overdueAmount, tt.chargedAmount, 0); !got.Equal(tt.want) {
+				t.Fatalf("expecting %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
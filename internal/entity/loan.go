@@ -9,26 +9,45 @@ import (
 	"github.com/axopadyani/billing-engine/internal/common/businesserror"
 )
 
-const delinquencyThresholdWeeks = 2 // Number of unpaid weeks to be considered delinquent
-
 var (
-	ErrLoanEmptyID                     = businesserror.New("loan id cannot be empty", businesserror.KindBadRequest)
-	ErrLoanEmptyUserID                 = businesserror.New("loan user id cannot be empty", businesserror.KindBadRequest)
-	ErrLoanInvalidAmount               = businesserror.New("loan amount must be greater than zero", businesserror.KindBadRequest)
-	ErrLoanInvalidPaymentDurationWeeks = businesserror.New("loan payment duration must be at least 1 week", businesserror.KindBadRequest)
-	ErrLoanInvalidPaymentAmount        = businesserror.New("loan payment amount must be greater than zero", businesserror.KindBadRequest)
-	ErrLoanInvalidStatus               = businesserror.New("invalid loan status", businesserror.KindBadRequest)
-	ErrLoanEmptyCreatedAt              = businesserror.New("created at cannot be empty", businesserror.KindBadRequest)
-	ErrLoanEmptyUpdatedAt              = businesserror.New("updated at cannot be empty", businesserror.KindBadRequest)
-	ErrLoanStillHasOngoingLoan         = businesserror.New("user still has ongoing loan", businesserror.KindUnprocessableEntity)
-	ErrLoanNotFound                    = businesserror.New("loan not found", businesserror.KindNotFound)
-	ErrLoanCurrentWeekAlreadyPaid      = businesserror.New("current week is already paid", businesserror.KindUnprocessableEntity)
-	ErrLoanNotExactPaymentAmount       = businesserror.New("loan payment amount does not match billing amount", businesserror.KindUnprocessableEntity)
-
-	interestRate = decimal.NewFromFloat(0.1)
+	ErrLoanEmptyID                   = businesserror.New("loan id cannot be empty", businesserror.KindBadRequest)
+	ErrLoanEmptyUserID               = businesserror.New("loan user id cannot be empty", businesserror.KindBadRequest)
+	ErrLoanEmptyProductID            = businesserror.New("loan product id cannot be empty", businesserror.KindBadRequest)
+	ErrLoanInvalidAmount             = businesserror.New("loan amount must be greater than zero", businesserror.KindBadRequest)
+	ErrLoanInvalidInstallmentCount   = businesserror.New("loan installment count must be at least 1", businesserror.KindBadRequest)
+	ErrLoanInvalidBillingFrequency   = businesserror.New("invalid loan billing frequency", businesserror.KindBadRequest)
+	ErrLoanInvalidPaymentAmount      = businesserror.New("loan payment amount must be greater than zero", businesserror.KindBadRequest)
+	ErrLoanInvalidInterestModel      = businesserror.New("invalid loan interest model", businesserror.KindBadRequest)
+	ErrLoanInvalidInterestRate       = businesserror.New("invalid loan interest rate for the interest model", businesserror.KindBadRequest)
+	ErrLoanInvalidRoundingPolicy     = businesserror.New("invalid loan rounding policy", businesserror.KindBadRequest)
+	ErrLoanInvalidAcceptanceMode     = businesserror.New("invalid loan payment acceptance mode", businesserror.KindBadRequest)
+	ErrLoanInvalidLateFeePolicy      = businesserror.New("invalid loan late fee policy", businesserror.KindBadRequest)
+	ErrLoanInvalidOverpaymentPolicy  = businesserror.New("invalid loan overpayment policy", businesserror.KindBadRequest)
+	ErrLoanInvalidRebateRate         = businesserror.New("loan early settlement rebate rate must be between 0 and 1", businesserror.KindBadRequest)
+	ErrLoanInvalidGracePeriod        = businesserror.New("loan grace period must be between 0 and 6 days", businesserror.KindBadRequest)
+	ErrLoanInvalidCoolingOffPeriod   = businesserror.New("loan cooling-off period cannot be negative", businesserror.KindBadRequest)
+	ErrLoanInvalidDefaultPeriod      = businesserror.New("loan default period cannot be negative", businesserror.KindBadRequest)
+	ErrLoanInvalidDelinquencyRule    = businesserror.New("invalid loan delinquency rule", businesserror.KindBadRequest)
+	ErrLoanInvalidBillingCalendar    = businesserror.New("invalid loan billing timezone or week start day", businesserror.KindBadRequest)
+	ErrLoanInvalidPaymentMode        = businesserror.New("invalid loan payment mode", businesserror.KindBadRequest)
+	ErrLoanInvalidStatus             = businesserror.New("invalid loan status", businesserror.KindBadRequest)
+	ErrLoanEmptyCreatedAt            = businesserror.New("created at cannot be empty", businesserror.KindBadRequest)
+	ErrLoanEmptyUpdatedAt            = businesserror.New("updated at cannot be empty", businesserror.KindBadRequest)
+	ErrLoanStillHasOngoingLoan       = businesserror.New("user still has ongoing loan", businesserror.KindUnprocessableEntity)
+	ErrLoanNotFound                  = businesserror.New("loan not found", businesserror.KindNotFound)
+	ErrLoanCurrentWeekAlreadyPaid    = businesserror.New("current week is already paid", businesserror.KindUnprocessableEntity)
+	ErrLoanNotExactPaymentAmount     = businesserror.New("loan payment amount does not match billing amount", businesserror.KindUnprocessableEntity)
+	ErrLoanPaymentExceedsBillAmount  = businesserror.New("loan payment amount exceeds billing amount", businesserror.KindUnprocessableEntity)
+	ErrLoanAlreadyPaid               = businesserror.New("loan is already paid", businesserror.KindUnprocessableEntity)
+	ErrLoanPaymentExceedsOutstanding = businesserror.New("loan payment amount exceeds outstanding amount", businesserror.KindUnprocessableEntity)
+	ErrLoanNotExactPayoffAmount      = businesserror.New("loan payment amount does not match payoff amount", businesserror.KindUnprocessableEntity)
+	ErrLoanNotDisbursed              = businesserror.New("loan is not disbursed yet", businesserror.KindUnprocessableEntity)
 )
 
 // LoanStatus represents the current state of a loan.
+//
+// A loan is created pending approval, and goes through PENDING_APPROVAL -> APPROVED -> DISBURSED -> ONGOING -> PAID,
+// unless it is rejected or cancelled before its first payment. See Loan.transitionTo for the allowed transitions.
 type LoanStatus int
 
 const (
@@ -37,6 +56,29 @@ const (
 
 	// LoanStatusPaid indicates that the loan has been fully paid off.
 	LoanStatusPaid
+
+	// LoanStatusPendingApproval indicates that the loan has been requested and is waiting to be approved or rejected.
+	LoanStatusPendingApproval
+
+	// LoanStatusApproved indicates that the loan has been approved and is waiting to be disbursed.
+	LoanStatusApproved
+
+	// LoanStatusDisbursed indicates that the loan amount has been disbursed and no payment has been made yet.
+	LoanStatusDisbursed
+
+	// LoanStatusRejected indicates that the loan has been rejected before it was disbursed.
+	LoanStatusRejected
+
+	// LoanStatusCancelled indicates that the loan has been cancelled by its user within the cooling-off period.
+	LoanStatusCancelled
+
+	// LoanStatusDefaulted indicates that the loan has been past due for longer than its default period.
+	// It is still being repaid until it is paid or written off.
+	LoanStatusDefaulted
+
+	// LoanStatusWrittenOff indicates that the outstanding amount of a defaulted loan has been written off as a loss.
+	// Only recovery payments are accepted towards it.
+	LoanStatusWrittenOff
 )
 
 // IsValid checks if the LoanStatus is a valid status.
@@ -44,15 +86,46 @@ const (
 // This method determines whether the LoanStatus is one of the predefined valid statuses.
 //
 // Returns:
-//   - bool: true if the status is either LoanStatusOngoing or LoanStatusPaid, false otherwise.
+//   - bool: true if the status is one of the predefined loan statuses, false otherwise.
 func (s LoanStatus) IsValid() bool {
-	return s == LoanStatusOngoing || s == LoanStatusPaid
+	switch s {
+	case LoanStatusOngoing, LoanStatusPaid, LoanStatusPendingApproval, LoanStatusApproved, LoanStatusDisbursed, LoanStatusRejected,
+		LoanStatusCancelled, LoanStatusDefaulted, LoanStatusWrittenOff:
+		return true
+	}
+
+	return false
+}
+
+// IsOpen checks if a loan with the status is still in progress, either waiting for a decision,
+// waiting to be disbursed or being repaid.
+//
+// Returns:
+//   - bool: true if the status is pending approval, approved, disbursed, ongoing or defaulted, false otherwise.
+func (s LoanStatus) IsOpen() bool {
+	return s == LoanStatusPendingApproval || s == LoanStatusApproved || s.isRepaying()
+}
+
+// isRepaying checks if a loan with the status has been disbursed and still has to be repaid.
+//
+// Returns:
+//   - bool: true if the status is disbursed, ongoing or defaulted, false otherwise.
+func (s LoanStatus) isRepaying() bool {
+	return s == LoanStatusDisbursed || s == LoanStatusOngoing || s == LoanStatusDefaulted
+}
+
+// isDisbursed checks if a loan with the status has been disbursed, and is billed.
+//
+// Returns:
+//   - bool: true if the status is disbursed, ongoing, defaulted or paid, false otherwise.
+func (s LoanStatus) isDisbursed() bool {
+	return s.isRepaying() || s == LoanStatusPaid
 }
 
 // Loan represents a loan entity in the system.
 //
 // It contains all the necessary information about a loan, including its
-// unique identifier, the user it belongs to, the loan amount, payment duration,
+// unique identifier, the user it belongs to, the loan amount, repayment terms,
 // total payment amount (including interest), current status, and timestamps.
 type Loan struct {
 	// ID is the unique identifier for the loan.
@@ -61,38 +134,160 @@ type Loan struct {
 	// UserID is the unique identifier of the user who took the loan.
 	UserID uuid.UUID
 
+	// ProductID is the unique identifier of the loan product the loan was created under.
+	ProductID uuid.UUID
+
 	// Amount is the principal amount of the loan.
 	Amount decimal.Decimal
 
-	// PaymentDurationWeeks is the duration of the loan in weeks.
-	PaymentDurationWeeks int32
+	// BillingFrequency is how often the installments of the loan are due.
+	BillingFrequency BillingFrequency
+
+	// InstallmentCount is the number of installments the loan is repaid in, one per billing period.
+	InstallmentCount int32
+
+	// InterestModel is the method used to calculate the interest of the loan.
+	InterestModel InterestModel
+
+	// InterestRate is the interest rate of the loan, interpreted according to the InterestModel.
+	InterestRate decimal.Decimal
 
 	// PaymentAmount is the total amount to be paid, including interest.
 	PaymentAmount decimal.Decimal
 
-	// Status represents the current state of the loan (e.g., ongoing, paid).
+	// RoundingPolicy is how the payment amount is split into installment amounts.
+	RoundingPolicy RoundingPolicy
+
+	// PaymentAcceptanceMode is which payment amounts are accepted towards the current bill.
+	PaymentAcceptanceMode PaymentAcceptanceMode
+
+	// OverpaymentPolicy is how a payment exceeding the amount accepted towards the loan is handled.
+	OverpaymentPolicy OverpaymentPolicy
+
+	// OriginationFee is the fee charged for the loan creation, based on the product's fee schedule.
+	OriginationFee decimal.Decimal
+
+	// LateFeePolicy is the late fees charged for the missed installments, based on the product's fee schedule.
+	LateFeePolicy LateFeePolicy
+
+	// EarlySettlementRebateRate is the portion of the unearned interest rebated when the loan is paid off early.
+	EarlySettlementRebateRate decimal.Decimal
+
+	// RebateAmount is the interest rebated when the loan was paid off early, zero otherwise.
+	RebateAmount decimal.Decimal
+
+	// GracePeriodDays is the number of days after an installment's due date before an unpaid installment
+	// counts toward delinquency and late fees.
+	GracePeriodDays int32
+
+	// CoolingOffDays is the number of days after the loan creation during which its user can cancel the loan.
+	// The loan cannot be cancelled when it is zero.
+	CoolingOffDays int32
+
+	// DefaultAfterWeeks is the number of weeks the loan can be past due before it is defaulted.
+	// The loan is never defaulted when it is zero.
+	DefaultAfterWeeks int32
+
+	// BillingCalendar is the timezone and week start day the billing weeks of the loan are calculated in.
+	// DefaultBillingCalendar is used when it is nil.
+	BillingCalendar *BillingCalendar
+
+	// DelinquencyRule is the rule the loan is considered delinquent by, recorded when the loan is created
+	// so that its delinquency is evaluated the same way later on. DefaultDelinquencyRule is used when it is nil.
+	DelinquencyRule *DelinquencyRule
+
+	// Status represents the current state of the loan (e.g., pending approval, ongoing, paid).
 	Status LoanStatus
 
+	// Installments is the repayment schedule of the loan, ordered by the installment number.
+	Installments []*LoanInstallment
+
+	// TermsVersion is the version of the terms the loan is repaid under, 1 for the terms it was created with,
+	// increased every time the loan is restructured.
+	TermsVersion int32
+
+	// RestructuredPrincipal is the outstanding amount the current schedule of the loan was restructured from,
+	// zero if the loan has not been restructured.
+	RestructuredPrincipal decimal.Decimal
+
+	// RestructuredFromInstallment is the number of the first installment of the current schedule of the loan
+	// when it was restructured, zero if the loan has not been restructured. The installments before it are
+	// kept from the previous terms of the loan.
+	RestructuredFromInstallment int32
+
+	// Charges is the charges applied to the loan on top of its repayment schedule, such as late fees.
+	Charges []*LoanCharge
+
+	// PaymentHolidays is the payment holidays granted on the loan, oldest first. The billing periods of a payment
+	// holiday are skipped, so the installments due after it are deferred by as many periods.
+	PaymentHolidays []*PaymentHoliday
+
+	// IdempotencyKey is the client-supplied key of the request the loan was created by, empty if none was given.
+	IdempotencyKey string
+
 	// CreatedAt is the timestamp when the loan was created.
 	CreatedAt time.Time
 
 	// UpdatedAt is the timestamp when the loan was last updated.
 	UpdatedAt time.Time
+
+	// ApprovedAt is the timestamp when the loan was approved, zero if it has not been approved.
+	ApprovedAt time.Time
+
+	// DisbursedAt is the timestamp when the loan amount was disbursed, zero if it has not been disbursed.
+	// The billing periods of the loan are counted from it.
+	DisbursedAt time.Time
+
+	// DefaultedAt is the timestamp when the loan was defaulted, zero if it has not been defaulted.
+	DefaultedAt time.Time
+
+	// WrittenOffAt is the timestamp when the loan was written off, zero if it has not been written off.
+	WrittenOffAt time.Time
+
+	// WrittenOffPrincipal is the principal outstanding on the installments when the loan was written off.
+	WrittenOffPrincipal decimal.Decimal
+
+	// WrittenOffInterest is the interest outstanding on the installments when the loan was written off.
+	WrittenOffInterest decimal.Decimal
+
+	// WrittenOffCharges is the charges outstanding when the loan was written off.
+	WrittenOffCharges decimal.Decimal
+
+	// RecoveredAmount is the amount recovered by the payments made after the loan was written off.
+	// The recovery payments are not counted in the paid amount of the loan.
+	RecoveredAmount decimal.Decimal
 }
 
-// validate checks if the Loan instance is valid by verifying all its fields.
+// validate checks if the Loan instance is valid by verifying all its fields
+// and the limits of the loan product it is created under.
 // It ensures that:
 // - The loan ID is not empty
 // - The user ID is not empty
 // - The loan amount is greater than zero
-// - The payment duration is at least 1 week
+// - The billing frequency is valid
+// - The installment count is at least 1
+// - The interest model is valid and the interest rate can be used with it
 // - The payment amount is greater than zero
+// - The rounding policy is valid
+// - The payment acceptance mode is valid
+// - The late fee policy is valid
+// - The early settlement rebate rate is between 0 and 1
+// - The grace period is shorter than a billing week
+// - The cooling-off period is not negative
+// - The default period is not negative
+// - The billing calendar, if any, has a known timezone and a valid week start day
+// - The delinquency rule, if any, is valid
 // - The loan status is valid
 // - The creation and update timestamps are not zero
+// - The product ID is not empty and matches the given product
+// - The loan amount and payment duration are within the product limits
+//
+// Parameters:
+//   - product: A pointer to the LoanProduct the loan is created under.
 //
 // Returns:
 //   - error: An error if any validation check fails, nil if the loan is valid.
-func (l *Loan) validate() error {
+func (l *Loan) validate(product *LoanProduct) error {
 	if l.ID == uuid.Nil {
 		return ErrLoanEmptyID
 	}
@@ -105,14 +300,66 @@ func (l *Loan) validate() error {
 		return ErrLoanInvalidAmount
 	}
 
-	if l.PaymentDurationWeeks <= 0 {
-		return ErrLoanInvalidPaymentDurationWeeks
+	if !l.BillingFrequency.IsValid() {
+		return ErrLoanInvalidBillingFrequency
+	}
+
+	if l.InstallmentCount <= 0 {
+		return ErrLoanInvalidInstallmentCount
+	}
+
+	if !l.InterestModel.IsValid() {
+		return ErrLoanInvalidInterestModel
+	}
+
+	if !l.InterestModel.validateRate(l.InterestRate) {
+		return ErrLoanInvalidInterestRate
 	}
 
 	if l.PaymentAmount.LessThanOrEqual(decimal.Zero) {
 		return ErrLoanInvalidPaymentAmount
 	}
 
+	if !l.RoundingPolicy.IsValid() {
+		return ErrLoanInvalidRoundingPolicy
+	}
+
+	if !l.PaymentAcceptanceMode.IsValid() {
+		return ErrLoanInvalidAcceptanceMode
+	}
+
+	if !l.OverpaymentPolicy.IsValid() {
+		return ErrLoanInvalidOverpaymentPolicy
+	}
+
+	if !l.LateFeePolicy.IsValid() {
+		return ErrLoanInvalidLateFeePolicy
+	}
+
+	if l.EarlySettlementRebateRate.IsNegative() || l.EarlySettlementRebateRate.GreaterThan(decimal.NewFromInt(1)) {
+		return ErrLoanInvalidRebateRate
+	}
+
+	if l.GracePeriodDays < 0 || l.GracePeriodDays >= 7 {
+		return ErrLoanInvalidGracePeriod
+	}
+
+	if l.CoolingOffDays < 0 {
+		return ErrLoanInvalidCoolingOffPeriod
+	}
+
+	if l.DefaultAfterWeeks < 0 {
+		return ErrLoanInvalidDefaultPeriod
+	}
+
+	if l.BillingCalendar != nil && !l.BillingCalendar.IsValid() {
+		return ErrLoanInvalidBillingCalendar
+	}
+
+	if l.DelinquencyRule != nil && !l.DelinquencyRule.IsValid() {
+		return ErrLoanInvalidDelinquencyRule
+	}
+
 	if !l.Status.IsValid() {
 		return ErrLoanInvalidStatus
 	}
@@ -125,61 +372,107 @@ func (l *Loan) validate() error {
 		return ErrLoanEmptyUpdatedAt
 	}
 
-	return nil
+	if l.ProductID == uuid.Nil {
+		return ErrLoanEmptyProductID
+	}
+
+	if product == nil || product.ID != l.ProductID {
+		return ErrLoanProductNotFound
+	}
+
+	return product.validateLoan(l)
 }
 
-// CreateLoan creates a new loan for a user with the specified details.
+// CreateLoan creates a new loan for a user under the given loan product with the specified details.
 //
 // Parameters:
+//   - product: A pointer to the LoanProduct the loan is created under.
 //   - userID: The unique identifier of the user taking the loan.
 //   - amount: The principal amount of the loan.
-//   - paymentDurationWeeks: The duration of the loan in weeks.
+//   - installmentCount: The number of installments the loan is repaid in, at the product's billing frequency.
+//   - now: The time the loan is created at, which starts its first billing period.
 //
 // Returns:
 //   - *Loan: A pointer to the newly created Loan instance if successful.
 //   - error: An error if the loan creation fails, nil otherwise.
 //
-// The function generates a new UUID for the loan, calculates the total payment amount
-// (including interest), and sets the initial status to ongoing. It also performs
-// validation on the created loan instance before returning.
-func CreateLoan(userID uuid.UUID, amount decimal.Decimal, paymentDurationWeeks int32) (*Loan, error) {
+// The function generates a new UUID for the loan, takes the billing frequency, and the billing calendar and
+// the delinquency rule of the product or the default ones, calculates the total payment amount
+// (including interest based on the product's interest model) and the origination fee,
+// generates the preview of the repayment schedule, and sets the initial status to pending approval. The schedule is
+// generated again from the disbursement time when the loan is disbursed. It also performs validation on the created loan
+// instance against the product before returning.
+func CreateLoan(product *LoanProduct, userID uuid.UUID, amount decimal.Decimal, installmentCount int32, now time.Time) (*Loan, error) {
+	if product == nil {
+		return nil, ErrLoanProductNotFound
+	}
+
 	loanID, err := uuid.NewV7()
 	if err != nil {
 		return nil, err
 	}
 
-	now := time.Now().UTC()
+	billingCalendar := DefaultBillingCalendar()
+	if product.BillingCalendar != nil {
+		billingCalendar = *product.BillingCalendar
+	}
+
+	delinquencyRule := DefaultDelinquencyRule()
+	if product.DelinquencyRule != nil {
+		delinquencyRule = *product.DelinquencyRule
+	}
+
+	now = now.UTC()
 	loan := &Loan{
-		ID:                   loanID,
-		UserID:               userID,
-		Amount:               amount,
-		PaymentDurationWeeks: paymentDurationWeeks,
-		PaymentAmount:        amount.Add(amount.Mul(interestRate).RoundUp(0)),
-		Status:               LoanStatusOngoing,
-		CreatedAt:            now,
-		UpdatedAt:            now,
+		ID:                        loanID,
+		UserID:                    userID,
+		ProductID:                 product.ID,
+		Amount:                    amount,
+		BillingFrequency:          product.BillingFrequency,
+		InstallmentCount:          installmentCount,
+		InterestModel:             product.InterestModel,
+		InterestRate:              product.InterestRate,
+		PaymentAmount:             amount.Add(product.InterestModel.totalInterest(amount, product.InterestRate, installmentCount)),
+		RoundingPolicy:            product.RoundingPolicy,
+		PaymentAcceptanceMode:     product.PaymentAcceptanceMode,
+		OverpaymentPolicy:         product.OverpaymentPolicy,
+		OriginationFee:            product.FeeSchedule.originationFee(amount),
+		LateFeePolicy:             product.FeeSchedule.LateFeePolicy,
+		EarlySettlementRebateRate: product.EarlySettlementRebateRate,
+		RebateAmount:              decimal.Zero,
+		GracePeriodDays:           product.GracePeriodDays,
+		CoolingOffDays:            product.CoolingOffDays,
+		DefaultAfterWeeks:         product.DefaultAfterWeeks,
+		BillingCalendar:           &billingCalendar,
+		DelinquencyRule:           &delinquencyRule,
+		Status:                    LoanStatusPendingApproval,
+		TermsVersion:              1,
+		CreatedAt:                 now,
+		UpdatedAt:                 now,
 	}
+	loan.Installments = loan.generateSchedule()
 
-	if err = loan.validate(); err != nil {
+	if err = loan.validate(product); err != nil {
 		return nil, err
 	}
 
 	return loan, nil
 }
 
-// ValidateLatestLoan checks if the user associated with this loan has any ongoing loans.
+// ValidateLatestLoan checks if the user associated with this loan has any open loans.
 // It compares the current loan with the latest loan to determine if a new loan can be created.
 //
 // Parameters:
 //   - latestLoan: A pointer to the most recent Loan instance for the user.
 //
 // Returns:
-//   - An error if the user has an ongoing loan, nil otherwise.
+//   - An error if the user has an open loan, nil otherwise.
 //
 // The function returns ErrLoanStillHasOngoingLoan if the user associated with this loan
-// already has an ongoing loan.
+// already has a loan that is pending approval, approved, disbursed, ongoing or defaulted. Rejected, cancelled, paid
+// and written-off loans do not block a new loan.
 func (l *Loan) ValidateLatestLoan(latestLoan *Loan) error {
-	if l != nil && latestLoan != nil && l.UserID == latestLoan.UserID && latestLoan.Status == LoanStatusOngoing {
+	if l != nil && latestLoan != nil && l.UserID == latestLoan.UserID && latestLoan.Status.IsOpen() {
 		return ErrLoanStillHasOngoingLoan
 	}
 
@@ -188,8 +481,9 @@ func (l *Loan) ValidateLatestLoan(latestLoan *Loan) error {
 
 // OutstandingAmount calculates the remaining amount to be paid on the loan.
 //
-// This method subtracts the paid amount from the total payment amount of the loan.
-// If the result is negative, it returns zero, ensuring the outstanding amount is never negative.
+// This method adds the charges and subtracts the rebated interest and the paid amount from the total payment amount of the loan.
+// If the result is negative, it returns zero, ensuring the outstanding amount is never negative. Nothing is outstanding
+// on a written-off loan, whose outstanding amount at the time of the write-off is recorded in its written-off amounts.
 //
 // Parameters:
 //   - paidAmount: The decimal.Decimal amount that has already been paid towards the loan.
@@ -197,11 +491,11 @@ func (l *Loan) ValidateLatestLoan(latestLoan *Loan) error {
 // Returns:
 //   - decimal.Decimal: The outstanding amount to be paid.
 func (l *Loan) OutstandingAmount(paidAmount decimal.Decimal) decimal.Decimal {
-	if l == nil {
+	if l == nil || l.Status == LoanStatusWrittenOff {
 		return decimal.Zero
 	}
 
-	outstandingAmount := l.PaymentAmount.Sub(paidAmount)
+	outstandingAmount := l.PaymentAmount.Add(l.ChargesAmount()).Sub(l.RebateAmount).Sub(paidAmount)
 	if outstandingAmount.IsNegative() {
 		outstandingAmount = decimal.Zero
 	}
@@ -209,54 +503,61 @@ func (l *Loan) OutstandingAmount(paidAmount decimal.Decimal) decimal.Decimal {
 	return outstandingAmount
 }
 
-// IsDelinquent determines if the loan is considered delinquent based on the current date and paid amount.
+// IsDelinquent determines if the loan is considered delinquent based on the current date.
 //
-// A loan is considered delinquent if the number of unpaid weeks exceeds the delinquencyThresholdWeeks.
+// A loan is considered delinquent if the number of missed installments exceeds the missed installment threshold
+// of its delinquency rule. The missed installments are the overdue installments that are not fully paid, where
+// a partially paid installment counts as missed or paid according to the partial payment rule of the delinquency
+// rule. Installments still within their grace period are not counted.
 //
 // Parameters:
-//   - now: The current time used to calculate the billing amount.
-//   - paidAmount: The total amount that has been paid towards the loan so far.
+//   - now: The current time used to determine the overdue installments.
 //
 // Returns:
 //   - bool: true if the loan is delinquent, false otherwise.
-func (l *Loan) IsDelinquent(now time.Time, paidAmount decimal.Decimal) bool {
-	if l == nil {
+func (l *Loan) IsDelinquent(now time.Time) bool {
+	if l == nil || l.Status == LoanStatusPaid || l.Status == LoanStatusWrittenOff {
 		return false
 	}
 
-	if l.Status == LoanStatusPaid || l.PaymentAmount.Equal(paidAmount) {
-		return false
+	rule := l.delinquencyRule()
+
+	missedInstallments := int32(0)
+	for _, installment := range l.overdueInstallments(now) {
+		if installment.OutstandingAmount().IsZero() {
+			continue
+		}
+		if installment.AmountPaid.IsPositive() && rule.PartialPaymentRule == PartialPaymentCountsAsPaid {
+			continue
+		}
+		missedInstallments++
 	}
 
-	billAmount := l.CurrentBillAmount(now, paidAmount)
-	unpaidWeeks := billAmount.Div(l.weeklyPaymentAmount()).Round(0).IntPart()
-	return unpaidWeeks > delinquencyThresholdWeeks
+	return missedInstallments > rule.MissedInstallmentThreshold
 }
 
 // CurrentBillAmount calculates the current bill amount for the loan based on the current date and paid amount.
 //
 // This method determines the amount that should be billed to the user at the current point in time,
-// taking into account the loan's payment schedule and any amounts already paid.
+// which is the sum of the installments due so far and the charges minus any amounts already paid.
 //
 // Parameters:
 //   - now: The current time used to calculate the billing amount.
 //   - paidAmount: The total amount that has been paid towards the loan so far.
 //
 // Returns:
-//   - decimal.Decimal: The current bill amount. This will be zero if the loan is fully paid.
+//   - decimal.Decimal: The current bill amount. This will be zero if the loan is fully paid or written off.
 func (l *Loan) CurrentBillAmount(now time.Time, paidAmount decimal.Decimal) decimal.Decimal {
-	if l == nil {
+	if l == nil || l.Status == LoanStatusWrittenOff {
 		return decimal.Zero
 	}
 
-	paymentObligation := l.PaymentAmount
-
-	// cap the amount to the total payment amount
-	if currentWeek := l.currentWeek(now); currentWeek < l.PaymentDurationWeeks {
-		paymentObligation = l.weeklyPaymentAmount().Mul(decimal.NewFromInt32(currentWeek))
+	paymentObligation := decimal.Zero
+	for _, installment := range l.dueInstallments(now) {
+		paymentObligation = paymentObligation.Add(installment.AmountDue)
 	}
 
-	billAmount := paymentObligation.Sub(paidAmount)
+	billAmount := paymentObligation.Add(l.ChargesAmount()).Sub(paidAmount)
 	if billAmount.IsNegative() {
 		billAmount = decimal.Zero
 	}
@@ -264,93 +565,268 @@ func (l *Loan) CurrentBillAmount(now time.Time, paidAmount decimal.Decimal) deci
 	return billAmount
 }
 
+// DueBy determines the time by which the oldest unpaid due installment must be paid,
+// which is the end of its grace period.
+//
+// Parameters:
+//   - now: The current time used to determine the due installments.
+//
+// Returns:
+//   - time.Time: The end of the grace period of the oldest due installment that is not fully paid,
+//     or the zero time if there is none.
+func (l *Loan) DueBy(now time.Time) time.Time {
+	installment := l.oldestUnpaidInstallment(now)
+	if installment == nil {
+		return time.Time{}
+	}
+
+	return l.gracePeriodEnd(installment)
+}
+
+// RemainingDueAmount calculates the amount still owed on the installment currently being paid.
+//
+// The remaining amount of the oldest installment that is not fully paid is returned. This is useful to show
+// how much of an installment is left after partial payments.
+//
+// Returns:
+//   - decimal.Decimal: The remaining amount of the oldest outstanding installment, or zero if the loan is fully paid.
+func (l *Loan) RemainingDueAmount() decimal.Decimal {
+	if l == nil {
+		return decimal.Zero
+	}
+
+	for _, installment := range l.schedule() {
+		if outstandingAmount := installment.OutstandingAmount(); outstandingAmount.IsPositive() {
+			return outstandingAmount
+		}
+	}
+
+	return decimal.Zero
+}
+
 // MakePayment processes a payment for the loan and updates its status if necessary.
 //
-// This method checks if the payment amount matches the current bill amount, creates a new
-// loan payment instance, and determines if the loan status should be updated to paid.
+// How the payment amount is checked depends on the payment mode:
+//   - PaymentModeRegular: the payment is accepted towards the current bill amount according to the loan's
+//     payment acceptance mode.
+//   - PaymentModePrepayment: any payment up to the outstanding amount is accepted, paying the future
+//     installments ahead of their due date.
+//   - PaymentModePayoff: the payment must match the payoff amount quoted at the current time, the early
+//     settlement rebate is applied to the installments that are not due yet, and the loan is closed.
+//   - PaymentModeRecovery: the payment is recovered from a written-off loan, which accepts no other payment mode,
+//     up to its written-off amount. It is not allocated to the installments and charges.
+//
+// Unless the loan's overpayment policy rejects overpayments, a payment over the current bill, outstanding
+// or payoff amount is also accepted: the accepted amount is applied to the loan, and the excess is either
+// held as a credit balance of the user, recorded in the payment's credit entry, or marked to be refunded.
+//
+// The method creates a new loan payment instance, allocates it to the due installments oldest first, then to the
+// charges, then to the future installments, and determines
+// if the loan status should be updated to ongoing, for the first payment of a disbursed loan, or to paid.
 //
 // Parameters:
-//   - now: The current time used to calculate the current bill amount.
+//   - now: The time the payment is made at, used to calculate the current bill amount.
 //   - paidAmount: The total amount already paid towards the loan before this payment.
 //   - paymentAmount: The amount being paid in this transaction.
+//   - mode: The payment mode determining which payment amounts are accepted.
+//   - details: The details of the payment reported by the channel it was received through.
 //
 // Returns:
 //   - loanPayment: The newly created LoanPayment instance.
 //   - shouldUpdateLoan: A boolean indicating whether any changes being made to the loan instance.
 //   - err: An error if the payment process fails, nil otherwise. Possible errors include:
-//     ErrLoanNotFound, ErrLoanCurrentWeekAlreadyPaid, ErrLoanNotExactPaymentAmount.
-func (l *Loan) MakePayment(now time.Time, paidAmount, paymentAmount decimal.Decimal) (loanPayment *LoanPayment, shouldUpdateLoan bool, err error) {
+//     ErrLoanNotFound, ErrLoanNotDisbursed, ErrLoanInvalidPaymentMode, ErrLoanCurrentWeekAlreadyPaid, ErrLoanNotExactPaymentAmount,
+//     ErrLoanPaymentExceedsBillAmount, ErrLoanAlreadyPaid, ErrLoanPaymentExceedsOutstanding, ErrLoanNotExactPayoffAmount,
+//     ErrLoanWrittenOff.
+func (l *Loan) MakePayment(now time.Time, paidAmount, paymentAmount decimal.Decimal, mode PaymentMode, details PaymentDetails) (loanPayment *LoanPayment, shouldUpdateLoan bool, err error) {
 	if l == nil {
 		return nil, false, ErrLoanNotFound
 	}
-
-	billAmount := l.CurrentBillAmount(now, paidAmount)
-	if billAmount.IsZero() {
-		return nil, false, ErrLoanCurrentWeekAlreadyPaid
+	if l.Status == LoanStatusWrittenOff {
+		return l.makeRecoveryPayment(now, paymentAmount, mode, details)
 	}
-	if !billAmount.Equal(paymentAmount) {
-		return nil, false, ErrLoanNotExactPaymentAmount
+	if !l.Status.isDisbursed() {
+		return nil, false, ErrLoanNotDisbursed
 	}
 
-	loanPayment, err = CreateLoanPayment(l.ID, paymentAmount)
+	var appliedAmount, excessAmount decimal.Decimal
+	switch mode {
+	case PaymentModeRegular:
+		billAmount := l.CurrentBillAmount(now, paidAmount)
+		if billAmount.IsZero() {
+			return nil, false, ErrLoanCurrentWeekAlreadyPaid
+		}
+		appliedAmount, excessAmount = l.OverpaymentPolicy.split(billAmount, paymentAmount)
+		if err = l.PaymentAcceptanceMode.accepts(billAmount, appliedAmount); err != nil {
+			return nil, false, err
+		}
+	case PaymentModePrepayment:
+		outstandingAmount := l.OutstandingAmount(paidAmount)
+		if l.Status == LoanStatusPaid || outstandingAmount.IsZero() {
+			return nil, false, ErrLoanAlreadyPaid
+		}
+		appliedAmount, excessAmount = l.OverpaymentPolicy.split(outstandingAmount, paymentAmount)
+		if appliedAmount.GreaterThan(outstandingAmount) {
+			return nil, false, ErrLoanPaymentExceedsOutstanding
+		}
+	case PaymentModePayoff:
+		if l.Status == LoanStatusPaid || l.OutstandingAmount(paidAmount).IsZero() {
+			return nil, false, ErrLoanAlreadyPaid
+		}
+		quote := l.PayoffQuote(now, paidAmount)
+		appliedAmount, excessAmount = l.OverpaymentPolicy.split(quote.PayoffAmount, paymentAmount)
+		if !quote.PayoffAmount.Equal(appliedAmount) {
+			return nil, false, ErrLoanNotExactPayoffAmount
+		}
+	default:
+		return nil, false, ErrLoanInvalidPaymentMode
+	}
+
+	loanPayment, err = CreateLoanPayment(l.ID, appliedAmount, details, now)
 	if err != nil {
 		return nil, false, err
 	}
+	loanPayment.Mode = mode
+	if excessAmount.IsPositive() {
+		if err = l.handleExcess(loanPayment, excessAmount); err != nil {
+			return nil, false, err
+		}
+	}
+	if mode == PaymentModePayoff {
+		l.applyEarlySettlementRebate(now, paidAmount, loanPayment.CreatedAt)
+	}
+	loanPayment.Allocations, loanPayment.ChargeAllocations = l.allocatePayment(appliedAmount, loanPayment.CreatedAt)
 
-	shouldUpdateLoan = false
-	if paidAmount.Add(paymentAmount).Equal(l.PaymentAmount) {
-		l.Status = LoanStatusPaid
-		l.UpdatedAt = time.Now().UTC()
-		shouldUpdateLoan = true
+	shouldUpdateLoan, err = l.updateStatusAfterPayment(paidAmount.Add(appliedAmount), loanPayment.CreatedAt)
+	if err != nil {
+		return nil, false, err
 	}
 
 	return loanPayment, shouldUpdateLoan, nil
 }
 
-// weeklyPaymentAmount calculates the weekly payment amount for the loan.
+// updateStatusAfterPayment moves a disbursed loan to ongoing on its first payment, and the loan to paid
+// once nothing is outstanding on it.
 //
-// This method computes the amount to be paid each week by dividing the total payment amount
-// by the number of weeks in the loan duration. The result is rounded down to the nearest
-// whole number.
+// Parameters:
+//   - paidAmount: The total amount paid towards the loan, including the payment.
+//   - now: The time the payment is made at.
 //
 // Returns:
-//   - decimal.Decimal: The amount that should be paid in weekly-basis.
-func (l *Loan) weeklyPaymentAmount() decimal.Decimal {
+//   - bool: true if the status of the loan is updated, false otherwise.
+//   - error: ErrLoanIllegalStatusTransition if the loan cannot be moved to its new status, nil otherwise.
+func (l *Loan) updateStatusAfterPayment(paidAmount decimal.Decimal, now time.Time) (bool, error) {
+	status := l.Status
+	if status == LoanStatusDisbursed {
+		status = LoanStatusOngoing
+	}
+	if l.OutstandingAmount(paidAmount).IsZero() {
+		status = LoanStatusPaid
+	}
+	if status == l.Status {
+		return false, nil
+	}
+
+	if err := l.transitionTo(status, now); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// handleExcess records the excess of a payment according to the loan's overpayment policy,
+// crediting it to the balance of the loan's user for OverpaymentPolicyCredit.
+//
+// Parameters:
+//   - loanPayment: A pointer to the payment the excess was received by.
+//   - excessAmount: The amount of the payment over the amount accepted towards the loan.
+//
+// Returns:
+//   - error: An error if the credit entry cannot be created, nil otherwise.
+func (l *Loan) handleExcess(loanPayment *LoanPayment, excessAmount decimal.Decimal) error {
+	loanPayment.ExcessAmount = excessAmount
+	loanPayment.ExcessHandling = l.OverpaymentPolicy
+
+	if l.OverpaymentPolicy != OverpaymentPolicyCredit {
+		return nil
+	}
+
+	creditEntry, err := newCreditEntry(l, loanPayment.ID, CreditEntryTypeOverpayment, excessAmount, loanPayment.CreatedAt)
+	if err != nil {
+		return err
+	}
+	loanPayment.CreditEntry = creditEntry
+
+	return nil
+}
+
+// PaymentDurationWeeks calculates the duration of the loan in weeks, for the billing frequencies made of whole weeks.
+//
+// Returns:
+//   - int32: The installment count for the weekly frequency, twice the installment count for the bi-weekly
+//     frequency, or 0 for the frequencies that are not made of whole weeks or if the loan is nil.
+func (l *Loan) PaymentDurationWeeks() int32 {
 	if l == nil {
-		return decimal.Zero
+		return 0
 	}
 
-	return l.PaymentAmount.Div(decimal.NewFromInt32(l.PaymentDurationWeeks)).RoundDown(0)
+	switch l.BillingFrequency {
+	case BillingFrequencyWeekly:
+		return l.InstallmentCount
+	case BillingFrequencyBiWeekly:
+		return 2 * l.InstallmentCount
+	default:
+		return 0
+	}
 }
 
-// currentWeek calculates the number of weeks that have passed since the loan was created.
+// currentPeriod calculates the number of billing periods that have passed since the loan was disbursed,
+// not counting the periods skipped by its payment holidays.
 //
-// This method determines the current week of the loan by calculating the difference
-// between the given time and the beginning of the week when the loan was created.
-// It accounts for partial weeks and ensures the count starts from the Monday of the
-// creation week.
+// This method determines the current billing period of the loan by calculating the difference
+// between the given time and the beginning of the billing period when the loan was disbursed.
+// It accounts for partial periods and ensures the count starts from the beginning of the
+// disbursement period, both in the loan's billing timezone. The count does not grow during a payment holiday.
 //
 // Parameters:
-//   - now: The current time to calculate the week difference from.
+//   - now: The current time to calculate the period difference from.
 //
 // Returns:
-//   - int32: The number of weeks that have passed since the loan was created.
-//     Returns 0 if the loan is nil.
-func (l *Loan) currentWeek(now time.Time) int32 {
-	if l == nil {
+//   - int32: The number of billing periods that have passed since the loan was disbursed.
+//     Returns 0 if the loan is nil or has not been disbursed yet.
+func (l *Loan) currentPeriod(now time.Time) int32 {
+	if l == nil || !l.Status.isDisbursed() {
 		return 0
 	}
 
-	createdAt := l.CreatedAt.UTC()
+	elapsedPeriods := l.elapsedPeriods(now)
+	return elapsedPeriods - l.skippedPeriods(elapsedPeriods)
+}
+
+// elapsedPeriods calculates the number of billing periods that have passed since the beginning of the loan's
+// first billing period, including the periods skipped by its payment holidays.
+//
+// Parameters:
+//   - now: The current time to count the periods to.
+//
+// Returns:
+//   - int32: The number of billing periods that have ended at the given time, never negative.
+func (l *Loan) elapsedPeriods(now time.Time) int32 {
+	return l.BillingFrequency.periodsBetween(l.billingCalendar(), l.beginningOfBillingPeriod(), now)
+}
 
-	// get the Monday's date of the loan's creation week
-	weekday := int(createdAt.Weekday() - 1)
-	if weekday < 0 {
-		weekday += 7
+// beginningOfBillingPeriod returns the start of the billing period the loan was disbursed in,
+// in the loan's billing timezone, which is the start of the loan's first billing period.
+// The creation time is used instead for the loans that have not been disbursed yet,
+// which gives the preview of their repayment schedule.
+//
+// Returns:
+//   - time.Time: The beginning of the loan's first billing period.
+func (l *Loan) beginningOfBillingPeriod() time.Time {
+	billingStartedAt := l.DisbursedAt
+	if billingStartedAt.IsZero() {
+		billingStartedAt = l.CreatedAt
 	}
-	beginningOfWeek := createdAt.AddDate(0, 0, -weekday)
 
-	beginningOfWeek = time.Date(beginningOfWeek.Year(), beginningOfWeek.Month(), beginningOfWeek.Day(), 0, 0, 0, 0, time.UTC)
-	currentWeek := int32(now.Sub(beginningOfWeek).Hours() / (24 * 7))
-	return currentWeek
+	return l.BillingFrequency.beginningOfPeriod(l.billingCalendar(), billingStartedAt)
 }
@@ -0,0 +1,112 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/axopadyani/billing-engine/internal/common/businesserror"
+)
+
+var (
+	ErrLoanIllegalStatusTransition = businesserror.New("loan cannot be moved to the requested status from its current status", businesserror.KindUnprocessableEntity)
+)
+
+// loanStatusTransitions lists the statuses a loan can be moved to from each status.
+// A paid loan is reopened when a payment is reversed, and a rejected, cancelled or written-off loan cannot be moved at all.
+var loanStatusTransitions = map[LoanStatus][]LoanStatus{
+	LoanStatusPendingApproval: {LoanStatusApproved, LoanStatusRejected, LoanStatusCancelled},
+	LoanStatusApproved:        {LoanStatusDisbursed, LoanStatusRejected, LoanStatusCancelled},
+	LoanStatusDisbursed:       {LoanStatusOngoing, LoanStatusPaid, LoanStatusDefaulted},
+	LoanStatusOngoing:         {LoanStatusPaid, LoanStatusDefaulted},
+	LoanStatusDefaulted:       {LoanStatusPaid, LoanStatusWrittenOff},
+	LoanStatusPaid:            {LoanStatusOngoing, LoanStatusDefaulted},
+}
+
+// canTransitionTo checks if a loan with the status can be moved to the given status.
+//
+// Parameters:
+//   - next: The status the loan is being moved to.
+//
+// Returns:
+//   - bool: true if the transition is allowed, false otherwise.
+func (s LoanStatus) canTransitionTo(next LoanStatus) bool {
+	return slices.Contains(loanStatusTransitions[s], next)
+}
+
+// transitionTo moves the loan to the given status if the transition is allowed from its current status.
+//
+// Parameters:
+//   - next: The status the loan is being moved to.
+//   - now: The time the loan is moved at.
+//
+// Returns:
+//   - error: ErrLoanNotFound if the loan is nil, ErrLoanIllegalStatusTransition if the transition is not allowed,
+//     nil otherwise.
+func (l *Loan) transitionTo(next LoanStatus, now time.Time) error {
+	if l == nil {
+		return ErrLoanNotFound
+	}
+	if !l.Status.canTransitionTo(next) {
+		return ErrLoanIllegalStatusTransition
+	}
+
+	l.Status = next
+	l.UpdatedAt = now.UTC()
+
+	return nil
+}
+
+// Approve approves a loan that is pending approval, so that it can be disbursed.
+//
+// Parameters:
+//   - now: The time the loan is approved at.
+//
+// Returns:
+//   - error: ErrLoanNotFound if the loan is nil, ErrLoanIllegalStatusTransition if the loan is not pending approval,
+//     nil otherwise.
+func (l *Loan) Approve(now time.Time) error {
+	if err := l.transitionTo(LoanStatusApproved, now); err != nil {
+		return err
+	}
+	l.ApprovedAt = l.UpdatedAt
+
+	return nil
+}
+
+// Disburse records the disbursement of an approved loan, which starts its billing.
+//
+// The repayment schedule is generated again so that the billing periods of the loan are counted from
+// the disbursement time rather than the creation time. The loan stays disbursed until its first payment,
+// which moves it to ongoing.
+//
+// Parameters:
+//   - now: The time the loan amount is disbursed at.
+//
+// Returns:
+//   - error: ErrLoanNotFound if the loan is nil, ErrLoanIllegalStatusTransition if the loan is not approved,
+//     nil otherwise.
+func (l *Loan) Disburse(now time.Time) error {
+	if err := l.transitionTo(LoanStatusDisbursed, now); err != nil {
+		return err
+	}
+	l.DisbursedAt = l.UpdatedAt
+
+	l.Installments = l.generateSchedule()
+	for _, installment := range l.Installments {
+		installment.UpdatedAt = l.UpdatedAt
+	}
+
+	return nil
+}
+
+// Reject rejects a loan that has not been disbursed yet, closing it.
+//
+// Parameters:
+//   - now: The time the loan is rejected at.
+//
+// Returns:
+//   - error: ErrLoanNotFound if the loan is nil, ErrLoanIllegalStatusTransition if the loan is neither
+//     pending approval nor approved, nil otherwise.
+func (l *Loan) Reject(now time.Time) error {
+	return l.transitionTo(LoanStatusRejected, now)
+}
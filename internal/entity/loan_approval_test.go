@@ -0,0 +1,259 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestLoanStatus_canTransitionTo(t *testing.T) {
+	tests := []struct {
+		name   string
+		status LoanStatus
+		next   LoanStatus
+		want   bool
+	}{
+		{name: "pending approval to approved", status: LoanStatusPendingApproval, next: LoanStatusApproved, want: true},
+		{name: "pending approval to rejected", status: LoanStatusPendingApproval, next: LoanStatusRejected, want: true},
+		{name: "pending approval to disbursed", status: LoanStatusPendingApproval, next: LoanStatusDisbursed, want: false},
+		{name: "approved to disbursed", status: LoanStatusApproved, next: LoanStatusDisbursed, want: true},
+		{name: "approved to rejected", status: LoanStatusApproved, next: LoanStatusRejected, want: true},
+		{name: "disbursed to ongoing", status: LoanStatusDisbursed, next: LoanStatusOngoing, want: true},
+		{name: "disbursed to rejected", status: LoanStatusDisbursed, next: LoanStatusRejected, want: false},
+		{name: "ongoing to paid", status: LoanStatusOngoing, next: LoanStatusPaid, want: true},
+		{name: "ongoing to approved", status: LoanStatusOngoing, next: LoanStatusApproved, want: false},
+		{name: "paid to ongoing", status: LoanStatusPaid, next: LoanStatusOngoing, want: true},
+		{name: "rejected to approved", status: LoanStatusRejected, next: LoanStatusApproved, want: false},
+		{name: "disbursed to cancelled", status: LoanStatusDisbursed, next: LoanStatusCancelled, want: false},
+		{name: "ongoing to cancelled", status: LoanStatusOngoing, next: LoanStatusCancelled, want: false},
+		{name: "cancelled to approved", status: LoanStatusCancelled, next: LoanStatusApproved, want: false},
+		{name: "ongoing to defaulted", status: LoanStatusOngoing, next: LoanStatusDefaulted, want: true},
+		{name: "ongoing to written off", status: LoanStatusOngoing, next: LoanStatusWrittenOff, want: false},
+		{name: "defaulted to written off", status: LoanStatusDefaulted, next: LoanStatusWrittenOff, want: true},
+		{name: "defaulted to ongoing", status: LoanStatusDefaulted, next: LoanStatusOngoing, want: false},
+		{name: "written off to paid", status: LoanStatusWrittenOff, next: LoanStatusPaid, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.status.canTransitionTo(tt.next); got != tt.want {
+				t.Fatalf("expecting %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLoan_Approve(t *testing.T) {
+	now := time.Date(2024, time.March, 14, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		loan    *Loan
+		wantErr error
+	}{
+		{
+			name:    "nil loan",
+			loan:    nil,
+			wantErr: ErrLoanNotFound,
+		},
+		{
+			name:    "loan pending approval",
+			loan:    &Loan{Status: LoanStatusPendingApproval},
+			wantErr: nil,
+		},
+		{
+			name:    "loan already approved",
+			loan:    &Loan{Status: LoanStatusApproved},
+			wantErr: ErrLoanIllegalStatusTransition,
+		},
+		{
+			name:    "rejected loan",
+			loan:    &Loan{Status: LoanStatusRejected},
+			wantErr: ErrLoanIllegalStatusTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.loan.Approve(now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if tt.loan.Status != LoanStatusApproved {
+				t.Errorf("expecting status %v, got %v", LoanStatusApproved, tt.loan.Status)
+			}
+			if !tt.loan.ApprovedAt.Equal(now) || !tt.loan.UpdatedAt.Equal(now) {
+				t.Errorf("expecting approved at and updated at to be %v, got %v and %v", now, tt.loan.ApprovedAt, tt.loan.UpdatedAt)
+			}
+		})
+	}
+}
+
+func TestLoan_Disburse(t *testing.T) {
+	createdAt := time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC) // Monday
+	now := createdAt.AddDate(0, 0, 16)                                 // Wednesday two weeks later
+
+	newLoan := func(status LoanStatus) *Loan {
+		loan := &Loan{
+			ID:               uuid.New(),
+			Amount:           decimal.NewFromInt(1000),
+			PaymentAmount:    decimal.NewFromInt(1000),
+			InstallmentCount: 4,
+			Status:           status,
+			CreatedAt:        createdAt,
+			UpdatedAt:        createdAt,
+		}
+		loan.Installments = loan.generateSchedule()
+
+		return loan
+	}
+
+	tests := []struct {
+		name    string
+		loan    *Loan
+		wantErr error
+	}{
+		{
+			name:    "nil loan",
+			loan:    nil,
+			wantErr: ErrLoanNotFound,
+		},
+		{
+			name:    "loan pending approval",
+			loan:    newLoan(LoanStatusPendingApproval),
+			wantErr: ErrLoanIllegalStatusTransition,
+		},
+		{
+			name:    "approved loan",
+			loan:    newLoan(LoanStatusApproved),
+			wantErr: nil,
+		},
+		{
+			name:    "loan already disbursed",
+			loan:    newLoan(LoanStatusDisbursed),
+			wantErr: ErrLoanIllegalStatusTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.loan.Disburse(now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if tt.loan.Status != LoanStatusDisbursed {
+				t.Errorf("expecting status %v, got %v", LoanStatusDisbursed, tt.loan.Status)
+			}
+			if !tt.loan.DisbursedAt.Equal(now) {
+				t.Errorf("expecting disbursed at to be %v, got %v", now, tt.loan.DisbursedAt)
+			}
+
+			// the first installment is due at the start of the week after the disbursement
+			wantDueDate := time.Date(2024, time.March, 25, 0, 0, 0, 0, time.UTC)
+			if got := tt.loan.Installments[0].DueDate; !got.Equal(wantDueDate) {
+				t.Errorf("expecting first installment due date %v, got %v", wantDueDate, got)
+			}
+			if got := tt.loan.CurrentBillAmount(now, decimal.Zero); !got.IsZero() {
+				t.Errorf("expecting no bill in the disbursement week, got %s", got)
+			}
+			if got := tt.loan.CurrentBillAmount(now.AddDate(0, 0, 7), decimal.Zero); !got.Equal(decimal.NewFromInt(250)) {
+				t.Errorf("expecting bill of 250 a week after the disbursement, got %s", got)
+			}
+		})
+	}
+}
+
+func TestLoan_Reject(t *testing.T) {
+	now := time.Date(2024, time.March, 14, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		loan    *Loan
+		wantErr error
+	}{
+		{
+			name:    "nil loan",
+			loan:    nil,
+			wantErr: ErrLoanNotFound,
+		},
+		{
+			name:    "loan pending approval",
+			loan:    &Loan{Status: LoanStatusPendingApproval},
+			wantErr: nil,
+		},
+		{
+			name:    "approved loan",
+			loan:    &Loan{Status: LoanStatusApproved},
+			wantErr: nil,
+		},
+		{
+			name:    "disbursed loan",
+			loan:    &Loan{Status: LoanStatusDisbursed},
+			wantErr: ErrLoanIllegalStatusTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.loan.Reject(now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if tt.loan.Status != LoanStatusRejected {
+				t.Errorf("expecting status %v, got %v", LoanStatusRejected, tt.loan.Status)
+			}
+			if !tt.loan.UpdatedAt.Equal(now) {
+				t.Errorf("expecting updated at to be %v, got %v", now, tt.loan.UpdatedAt)
+			}
+		})
+	}
+}
+
+func TestLoan_MakePayment_disbursedLoan(t *testing.T) {
+	createdAt := time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC)
+	product := &LoanProduct{
+		ID:            uuid.New(),
+		MinPrincipal:  decimal.NewFromInt(1_000),
+		MaxPrincipal:  decimal.NewFromInt(10_000),
+		InterestModel: InterestModelZeroInterest,
+	}
+
+	loan, err := CreateLoan(product, uuid.New(), decimal.NewFromInt(1_000), 4, createdAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = loan.MakePayment(createdAt, decimal.Zero, decimal.NewFromInt(250), PaymentModePrepayment, PaymentDetails{}); !errors.Is(err, ErrLoanNotDisbursed) {
+		t.Fatalf("expecting error to be %v before the disbursement, got %v", ErrLoanNotDisbursed, err)
+	}
+
+	if err = loan.Approve(createdAt.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err = loan.Disburse(createdAt.Add(2 * time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	now := createdAt.AddDate(0, 0, 7)
+	_, shouldUpdateLoan, err := loan.MakePayment(now, decimal.Zero, decimal.NewFromInt(250), PaymentModeRegular, PaymentDetails{})
+	if err != nil {
+		t.Fatalf("expecting no error, got %v", err)
+	}
+	if !shouldUpdateLoan || loan.Status != LoanStatusOngoing {
+		t.Fatalf("expecting the first payment to move the loan to ongoing, got status %v and shouldUpdateLoan %v", loan.Status, shouldUpdateLoan)
+	}
+}
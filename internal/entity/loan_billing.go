@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// LoanBilling represents the changes made to a loan when it is billed up to a given time.
+type LoanBilling struct {
+	// CreditPayment is the payment of the current bill made from the credit balance of the loan's user,
+	// nil if no credit is applied.
+	CreditPayment *LoanPayment
+
+	// Charges is the late fee charges accrued on the loan, empty if there is none.
+	Charges []*LoanCharge
+
+	// ShouldUpdateLoan is true if the billing changes the loan record, which is when the loan is defaulted
+	// or its status is changed by the credit payment.
+	ShouldUpdateLoan bool
+}
+
+// CreditAmount returns the amount paid towards the loan from the credit balance of its user by the billing.
+//
+// Returns:
+//   - decimal.Decimal: The amount of the credit payment, zero if no credit is applied.
+func (b *LoanBilling) CreditAmount() decimal.Decimal {
+	if b == nil || b.CreditPayment == nil {
+		return decimal.Zero
+	}
+
+	return b.CreditPayment.Amount
+}
+
+// Bill brings the loan up to date with the given time.
+//
+// The current bill of the loan is first paid from the credit balance of its user, so that the credit counts
+// before anything is missed. The late fees of the installments still missed are then accrued, and the loan is
+// defaulted if it has been past due for longer than its default period. The loan is only changed in memory: a read
+// bills the loan to show its current state without storing anything, and a write stores the billing in the same
+// transaction as its own changes.
+//
+// Parameters:
+//   - now: The time the loan is billed at.
+//   - paidAmount: The total amount that has been paid towards the loan so far.
+//   - creditBalance: The credit balance of the loan's user.
+//
+// Returns:
+//   - *LoanBilling: The changes made to the loan by the billing, which is empty if the loan is nil.
+//   - error: An error if the credit payment or a late fee charge cannot be created, or the loan cannot be defaulted,
+//     nil otherwise.
+func (l *Loan) Bill(now time.Time, paidAmount, creditBalance decimal.Decimal) (*LoanBilling, error) {
+	billing := &LoanBilling{}
+	if l == nil {
+		return billing, nil
+	}
+
+	creditPayment, shouldUpdateLoan, err := l.ApplyCredit(now, paidAmount, creditBalance)
+	if err != nil {
+		return nil, err
+	}
+	billing.CreditPayment = creditPayment
+	billing.ShouldUpdateLoan = shouldUpdateLoan
+
+	charges, err := l.AccrueLateFees(now)
+	if err != nil {
+		return nil, err
+	}
+	billing.Charges = charges
+
+	if l.IsPastDefaultPeriod(now) {
+		if err = l.Default(now); err != nil {
+			return nil, err
+		}
+		billing.ShouldUpdateLoan = true
+	}
+
+	return billing, nil
+}
@@ -0,0 +1,125 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestLoan_Bill(t *testing.T) {
+	createdAt := time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC) // Monday, installments due from March 11
+
+	// newLoan creates an ongoing loan of 1000 repaid in 10 weekly installments of 100, charged a late fee of 10
+	// for every missed installment and defaulted after 2 weeks past due.
+	newLoan := func() *Loan {
+		loan := &Loan{
+			ID:                uuid.New(),
+			Amount:            decimal.NewFromInt(1000),
+			InterestModel:     InterestModelFlat,
+			InterestRate:      decimal.Zero,
+			InstallmentCount:  10,
+			PaymentAmount:     decimal.NewFromInt(1000),
+			LateFeePolicy:     LateFeePolicy{Method: LateFeeMethodFixedPerMissedInstallment, Amount: decimal.NewFromInt(10)},
+			DefaultAfterWeeks: 2,
+			Status:            LoanStatusOngoing,
+			CreatedAt:         createdAt,
+			UpdatedAt:         createdAt,
+		}
+		loan.Installments = loan.generateSchedule()
+
+		return loan
+	}
+
+	tests := []struct {
+		name          string
+		loan          *Loan
+		now           time.Time
+		creditBalance decimal.Decimal
+		wantCredit    decimal.Decimal
+		wantCharges   int
+		wantUpdate    bool
+		wantStatus    LoanStatus
+	}{
+		{
+			name:        "nil loan",
+			loan:        nil,
+			now:         createdAt.AddDate(0, 0, 60),
+			wantCharges: 0,
+			wantUpdate:  false,
+		},
+		{
+			name:        "no installment missed",
+			loan:        newLoan(),
+			now:         time.Date(2024, time.March, 10, 10, 0, 0, 0, time.UTC),
+			wantCharges: 0,
+			wantUpdate:  false,
+			wantStatus:  LoanStatusOngoing,
+		},
+		{
+			name:        "installments missed",
+			loan:        newLoan(),
+			now:         time.Date(2024, time.March, 19, 10, 0, 0, 0, time.UTC), // 8 days past due
+			wantCharges: 2,
+			wantUpdate:  false,
+			wantStatus:  LoanStatusOngoing,
+		},
+		{
+			name:          "credit balance pays the missed installments",
+			loan:          newLoan(),
+			now:           time.Date(2024, time.March, 19, 10, 0, 0, 0, time.UTC),
+			creditBalance: decimal.NewFromInt(500),
+			wantCredit:    decimal.NewFromInt(200),
+			wantCharges:   0,
+			wantUpdate:    false,
+			wantStatus:    LoanStatusOngoing,
+		},
+		{
+			name:          "credit balance pays part of the missed installments",
+			loan:          newLoan(),
+			now:           time.Date(2024, time.March, 19, 10, 0, 0, 0, time.UTC),
+			creditBalance: decimal.NewFromInt(150),
+			wantCredit:    decimal.NewFromInt(150),
+			wantCharges:   1,
+			wantUpdate:    false,
+			wantStatus:    LoanStatusOngoing,
+		},
+		{
+			name:        "past the default period",
+			loan:        newLoan(),
+			now:         time.Date(2024, time.March, 25, 10, 0, 0, 0, time.UTC), // 14 days past due
+			wantCharges: 3,
+			wantUpdate:  true,
+			wantStatus:  LoanStatusDefaulted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			billing, err := tt.loan.Bill(tt.now, decimal.Zero, tt.creditBalance)
+			if err != nil {
+				t.Fatalf("expecting no error, got %v", err)
+			}
+
+			if !billing.CreditAmount().Equal(tt.wantCredit) {
+				t.Errorf("expecting credit payment of %s, got %s", tt.wantCredit, billing.CreditAmount())
+			}
+			if len(billing.Charges) != tt.wantCharges || billing.ShouldUpdateLoan != tt.wantUpdate {
+				t.Errorf("expecting %d charges and loan update %v, got %d and %v", tt.wantCharges, tt.wantUpdate, len(billing.Charges), billing.ShouldUpdateLoan)
+			}
+			if tt.loan == nil {
+				return
+			}
+			if tt.loan.Status != tt.wantStatus || len(tt.loan.Charges) != tt.wantCharges {
+				t.Errorf("expecting status %v with %d charges, got %v with %d", tt.wantStatus, tt.wantCharges, tt.loan.Status, len(tt.loan.Charges))
+			}
+
+			// billing the loan again at the same time changes nothing
+			billing, err = tt.loan.Bill(tt.now, billing.CreditAmount(), tt.creditBalance.Sub(billing.CreditAmount()))
+			if err != nil || billing.CreditPayment != nil || len(billing.Charges) != 0 || billing.ShouldUpdateLoan {
+				t.Errorf("expecting no change when billed again, got %d charges, loan update %v and error %v", len(billing.Charges), billing.ShouldUpdateLoan, err)
+			}
+		})
+	}
+}
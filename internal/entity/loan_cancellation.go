@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/axopadyani/billing-engine/internal/common/businesserror"
+)
+
+var (
+	ErrLoanCoolingOffPeriodEnded = businesserror.New("loan cooling-off period has ended", businesserror.KindUnprocessableEntity)
+	ErrLoanAlreadyDisbursed      = businesserror.New("loan cannot be cancelled after it has been disbursed", businesserror.KindUnprocessableEntity)
+)
+
+// CoolingOffEndsAt calculates the time the cooling-off period of the loan ends, after which it cannot be cancelled.
+//
+// Returns:
+//   - time.Time: The creation time of the loan plus its cooling-off days, or the zero time if the loan is nil.
+func (l *Loan) CoolingOffEndsAt() time.Time {
+	if l == nil {
+		return time.Time{}
+	}
+
+	return l.CreatedAt.AddDate(0, 0, int(l.CoolingOffDays))
+}
+
+// Cancel cancels the loan at the request of its user, closing it.
+//
+// A loan can only be cancelled within its cooling-off period and before it is disbursed, which is
+// while it is pending approval or approved. A disbursed loan has to be repaid instead.
+//
+// Parameters:
+//   - now: The time the loan is cancelled at.
+//
+// Returns:
+//   - error: ErrLoanNotFound if the loan is nil, ErrLoanAlreadyDisbursed if the loan has been disbursed,
+//     ErrLoanIllegalStatusTransition if the loan is already closed, ErrLoanCoolingOffPeriodEnded if the cooling-off
+//     period of the loan has ended, nil otherwise.
+func (l *Loan) Cancel(now time.Time) error {
+	if l == nil {
+		return ErrLoanNotFound
+	}
+	if l.Status.isDisbursed() {
+		return ErrLoanAlreadyDisbursed
+	}
+	if !l.Status.canTransitionTo(LoanStatusCancelled) {
+		return ErrLoanIllegalStatusTransition
+	}
+	if !now.Before(l.CoolingOffEndsAt()) {
+		return ErrLoanCoolingOffPeriodEnded
+	}
+
+	return l.transitionTo(LoanStatusCancelled, now)
+}
@@ -0,0 +1,130 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLoan_CoolingOffEndsAt(t *testing.T) {
+	createdAt := time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		loan *Loan
+		want time.Time
+	}{
+		{
+			name: "nil loan",
+			loan: nil,
+			want: time.Time{},
+		},
+		{
+			name: "no cooling-off period",
+			loan: &Loan{CreatedAt: createdAt},
+			want: createdAt,
+		},
+		{
+			name: "fourteen days cooling-off period",
+			loan: &Loan{CreatedAt: createdAt, CoolingOffDays: 14},
+			want: time.Date(2024, time.March, 18, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.loan.CoolingOffEndsAt(); !got.Equal(tt.want) {
+				t.Fatalf("expecting %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLoan_Cancel(t *testing.T) {
+	createdAt := time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC)
+
+	newLoan := func(status LoanStatus, coolingOffDays int32) *Loan {
+		return &Loan{Status: status, CoolingOffDays: coolingOffDays, CreatedAt: createdAt, UpdatedAt: createdAt}
+	}
+
+	tests := []struct {
+		name    string
+		loan    *Loan
+		now     time.Time
+		wantErr error
+	}{
+		{
+			name:    "nil loan",
+			loan:    nil,
+			now:     createdAt,
+			wantErr: ErrLoanNotFound,
+		},
+		{
+			name:    "loan pending approval",
+			loan:    newLoan(LoanStatusPendingApproval, 14),
+			now:     createdAt.AddDate(0, 0, 1),
+			wantErr: nil,
+		},
+		{
+			name:    "approved loan",
+			loan:    newLoan(LoanStatusApproved, 14),
+			now:     createdAt.AddDate(0, 0, 1),
+			wantErr: nil,
+		},
+		{
+			name:    "disbursed loan without payments",
+			loan:    newLoan(LoanStatusDisbursed, 14),
+			now:     createdAt.AddDate(0, 0, 1),
+			wantErr: ErrLoanAlreadyDisbursed,
+		},
+		{
+			name:    "ongoing loan",
+			loan:    newLoan(LoanStatusOngoing, 14),
+			now:     createdAt.AddDate(0, 0, 1),
+			wantErr: ErrLoanAlreadyDisbursed,
+		},
+		{
+			name:    "paid loan",
+			loan:    newLoan(LoanStatusPaid, 14),
+			now:     createdAt.AddDate(0, 0, 1),
+			wantErr: ErrLoanAlreadyDisbursed,
+		},
+		{
+			name:    "rejected loan",
+			loan:    newLoan(LoanStatusRejected, 14),
+			now:     createdAt.AddDate(0, 0, 1),
+			wantErr: ErrLoanIllegalStatusTransition,
+		},
+		{
+			name:    "cooling-off period ended",
+			loan:    newLoan(LoanStatusApproved, 14),
+			now:     createdAt.AddDate(0, 0, 14),
+			wantErr: ErrLoanCoolingOffPeriodEnded,
+		},
+		{
+			name:    "no cooling-off period",
+			loan:    newLoan(LoanStatusPendingApproval, 0),
+			now:     createdAt,
+			wantErr: ErrLoanCoolingOffPeriodEnded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.loan.Cancel(tt.now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if tt.loan.Status != LoanStatusCancelled {
+				t.Errorf("expecting status %v, got %v", LoanStatusCancelled, tt.loan.Status)
+			}
+			if !tt.loan.UpdatedAt.Equal(tt.now) {
+				t.Errorf("expecting updated at to be %v, got %v", tt.now, tt.loan.UpdatedAt)
+			}
+		})
+	}
+}
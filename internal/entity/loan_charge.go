@@ -0,0 +1,237 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LoanChargeType represents the kind of a charge applied to a loan.
+type LoanChargeType int
+
+const (
+	// LoanChargeTypeLateFee is a fee charged for a missed installment.
+	LoanChargeTypeLateFee LoanChargeType = iota
+)
+
+// IsValid checks if the LoanChargeType is a valid charge type.
+//
+// Returns:
+//   - bool: true if the type is one of the predefined charge types, false otherwise.
+func (t LoanChargeType) IsValid() bool {
+	return t == LoanChargeTypeLateFee
+}
+
+// LoanCharge represents a charge applied to a loan on top of its repayment schedule, such as a late fee.
+type LoanCharge struct {
+	// ID is the unique identifier for the loan charge.
+	ID uuid.UUID
+
+	// LoanID is the unique identifier of the loan the charge is applied to.
+	LoanID uuid.UUID
+
+	// Type is the kind of the charge.
+	Type LoanChargeType
+
+	// InstallmentNumber is the number of the installment the charge is applied for.
+	InstallmentNumber int32
+
+	// Amount is the amount charged.
+	Amount decimal.Decimal
+
+	// AmountPaid is the amount that has been paid towards the charge so far.
+	AmountPaid decimal.Decimal
+
+	// CapitalizedAmount is the part of the amount charged that was still outstanding when the loan was restructured,
+	// and is repaid in the principal of its new terms instead.
+	CapitalizedAmount decimal.Decimal
+
+	// CreatedAt is the timestamp when the charge was created.
+	CreatedAt time.Time
+
+	// UpdatedAt is the timestamp when the charge was last updated.
+	UpdatedAt time.Time
+}
+
+// OutstandingAmount calculates the remaining amount to be paid for the charge.
+//
+// Returns:
+//   - decimal.Decimal: The amount charged minus the amount paid and the amount capitalized, never negative.
+func (c *LoanCharge) OutstandingAmount() decimal.Decimal {
+	if c == nil {
+		return decimal.Zero
+	}
+
+	outstandingAmount := c.Amount.Sub(c.AmountPaid).Sub(c.CapitalizedAmount)
+	if outstandingAmount.IsNegative() {
+		outstandingAmount = decimal.Zero
+	}
+
+	return outstandingAmount
+}
+
+// pay applies as much of the given amount as the charge still needs.
+//
+// Parameters:
+//   - amount: The amount available to be applied to the charge.
+//   - now: The time the payment is applied at.
+//
+// Returns:
+//   - decimal.Decimal: The amount actually applied to the charge.
+func (c *LoanCharge) pay(amount decimal.Decimal, now time.Time) decimal.Decimal {
+	applied := decimal.Min(amount, c.OutstandingAmount())
+	if !applied.IsPositive() {
+		return decimal.Zero
+	}
+
+	c.AmountPaid = c.AmountPaid.Add(applied)
+	c.UpdatedAt = now
+
+	return applied
+}
+
+// unpay takes back an amount previously applied to the charge.
+//
+// Parameters:
+//   - amount: The amount to be taken back from the charge.
+//   - now: The time the amount is taken back at.
+func (c *LoanCharge) unpay(amount decimal.Decimal, now time.Time) {
+	if c == nil || !amount.IsPositive() {
+		return
+	}
+
+	c.AmountPaid = decimal.Max(decimal.Zero, c.AmountPaid.Sub(amount))
+	c.UpdatedAt = now
+}
+
+// ChargeAllocation represents the portion of a payment applied to a loan charge.
+type ChargeAllocation struct {
+	// ChargeID is the unique identifier of the charge the amount is applied to.
+	ChargeID uuid.UUID
+
+	// Amount is the portion of the payment applied to the charge.
+	Amount decimal.Decimal
+}
+
+// Charge returns the charge of the loan with the given ID.
+//
+// Parameters:
+//   - id: The unique identifier of the charge.
+//
+// Returns:
+//   - *LoanCharge: The charge, or nil if the loan has no charge with the given ID.
+func (l *Loan) Charge(id uuid.UUID) *LoanCharge {
+	if l == nil {
+		return nil
+	}
+
+	for _, charge := range l.Charges {
+		if charge.ID == id {
+			return charge
+		}
+	}
+
+	return nil
+}
+
+// ChargesAmount calculates the total amount charged on the loan that is not repaid in the principal of its terms.
+//
+// Returns:
+//   - decimal.Decimal: The sum of the amounts of all the loan charges, minus their capitalized amounts.
+func (l *Loan) ChargesAmount() decimal.Decimal {
+	if l == nil {
+		return decimal.Zero
+	}
+
+	chargesAmount := decimal.Zero
+	for _, charge := range l.Charges {
+		chargesAmount = chargesAmount.Add(charge.Amount.Sub(charge.CapitalizedAmount))
+	}
+
+	return chargesAmount
+}
+
+// lateFeesAmount calculates the total late fees charged on the loan.
+//
+// Returns:
+//   - decimal.Decimal: The sum of the amounts of the late fee charges.
+func (l *Loan) lateFeesAmount() decimal.Decimal {
+	lateFeesAmount := decimal.Zero
+	for _, charge := range l.Charges {
+		if charge.Type == LoanChargeTypeLateFee {
+			lateFeesAmount = lateFeesAmount.Add(charge.Amount)
+		}
+	}
+
+	return lateFeesAmount
+}
+
+// hasLateFee checks if a late fee has already been charged for the installment.
+//
+// Parameters:
+//   - installmentNumber: The number of the installment.
+//
+// Returns:
+//   - bool: true if the loan has a late fee charge for the installment, false otherwise.
+func (l *Loan) hasLateFee(installmentNumber int32) bool {
+	for _, charge := range l.Charges {
+		if charge.Type == LoanChargeTypeLateFee && charge.InstallmentNumber == installmentNumber {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AccrueLateFees charges the late fees of the installments missed up to the given time.
+//
+// An installment is missed when it is still not fully paid at the end of its grace period.
+// Every missed installment is charged once, according to the loan's late fee policy and up to its cap.
+// The new charges are appended to the loan's charges.
+//
+// Parameters:
+//   - now: The current time used to determine the missed installments.
+//
+// Returns:
+//   - []*LoanCharge: The newly accrued late fee charges, empty if there is none.
+//   - error: An error if the charge creation fails, nil otherwise.
+func (l *Loan) AccrueLateFees(now time.Time) ([]*LoanCharge, error) {
+	if l == nil || !l.Status.isRepaying() || l.LateFeePolicy.Method == LateFeeMethodNone {
+		return nil, nil
+	}
+
+	var charges []*LoanCharge
+	for _, installment := range l.overdueInstallments(now) {
+		overdueAmount := installment.OutstandingAmount()
+		if overdueAmount.IsZero() || l.hasLateFee(installment.Number) {
+			continue
+		}
+
+		fee := l.LateFeePolicy.fee(overdueAmount, l.lateFeesAmount(), l.RoundingPolicy.Precision)
+		if !fee.IsPositive() {
+			continue
+		}
+
+		chargeID, err := uuid.NewV7()
+		if err != nil {
+			return nil, err
+		}
+
+		charge := &LoanCharge{
+			ID:                chargeID,
+			LoanID:            l.ID,
+			Type:              LoanChargeTypeLateFee,
+			InstallmentNumber: installment.Number,
+			Amount:            fee,
+			AmountPaid:        decimal.Zero,
+			CapitalizedAmount: decimal.Zero,
+			CreatedAt:         now,
+			UpdatedAt:         now,
+		}
+		l.Charges = append(l.Charges, charge)
+		charges = append(charges, charge)
+	}
+
+	return charges, nil
+}
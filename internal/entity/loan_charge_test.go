@@ -0,0 +1,211 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestLoanCharge_OutstandingAmount(t *testing.T) {
+	tests := []struct {
+		name   string
+		charge *LoanCharge
+		want   decimal.Decimal
+	}{
+		{
+			name:   "nil charge",
+			charge: nil,
+			want:   decimal.Zero,
+		},
+		{
+			name:   "partially paid",
+			charge: &LoanCharge{Amount: decimal.NewFromInt(100), AmountPaid: decimal.NewFromInt(40)},
+			want:   decimal.NewFromInt(60),
+		},
+		{
+			name:   "overpaid",
+			charge: &LoanCharge{Amount: decimal.NewFromInt(100), AmountPaid: decimal.NewFromInt(120)},
+			want:   decimal.Zero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.charge.OutstandingAmount(); !got.Equal(tt.want) {
+				t.Fatalf("expecting %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLoan_ChargesAmount(t *testing.T) {
+	loan := &Loan{
+		Charges: []*LoanCharge{
+			{Amount: decimal.NewFromInt(100)},
+			{Amount: decimal.NewFromInt(50)},
+		},
+	}
+
+	if got := loan.ChargesAmount(); !got.Equal(decimal.NewFromInt(150)) {
+		t.Fatalf("expecting charges amount to be 150, got %s", got)
+	}
+
+	var nilLoan *Loan
+	if got := nilLoan.ChargesAmount(); !got.IsZero() {
+		t.Fatalf("expecting charges amount to be zero, got %s", got)
+	}
+}
+
+func TestLoan_AccrueLateFees(t *testing.T) {
+	now := time.Now().UTC()
+	loanID := uuid.New()
+
+	type chargeSummary struct {
+		InstallmentNumber int32
+		Amount            string
+	}
+
+	// installments 1 and 2 are missed, installment 3 is due but still within its grace period, installment 4 is not due yet
+	newLoan := func(policy LateFeePolicy, charges ...*LoanCharge) *Loan {
+		return &Loan{
+			ID:               loanID,
+			PaymentAmount:    decimal.NewFromInt(400),
+			InstallmentCount: 4,
+			LateFeePolicy:    policy,
+			GracePeriodDays:  3,
+			CreatedAt:        now.Add(-time.Hour * 24 * 21), // now is loan week 3
+			Installments: []*LoanInstallment{
+				{Number: 1, DueDate: now.Add(-time.Hour * 24 * 15), AmountDue: decimal.NewFromInt(100)},
+				{Number: 2, DueDate: now.Add(-time.Hour * 24 * 8), AmountDue: decimal.NewFromInt(100)},
+				{Number: 3, DueDate: now.Add(-time.Hour * 24 * 1), AmountDue: decimal.NewFromInt(100)},
+				{Number: 4, DueDate: now.Add(time.Hour * 24 * 6), AmountDue: decimal.NewFromInt(100)},
+			},
+			Charges: charges,
+		}
+	}
+	fixedPolicy := LateFeePolicy{Method: LateFeeMethodFixedPerMissedInstallment, Amount: decimal.NewFromInt(10)}
+
+	paidLoan := newLoan(fixedPolicy)
+	paidLoan.Status = LoanStatusPaid
+
+	tests := []struct {
+		name       string
+		loan       *Loan
+		paidAmount decimal.Decimal
+		want       []chargeSummary
+	}{
+		{
+			name:       "nil loan",
+			loan:       nil,
+			paidAmount: decimal.Zero,
+			want:       nil,
+		},
+		{
+			name:       "no late fee policy",
+			loan:       newLoan(LateFeePolicy{}),
+			paidAmount: decimal.Zero,
+			want:       nil,
+		},
+		{
+			name:       "paid loan",
+			loan:       paidLoan,
+			paidAmount: decimal.Zero,
+			want:       nil,
+		},
+		{
+			name:       "fixed fee for every missed installment",
+			loan:       newLoan(fixedPolicy),
+			paidAmount: decimal.Zero,
+			want:       []chargeSummary{{1, "10"}, {2, "10"}},
+		},
+		{
+			name:       "covered installment is not missed",
+			loan:       newLoan(fixedPolicy),
+			paidAmount: decimal.NewFromInt(100),
+			want:       []chargeSummary{{2, "10"}},
+		},
+		{
+			name:       "percentage of the overdue amount",
+			loan:       newLoan(LateFeePolicy{Method: LateFeeMethodPercentageOfOverdue, Amount: decimal.NewFromFloat(0.05)}),
+			paidAmount: decimal.NewFromInt(150),
+			want:       []chargeSummary{{2, "2"}},
+		},
+		{
+			name:       "limited by the cap",
+			loan:       newLoan(LateFeePolicy{Method: LateFeeMethodFixedPerMissedInstallment, Amount: decimal.NewFromInt(10), Cap: decimal.NewFromInt(15)}),
+			paidAmount: decimal.Zero,
+			want:       []chargeSummary{{1, "10"}, {2, "5"}},
+		},
+		{
+			name: "payment allocated to a charge does not cover an installment",
+			loan: func() *Loan {
+				loan := newLoan(LateFeePolicy{Method: LateFeeMethodPercentageOfOverdue, Amount: decimal.NewFromFloat(0.05)}, &LoanCharge{
+					LoanID:            loanID,
+					Type:              LoanChargeTypeLateFee,
+					InstallmentNumber: 1,
+					Amount:            decimal.NewFromInt(10),
+					AmountPaid:        decimal.NewFromInt(10),
+				})
+				loan.Installments[0].pay(decimal.NewFromInt(100), now)
+				return loan
+			}(),
+			paidAmount: decimal.Zero,
+			want:       []chargeSummary{{2, "5"}},
+		},
+		{
+			name: "installment is charged once",
+			loan: newLoan(fixedPolicy, &LoanCharge{
+				LoanID:            loanID,
+				Type:              LoanChargeTypeLateFee,
+				InstallmentNumber: 1,
+				Amount:            decimal.NewFromInt(10),
+			}),
+			paidAmount: decimal.Zero,
+			want:       []chargeSummary{{2, "10"}},
+		},
+		{
+			name: "grace period delays the missed installment",
+			loan: func() *Loan {
+				loan := newLoan(fixedPolicy)
+				loan.GracePeriodDays = 9
+				return loan
+			}(),
+			paidAmount: decimal.Zero,
+			want:       []chargeSummary{{1, "10"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var existingCharges int
+			if tt.loan != nil {
+				existingCharges = len(tt.loan.Charges)
+			}
+
+			payLoan(tt.loan, tt.paidAmount, now)
+
+			charges, err := tt.loan.AccrueLateFees(now)
+			if err != nil {
+				t.Fatalf("expecting no error, got %v", err)
+			}
+
+			var got []chargeSummary
+			for _, charge := range charges {
+				if charge.ID == uuid.Nil || charge.LoanID != loanID || charge.Type != LoanChargeTypeLateFee {
+					t.Fatalf("unexpected charge %+v", charge)
+				}
+				got = append(got, chargeSummary{charge.InstallmentNumber, charge.Amount.String()})
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("charges mismatch (-want +got):\n%s", diff)
+			}
+
+			if tt.loan != nil && len(tt.loan.Charges) != existingCharges+len(charges) {
+				t.Fatalf("expecting the accrued charges to be added to the loan")
+			}
+		})
+	}
+}
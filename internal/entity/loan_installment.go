@@ -0,0 +1,387 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LoanInstallmentStatus represents the current state of a loan installment.
+type LoanInstallmentStatus int
+
+const (
+	// LoanInstallmentStatusUnpaid indicates that the installment has not been fully paid.
+	LoanInstallmentStatusUnpaid LoanInstallmentStatus = iota
+
+	// LoanInstallmentStatusPaid indicates that the installment has been fully paid.
+	LoanInstallmentStatusPaid
+
+	// LoanInstallmentStatusPartiallyPaid indicates that only part of the installment has been paid.
+	LoanInstallmentStatusPartiallyPaid
+
+	// LoanInstallmentStatusRestructured indicates that the amount of the installment not paid yet has been moved
+	// to the restructured schedule of the loan.
+	LoanInstallmentStatusRestructured
+)
+
+// IsValid checks if the LoanInstallmentStatus is a valid status.
+//
+// Returns:
+//   - bool: true if the status is one of the predefined installment statuses, false otherwise.
+func (s LoanInstallmentStatus) IsValid() bool {
+	return s == LoanInstallmentStatusUnpaid || s == LoanInstallmentStatusPaid || s == LoanInstallmentStatusPartiallyPaid ||
+		s == LoanInstallmentStatusRestructured
+}
+
+// LoanInstallment represents a single installment in the repayment schedule of a loan.
+type LoanInstallment struct {
+	// LoanID is the unique identifier of the loan the installment belongs to.
+	LoanID uuid.UUID
+
+	// Number is the 1-based position of the installment in the repayment schedule.
+	Number int32
+
+	// DueDate is the time when the installment becomes due.
+	DueDate time.Time
+
+	// PrincipalAmount is the portion of the installment that repays the principal amount.
+	PrincipalAmount decimal.Decimal
+
+	// InterestAmount is the portion of the installment that pays the interest.
+	InterestAmount decimal.Decimal
+
+	// AmountDue is the total amount to be paid for the installment.
+	AmountDue decimal.Decimal
+
+	// AmountPaid is the amount that has been paid towards the installment so far.
+	AmountPaid decimal.Decimal
+
+	// Status represents the current state of the installment (e.g., unpaid, partially paid, paid).
+	Status LoanInstallmentStatus
+
+	// CreatedAt is the timestamp when the installment was created.
+	CreatedAt time.Time
+
+	// UpdatedAt is the timestamp when the installment was last updated.
+	UpdatedAt time.Time
+}
+
+// OutstandingAmount calculates the remaining amount to be paid for the installment.
+//
+// Returns:
+//   - decimal.Decimal: The amount due minus the amount paid, never negative.
+func (i *LoanInstallment) OutstandingAmount() decimal.Decimal {
+	if i == nil {
+		return decimal.Zero
+	}
+
+	outstandingAmount := i.AmountDue.Sub(i.AmountPaid)
+	if outstandingAmount.IsNegative() {
+		outstandingAmount = decimal.Zero
+	}
+
+	return outstandingAmount
+}
+
+// pay applies as much of the given amount as the installment still needs and updates its status.
+//
+// Parameters:
+//   - amount: The amount available to be applied to the installment.
+//   - now: The time the payment is applied at.
+//
+// Returns:
+//   - decimal.Decimal: The amount actually applied to the installment.
+func (i *LoanInstallment) pay(amount decimal.Decimal, now time.Time) decimal.Decimal {
+	applied := decimal.Min(amount, i.OutstandingAmount())
+	if !applied.IsPositive() {
+		return decimal.Zero
+	}
+
+	i.AmountPaid = i.AmountPaid.Add(applied)
+	i.Status = LoanInstallmentStatusPartiallyPaid
+	if i.OutstandingAmount().IsZero() {
+		i.Status = LoanInstallmentStatusPaid
+	}
+	i.UpdatedAt = now
+
+	return applied
+}
+
+// unpay takes back an amount previously applied to the installment and updates its status.
+//
+// Parameters:
+//   - amount: The amount to be taken back from the installment.
+//   - now: The time the amount is taken back at.
+func (i *LoanInstallment) unpay(amount decimal.Decimal, now time.Time) {
+	if i == nil || !amount.IsPositive() {
+		return
+	}
+
+	i.AmountPaid = decimal.Max(decimal.Zero, i.AmountPaid.Sub(amount))
+	i.updateStatus()
+	i.UpdatedAt = now
+}
+
+// updateStatus sets the status of the installment from its amount due and amount paid.
+func (i *LoanInstallment) updateStatus() {
+	switch {
+	case i.OutstandingAmount().IsZero():
+		i.Status = LoanInstallmentStatusPaid
+	case i.AmountPaid.IsZero():
+		i.Status = LoanInstallmentStatusUnpaid
+	default:
+		i.Status = LoanInstallmentStatusPartiallyPaid
+	}
+}
+
+// InstallmentAllocation represents the portion of a payment applied to a loan installment.
+type InstallmentAllocation struct {
+	// InstallmentNumber is the number of the installment the amount is applied to.
+	InstallmentNumber int32
+
+	// Amount is the portion of the payment applied to the installment.
+	Amount decimal.Decimal
+}
+
+// Installment returns the installment of the loan with the given number.
+//
+// Parameters:
+//   - number: The 1-based number of the installment.
+//
+// Returns:
+//   - *LoanInstallment: The installment, or nil if the loan has no installment with the given number.
+func (l *Loan) Installment(number int32) *LoanInstallment {
+	if l == nil {
+		return nil
+	}
+
+	for _, installment := range l.Installments {
+		if installment.Number == number {
+			return installment
+		}
+	}
+
+	return nil
+}
+
+// generateSchedule builds the repayment schedule of the loan.
+//
+// Every installment is due at the start of a billing period, counted from the beginning of the
+// loan's creation period. The payment amount is split into the installment amounts according to
+// the loan's rounding policy, so the schedule always adds up to the total payment amount. The
+// amount due is split into principal and interest portions according to the loan's interest model.
+//
+// For a restructured loan, only the installments of its restructured schedule are generated, from the restructured
+// principal and the part of the payment amount not due on the installments kept from its previous terms.
+//
+// Returns:
+//   - []*LoanInstallment: The installments of the loan, ordered by their number.
+func (l *Loan) generateSchedule() []*LoanInstallment {
+	if l == nil || l.InstallmentCount <= 0 {
+		return nil
+	}
+
+	if l.TermsVersion <= 1 {
+		return l.buildInstallments(l.Amount, l.PaymentAmount, 1, l.InstallmentCount)
+	}
+
+	keptCount := min(int(l.RestructuredFromInstallment-1), len(l.Installments))
+	paymentAmount := l.PaymentAmount
+	for _, installment := range l.Installments[:keptCount] {
+		paymentAmount = paymentAmount.Sub(installment.AmountDue)
+	}
+
+	return l.buildInstallments(l.RestructuredPrincipal, paymentAmount, l.RestructuredFromInstallment, l.InstallmentCount-l.RestructuredFromInstallment+1)
+}
+
+// buildInstallments builds consecutive installments of the repayment schedule of the loan repaying the given amounts.
+//
+// Parameters:
+//   - principal: The principal amount repaid by the installments.
+//   - paymentAmount: The total amount to be paid by the installments, including interest.
+//   - firstNumber: The number of the first installment.
+//   - count: The number of installments.
+//
+// Returns:
+//   - []*LoanInstallment: The installments, ordered by their number.
+func (l *Loan) buildInstallments(principal, paymentAmount decimal.Decimal, firstNumber, count int32) []*LoanInstallment {
+	if count <= 0 {
+		return nil
+	}
+
+	amountsDue := l.RoundingPolicy.split(paymentAmount, count)
+	principalAmounts := l.RoundingPolicy.split(principal, count)
+	beginningOfPeriod := l.beginningOfBillingPeriod()
+
+	installments := make([]*LoanInstallment, 0, count)
+	remainingPrincipalAmount := principal
+	for i, amountDue := range amountsDue {
+		number := firstNumber + int32(i)
+
+		principalAmount := principalAmounts[i]
+		if l.InterestModel == InterestModelDecliningBalance {
+			principalAmount = amountDue.Sub(remainingPrincipalAmount.Mul(l.InterestRate).RoundDown(l.RoundingPolicy.Precision))
+			if int32(i) == count-1 {
+				principalAmount = remainingPrincipalAmount
+			}
+		}
+		principalAmount = decimal.Max(decimal.Zero, decimal.Min(principalAmount, remainingPrincipalAmount, amountDue))
+
+		installments = append(installments, &LoanInstallment{
+			LoanID:          l.ID,
+			Number:          number,
+			DueDate:         l.BillingFrequency.dueDate(beginningOfPeriod, number+l.deferredPeriods(number)),
+			PrincipalAmount: principalAmount,
+			InterestAmount:  amountDue.Sub(principalAmount),
+			AmountDue:       amountDue,
+			AmountPaid:      decimal.Zero,
+			Status:          LoanInstallmentStatusUnpaid,
+			CreatedAt:       l.CreatedAt,
+			UpdatedAt:       l.CreatedAt,
+		})
+
+		remainingPrincipalAmount = remainingPrincipalAmount.Sub(principalAmount)
+	}
+
+	return installments
+}
+
+// schedule returns the repayment schedule of the loan.
+//
+// Returns:
+//   - []*LoanInstallment: The stored installments of the loan, or a freshly generated schedule
+//     if the loan has none stored.
+func (l *Loan) schedule() []*LoanInstallment {
+	if len(l.Installments) > 0 {
+		return l.Installments
+	}
+
+	return l.generateSchedule()
+}
+
+// dueInstallments returns the installments of the loan that are due at the given time.
+//
+// Parameters:
+//   - now: The current time used to determine the due installments.
+//
+// Returns:
+//   - []*LoanInstallment: The installments due up to the current billing period, oldest first.
+func (l *Loan) dueInstallments(now time.Time) []*LoanInstallment {
+	schedule := l.schedule()
+
+	currentPeriod := l.currentPeriod(now)
+	if currentPeriod < 0 {
+		currentPeriod = 0
+	}
+	if int(currentPeriod) < len(schedule) {
+		schedule = schedule[:currentPeriod]
+	}
+
+	return schedule
+}
+
+// gracePeriodEnd returns the time an installment stops being within its grace period, after which it is missed
+// if it is still unpaid. It counts towards the delinquency of the loan and is charged a late fee from then on.
+//
+// Parameters:
+//   - installment: A pointer to the installment of the loan.
+//
+// Returns:
+//   - time.Time: The due date of the installment plus the loan's grace period, extended by the payment holidays
+//     starting in between.
+func (l *Loan) gracePeriodEnd(installment *LoanInstallment) time.Time {
+	gracePeriodEnd := installment.DueDate.AddDate(0, 0, int(l.GracePeriodDays))
+	for _, holiday := range l.PaymentHolidays {
+		if holiday.StartsAt.Before(installment.DueDate) || holiday.StartsAt.After(gracePeriodEnd) {
+			continue
+		}
+
+		gracePeriodEnd = gracePeriodEnd.AddDate(0, 0, int(l.billingCalendar().daysBetween(holiday.StartsAt, holiday.EndsAt)))
+	}
+
+	return gracePeriodEnd
+}
+
+// overdueInstallments returns the due installments of the loan whose grace period has ended at the given time.
+//
+// Parameters:
+//   - now: The current time used to determine the overdue installments.
+//
+// Returns:
+//   - []*LoanInstallment: The due installments that are past their grace period, oldest first.
+func (l *Loan) overdueInstallments(now time.Time) []*LoanInstallment {
+	installments := l.dueInstallments(now)
+	for i, installment := range installments {
+		if now.Before(l.gracePeriodEnd(installment)) {
+			return installments[:i]
+		}
+	}
+
+	return installments
+}
+
+// allocatePayment applies a payment to the unpaid installments and charges of the loan.
+//
+// The payment is applied to the installments due at the given time first, oldest installment first, then to the
+// charges, oldest charge first, and finally to the installments that are not due yet.
+//
+// Parameters:
+//   - amount: The amount of the payment to be allocated.
+//   - now: The time the payment is made at.
+//
+// Returns:
+//   - []InstallmentAllocation: The portions of the payment applied to each installment.
+//   - []ChargeAllocation: The portions of the payment applied to each charge.
+func (l *Loan) allocatePayment(amount decimal.Decimal, now time.Time) ([]InstallmentAllocation, []ChargeAllocation) {
+	if l == nil {
+		return nil, nil
+	}
+
+	var allocations []InstallmentAllocation
+	payInstallments := func(isDue bool) {
+		for _, installment := range l.Installments {
+			if !amount.IsPositive() {
+				break
+			}
+			if installment.DueDate.After(now) == isDue {
+				continue
+			}
+
+			applied := installment.pay(amount, now)
+			if applied.IsZero() {
+				continue
+			}
+
+			allocations = append(allocations, InstallmentAllocation{
+				InstallmentNumber: installment.Number,
+				Amount:            applied,
+			})
+			amount = amount.Sub(applied)
+		}
+	}
+
+	payInstallments(true)
+
+	var chargeAllocations []ChargeAllocation
+	for _, charge := range l.Charges {
+		if !amount.IsPositive() {
+			break
+		}
+
+		applied := charge.pay(amount, now)
+		if applied.IsZero() {
+			continue
+		}
+
+		chargeAllocations = append(chargeAllocations, ChargeAllocation{
+			ChargeID: charge.ID,
+			Amount:   applied,
+		})
+		amount = amount.Sub(applied)
+	}
+
+	payInstallments(false)
+
+	return allocations, chargeAllocations
+}
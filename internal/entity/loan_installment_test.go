@@ -0,0 +1,311 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestLoanInstallmentStatus_IsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		status LoanInstallmentStatus
+		want   bool
+	}{
+		{
+			name:   "unpaid",
+			status: LoanInstallmentStatusUnpaid,
+			want:   true,
+		},
+		{
+			name:   "paid",
+			status: LoanInstallmentStatusPaid,
+			want:   true,
+		},
+		{
+			name:   "partially paid",
+			status: LoanInstallmentStatusPartiallyPaid,
+			want:   true,
+		},
+		{
+			name:   "unknown status",
+			status: LoanInstallmentStatus(-1),
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.status.IsValid(); got != tt.want {
+				t.Fatalf("expecting %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLoanInstallment_OutstandingAmount(t *testing.T) {
+	tests := []struct {
+		name        string
+		installment *LoanInstallment
+		want        decimal.Decimal
+	}{
+		{
+			name:        "nil installment",
+			installment: nil,
+			want:        decimal.Zero,
+		},
+		{
+			name: "partially paid",
+			installment: &LoanInstallment{
+				AmountDue:  decimal.NewFromInt(100),
+				AmountPaid: decimal.NewFromInt(40),
+			},
+			want: decimal.NewFromInt(60),
+		},
+		{
+			name: "overpaid",
+			installment: &LoanInstallment{
+				AmountDue:  decimal.NewFromInt(100),
+				AmountPaid: decimal.NewFromInt(120),
+			},
+			want: decimal.Zero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.installment.OutstandingAmount(); !got.Equal(tt.want) {
+				t.Fatalf("expecting %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLoan_Installment(t *testing.T) {
+	loan := &Loan{
+		Installments: []*LoanInstallment{
+			{Number: 1},
+			{Number: 2},
+		},
+	}
+
+	if got := loan.Installment(2); got != loan.Installments[1] {
+		t.Fatalf("expecting installment 2, got %v", got)
+	}
+	if got := loan.Installment(3); got != nil {
+		t.Fatalf("expecting nil installment, got %v", got)
+	}
+
+	var nilLoan *Loan
+	if got := nilLoan.Installment(1); got != nil {
+		t.Fatalf("expecting nil installment, got %v", got)
+	}
+}
+
+func TestLoan_generateSchedule(t *testing.T) {
+	loanID := uuid.New()
+	createdAt := time.Date(2026, 10, 14, 9, 30, 0, 0, time.UTC) // Wednesday
+
+	tests := []struct {
+		name string
+		loan *Loan
+		want []*LoanInstallment
+	}{
+		{
+			name: "nil loan",
+			loan: nil,
+			want: nil,
+		},
+		{
+			name: "flat interest with rounding remainder on the last installment",
+			loan: &Loan{
+				ID:               loanID,
+				Amount:           decimal.NewFromInt(1_000),
+				InstallmentCount: 3,
+				InterestModel:    InterestModelFlat,
+				InterestRate:     decimal.NewFromFloat(0.1),
+				PaymentAmount:    decimal.NewFromInt(1_100),
+				CreatedAt:        createdAt,
+			},
+			want: []*LoanInstallment{
+				{
+					LoanID:          loanID,
+					Number:          1,
+					DueDate:         time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+					PrincipalAmount: decimal.NewFromInt(333),
+					InterestAmount:  decimal.NewFromInt(33),
+					AmountDue:       decimal.NewFromInt(366),
+				},
+				{
+					LoanID:          loanID,
+					Number:          2,
+					DueDate:         time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC),
+					PrincipalAmount: decimal.NewFromInt(333),
+					InterestAmount:  decimal.NewFromInt(33),
+					AmountDue:       decimal.NewFromInt(366),
+				},
+				{
+					LoanID:          loanID,
+					Number:          3,
+					DueDate:         time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC),
+					PrincipalAmount: decimal.NewFromInt(334),
+					InterestAmount:  decimal.NewFromInt(34),
+					AmountDue:       decimal.NewFromInt(368),
+				},
+			},
+		},
+		{
+			name: "declining balance interest",
+			loan: &Loan{
+				ID:               loanID,
+				Amount:           decimal.NewFromInt(1_000_000),
+				InstallmentCount: 2,
+				InterestModel:    InterestModelDecliningBalance,
+				InterestRate:     decimal.NewFromFloat(0.1),
+				PaymentAmount:    decimal.NewFromInt(1_152_381),
+				CreatedAt:        createdAt,
+			},
+			want: []*LoanInstallment{
+				{
+					LoanID:          loanID,
+					Number:          1,
+					DueDate:         time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+					PrincipalAmount: decimal.NewFromInt(476_190),
+					InterestAmount:  decimal.NewFromInt(100_000),
+					AmountDue:       decimal.NewFromInt(576_190),
+				},
+				{
+					LoanID:          loanID,
+					Number:          2,
+					DueDate:         time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC),
+					PrincipalAmount: decimal.NewFromInt(523_810),
+					InterestAmount:  decimal.NewFromInt(52_381),
+					AmountDue:       decimal.NewFromInt(576_191),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.loan.generateSchedule()
+			if diff := cmp.Diff(
+				tt.want, got,
+				cmpopts.IgnoreFields(LoanInstallment{}, "AmountPaid", "Status", "CreatedAt", "UpdatedAt"),
+			); diff != "" {
+				t.Fatalf("schedule mismatch (-want +got):\n%s", diff)
+			}
+
+			for _, installment := range got {
+				if !installment.AmountPaid.IsZero() || installment.Status != LoanInstallmentStatusUnpaid {
+					t.Fatalf("expecting installment %d to be unpaid", installment.Number)
+				}
+			}
+		})
+	}
+}
+
+func TestLoan_allocatePayment(t *testing.T) {
+	now := time.Now().UTC()
+
+	newLoan := func() *Loan {
+		return &Loan{
+			Installments: []*LoanInstallment{
+				{Number: 1, AmountDue: decimal.NewFromInt(100), AmountPaid: decimal.NewFromInt(100), Status: LoanInstallmentStatusPaid},
+				{Number: 2, AmountDue: decimal.NewFromInt(100), AmountPaid: decimal.NewFromInt(30), Status: LoanInstallmentStatusPartiallyPaid},
+				{Number: 3, AmountDue: decimal.NewFromInt(100), AmountPaid: decimal.Zero},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		amount     decimal.Decimal
+		want       []InstallmentAllocation
+		wantStatus []LoanInstallmentStatus
+	}{
+		{
+			name:   "settles the oldest unpaid installment only",
+			amount: decimal.NewFromInt(70),
+			want: []InstallmentAllocation{
+				{InstallmentNumber: 2, Amount: decimal.NewFromInt(70)},
+			},
+			wantStatus: []LoanInstallmentStatus{LoanInstallmentStatusPaid, LoanInstallmentStatusPaid, LoanInstallmentStatusUnpaid},
+		},
+		{
+			name:   "spills over to the next installment",
+			amount: decimal.NewFromInt(120),
+			want: []InstallmentAllocation{
+				{InstallmentNumber: 2, Amount: decimal.NewFromInt(70)},
+				{InstallmentNumber: 3, Amount: decimal.NewFromInt(50)},
+			},
+			wantStatus: []LoanInstallmentStatus{LoanInstallmentStatusPaid, LoanInstallmentStatusPaid, LoanInstallmentStatusPartiallyPaid},
+		},
+		{
+			name:   "amount exceeding the schedule is not allocated",
+			amount: decimal.NewFromInt(500),
+			want: []InstallmentAllocation{
+				{InstallmentNumber: 2, Amount: decimal.NewFromInt(70)},
+				{InstallmentNumber: 3, Amount: decimal.NewFromInt(100)},
+			},
+			wantStatus: []LoanInstallmentStatus{LoanInstallmentStatusPaid, LoanInstallmentStatusPaid, LoanInstallmentStatusPaid},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loan := newLoan()
+
+			got, _ := loan.allocatePayment(tt.amount, now)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("allocations mismatch (-want +got):\n%s", diff)
+			}
+
+			for i, installment := range loan.Installments {
+				if installment.Status != tt.wantStatus[i] {
+					t.Fatalf("expecting installment %d status to be %v, got %v", installment.Number, tt.wantStatus[i], installment.Status)
+				}
+			}
+		})
+	}
+}
+
+func TestLoan_allocatePayment_charges(t *testing.T) {
+	now := time.Now().UTC()
+	chargeID := uuid.New()
+
+	loan := &Loan{
+		Installments: []*LoanInstallment{
+			{Number: 1, DueDate: now.Add(-time.Hour * 24), AmountDue: decimal.NewFromInt(100), AmountPaid: decimal.Zero},
+			{Number: 2, DueDate: now.Add(time.Hour * 24 * 6), AmountDue: decimal.NewFromInt(100), AmountPaid: decimal.Zero},
+		},
+		Charges: []*LoanCharge{
+			{ID: chargeID, Amount: decimal.NewFromInt(10), AmountPaid: decimal.Zero},
+		},
+	}
+
+	allocations, chargeAllocations := loan.allocatePayment(decimal.NewFromInt(150), now)
+
+	wantAllocations := []InstallmentAllocation{
+		{InstallmentNumber: 1, Amount: decimal.NewFromInt(100)},
+		{InstallmentNumber: 2, Amount: decimal.NewFromInt(40)},
+	}
+	if diff := cmp.Diff(wantAllocations, allocations); diff != "" {
+		t.Fatalf("allocations mismatch (-want +got):\n%s", diff)
+	}
+
+	wantChargeAllocations := []ChargeAllocation{
+		{ChargeID: chargeID, Amount: decimal.NewFromInt(10)},
+	}
+	if diff := cmp.Diff(wantChargeAllocations, chargeAllocations); diff != "" {
+		t.Fatalf("charge allocations mismatch (-want +got):\n%s", diff)
+	}
+
+	if !loan.Charges[0].OutstandingAmount().IsZero() {
+		t.Fatalf("expecting the charge to be paid")
+	}
+}
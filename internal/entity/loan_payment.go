@@ -15,6 +15,11 @@ var (
 	ErrLoanPaymentInvalidAmount  = businesserror.New("loan payment amount must be greater than zero", businesserror.KindBadRequest)
 	ErrLoanPaymentEmptyCreatedAt = businesserror.New("created at cannot be empty", businesserror.KindBadRequest)
 	ErrLoanPaymentEmptyUpdatedAt = businesserror.New("updated at cannot be empty", businesserror.KindBadRequest)
+	ErrLoanPaymentInvalidChannel = businesserror.New("invalid loan payment channel", businesserror.KindBadRequest)
+	ErrLoanPaymentInvalidPaidAt  = businesserror.New("loan payment paid at cannot be in the future", businesserror.KindBadRequest)
+	ErrLoanPaymentInvalidDetails = businesserror.New("loan payment external reference or metadata is too long", businesserror.KindBadRequest)
+
+	ErrLoanPaymentDuplicateExternalReference = businesserror.New("loan payment external reference already exists for the channel", businesserror.KindAlreadyExists)
 )
 
 // LoanPayment represents a payment made towards a loan.
@@ -25,9 +30,37 @@ type LoanPayment struct {
     // LoanID is the unique identifier of the loan associated with this payment.
     LoanID uuid.UUID
 
-    // Amount is the monetary value of the payment.
+    // Amount is the monetary value of the payment applied to the loan.
     Amount decimal.Decimal
 
+    // ExcessAmount is the portion of the payment received over the amount accepted towards the loan,
+    // handled according to ExcessHandling. It is zero if the payment was not over the accepted amount.
+    ExcessAmount decimal.Decimal
+
+    // ExcessHandling is how the excess of the payment was handled, OverpaymentPolicyReject if there is no excess.
+    ExcessHandling OverpaymentPolicy
+
+    // CreditEntry is the change the payment made to the credit balance of the loan's user, nil if there is none.
+    CreditEntry *CreditEntry
+
+    // Allocations lists the portions of the payment applied to the loan installments.
+    Allocations []InstallmentAllocation
+
+    // ChargeAllocations lists the portions of the payment applied to the loan charges.
+    ChargeAllocations []ChargeAllocation
+
+    // Mode is the purpose the payment was made for.
+    Mode PaymentMode
+
+    // IdempotencyKey is the client-supplied key of the request the payment was made by, empty if none was given.
+    IdempotencyKey string
+
+    // Details is the information of the payment reported by the channel it was received through.
+    Details PaymentDetails
+
+    // ReversedAt is the timestamp when the payment was reversed, or the zero time if it has not been reversed.
+    ReversedAt time.Time
+
     // CreatedAt is the timestamp when the payment record was created.
     CreatedAt time.Time
 
@@ -35,28 +68,36 @@ type LoanPayment struct {
     UpdatedAt time.Time
 }
 
-// CreateLoanPayment creates a new LoanPayment instance with the given loan ID and amount.
-// It generates a new UUID for the payment, sets the creation and update times to the current UTC time,
-// and validates the payment before returning it.
+// CreateLoanPayment creates a new LoanPayment instance with the given loan ID, amount and details.
+// It generates a new UUID for the payment, sets the creation and update times to the given time in UTC,
+// defaults the value date of the payment to the same time, and validates the payment before returning it.
 //
 // Parameters:
 //   - loanID: A UUID representing the ID of the loan associated with this payment.
 //   - amount: A decimal.Decimal value representing the amount of the payment.
+//   - details: The details of the payment reported by the channel it was received through.
+//   - now: The time the payment is made at.
 //
 // Returns:
 //   - *LoanPayment: The newly created and validated LoanPayment instance.
 //   - error: An error if there was a problem creating the UUID or if the payment fails validation.
-func CreateLoanPayment(loanID uuid.UUID, amount decimal.Decimal) (*LoanPayment, error) {
+func CreateLoanPayment(loanID uuid.UUID, amount decimal.Decimal, details PaymentDetails, now time.Time) (*LoanPayment, error) {
     paymentID, err := uuid.NewV7()
     if err != nil {
         return nil, err
     }
 
-    now := time.Now().UTC()
+    now = now.UTC()
+    if details.PaidAt.IsZero() {
+        details.PaidAt = now
+    }
+    details.PaidAt = details.PaidAt.UTC()
+
     payment := &LoanPayment{
         ID:        paymentID,
         LoanID:    loanID,
         Amount:    amount,
+        Details:   details,
         CreatedAt: now,
         UpdatedAt: now,
     }
@@ -68,6 +109,14 @@ func CreateLoanPayment(loanID uuid.UUID, amount decimal.Decimal) (*LoanPayment,
     return payment, nil
 }
 
+// ReceivedAmount calculates the total amount received by the payment.
+//
+// Returns:
+//   - decimal.Decimal: The amount applied to the loan plus the excess amount.
+func (lp *LoanPayment) ReceivedAmount() decimal.Decimal {
+    return lp.Amount.Add(lp.ExcessAmount)
+}
+
 // validate checks the LoanPayment struct for validity.
 //
 // It performs the following checks:
@@ -76,6 +125,8 @@ func CreateLoanPayment(loanID uuid.UUID, amount decimal.Decimal) (*LoanPayment,
 //   - Verifies that the Amount is greater than zero
 //   - Checks that CreatedAt is not a zero time
 //   - Checks that UpdatedAt is not a zero time
+//   - Verifies that the channel is valid and the value date is not after CreatedAt
+//   - Verifies that the external reference and metadata are within their limits
 //
 // Returns:
 //   - error: nil if the LoanPayment is valid, otherwise returns a specific error
@@ -101,5 +152,17 @@ func (lp *LoanPayment) validate() error {
         return ErrLoanPaymentEmptyUpdatedAt
     }
 
+    if !lp.Details.Channel.IsValid() {
+        return ErrLoanPaymentInvalidChannel
+    }
+
+    if lp.Details.PaidAt.After(lp.CreatedAt) {
+        return ErrLoanPaymentInvalidPaidAt
+    }
+
+    if len(lp.Details.ExternalReference) > maxExternalReferenceLength || len(lp.Details.Metadata) > maxPaymentMetadataEntries {
+        return ErrLoanPaymentInvalidDetails
+    }
+
     return nil
 }
@@ -0,0 +1,170 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/common/businesserror"
+)
+
+const maxReversalReasonLength = 500 // Maximum length of the reason a payment is reversed for
+
+var (
+	ErrLoanPaymentNotFound        = businesserror.New("loan payment not found", businesserror.KindNotFound)
+	ErrLoanPaymentAlreadyReversed = businesserror.New("loan payment is already reversed", businesserror.KindUnprocessableEntity)
+	ErrLoanPaymentInvalidReason   = businesserror.New("loan payment reversal reason must be between 1 and 500 characters", businesserror.KindBadRequest)
+	ErrLoanPaymentCreditSpent     = businesserror.New("loan payment excess credit has already been spent", businesserror.KindUnprocessableEntity)
+)
+
+// LoanPaymentReversal represents the compensating entry recorded when a payment made towards a loan is reversed,
+// such as for a bounced transfer or a chargeback. The reversed payment itself is kept.
+type LoanPaymentReversal struct {
+	// ID is the unique identifier for the reversal.
+	ID uuid.UUID
+
+	// PaymentID is the unique identifier of the payment being reversed.
+	PaymentID uuid.UUID
+
+	// LoanID is the unique identifier of the loan the reversed payment was made towards.
+	LoanID uuid.UUID
+
+	// Amount is the amount of the reversed payment taken back from the loan.
+	Amount decimal.Decimal
+
+	// Reason is the explanation of why the payment was reversed.
+	Reason string
+
+	// CreditEntry is the change undoing the change the reversed payment made to the credit balance
+	// of the loan's user, nil if the payment made none.
+	CreditEntry *CreditEntry
+
+	// CreatedAt is the timestamp when the reversal was recorded.
+	CreatedAt time.Time
+}
+
+// ValidateReversalReason checks that the reason of a payment reversal can be stored.
+//
+// Parameters:
+//   - reason: The explanation of why the payment is reversed.
+//
+// Returns:
+//   - error: ErrLoanPaymentInvalidReason if the reason is empty or too long, nil otherwise.
+func ValidateReversalReason(reason string) error {
+	if reason == "" || len(reason) > maxReversalReasonLength {
+		return ErrLoanPaymentInvalidReason
+	}
+
+	return nil
+}
+
+// ReversePayment reverses a payment made towards the loan.
+//
+// The portions of the payment applied to the installments and charges are taken back from them, the early
+// settlement rebate is restored if the payment paid the loan off, and the loan is reopened, as defaulted if it had been
+// defaulted, if the payment left it with an outstanding amount. The amount of a recovery payment is taken back from
+// the recovered amount of the loan instead, and a written-off loan accepts no other reversal. A payment applied before
+// the loan was restructured cannot be reversed, since the amount it left owing was repaid in the new terms.
+// The excess of the payment credited to the balance of the loan's user
+// is deducted from it again, and the credit a payment of PaymentModeCredit was made from is given back. A payment whose
+// credited excess has since been applied to the user's bills cannot be reversed while the balance no longer holds it,
+// since the credit it paid them with would be taken back without them becoming due again.
+// The payment is marked as reversed rather than removed, and a reversal entry recording the amount and reason is returned.
+//
+// Parameters:
+//   - payment: A pointer to the payment to be reversed, with its allocations.
+//   - paidAmount: The total amount paid towards the loan, including the payment being reversed.
+//   - creditBalance: The credit balance of the loan's user.
+//   - reason: The explanation of why the payment is reversed.
+//   - now: The time the payment is reversed at.
+//
+// Returns:
+//   - *LoanPaymentReversal: The reversal entry of the payment.
+//   - error: An error if the payment cannot be reversed. Possible errors include: ErrLoanNotFound,
+//     ErrLoanPaymentNotFound, ErrLoanPaymentAlreadyReversed, ErrLoanPaymentInvalidReason, ErrLoanWrittenOff,
+//     ErrLoanPaymentRestructured and ErrLoanPaymentCreditSpent.
+func (l *Loan) ReversePayment(
+	payment *LoanPayment, paidAmount, creditBalance decimal.Decimal, reason string, now time.Time,
+) (*LoanPaymentReversal, error) {
+	if l == nil {
+		return nil, ErrLoanNotFound
+	}
+	if payment == nil || payment.LoanID != l.ID {
+		return nil, ErrLoanPaymentNotFound
+	}
+	if !payment.ReversedAt.IsZero() {
+		return nil, ErrLoanPaymentAlreadyReversed
+	}
+	if err := ValidateReversalReason(reason); err != nil {
+		return nil, err
+	}
+	if l.Status == LoanStatusWrittenOff && payment.Mode != PaymentModeRecovery {
+		return nil, ErrLoanWrittenOff
+	}
+	if l.isPaidBeforeRestructure(payment) {
+		return nil, ErrLoanPaymentRestructured
+	}
+	if payment.Mode != PaymentModeCredit && payment.ExcessHandling == OverpaymentPolicyCredit &&
+		creditBalance.LessThan(payment.ExcessAmount) {
+		return nil, ErrLoanPaymentCreditSpent
+	}
+
+	reversalID, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	now = now.UTC()
+	for _, allocation := range payment.Allocations {
+		l.Installment(allocation.InstallmentNumber).unpay(allocation.Amount, now)
+	}
+	for _, allocation := range payment.ChargeAllocations {
+		l.Charge(allocation.ChargeID).unpay(allocation.Amount, now)
+	}
+	switch payment.Mode {
+	case PaymentModePayoff:
+		l.restoreEarlySettlementRebate(now)
+	case PaymentModeRecovery:
+		l.RecoveredAmount = l.RecoveredAmount.Sub(payment.Amount)
+	}
+
+	if l.Status == LoanStatusPaid && !l.OutstandingAmount(paidAmount.Sub(payment.Amount)).IsZero() {
+		status := LoanStatusOngoing
+		if !l.DefaultedAt.IsZero() {
+			status = LoanStatusDefaulted
+		}
+		if err = l.transitionTo(status, now); err != nil {
+			return nil, err
+		}
+	}
+	l.UpdatedAt = now
+
+	payment.ReversedAt = now
+	payment.UpdatedAt = now
+
+	reversal := &LoanPaymentReversal{
+		ID:        reversalID,
+		PaymentID: payment.ID,
+		LoanID:    l.ID,
+		Amount:    payment.Amount,
+		Reason:    reason,
+		CreatedAt: now,
+	}
+
+	var creditAmount decimal.Decimal
+	switch {
+	case payment.Mode == PaymentModeCredit:
+		creditAmount = payment.Amount
+	case payment.ExcessHandling == OverpaymentPolicyCredit:
+		creditAmount = payment.ExcessAmount.Neg()
+	}
+	if !creditAmount.IsZero() {
+		reversal.CreditEntry, err = newCreditEntry(l, payment.ID, CreditEntryTypeReversal, creditAmount, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return reversal, nil
+}
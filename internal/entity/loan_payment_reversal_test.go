@@ -0,0 +1,407 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestValidateReversalReason(t *testing.T) {
+	tests := []struct {
+		name    string
+		reason  string
+		wantErr error
+	}{
+		{
+			name:    "valid reason",
+			reason:  "bounced transfer",
+			wantErr: nil,
+		},
+		{
+			name:    "empty reason",
+			reason:  "",
+			wantErr: ErrLoanPaymentInvalidReason,
+		},
+		{
+			name:    "reason at the maximum length",
+			reason:  strings.Repeat("a", maxReversalReasonLength),
+			wantErr: nil,
+		},
+		{
+			name:    "reason too long",
+			reason:  strings.Repeat("a", maxReversalReasonLength+1),
+			wantErr: ErrLoanPaymentInvalidReason,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateReversalReason(tt.reason); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateReversalReason() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoan_ReversePayment(t *testing.T) {
+	now := time.Now().UTC()
+	loanID := uuid.New()
+	chargeID := uuid.New()
+
+	type installmentSummary struct {
+		Number         int32
+		InterestAmount string
+		AmountDue      string
+		AmountPaid     string
+		Status         LoanInstallmentStatus
+	}
+
+	// installment 1 is paid and installments 2 and 3 are not due yet
+	newLoan := func(status LoanStatus, rebateAmount decimal.Decimal, installments ...*LoanInstallment) *Loan {
+		return &Loan{
+			ID:                        loanID,
+			Amount:                    decimal.NewFromInt(300),
+			InterestModel:             InterestModelFlat,
+			InterestRate:              decimal.NewFromFloat(0.1),
+			PaymentAmount:             decimal.NewFromInt(330),
+			InstallmentCount:          3,
+			EarlySettlementRebateRate: decimal.NewFromFloat(0.5),
+			RebateAmount:              rebateAmount,
+			Status:                    status,
+			CreatedAt:                 now.Add(-time.Hour * 24 * 7), // now is loan week 1
+			Installments:              installments,
+		}
+	}
+	installment := func(number int32, interestAmount, amountPaid int64, status LoanInstallmentStatus) *LoanInstallment {
+		return &LoanInstallment{
+			Number:          number,
+			PrincipalAmount: decimal.NewFromInt(100),
+			InterestAmount:  decimal.NewFromInt(interestAmount),
+			AmountDue:       decimal.NewFromInt(100 + interestAmount),
+			AmountPaid:      decimal.NewFromInt(amountPaid),
+			Status:          status,
+		}
+	}
+
+	tests := []struct {
+		name             string
+		loan             *Loan
+		payment          *LoanPayment
+		paidAmount       decimal.Decimal
+		creditBalance    decimal.Decimal
+		reason           string
+		wantInstallments []installmentSummary
+		wantChargePaid   decimal.Decimal
+		wantCredit       decimal.Decimal
+		wantStatus       LoanStatus
+		wantRebate       decimal.Decimal
+		wantErr          error
+	}{
+		{
+			name:       "nil loan",
+			loan:       nil,
+			payment:    &LoanPayment{LoanID: loanID},
+			paidAmount: decimal.Zero,
+			reason:     "bounced transfer",
+			wantErr:    ErrLoanNotFound,
+		},
+		{
+			name:       "nil payment",
+			loan:       newLoan(LoanStatusOngoing, decimal.Zero),
+			payment:    nil,
+			paidAmount: decimal.Zero,
+			reason:     "bounced transfer",
+			wantErr:    ErrLoanPaymentNotFound,
+		},
+		{
+			name:       "payment of another loan",
+			loan:       newLoan(LoanStatusOngoing, decimal.Zero),
+			payment:    &LoanPayment{LoanID: uuid.New(), Amount: decimal.NewFromInt(110)},
+			paidAmount: decimal.NewFromInt(110),
+			reason:     "bounced transfer",
+			wantErr:    ErrLoanPaymentNotFound,
+		},
+		{
+			name:       "payment already reversed",
+			loan:       newLoan(LoanStatusOngoing, decimal.Zero),
+			payment:    &LoanPayment{LoanID: loanID, Amount: decimal.NewFromInt(110), ReversedAt: now.Add(-time.Hour)},
+			paidAmount: decimal.Zero,
+			reason:     "bounced transfer",
+			wantErr:    ErrLoanPaymentAlreadyReversed,
+		},
+		{
+			name:       "empty reason",
+			loan:       newLoan(LoanStatusOngoing, decimal.Zero),
+			payment:    &LoanPayment{LoanID: loanID, Amount: decimal.NewFromInt(110)},
+			paidAmount: decimal.NewFromInt(110),
+			reason:     "",
+			wantErr:    ErrLoanPaymentInvalidReason,
+		},
+		{
+			name: "regular payment is taken back from its installments",
+			loan: newLoan(LoanStatusOngoing, decimal.Zero,
+				installment(1, 10, 110, LoanInstallmentStatusPaid),
+				installment(2, 10, 40, LoanInstallmentStatusPartiallyPaid),
+				installment(3, 10, 0, LoanInstallmentStatusUnpaid),
+			),
+			payment: &LoanPayment{
+				LoanID: loanID,
+				Mode:   PaymentModeRegular,
+				Amount: decimal.NewFromInt(80),
+				Allocations: []InstallmentAllocation{
+					{InstallmentNumber: 1, Amount: decimal.NewFromInt(40)},
+					{InstallmentNumber: 2, Amount: decimal.NewFromInt(40)},
+				},
+			},
+			paidAmount: decimal.NewFromInt(150),
+			reason:     "bounced transfer",
+			wantInstallments: []installmentSummary{
+				{Number: 1, InterestAmount: "10", AmountDue: "110", AmountPaid: "70", Status: LoanInstallmentStatusPartiallyPaid},
+				{Number: 2, InterestAmount: "10", AmountDue: "110", AmountPaid: "0", Status: LoanInstallmentStatusUnpaid},
+				{Number: 3, InterestAmount: "10", AmountDue: "110", AmountPaid: "0", Status: LoanInstallmentStatusUnpaid},
+			},
+			wantStatus: LoanStatusOngoing,
+			wantRebate: decimal.Zero,
+			wantErr:    nil,
+		},
+		{
+			name: "payoff is reversed, restoring the rebate and reopening the loan",
+			loan: newLoan(LoanStatusPaid, decimal.NewFromInt(10),
+				installment(1, 10, 110, LoanInstallmentStatusPaid),
+				installment(2, 5, 105, LoanInstallmentStatusPaid),
+				installment(3, 5, 105, LoanInstallmentStatusPaid),
+			),
+			payment: &LoanPayment{
+				LoanID: loanID,
+				Mode:   PaymentModePayoff,
+				Amount: decimal.NewFromInt(210),
+				Allocations: []InstallmentAllocation{
+					{InstallmentNumber: 2, Amount: decimal.NewFromInt(105)},
+					{InstallmentNumber: 3, Amount: decimal.NewFromInt(105)},
+				},
+			},
+			paidAmount: decimal.NewFromInt(320),
+			reason:     "chargeback",
+			wantInstallments: []installmentSummary{
+				{Number: 1, InterestAmount: "10", AmountDue: "110", AmountPaid: "110", Status: LoanInstallmentStatusPaid},
+				{Number: 2, InterestAmount: "10", AmountDue: "110", AmountPaid: "0", Status: LoanInstallmentStatusUnpaid},
+				{Number: 3, InterestAmount: "10", AmountDue: "110", AmountPaid: "0", Status: LoanInstallmentStatusUnpaid},
+			},
+			wantStatus: LoanStatusOngoing,
+			wantRebate: decimal.Zero,
+			wantErr:    nil,
+		},
+		{
+			name: "paid loan stays paid when an earlier payment left no outstanding amount",
+			loan: newLoan(LoanStatusPaid, decimal.Zero,
+				installment(1, 10, 110, LoanInstallmentStatusPaid),
+				installment(2, 10, 110, LoanInstallmentStatusPaid),
+				installment(3, 10, 110, LoanInstallmentStatusPaid),
+			),
+			payment: &LoanPayment{
+				LoanID: loanID,
+				Mode:   PaymentModePrepayment,
+				Amount: decimal.NewFromInt(50), // paid over the outstanding amount, allocated to nothing
+			},
+			paidAmount: decimal.NewFromInt(380),
+			reason:     "duplicate transfer",
+			wantInstallments: []installmentSummary{
+				{Number: 1, InterestAmount: "10", AmountDue: "110", AmountPaid: "110", Status: LoanInstallmentStatusPaid},
+				{Number: 2, InterestAmount: "10", AmountDue: "110", AmountPaid: "110", Status: LoanInstallmentStatusPaid},
+				{Number: 3, InterestAmount: "10", AmountDue: "110", AmountPaid: "110", Status: LoanInstallmentStatusPaid},
+			},
+			wantStatus: LoanStatusPaid,
+			wantRebate: decimal.Zero,
+			wantErr:    nil,
+		},
+		{
+			name: "credited excess is deducted from the credit balance",
+			loan: newLoan(LoanStatusOngoing, decimal.Zero,
+				installment(1, 10, 110, LoanInstallmentStatusPaid),
+				installment(2, 10, 0, LoanInstallmentStatusUnpaid),
+				installment(3, 10, 0, LoanInstallmentStatusUnpaid),
+			),
+			payment: &LoanPayment{
+				LoanID:         loanID,
+				Mode:           PaymentModeRegular,
+				Amount:         decimal.NewFromInt(110),
+				ExcessAmount:   decimal.NewFromInt(40),
+				ExcessHandling: OverpaymentPolicyCredit,
+				Allocations: []InstallmentAllocation{
+					{InstallmentNumber: 1, Amount: decimal.NewFromInt(110)},
+				},
+			},
+			paidAmount:    decimal.NewFromInt(110),
+			creditBalance: decimal.NewFromInt(40),
+			reason:        "bounced transfer",
+			wantInstallments: []installmentSummary{
+				{Number: 1, InterestAmount: "10", AmountDue: "110", AmountPaid: "0", Status: LoanInstallmentStatusUnpaid},
+				{Number: 2, InterestAmount: "10", AmountDue: "110", AmountPaid: "0", Status: LoanInstallmentStatusUnpaid},
+				{Number: 3, InterestAmount: "10", AmountDue: "110", AmountPaid: "0", Status: LoanInstallmentStatusUnpaid},
+			},
+			wantCredit: decimal.NewFromInt(-40),
+			wantStatus: LoanStatusOngoing,
+			wantRebate: decimal.Zero,
+			wantErr:    nil,
+		},
+		{
+			// 30 of the credited excess has since been applied to the installment 2 bill
+			name: "credited excess already spent",
+			loan: newLoan(LoanStatusOngoing, decimal.Zero,
+				installment(1, 10, 110, LoanInstallmentStatusPaid),
+				installment(2, 10, 30, LoanInstallmentStatusPartiallyPaid),
+				installment(3, 10, 0, LoanInstallmentStatusUnpaid),
+			),
+			payment: &LoanPayment{
+				LoanID:         loanID,
+				Mode:           PaymentModeRegular,
+				Amount:         decimal.NewFromInt(110),
+				ExcessAmount:   decimal.NewFromInt(40),
+				ExcessHandling: OverpaymentPolicyCredit,
+				Allocations: []InstallmentAllocation{
+					{InstallmentNumber: 1, Amount: decimal.NewFromInt(110)},
+				},
+			},
+			paidAmount:    decimal.NewFromInt(140),
+			creditBalance: decimal.NewFromInt(10),
+			reason:        "bounced transfer",
+			wantErr:       ErrLoanPaymentCreditSpent,
+		},
+		{
+			name: "credit applied by the payment is given back",
+			loan: newLoan(LoanStatusOngoing, decimal.Zero,
+				installment(1, 10, 30, LoanInstallmentStatusPartiallyPaid),
+				installment(2, 10, 0, LoanInstallmentStatusUnpaid),
+				installment(3, 10, 0, LoanInstallmentStatusUnpaid),
+			),
+			payment: &LoanPayment{
+				LoanID: loanID,
+				Mode:   PaymentModeCredit,
+				Amount: decimal.NewFromInt(30),
+				Allocations: []InstallmentAllocation{
+					{InstallmentNumber: 1, Amount: decimal.NewFromInt(30)},
+				},
+			},
+			paidAmount: decimal.NewFromInt(30),
+			reason:     "credit applied twice",
+			wantInstallments: []installmentSummary{
+				{Number: 1, InterestAmount: "10", AmountDue: "110", AmountPaid: "0", Status: LoanInstallmentStatusUnpaid},
+				{Number: 2, InterestAmount: "10", AmountDue: "110", AmountPaid: "0", Status: LoanInstallmentStatusUnpaid},
+				{Number: 3, InterestAmount: "10", AmountDue: "110", AmountPaid: "0", Status: LoanInstallmentStatusUnpaid},
+			},
+			wantCredit: decimal.NewFromInt(30),
+			wantStatus: LoanStatusOngoing,
+			wantRebate: decimal.Zero,
+			wantErr:    nil,
+		},
+		{
+			name: "payment is taken back from its charges",
+			loan: func() *Loan {
+				loan := newLoan(LoanStatusOngoing, decimal.Zero,
+					installment(1, 10, 110, LoanInstallmentStatusPaid),
+					installment(2, 10, 0, LoanInstallmentStatusUnpaid),
+					installment(3, 10, 0, LoanInstallmentStatusUnpaid),
+				)
+				loan.Charges = []*LoanCharge{
+					{ID: chargeID, LoanID: loanID, Type: LoanChargeTypeLateFee, InstallmentNumber: 1, Amount: decimal.NewFromInt(20), AmountPaid: decimal.NewFromInt(20)},
+				}
+				return loan
+			}(),
+			payment: &LoanPayment{
+				LoanID: loanID,
+				Mode:   PaymentModeRegular,
+				Amount: decimal.NewFromInt(130),
+				Allocations: []InstallmentAllocation{
+					{InstallmentNumber: 1, Amount: decimal.NewFromInt(110)},
+				},
+				ChargeAllocations: []ChargeAllocation{
+					{ChargeID: chargeID, Amount: decimal.NewFromInt(20)},
+				},
+			},
+			paidAmount: decimal.NewFromInt(130),
+			reason:     "bounced transfer",
+			wantInstallments: []installmentSummary{
+				{Number: 1, InterestAmount: "10", AmountDue: "110", AmountPaid: "0", Status: LoanInstallmentStatusUnpaid},
+				{Number: 2, InterestAmount: "10", AmountDue: "110", AmountPaid: "0", Status: LoanInstallmentStatusUnpaid},
+				{Number: 3, InterestAmount: "10", AmountDue: "110", AmountPaid: "0", Status: LoanInstallmentStatusUnpaid},
+			},
+			wantChargePaid: decimal.Zero,
+			wantStatus:     LoanStatusOngoing,
+			wantRebate:     decimal.Zero,
+			wantErr:        nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reversal, err := tt.loan.ReversePayment(tt.payment, tt.paidAmount, tt.creditBalance, tt.reason, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReversePayment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			wantReversal := &LoanPaymentReversal{
+				PaymentID: tt.payment.ID,
+				LoanID:    loanID,
+				Amount:    tt.payment.Amount,
+				Reason:    tt.reason,
+				CreatedAt: now,
+			}
+			if diff := cmp.Diff(wantReversal, reversal, cmpopts.IgnoreFields(LoanPaymentReversal{}, "ID", "CreditEntry")); diff != "" {
+				t.Fatalf("LoanPaymentReversal mismatch (-want +got):\n%s", diff)
+			}
+			if reversal.ID == uuid.Nil {
+				t.Errorf("expecting reversal.ID to be non-zero")
+			}
+
+			gotCredit := decimal.Zero
+			if reversal.CreditEntry != nil {
+				gotCredit = reversal.CreditEntry.Amount
+				if reversal.CreditEntry.Type != CreditEntryTypeReversal {
+					t.Errorf("ReversePayment() credit entry type = %v, want %v", reversal.CreditEntry.Type, CreditEntryTypeReversal)
+				}
+			}
+			if !gotCredit.Equal(tt.wantCredit) {
+				t.Errorf("ReversePayment() credit amount = %s, want %s", gotCredit, tt.wantCredit)
+			}
+
+			gotInstallments := make([]installmentSummary, 0, len(tt.loan.Installments))
+			for _, installment := range tt.loan.Installments {
+				gotInstallments = append(gotInstallments, installmentSummary{
+					Number:         installment.Number,
+					InterestAmount: installment.InterestAmount.String(),
+					AmountDue:      installment.AmountDue.String(),
+					AmountPaid:     installment.AmountPaid.String(),
+					Status:         installment.Status,
+				})
+			}
+			if diff := cmp.Diff(tt.wantInstallments, gotInstallments); diff != "" {
+				t.Errorf("installments mismatch (-want +got):\n%s", diff)
+			}
+
+			if charge := tt.loan.Charge(chargeID); charge != nil && !charge.AmountPaid.Equal(tt.wantChargePaid) {
+				t.Errorf("ReversePayment() charge AmountPaid = %s, want %s", charge.AmountPaid, tt.wantChargePaid)
+			}
+			if tt.loan.Status != tt.wantStatus {
+				t.Errorf("ReversePayment() loan status = %v, want %v", tt.loan.Status, tt.wantStatus)
+			}
+			if !tt.loan.RebateAmount.Equal(tt.wantRebate) {
+				t.Errorf("ReversePayment() loan RebateAmount = %s, want %s", tt.loan.RebateAmount, tt.wantRebate)
+			}
+			if !tt.loan.UpdatedAt.Equal(now) {
+				t.Errorf("ReversePayment() loan UpdatedAt = %v, want %v", tt.loan.UpdatedAt, now)
+			}
+			if !tt.payment.ReversedAt.Equal(now) {
+				t.Errorf("ReversePayment() payment ReversedAt = %v, want %v", tt.payment.ReversedAt, now)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -87,6 +88,42 @@ func TestLoanPayment_validate(t *testing.T) {
 			},
 			wantErr: ErrLoanPaymentEmptyUpdatedAt,
 		},
+		{
+			name: "invalid channel",
+			payment: &LoanPayment{
+				ID:        validID,
+				LoanID:    validID,
+				Amount:    validAmount,
+				Details:   PaymentDetails{Channel: PaymentChannel(99)},
+				CreatedAt: validTime,
+				UpdatedAt: validTime,
+			},
+			wantErr: ErrLoanPaymentInvalidChannel,
+		},
+		{
+			name: "paid at in the future",
+			payment: &LoanPayment{
+				ID:        validID,
+				LoanID:    validID,
+				Amount:    validAmount,
+				Details:   PaymentDetails{PaidAt: validTime.Add(time.Hour)},
+				CreatedAt: validTime,
+				UpdatedAt: validTime,
+			},
+			wantErr: ErrLoanPaymentInvalidPaidAt,
+		},
+		{
+			name: "external reference too long",
+			payment: &LoanPayment{
+				ID:        validID,
+				LoanID:    validID,
+				Amount:    validAmount,
+				Details:   PaymentDetails{ExternalReference: strings.Repeat("r", maxExternalReferenceLength+1)},
+				CreatedAt: validTime,
+				UpdatedAt: validTime,
+			},
+			wantErr: ErrLoanPaymentInvalidDetails,
+		},
 	}
 
 	for _, test := range tests {
@@ -100,13 +137,17 @@ func TestLoanPayment_validate(t *testing.T) {
 }
 
 func TestCreateLoanPayment(t *testing.T) {
+	now := time.Now()
 	validLoanID := uuid.New()
 	validAmount := decimal.NewFromInt(1000)
 
+	valueDate := now.Add(-24 * time.Hour)
+
 	tests := []struct {
 		name    string
 		loanID  uuid.UUID
 		amount  decimal.Decimal
+		details PaymentDetails
 		wantRes *LoanPayment
 		wantErr error
 	}{
@@ -114,12 +155,43 @@ func TestCreateLoanPayment(t *testing.T) {
 			name:   "valid payment",
 			loanID: validLoanID,
 			amount: validAmount,
+			wantRes: &LoanPayment{
+				LoanID:  validLoanID,
+				Amount:  validAmount,
+				Details: PaymentDetails{PaidAt: now.UTC()},
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "payment with details",
+			loanID: validLoanID,
+			amount: validAmount,
+			details: PaymentDetails{
+				ExternalReference: "TRX-001",
+				Channel:           PaymentChannelVirtualAccount,
+				PaidAt:            valueDate,
+				Metadata:          map[string]string{"bank": "BCA"},
+			},
 			wantRes: &LoanPayment{
 				LoanID: validLoanID,
 				Amount: validAmount,
+				Details: PaymentDetails{
+					ExternalReference: "TRX-001",
+					Channel:           PaymentChannelVirtualAccount,
+					PaidAt:            valueDate.UTC(),
+					Metadata:          map[string]string{"bank": "BCA"},
+				},
 			},
 			wantErr: nil,
 		},
+		{
+			name:    "paid at in the future",
+			loanID:  validLoanID,
+			amount:  validAmount,
+			details: PaymentDetails{PaidAt: now.Add(time.Minute)},
+			wantRes: nil,
+			wantErr: ErrLoanPaymentInvalidPaidAt,
+		},
 		{
 			name:    "validation error",
 			loanID:  uuid.Nil,
@@ -131,7 +203,7 @@ func TestCreateLoanPayment(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			res, err := CreateLoanPayment(test.loanID, test.amount)
+			res, err := CreateLoanPayment(test.loanID, test.amount, test.details, now)
 
 			if !errors.Is(err, test.wantErr) {
 				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
@@ -149,12 +221,12 @@ func TestCreateLoanPayment(t *testing.T) {
 					t.Fatal("expecting loan payment id to be non-zero")
 				}
 
-				if res.CreatedAt.IsZero() {
-					t.Fatal("expecting loan payment created at to be non-zero")
+				if !res.CreatedAt.Equal(now) {
+					t.Fatalf("expecting loan payment created at to be %v, got %v", now, res.CreatedAt)
 				}
 
-				if res.UpdatedAt.IsZero() {
-					t.Fatal("expecting loan payment updated at to be non-zero")
+				if !res.UpdatedAt.Equal(now) {
+					t.Fatalf("expecting loan payment updated at to be %v, got %v", now, res.UpdatedAt)
 				}
 			}
 		})
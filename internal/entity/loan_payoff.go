@@ -0,0 +1,183 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// PaymentMode represents the purpose of a payment made towards a loan.
+type PaymentMode int
+
+const (
+	// PaymentModeRegular pays the current bill of the loan.
+	PaymentModeRegular PaymentMode = iota
+
+	// PaymentModePrepayment pays the loan ahead of the installments' due dates, up to the outstanding amount.
+	PaymentModePrepayment
+
+	// PaymentModePayoff settles the loan early by paying the payoff amount, closing the loan.
+	PaymentModePayoff
+
+	// PaymentModeCredit pays the current bill of the loan from the credit balance of its user.
+	// It is only made by the billing engine and cannot be requested by MakePayment.
+	PaymentModeCredit
+
+	// PaymentModeRecovery recovers part of the written-off amount of a written-off loan.
+	// It is not counted in the paid amount of the loan.
+	PaymentModeRecovery
+)
+
+// IsValid checks if the PaymentMode is a valid payment mode.
+//
+// Returns:
+//   - bool: true if the mode is one of the predefined payment modes, false otherwise.
+func (m PaymentMode) IsValid() bool {
+	return m == PaymentModeRegular || m == PaymentModePrepayment || m == PaymentModePayoff || m == PaymentModeCredit ||
+		m == PaymentModeRecovery
+}
+
+// PayoffQuote represents the amount needed to close a loan at a point in time.
+type PayoffQuote struct {
+	// OutstandingAmount is the remaining amount to be paid on the loan without any rebate.
+	OutstandingAmount decimal.Decimal
+
+	// RebateAmount is the unearned interest rebated when the loan is settled early.
+	RebateAmount decimal.Decimal
+
+	// PayoffAmount is the amount to be paid to close the loan, which is the outstanding amount minus the rebate.
+	PayoffAmount decimal.Decimal
+}
+
+// PayoffQuote calculates the amount needed to close the loan at the given time.
+//
+// The interest of the installments that are not due yet is considered unearned. The portion of it
+// defined by the loan's early settlement rebate rate is rebated from the outstanding amount.
+//
+// Parameters:
+//   - now: The current time used to determine the installments that are not due yet.
+//   - paidAmount: The total amount that has been paid towards the loan so far.
+//
+// Returns:
+//   - PayoffQuote: The outstanding, rebate and payoff amounts of the loan.
+func (l *Loan) PayoffQuote(now time.Time, paidAmount decimal.Decimal) PayoffQuote {
+	if l == nil {
+		return PayoffQuote{
+			OutstandingAmount: decimal.Zero,
+			RebateAmount:      decimal.Zero,
+			PayoffAmount:      decimal.Zero,
+		}
+	}
+
+	outstandingAmount := l.OutstandingAmount(paidAmount)
+
+	rebateAmount := decimal.Zero
+	if !outstandingAmount.IsZero() {
+		for _, rebate := range l.earlySettlementRebates(now, paidAmount) {
+			rebateAmount = rebateAmount.Add(rebate)
+		}
+	}
+
+	return PayoffQuote{
+		OutstandingAmount: outstandingAmount,
+		RebateAmount:      rebateAmount,
+		PayoffAmount:      outstandingAmount.Sub(rebateAmount),
+	}
+}
+
+// earlySettlementRebates calculates the interest rebated on each installment if the loan is settled at the given time.
+//
+// The paid amount is allocated to the due installments oldest first, then to the charges, then to the
+// installments that are not due yet, settling the interest portion of an installment before its principal
+// portion. The unpaid interest of the installments that are not due yet is multiplied by the early
+// settlement rebate rate and rounded down to the minor unit.
+//
+// Parameters:
+//   - now: The current time used to determine the installments that are not due yet.
+//   - paidAmount: The total amount that has been paid towards the loan so far.
+//
+// Returns:
+//   - []decimal.Decimal: The rebate of each installment, in the same order as the repayment schedule.
+func (l *Loan) earlySettlementRebates(now time.Time, paidAmount decimal.Decimal) []decimal.Decimal {
+	schedule := l.schedule()
+	dueCount := len(l.dueInstallments(now))
+
+	rebates := make([]decimal.Decimal, len(schedule))
+	for i, installment := range schedule {
+		if i == dueCount {
+			// the paid amount covers the charges before the installments that are not due yet
+			paidAmount = paidAmount.Sub(decimal.Min(paidAmount, l.ChargesAmount()))
+		}
+
+		coveredAmount := decimal.Max(decimal.Zero, decimal.Min(paidAmount, installment.AmountDue))
+		paidAmount = paidAmount.Sub(coveredAmount)
+
+		rebates[i] = decimal.Zero
+		if i < dueCount || installment.Number < l.RestructuredFromInstallment {
+			// the installments kept from the previous terms of a restructured loan are not rebated either
+			continue
+		}
+
+		unpaidInterestAmount := installment.InterestAmount.Sub(decimal.Min(coveredAmount, installment.InterestAmount))
+		rebates[i] = unpaidInterestAmount.Mul(l.EarlySettlementRebateRate).RoundDown(l.RoundingPolicy.Precision)
+	}
+
+	return rebates
+}
+
+// applyEarlySettlementRebate deducts the early settlement rebate from the interest of the installments
+// that are not due yet and records the total rebate on the loan.
+//
+// Parameters:
+//   - now: The current time used to determine the installments that are not due yet.
+//   - paidAmount: The total amount that has been paid towards the loan so far.
+//   - updatedAt: The time the rebate is applied at.
+func (l *Loan) applyEarlySettlementRebate(now time.Time, paidAmount decimal.Decimal, updatedAt time.Time) {
+	rebates := l.earlySettlementRebates(now, paidAmount)
+
+	for i, rebate := range rebates {
+		if !rebate.IsPositive() {
+			continue
+		}
+
+		if i < len(l.Installments) {
+			installment := l.Installments[i]
+			installment.InterestAmount = installment.InterestAmount.Sub(rebate)
+			installment.AmountDue = installment.AmountDue.Sub(rebate)
+			installment.UpdatedAt = updatedAt
+		}
+		l.RebateAmount = l.RebateAmount.Add(rebate)
+	}
+}
+
+// restoreEarlySettlementRebate adds the interest rebated when the loan was settled early back to the installments
+// and clears the total rebate recorded on the loan.
+//
+// The rebate of each installment is the difference between its interest in the schedule generated from
+// the loan's terms and its current interest.
+//
+// Parameters:
+//   - updatedAt: The time the rebate is restored at.
+func (l *Loan) restoreEarlySettlementRebate(updatedAt time.Time) {
+	if !l.RebateAmount.IsPositive() {
+		return
+	}
+
+	for _, original := range l.generateSchedule() {
+		installment := l.Installment(original.Number)
+		if installment == nil {
+			continue
+		}
+
+		rebate := original.InterestAmount.Sub(installment.InterestAmount)
+		if !rebate.IsPositive() {
+			continue
+		}
+
+		installment.InterestAmount = installment.InterestAmount.Add(rebate)
+		installment.AmountDue = installment.AmountDue.Add(rebate)
+		installment.updateStatus()
+		installment.UpdatedAt = updatedAt
+	}
+	l.RebateAmount = decimal.Zero
+}
@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shopspring/decimal"
+)
+
+func TestPaymentMode_IsValid(t *testing.T) {
+	tests := []struct {
+		name string
+		mode PaymentMode
+		want bool
+	}{
+		{
+			name: "regular",
+			mode: PaymentModeRegular,
+			want: true,
+		},
+		{
+			name: "prepayment",
+			mode: PaymentModePrepayment,
+			want: true,
+		},
+		{
+			name: "payoff",
+			mode: PaymentModePayoff,
+			want: true,
+		},
+		{
+			name: "credit",
+			mode: PaymentModeCredit,
+			want: true,
+		},
+		{
+			name: "unknown mode",
+			mode: PaymentMode(-1),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mode.IsValid(); got != tt.want {
+				t.Fatalf("expecting %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLoan_PayoffQuote(t *testing.T) {
+	now := time.Now().UTC()
+
+	newLoan := func(rebateRate decimal.Decimal) *Loan {
+		return &Loan{
+			PaymentAmount:             decimal.NewFromInt(330),
+			InstallmentCount:          3,
+			EarlySettlementRebateRate: rebateRate,
+			CreatedAt:                 now.Add(-time.Hour * 24 * 7), // now is loan week 1
+			Installments: []*LoanInstallment{
+				{Number: 1, PrincipalAmount: decimal.NewFromInt(100), InterestAmount: decimal.NewFromInt(10), AmountDue: decimal.NewFromInt(110)},
+				{Number: 2, PrincipalAmount: decimal.NewFromInt(100), InterestAmount: decimal.NewFromInt(10), AmountDue: decimal.NewFromInt(110)},
+				{Number: 3, PrincipalAmount: decimal.NewFromInt(100), InterestAmount: decimal.NewFromInt(10), AmountDue: decimal.NewFromInt(110)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		loan       *Loan
+		paidAmount decimal.Decimal
+		want       PayoffQuote
+	}{
+		{
+			name:       "nil loan",
+			loan:       nil,
+			paidAmount: decimal.Zero,
+			want:       PayoffQuote{OutstandingAmount: decimal.Zero, RebateAmount: decimal.Zero, PayoffAmount: decimal.Zero},
+		},
+		{
+			name:       "no rebate",
+			loan:       newLoan(decimal.Zero),
+			paidAmount: decimal.NewFromInt(110),
+			want:       PayoffQuote{OutstandingAmount: decimal.NewFromInt(220), RebateAmount: decimal.Zero, PayoffAmount: decimal.NewFromInt(220)},
+		},
+		{
+			name:       "due installments are not rebated",
+			loan:       newLoan(decimal.NewFromInt(1)),
+			paidAmount: decimal.Zero,
+			want:       PayoffQuote{OutstandingAmount: decimal.NewFromInt(330), RebateAmount: decimal.NewFromInt(20), PayoffAmount: decimal.NewFromInt(310)},
+		},
+		{
+			name:       "prepaid interest is not rebated",
+			loan:       newLoan(decimal.NewFromFloat(0.5)),
+			paidAmount: decimal.NewFromInt(114), // 4 of the interest of the second installment is prepaid
+			want:       PayoffQuote{OutstandingAmount: decimal.NewFromInt(216), RebateAmount: decimal.NewFromInt(8), PayoffAmount: decimal.NewFromInt(208)},
+		},
+		{
+			name:       "fully paid",
+			loan:       newLoan(decimal.NewFromFloat(0.5)),
+			paidAmount: decimal.NewFromInt(330),
+			want:       PayoffQuote{OutstandingAmount: decimal.Zero, RebateAmount: decimal.Zero, PayoffAmount: decimal.Zero},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.loan.PayoffQuote(now, tt.paidAmount)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("PayoffQuote mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/common/businesserror"
+)
+
+var (
+	ErrLoanProductNotFound             = businesserror.New("loan product not found", businesserror.KindNotFound)
+	ErrLoanAmountOutOfProductRange     = businesserror.New("loan amount is outside of the loan product principal limits", businesserror.KindUnprocessableEntity)
+	ErrLoanDurationNotAllowedByProduct = businesserror.New("loan installment count is not allowed by the loan product", businesserror.KindUnprocessableEntity)
+)
+
+// FeeSchedule represents the fees charged for loans of a loan product.
+type FeeSchedule struct {
+	// OriginationFeeRate is the origination fee charged as a rate of the principal amount.
+	OriginationFeeRate decimal.Decimal
+
+	// OriginationFeeFixed is the fixed origination fee charged on top of the rated fee.
+	OriginationFeeFixed decimal.Decimal
+
+	// LateFeePolicy is the late fees charged for the missed installments.
+	LateFeePolicy LateFeePolicy
+}
+
+// originationFee calculates the origination fee to be charged for the given principal amount.
+//
+// Parameters:
+//   - principal: The principal amount of the loan.
+//
+// Returns:
+//   - decimal.Decimal: The sum of the fixed and rated origination fees, rounded up to the nearest whole number.
+func (f FeeSchedule) originationFee(principal decimal.Decimal) decimal.Decimal {
+	return f.OriginationFeeFixed.Add(principal.Mul(f.OriginationFeeRate)).RoundUp(0)
+}
+
+// LoanProduct represents a loan product offered to users.
+//
+// It defines the underwriting limits of the loans created under it, along with
+// the billing frequency, interest model, interest rate, rounding policy, overpayment policy, fees, early settlement rebate,
+// grace period and billing calendar applied to them.
+type LoanProduct struct {
+	// ID is the unique identifier for the loan product.
+	ID uuid.UUID
+
+	// Name is the display name of the loan product.
+	Name string
+
+	// MinPrincipal is the minimum principal amount of a loan of this product.
+	MinPrincipal decimal.Decimal
+
+	// MaxPrincipal is the maximum principal amount of a loan of this product.
+	MaxPrincipal decimal.Decimal
+
+	// BillingFrequency is how often the installments of the loans of this product are due.
+	BillingFrequency BillingFrequency
+
+	// AllowedInstallmentCounts lists the installment counts allowed for a loan of this product,
+	// at the product's billing frequency. Any installment count is allowed when it is empty.
+	AllowedInstallmentCounts []int32
+
+	// InterestModel is the method used to calculate the interest of the loans of this product.
+	InterestModel InterestModel
+
+	// InterestRate is the interest rate of the loans of this product, interpreted according to the InterestModel.
+	InterestRate decimal.Decimal
+
+	// RoundingPolicy is how the payment amount of the loans of this product is split into installment amounts.
+	RoundingPolicy RoundingPolicy
+
+	// PaymentAcceptanceMode is which payment amounts are accepted towards the bill of the loans of this product.
+	PaymentAcceptanceMode PaymentAcceptanceMode
+
+	// OverpaymentPolicy is how a payment exceeding the amount accepted towards a loan of this product is handled.
+	OverpaymentPolicy OverpaymentPolicy
+
+	// FeeSchedule is the fees charged for the loans of this product.
+	FeeSchedule FeeSchedule
+
+	// EarlySettlementRebateRate is the portion of the unearned interest rebated when a loan of this product
+	// is paid off early. No interest is rebated when it is zero.
+	EarlySettlementRebateRate decimal.Decimal
+
+	// GracePeriodDays is the number of days after an installment's due date before an unpaid installment
+	// of the loans of this product counts toward delinquency and late fees.
+	GracePeriodDays int32
+
+	// CoolingOffDays is the number of days after the creation of a loan of this product during which its user
+	// can cancel the loan. The loans of this product cannot be cancelled when it is zero.
+	CoolingOffDays int32
+
+	// DefaultAfterWeeks is the number of weeks a loan of this product can be past due before it is defaulted.
+	// The loans of this product are never defaulted when it is zero.
+	DefaultAfterWeeks int32
+
+	// BillingCalendar is the timezone and week start day the billing weeks of the loans of this product
+	// are calculated in. The default billing calendar of the deployment is used when it is nil.
+	BillingCalendar *BillingCalendar
+
+	// DelinquencyRule is the rule the loans of this product are considered delinquent by.
+	// DefaultDelinquencyRule is used when it is nil.
+	DelinquencyRule *DelinquencyRule
+
+	// CreatedAt is the timestamp when the loan product was created.
+	CreatedAt time.Time
+
+	// UpdatedAt is the timestamp when the loan product was last updated.
+	UpdatedAt time.Time
+}
+
+// validateLoan checks if the loan satisfies the limits of the loan product.
+//
+// Parameters:
+//   - loan: A pointer to the Loan instance to be validated.
+//
+// Returns:
+//   - error: ErrLoanAmountOutOfProductRange if the loan amount is outside the principal limits,
+//     ErrLoanDurationNotAllowedByProduct if the installment count is not allowed, nil otherwise.
+func (p *LoanProduct) validateLoan(loan *Loan) error {
+	if loan.Amount.LessThan(p.MinPrincipal) || loan.Amount.GreaterThan(p.MaxPrincipal) {
+		return ErrLoanAmountOutOfProductRange
+	}
+
+	if len(p.AllowedInstallmentCounts) > 0 && !slices.Contains(p.AllowedInstallmentCounts, loan.InstallmentCount) {
+		return ErrLoanDurationNotAllowedByProduct
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestFeeSchedule_originationFee(t *testing.T) {
+	tests := []struct {
+		name        string
+		feeSchedule FeeSchedule
+		principal   decimal.Decimal
+		want        decimal.Decimal
+	}{
+		{
+			name:        "no fee",
+			feeSchedule: FeeSchedule{},
+			principal:   decimal.NewFromInt(5_000_000),
+			want:        decimal.Zero,
+		},
+		{
+			name:        "fixed fee only",
+			feeSchedule: FeeSchedule{OriginationFeeFixed: decimal.NewFromInt(25_000)},
+			principal:   decimal.NewFromInt(5_000_000),
+			want:        decimal.NewFromInt(25_000),
+		},
+		{
+			name: "fixed and rated fee, rounded up",
+			feeSchedule: FeeSchedule{
+				OriginationFeeRate:  decimal.NewFromFloat(0.015),
+				OriginationFeeFixed: decimal.NewFromInt(10_000),
+			},
+			principal: decimal.NewFromInt(1_000_033),
+			want:      decimal.NewFromInt(25_001),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.feeSchedule.originationFee(test.principal)
+			if !got.Equal(test.want) {
+				t.Fatalf("expecting origination fee to be %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestLoanProduct_validateLoan(t *testing.T) {
+	product := &LoanProduct{
+		ID:                       uuid.New(),
+		MinPrincipal:             decimal.NewFromInt(1_000_000),
+		MaxPrincipal:             decimal.NewFromInt(10_000_000),
+		AllowedInstallmentCounts: []int32{25, 50},
+	}
+
+	anyDurationProduct := &LoanProduct{
+		ID:           uuid.New(),
+		MinPrincipal: decimal.NewFromInt(1_000_000),
+		MaxPrincipal: decimal.NewFromInt(10_000_000),
+	}
+
+	tests := []struct {
+		name    string
+		product *LoanProduct
+		loan    *Loan
+		wantErr error
+	}{
+		{
+			name:    "amount below minimum",
+			product: product,
+			loan:    &Loan{Amount: decimal.NewFromInt(999_999), InstallmentCount: 50},
+			wantErr: ErrLoanAmountOutOfProductRange,
+		},
+		{
+			name:    "amount above maximum",
+			product: product,
+			loan:    &Loan{Amount: decimal.NewFromInt(10_000_001), InstallmentCount: 50},
+			wantErr: ErrLoanAmountOutOfProductRange,
+		},
+		{
+			name:    "duration not allowed",
+			product: product,
+			loan:    &Loan{Amount: decimal.NewFromInt(5_000_000), InstallmentCount: 10},
+			wantErr: ErrLoanDurationNotAllowedByProduct,
+		},
+		{
+			name:    "any duration allowed",
+			product: anyDurationProduct,
+			loan:    &Loan{Amount: decimal.NewFromInt(5_000_000), InstallmentCount: 10},
+			wantErr: nil,
+		},
+		{
+			name:    "within limits",
+			product: product,
+			loan:    &Loan{Amount: decimal.NewFromInt(10_000_000), InstallmentCount: 25},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.product.validateLoan(test.loan)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/common/businesserror"
+)
+
+const maxRestructureReasonLength = 500 // Maximum length of the reason a loan is restructured for
+
+var (
+	ErrLoanNotRestructurable        = businesserror.New("loan can only be restructured while it is being repaid", businesserror.KindUnprocessableEntity)
+	ErrLoanInvalidRestructureReason = businesserror.New("loan restructure reason must be between 1 and 500 characters", businesserror.KindBadRequest)
+	ErrLoanPaymentRestructured      = businesserror.New("loan payment made before the loan was restructured cannot be reversed", businesserror.KindUnprocessableEntity)
+)
+
+// LoanTerms represents a version of the terms a loan is repaid under. The first version is the terms the loan
+// was created with, and a new version is recorded every time the loan is restructured, so that the original terms
+// and every restructure remain available.
+type LoanTerms struct {
+	// LoanID is the unique identifier of the loan the terms belong to.
+	LoanID uuid.UUID
+
+	// Version is the 1-based version of the terms, 1 for the terms the loan was created with.
+	Version int32
+
+	// Principal is the amount repaid under the terms: the loan amount for the first version,
+	// or the outstanding amount of the loan when it was restructured for the later versions.
+	Principal decimal.Decimal
+
+	// InterestRate is the interest rate charged on the principal, interpreted according to the interest model of the loan.
+	InterestRate decimal.Decimal
+
+	// InstallmentCount is the number of installments the principal is repaid in.
+	InstallmentCount int32
+
+	// PaymentAmount is the total amount to be paid under the terms, including interest.
+	PaymentAmount decimal.Decimal
+
+	// FirstInstallmentNumber is the number of the first installment of the schedule of the terms.
+	FirstInstallmentNumber int32
+
+	// CapitalizedCharges is the amount of the outstanding charges of the loan included in the principal
+	// when it was restructured, zero for the first version.
+	CapitalizedCharges decimal.Decimal
+
+	// Reason is the explanation of why the loan was restructured, empty for the first version.
+	Reason string
+
+	// CreatedAt is the timestamp when the terms took effect.
+	CreatedAt time.Time
+}
+
+// InitialTerms returns the terms the loan is created with, which are recorded as the first version of its terms.
+//
+// Returns:
+//   - *LoanTerms: The first version of the terms of the loan, or nil if the loan is nil.
+func (l *Loan) InitialTerms() *LoanTerms {
+	if l == nil {
+		return nil
+	}
+
+	return &LoanTerms{
+		LoanID:                 l.ID,
+		Version:                1,
+		Principal:              l.Amount,
+		InterestRate:           l.InterestRate,
+		InstallmentCount:       l.InstallmentCount,
+		PaymentAmount:          l.PaymentAmount,
+		FirstInstallmentNumber: 1,
+		CapitalizedCharges:     decimal.Zero,
+		CreatedAt:              l.CreatedAt,
+	}
+}
+
+// ValidateRestructureReason checks that the reason of a loan restructure can be stored.
+//
+// Parameters:
+//   - reason: The explanation of why the loan is restructured.
+//
+// Returns:
+//   - error: ErrLoanInvalidRestructureReason if the reason is empty or too long, nil otherwise.
+func ValidateRestructureReason(reason string) error {
+	if reason == "" || len(reason) > maxRestructureReasonLength {
+		return ErrLoanInvalidRestructureReason
+	}
+
+	return nil
+}
+
+// Restructure repays the outstanding amount of the loan under new terms, without closing the loan.
+//
+// The installments that are due or have been paid ahead are kept with the amount paid towards them, and the amount
+// they still owe is marked as restructured. The outstanding amounts of the charges are recorded as capitalized, so that
+// they are not charged again, and the outstanding amount of the loan, including the interest of its previous terms
+// not paid yet and the capitalized charges, is repaid in a new schedule of the given installment count starting from
+// the next billing period, at the given interest rate or the current one. The loan's terms are updated and the new version of its terms is returned.
+//
+// Parameters:
+//   - now: The time the loan is restructured at.
+//   - paidAmount: The total amount that has been paid towards the loan so far.
+//   - installmentCount: The number of installments the outstanding amount is repaid in.
+//   - interestRate: The interest rate of the new terms, the current interest rate of the loan is kept when it is nil.
+//   - reason: The explanation of why the loan is restructured.
+//
+// Returns:
+//   - *LoanTerms: The new version of the terms of the loan.
+//   - error: An error if the loan cannot be restructured. Possible errors include: ErrLoanNotFound,
+//     ErrLoanNotRestructurable, ErrLoanInvalidInstallmentCount, ErrLoanInvalidInterestRate,
+//     ErrLoanInvalidRestructureReason and ErrLoanAlreadyPaid.
+func (l *Loan) Restructure(now time.Time, paidAmount decimal.Decimal, installmentCount int32, interestRate *decimal.Decimal, reason string) (*LoanTerms, error) {
+	if l == nil {
+		return nil, ErrLoanNotFound
+	}
+	if !l.Status.isRepaying() {
+		return nil, ErrLoanNotRestructurable
+	}
+	if installmentCount < 1 {
+		return nil, ErrLoanInvalidInstallmentCount
+	}
+
+	rate := l.InterestRate
+	if interestRate != nil {
+		rate = *interestRate
+	}
+	if !l.InterestModel.validateRate(rate) {
+		return nil, ErrLoanInvalidInterestRate
+	}
+	if err := ValidateRestructureReason(reason); err != nil {
+		return nil, err
+	}
+
+	principal := l.OutstandingAmount(paidAmount)
+	if principal.IsZero() {
+		return nil, ErrLoanAlreadyPaid
+	}
+
+	now = now.UTC()
+	keptInstallments := l.keepInstallments(now)
+	keptAmount := decimal.Zero
+	for _, installment := range keptInstallments {
+		keptAmount = keptAmount.Add(installment.AmountDue)
+	}
+
+	capitalizedCharges := decimal.Zero
+	for _, charge := range l.Charges {
+		outstandingAmount := charge.OutstandingAmount()
+		if !outstandingAmount.IsPositive() {
+			continue
+		}
+
+		capitalizedCharges = capitalizedCharges.Add(outstandingAmount)
+		charge.CapitalizedAmount = charge.CapitalizedAmount.Add(outstandingAmount)
+		charge.UpdatedAt = now
+	}
+
+	firstNumber := int32(len(keptInstallments)) + 1
+	paymentAmount := principal.Add(l.InterestModel.totalInterest(principal, rate, installmentCount))
+
+	l.InterestRate = rate
+	l.InstallmentCount = firstNumber - 1 + installmentCount
+	l.PaymentAmount = keptAmount.Add(paymentAmount)
+	l.RestructuredPrincipal = principal
+	l.RestructuredFromInstallment = firstNumber
+	l.TermsVersion = max(l.TermsVersion, 1) + 1
+	l.UpdatedAt = now
+
+	installments := l.generateSchedule()
+	for _, installment := range installments {
+		installment.CreatedAt = now
+		installment.UpdatedAt = now
+	}
+	l.Installments = append(keptInstallments, installments...)
+
+	return &LoanTerms{
+		LoanID:                 l.ID,
+		Version:                l.TermsVersion,
+		Principal:              principal,
+		InterestRate:           rate,
+		InstallmentCount:       installmentCount,
+		PaymentAmount:          paymentAmount,
+		FirstInstallmentNumber: firstNumber,
+		CapitalizedCharges:     capitalizedCharges,
+		Reason:                 reason,
+		CreatedAt:              now,
+	}, nil
+}
+
+// keepInstallments closes the installments of the loan kept when it is restructured, which are the installments
+// due at the given time and the installments paid ahead of their due date.
+//
+// Each kept installment is reduced to the amount paid towards it, applied to its interest first, and the amount
+// it still owed is marked as restructured.
+//
+// Parameters:
+//   - now: The time the loan is restructured at.
+//
+// Returns:
+//   - []*LoanInstallment: The kept installments, ordered by their number.
+func (l *Loan) keepInstallments(now time.Time) []*LoanInstallment {
+	keptCount := min(int(l.currentPeriod(now)), len(l.Installments))
+	for i, installment := range l.Installments {
+		if installment.AmountPaid.IsPositive() {
+			keptCount = max(keptCount, i+1)
+		}
+	}
+
+	keptInstallments := l.Installments[:keptCount:keptCount]
+	for _, installment := range keptInstallments {
+		if installment.OutstandingAmount().IsZero() {
+			continue
+		}
+
+		installment.InterestAmount = decimal.Min(installment.InterestAmount, installment.AmountPaid)
+		installment.PrincipalAmount = installment.AmountPaid.Sub(installment.InterestAmount)
+		installment.AmountDue = installment.AmountPaid
+		installment.Status = LoanInstallmentStatusRestructured
+		installment.UpdatedAt = now
+	}
+
+	return keptInstallments
+}
+
+// isPaidBeforeRestructure checks if the payment was applied to the loan before it was restructured, which is when
+// it was applied to an installment kept from the previous terms or to a charge capitalized by the restructure.
+// The amounts those installments and charges still owed are repaid in the principal of the new terms, so taking
+// the payment back from them would leave the loan with an amount that nothing bills.
+//
+// Parameters:
+//   - payment: A pointer to the payment, with its allocations.
+//
+// Returns:
+//   - bool: true if the payment was applied before the loan was last restructured, false otherwise.
+func (l *Loan) isPaidBeforeRestructure(payment *LoanPayment) bool {
+	if l.TermsVersion <= 1 {
+		return false
+	}
+
+	for _, allocation := range payment.Allocations {
+		if allocation.InstallmentNumber < l.RestructuredFromInstallment {
+			return true
+		}
+	}
+	for _, allocation := range payment.ChargeAllocations {
+		if charge := l.Charge(allocation.ChargeID); charge != nil && charge.CapitalizedAmount.IsPositive() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,273 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestLoan_InitialTerms(t *testing.T) {
+	createdAt := time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC)
+	loan := &Loan{
+		ID:               uuid.New(),
+		Amount:           decimal.NewFromInt(1000),
+		InterestRate:     decimal.NewFromFloat(0.1),
+		InstallmentCount: 4,
+		PaymentAmount:    decimal.NewFromInt(1100),
+		CreatedAt:        createdAt,
+	}
+
+	if got := (*Loan)(nil).InitialTerms(); got != nil {
+		t.Fatalf("expecting nil terms for nil loan, got %+v", got)
+	}
+
+	got := loan.InitialTerms()
+	if got.LoanID != loan.ID || got.Version != 1 || got.FirstInstallmentNumber != 1 || got.InstallmentCount != 4 {
+		t.Errorf("expecting version 1 of the terms of loan %v with 4 installments from 1, got %+v", loan.ID, got)
+	}
+	if !got.Principal.Equal(loan.Amount) || !got.PaymentAmount.Equal(loan.PaymentAmount) || !got.InterestRate.Equal(loan.InterestRate) {
+		t.Errorf("expecting the amounts of the loan, got %+v", got)
+	}
+	if !got.CapitalizedCharges.IsZero() || got.Reason != "" || !got.CreatedAt.Equal(createdAt) {
+		t.Errorf("expecting no capitalized charges, no reason and creation time %v, got %+v", createdAt, got)
+	}
+}
+
+func TestValidateRestructureReason(t *testing.T) {
+	tests := []struct {
+		name    string
+		reason  string
+		wantErr error
+	}{
+		{name: "valid reason", reason: "income reduced", wantErr: nil},
+		{name: "empty reason", reason: "", wantErr: ErrLoanInvalidRestructureReason},
+		{name: "maximum length", reason: strings.Repeat("a", maxRestructureReasonLength), wantErr: nil},
+		{name: "too long", reason: strings.Repeat("a", maxRestructureReasonLength+1), wantErr: ErrLoanInvalidRestructureReason},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateRestructureReason(tt.reason); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestLoan_Restructure(t *testing.T) {
+	createdAt := time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC) // Monday, installments due from March 11
+	now := time.Date(2024, time.March, 20, 10, 0, 0, 0, time.UTC)      // installments 1 and 2 are due
+
+	// newLoan creates a loan of 1000 at 10% flat interest repaid in 4 installments of 275, with the first installment
+	// paid and a late fee of 50 charged for the second one.
+	newLoan := func(status LoanStatus) *Loan {
+		loan := &Loan{
+			ID:               uuid.New(),
+			Amount:           decimal.NewFromInt(1000),
+			InterestModel:    InterestModelFlat,
+			InterestRate:     decimal.NewFromFloat(0.1),
+			InstallmentCount: 4,
+			PaymentAmount:    decimal.NewFromInt(1100),
+			Status:           status,
+			TermsVersion:     1,
+			CreatedAt:        createdAt,
+			UpdatedAt:        createdAt,
+		}
+		loan.Installments = loan.generateSchedule()
+		loan.Installments[0].AmountPaid = decimal.NewFromInt(275)
+		loan.Installments[0].Status = LoanInstallmentStatusPaid
+		loan.Charges = []*LoanCharge{
+			{ID: uuid.New(), LoanID: loan.ID, InstallmentNumber: 2, Amount: decimal.NewFromInt(50), AmountPaid: decimal.Zero},
+		}
+
+		return loan
+	}
+
+	zeroRate := decimal.Zero
+	negativeRate := decimal.NewFromFloat(-0.1)
+
+	tests := []struct {
+		name             string
+		loan             *Loan
+		paidAmount       decimal.Decimal
+		installmentCount int32
+		interestRate     *decimal.Decimal
+		reason           string
+		wantErr          error
+	}{
+		{
+			name:             "nil loan",
+			loan:             nil,
+			paidAmount:       decimal.Zero,
+			installmentCount: 5,
+			reason:           "income reduced",
+			wantErr:          ErrLoanNotFound,
+		},
+		{
+			name:             "loan pending approval",
+			loan:             newLoan(LoanStatusPendingApproval),
+			paidAmount:       decimal.NewFromInt(275),
+			installmentCount: 5,
+			reason:           "income reduced",
+			wantErr:          ErrLoanNotRestructurable,
+		},
+		{
+			name:             "written-off loan",
+			loan:             newLoan(LoanStatusWrittenOff),
+			paidAmount:       decimal.NewFromInt(275),
+			installmentCount: 5,
+			reason:           "income reduced",
+			wantErr:          ErrLoanNotRestructurable,
+		},
+		{
+			name:             "no installments",
+			loan:             newLoan(LoanStatusOngoing),
+			paidAmount:       decimal.NewFromInt(275),
+			installmentCount: 0,
+			reason:           "income reduced",
+			wantErr:          ErrLoanInvalidInstallmentCount,
+		},
+		{
+			name:             "negative interest rate",
+			loan:             newLoan(LoanStatusOngoing),
+			paidAmount:       decimal.NewFromInt(275),
+			installmentCount: 5,
+			interestRate:     &negativeRate,
+			reason:           "income reduced",
+			wantErr:          ErrLoanInvalidInterestRate,
+		},
+		{
+			name:             "empty reason",
+			loan:             newLoan(LoanStatusOngoing),
+			paidAmount:       decimal.NewFromInt(275),
+			installmentCount: 5,
+			reason:           "",
+			wantErr:          ErrLoanInvalidRestructureReason,
+		},
+		{
+			name:             "nothing outstanding",
+			loan:             newLoan(LoanStatusOngoing),
+			paidAmount:       decimal.NewFromInt(1150),
+			installmentCount: 5,
+			reason:           "income reduced",
+			wantErr:          ErrLoanAlreadyPaid,
+		},
+		{
+			name:             "ongoing loan",
+			loan:             newLoan(LoanStatusOngoing),
+			paidAmount:       decimal.NewFromInt(275),
+			installmentCount: 5,
+			interestRate:     &zeroRate,
+			reason:           "income reduced",
+			wantErr:          nil,
+		},
+		{
+			name:             "defaulted loan",
+			loan:             newLoan(LoanStatusDefaulted),
+			paidAmount:       decimal.NewFromInt(275),
+			installmentCount: 5,
+			interestRate:     &zeroRate,
+			reason:           "income reduced",
+			wantErr:          nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var status LoanStatus
+			if tt.loan != nil {
+				status = tt.loan.Status
+			}
+
+			terms, err := tt.loan.Restructure(now, tt.paidAmount, tt.installmentCount, tt.interestRate, tt.reason)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			// 1150 owed minus 275 paid, including the late fee, is repaid in 5 installments of 175 from installment 3
+			if terms.Version != 2 || terms.FirstInstallmentNumber != 3 || terms.InstallmentCount != 5 || terms.Reason != tt.reason {
+				t.Errorf("expecting version 2 of the terms with 5 installments from 3, got %+v", terms)
+			}
+			if !terms.Principal.Equal(decimal.NewFromInt(875)) || !terms.PaymentAmount.Equal(decimal.NewFromInt(875)) ||
+				!terms.CapitalizedCharges.Equal(decimal.NewFromInt(50)) || !terms.InterestRate.IsZero() {
+				t.Errorf("expecting principal and payment amount of 875 with 50 capitalized charges at no interest, got %+v", terms)
+			}
+
+			loan := tt.loan
+			if loan.Status != status || loan.TermsVersion != 2 || loan.InstallmentCount != 7 || loan.RestructuredFromInstallment != 3 {
+				t.Errorf("expecting status %v, terms version 2 and 7 installments restructured from 3, got %v, %d, %d and %d",
+					status, loan.Status, loan.TermsVersion, loan.InstallmentCount, loan.RestructuredFromInstallment)
+			}
+			if !loan.PaymentAmount.Equal(decimal.NewFromInt(1150)) || !loan.RestructuredPrincipal.Equal(decimal.NewFromInt(875)) {
+				t.Errorf("expecting payment amount 1150 and restructured principal 875, got %s and %s", loan.PaymentAmount, loan.RestructuredPrincipal)
+			}
+			if charge := loan.Charges[0]; !charge.OutstandingAmount().IsZero() || !charge.Amount.Equal(decimal.NewFromInt(50)) ||
+				!charge.CapitalizedAmount.Equal(decimal.NewFromInt(50)) {
+				t.Errorf("expecting the late fee of 50 to be kept and capitalized, got %+v", charge)
+			}
+
+			if len(loan.Installments) != 7 {
+				t.Fatalf("expecting 7 installments, got %d", len(loan.Installments))
+			}
+			if got := loan.Installments[0]; got.Status != LoanInstallmentStatusPaid || !got.AmountDue.Equal(decimal.NewFromInt(275)) {
+				t.Errorf("expecting the paid installment to be kept, got %+v", got)
+			}
+			if got := loan.Installments[1]; got.Status != LoanInstallmentStatusRestructured || !got.AmountDue.IsZero() || !got.PrincipalAmount.IsZero() {
+				t.Errorf("expecting the unpaid due installment to be restructured, got %+v", got)
+			}
+
+			wantDueDate := time.Date(2024, time.March, 25, 0, 0, 0, 0, time.UTC)
+			for i, installment := range loan.Installments[2:] {
+				if installment.Number != int32(i+3) || !installment.AmountDue.Equal(decimal.NewFromInt(175)) || !installment.DueDate.Equal(wantDueDate) {
+					t.Errorf("expecting installment %d of 175 due on %v, got %+v", i+3, wantDueDate, installment)
+				}
+				wantDueDate = wantDueDate.AddDate(0, 0, 7)
+			}
+
+			if got := loan.OutstandingAmount(tt.paidAmount); !got.Equal(decimal.NewFromInt(875)) {
+				t.Errorf("expecting outstanding amount 875, got %s", got)
+			}
+			if got := loan.CurrentBillAmount(now, tt.paidAmount); !got.IsZero() {
+				t.Errorf("expecting no bill until the first new installment is due, got %s", got)
+			}
+			if got := loan.CurrentBillAmount(wantDueDate.AddDate(0, 0, -35), tt.paidAmount); !got.Equal(decimal.NewFromInt(175)) {
+				t.Errorf("expecting bill of 175 when the first new installment is due, got %s", got)
+			}
+
+			// the schedule of the restructured terms can be generated again, which the early settlement rebate relies on
+			schedule := loan.generateSchedule()
+			if len(schedule) != 5 || schedule[0].Number != 3 || !schedule[4].AmountDue.Equal(decimal.NewFromInt(175)) {
+				t.Errorf("expecting the generated schedule to match the new installments, got %d installments", len(schedule))
+			}
+
+			// the payment of the kept installment cannot be reversed, a payment of the new installments can
+			paidBefore := &LoanPayment{
+				ID:          uuid.New(),
+				LoanID:      loan.ID,
+				Amount:      decimal.NewFromInt(275),
+				Allocations: []InstallmentAllocation{{InstallmentNumber: 1, Amount: decimal.NewFromInt(275)}},
+			}
+			if _, err := loan.ReversePayment(paidBefore, tt.paidAmount, decimal.Zero, "bounced transfer", now); !errors.Is(err, ErrLoanPaymentRestructured) {
+				t.Errorf("expecting error to be %v for a payment made before the restructure, got %v", ErrLoanPaymentRestructured, err)
+			}
+			if got := loan.Installments[0]; !got.AmountPaid.Equal(decimal.NewFromInt(275)) {
+				t.Errorf("expecting the kept installment to stay paid, got %+v", got)
+			}
+
+			payment, _, err := loan.MakePayment(now, tt.paidAmount, decimal.NewFromInt(175), PaymentModePrepayment, PaymentDetails{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := loan.ReversePayment(payment, tt.paidAmount.Add(payment.Amount), decimal.Zero, "bounced transfer", now); err != nil {
+				t.Errorf("expecting no error for a payment made after the restructure, got %v", err)
+			}
+		})
+	}
+}
@@ -27,6 +27,41 @@ func TestLoanStatus_IsValid(t *testing.T) {
 			status: LoanStatusPaid,
 			want:   true,
 		},
+		{
+			name:   "pending approval",
+			status: LoanStatusPendingApproval,
+			want:   true,
+		},
+		{
+			name:   "approved",
+			status: LoanStatusApproved,
+			want:   true,
+		},
+		{
+			name:   "disbursed",
+			status: LoanStatusDisbursed,
+			want:   true,
+		},
+		{
+			name:   "rejected",
+			status: LoanStatusRejected,
+			want:   true,
+		},
+		{
+			name:   "cancelled",
+			status: LoanStatusCancelled,
+			want:   true,
+		},
+		{
+			name:   "defaulted",
+			status: LoanStatusDefaulted,
+			want:   true,
+		},
+		{
+			name:   "written off",
+			status: LoanStatusWrittenOff,
+			want:   true,
+		},
 		{
 			name:   "unknown status",
 			status: LoanStatus(-1),
@@ -43,7 +78,37 @@ func TestLoanStatus_IsValid(t *testing.T) {
 	}
 }
 
+func TestLoanStatus_IsOpen(t *testing.T) {
+	tests := []struct {
+		status LoanStatus
+		want   bool
+	}{
+		{status: LoanStatusPendingApproval, want: true},
+		{status: LoanStatusApproved, want: true},
+		{status: LoanStatusDisbursed, want: true},
+		{status: LoanStatusOngoing, want: true},
+		{status: LoanStatusPaid, want: false},
+		{status: LoanStatusRejected, want: false},
+		{status: LoanStatusCancelled, want: false},
+		{status: LoanStatusDefaulted, want: true},
+		{status: LoanStatusWrittenOff, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.status.IsOpen(); got != tt.want {
+			t.Errorf("LoanStatus(%d).IsOpen() = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
+
 func TestLoan_validate(t *testing.T) {
+	product := &LoanProduct{
+		ID:                       uuid.New(),
+		MinPrincipal:             decimal.NewFromInt(1_000_000),
+		MaxPrincipal:             decimal.NewFromInt(10_000_000),
+		AllowedInstallmentCounts: []int32{25, 50},
+	}
+
 	tests := []struct {
 		name      string
 		loan      *Loan
@@ -52,106 +117,286 @@ func TestLoan_validate(t *testing.T) {
 		{
 			name: "empty ID",
 			loan: &Loan{
-				UserID:               uuid.New(),
-				Amount:               decimal.NewFromInt(5_000_000),
-				PaymentDurationWeeks: 50,
-				PaymentAmount:        decimal.NewFromInt(5_500_000),
+				UserID:           uuid.New(),
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
 			},
 			wantError: ErrLoanEmptyID,
 		},
 		{
 			name: "empty user ID",
 			loan: &Loan{
-				ID:                   uuid.New(),
-				Amount:               decimal.NewFromInt(5_000_000),
-				PaymentDurationWeeks: 50,
-				PaymentAmount:        decimal.NewFromInt(5_500_000),
+				ID:               uuid.New(),
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
 			},
 			wantError: ErrLoanEmptyUserID,
 		},
 		{
 			name: "invalid amount",
 			loan: &Loan{
-				ID:                   uuid.New(),
-				UserID:               uuid.New(),
-				Amount:               decimal.Zero,
-				PaymentDurationWeeks: 50,
-				PaymentAmount:        decimal.NewFromInt(5_500_000),
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				Amount:           decimal.Zero,
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
 			},
 			wantError: ErrLoanInvalidAmount,
 		},
 		{
 			name: "invalid payment duration",
 			loan: &Loan{
-				ID:                   uuid.New(),
-				UserID:               uuid.New(),
-				Amount:               decimal.NewFromInt(5_000_000),
-				PaymentDurationWeeks: 0,
-				PaymentAmount:        decimal.NewFromInt(5_500_000),
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 0,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
+			},
+			wantError: ErrLoanInvalidInstallmentCount,
+		},
+		{
+			name: "invalid billing frequency",
+			loan: &Loan{
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				Amount:           decimal.NewFromInt(5_000_000),
+				BillingFrequency: BillingFrequency(-1),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
 			},
-			wantError: ErrLoanInvalidPaymentDurationWeeks,
+			wantError: ErrLoanInvalidBillingFrequency,
 		},
 		{
 			name: "invalid payment amount",
 			loan: &Loan{
-				ID:                   uuid.New(),
-				UserID:               uuid.New(),
-				Amount:               decimal.NewFromInt(5_000_000),
-				PaymentDurationWeeks: 50,
-				PaymentAmount:        decimal.Zero,
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.Zero,
 			},
 			wantError: ErrLoanInvalidPaymentAmount,
 		},
+		{
+			name: "invalid rounding policy",
+			loan: &Loan{
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
+				RoundingPolicy:   RoundingPolicy{Method: RoundingMethodBankers, Precision: -1},
+			},
+			wantError: ErrLoanInvalidRoundingPolicy,
+		},
+		{
+			name: "invalid payment acceptance mode",
+			loan: &Loan{
+				ID:                    uuid.New(),
+				UserID:                uuid.New(),
+				Amount:                decimal.NewFromInt(5_000_000),
+				InstallmentCount:      50,
+				PaymentAmount:         decimal.NewFromInt(5_500_000),
+				PaymentAcceptanceMode: PaymentAcceptanceMode(-1),
+			},
+			wantError: ErrLoanInvalidAcceptanceMode,
+		},
+		{
+			name: "invalid late fee policy",
+			loan: &Loan{
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
+				LateFeePolicy:    LateFeePolicy{Method: LateFeeMethod(-1)},
+			},
+			wantError: ErrLoanInvalidLateFeePolicy,
+		},
+		{
+			name: "invalid early settlement rebate rate",
+			loan: &Loan{
+				ID:                        uuid.New(),
+				UserID:                    uuid.New(),
+				Amount:                    decimal.NewFromInt(5_000_000),
+				InstallmentCount:          50,
+				PaymentAmount:             decimal.NewFromInt(5_500_000),
+				EarlySettlementRebateRate: decimal.NewFromFloat(1.5),
+			},
+			wantError: ErrLoanInvalidRebateRate,
+		},
+		{
+			name: "invalid grace period",
+			loan: &Loan{
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
+				GracePeriodDays:  7,
+			},
+			wantError: ErrLoanInvalidGracePeriod,
+		},
+		{
+			name: "invalid cooling-off period",
+			loan: &Loan{
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
+				CoolingOffDays:   -1,
+			},
+			wantError: ErrLoanInvalidCoolingOffPeriod,
+		},
+		{
+			name: "invalid default period",
+			loan: &Loan{
+				ID:                uuid.New(),
+				UserID:            uuid.New(),
+				Amount:            decimal.NewFromInt(5_000_000),
+				InstallmentCount:  50,
+				PaymentAmount:     decimal.NewFromInt(5_500_000),
+				DefaultAfterWeeks: -1,
+			},
+			wantError: ErrLoanInvalidDefaultPeriod,
+		},
+		{
+			name: "invalid billing calendar",
+			loan: &Loan{
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
+				BillingCalendar:  &BillingCalendar{Timezone: "Asia/Jakarta", WeekStartDay: time.Weekday(7)},
+			},
+			wantError: ErrLoanInvalidBillingCalendar,
+		},
+		{
+			name: "invalid delinquency rule",
+			loan: &Loan{
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
+				DelinquencyRule:  &DelinquencyRule{PartialPaymentRule: PartialPaymentRule(2)},
+			},
+			wantError: ErrLoanInvalidDelinquencyRule,
+		},
 		{
 			name: "invalid loan status",
 			loan: &Loan{
-				ID:                   uuid.New(),
-				UserID:               uuid.New(),
-				Amount:               decimal.NewFromInt(5_000_000),
-				PaymentDurationWeeks: 50,
-				PaymentAmount:        decimal.NewFromInt(5_500_000),
-				Status:               LoanStatus(-1),
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
+				Status:           LoanStatus(-1),
 			},
 			wantError: ErrLoanInvalidStatus,
 		},
 		{
 			name: "empty created at",
 			loan: &Loan{
-				ID:                   uuid.New(),
-				UserID:               uuid.New(),
-				Amount:               decimal.NewFromInt(5_000_000),
-				PaymentDurationWeeks: 50,
-				PaymentAmount:        decimal.NewFromInt(5_500_000),
-				Status:               LoanStatusOngoing,
-				CreatedAt:            time.Time{},
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
+				Status:           LoanStatusOngoing,
+				CreatedAt:        time.Time{},
 			},
 			wantError: ErrLoanEmptyCreatedAt,
 		},
 		{
 			name: "empty updated at",
 			loan: &Loan{
-				ID:                   uuid.New(),
-				UserID:               uuid.New(),
-				Amount:               decimal.NewFromInt(5_000_000),
-				PaymentDurationWeeks: 50,
-				PaymentAmount:        decimal.NewFromInt(5_500_000),
-				Status:               LoanStatusOngoing,
-				CreatedAt:            time.Now(),
-				UpdatedAt:            time.Time{},
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
+				Status:           LoanStatusOngoing,
+				CreatedAt:        time.Now(),
+				UpdatedAt:        time.Time{},
 			},
 			wantError: ErrLoanEmptyUpdatedAt,
 		},
+		{
+			name: "empty product ID",
+			loan: &Loan{
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
+				Status:           LoanStatusOngoing,
+				CreatedAt:        time.Now(),
+				UpdatedAt:        time.Now(),
+			},
+			wantError: ErrLoanEmptyProductID,
+		},
+		{
+			name: "product mismatch",
+			loan: &Loan{
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				ProductID:        uuid.New(),
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
+				Status:           LoanStatusOngoing,
+				CreatedAt:        time.Now(),
+				UpdatedAt:        time.Now(),
+			},
+			wantError: ErrLoanProductNotFound,
+		},
+		{
+			name: "amount out of product range",
+			loan: &Loan{
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				ProductID:        product.ID,
+				Amount:           decimal.NewFromInt(50_000_000),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(55_000_000),
+				Status:           LoanStatusOngoing,
+				CreatedAt:        time.Now(),
+				UpdatedAt:        time.Now(),
+			},
+			wantError: ErrLoanAmountOutOfProductRange,
+		},
+		{
+			name: "duration not allowed by product",
+			loan: &Loan{
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				ProductID:        product.ID,
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 10,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
+				Status:           LoanStatusOngoing,
+				CreatedAt:        time.Now(),
+				UpdatedAt:        time.Now(),
+			},
+			wantError: ErrLoanDurationNotAllowedByProduct,
+		},
 		{
 			name: "normal case",
 			loan: &Loan{
-				ID:                   uuid.New(),
-				UserID:               uuid.New(),
-				Amount:               decimal.NewFromInt(5_000_000),
-				PaymentDurationWeeks: 50,
-				PaymentAmount:        decimal.NewFromInt(5_500_000),
-				Status:               LoanStatusOngoing,
-				CreatedAt:            time.Now(),
-				UpdatedAt:            time.Now(),
+				ID:               uuid.New(),
+				UserID:           uuid.New(),
+				ProductID:        product.ID,
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
+				Status:           LoanStatusOngoing,
+				CreatedAt:        time.Now(),
+				UpdatedAt:        time.Now(),
 			},
 			wantError: nil,
 		},
@@ -159,7 +404,7 @@ func TestLoan_validate(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			err := test.loan.validate()
+			err := test.loan.validate(product)
 			if !errors.Is(err, test.wantError) {
 				t.Fatalf("expecting error to be %v, got %v", test.wantError, err)
 			}
@@ -169,49 +414,239 @@ func TestLoan_validate(t *testing.T) {
 
 func TestCreateLoan(t *testing.T) {
 	userID := uuid.New()
+	now := time.Date(2024, time.March, 14, 17, 30, 0, 0, time.FixedZone("UTC+7", 7*60*60))
+	defaultBillingCalendar := DefaultBillingCalendar()
+	defaultDelinquencyRule := DefaultDelinquencyRule()
+
+	flatProduct := &LoanProduct{
+		ID:            uuid.New(),
+		MinPrincipal:  decimal.NewFromInt(1_000_000),
+		MaxPrincipal:  decimal.NewFromInt(10_000_000),
+		InterestModel: InterestModelFlat,
+		InterestRate:  decimal.NewFromFloat(0.1),
+		FeeSchedule: FeeSchedule{
+			OriginationFeeRate:  decimal.NewFromFloat(0.01),
+			OriginationFeeFixed: decimal.NewFromInt(10_000),
+		},
+	}
+	decliningProduct := &LoanProduct{
+		ID:            uuid.New(),
+		MinPrincipal:  decimal.NewFromInt(1_000_000),
+		MaxPrincipal:  decimal.NewFromInt(10_000_000),
+		InterestModel: InterestModelDecliningBalance,
+		InterestRate:  decimal.NewFromFloat(0.1),
+		RoundingPolicy: RoundingPolicy{
+			Method: RoundingMethodRemainderOnFirst,
+		},
+		BillingCalendar: &BillingCalendar{Timezone: "Asia/Jakarta", WeekStartDay: time.Sunday},
+	}
+	zeroInterestProduct := &LoanProduct{
+		ID:            uuid.New(),
+		MinPrincipal:  decimal.NewFromInt(1_000_000),
+		MaxPrincipal:  decimal.NewFromInt(10_000_000),
+		InterestModel: InterestModelZeroInterest,
+	}
+	monthlyProduct := &LoanProduct{
+		ID:                uuid.New(),
+		MinPrincipal:      decimal.NewFromInt(1_000_000),
+		MaxPrincipal:      decimal.NewFromInt(10_000_000),
+		BillingFrequency:  BillingFrequencyMonthly,
+		InterestModel:     InterestModelDecliningBalance,
+		InterestRate:      decimal.NewFromFloat(0.02),
+		DelinquencyRule:   &DelinquencyRule{MissedInstallmentThreshold: 1, PartialPaymentRule: PartialPaymentCountsAsPaid},
+		DefaultAfterWeeks: 12,
+	}
+	invalidRateProduct := &LoanProduct{
+		ID:            uuid.New(),
+		MinPrincipal:  decimal.NewFromInt(1_000_000),
+		MaxPrincipal:  decimal.NewFromInt(10_000_000),
+		InterestModel: InterestModelFlat,
+		InterestRate:  decimal.NewFromFloat(-0.1),
+	}
+	invalidCalendarProduct := &LoanProduct{
+		ID:              uuid.New(),
+		MinPrincipal:    decimal.NewFromInt(1_000_000),
+		MaxPrincipal:    decimal.NewFromInt(10_000_000),
+		InterestModel:   InterestModelZeroInterest,
+		BillingCalendar: &BillingCalendar{Timezone: "Mars/Olympus_Mons", WeekStartDay: time.Monday},
+	}
+	invalidDelinquencyRuleProduct := &LoanProduct{
+		ID:              uuid.New(),
+		MinPrincipal:    decimal.NewFromInt(1_000_000),
+		MaxPrincipal:    decimal.NewFromInt(10_000_000),
+		InterestModel:   InterestModelZeroInterest,
+		DelinquencyRule: &DelinquencyRule{MissedInstallmentThreshold: -1},
+	}
 
 	tests := []struct {
-		name                 string
-		userID               uuid.UUID
-		amount               decimal.Decimal
-		paymentDurationWeeks int32
-		wantLoan             *Loan
-		wantErr              error
+		name             string
+		product          *LoanProduct
+		userID           uuid.UUID
+		amount           decimal.Decimal
+		installmentCount int32
+		wantLoan         *Loan
+		wantErr          error
 	}{
 		{
-			name:                 "empty user ID",
-			userID:               uuid.Nil,
-			amount:               decimal.NewFromInt(5_000_000),
-			paymentDurationWeeks: 50,
-			wantLoan:             nil,
-			wantErr:              ErrLoanEmptyUserID,
+			name:             "nil product",
+			product:          nil,
+			userID:           userID,
+			amount:           decimal.NewFromInt(5_000_000),
+			installmentCount: 50,
+			wantLoan:         nil,
+			wantErr:          ErrLoanProductNotFound,
+		},
+		{
+			name:             "empty user ID",
+			product:          flatProduct,
+			userID:           uuid.Nil,
+			amount:           decimal.NewFromInt(5_000_000),
+			installmentCount: 50,
+			wantLoan:         nil,
+			wantErr:          ErrLoanEmptyUserID,
 		},
 		{
-			name:                 "invalid amount",
-			userID:               userID,
-			amount:               decimal.NewFromInt(0),
-			paymentDurationWeeks: 50,
-			wantLoan:             nil,
-			wantErr:              ErrLoanInvalidAmount,
+			name:             "invalid amount",
+			product:          flatProduct,
+			userID:           userID,
+			amount:           decimal.NewFromInt(0),
+			installmentCount: 50,
+			wantLoan:         nil,
+			wantErr:          ErrLoanInvalidAmount,
 		},
 		{
-			name:                 "invalid payment duration",
-			userID:               userID,
-			amount:               decimal.NewFromInt(5_000_000),
-			paymentDurationWeeks: 0,
-			wantLoan:             nil,
-			wantErr:              ErrLoanInvalidPaymentDurationWeeks,
+			name:             "invalid payment duration",
+			product:          flatProduct,
+			userID:           userID,
+			amount:           decimal.NewFromInt(5_000_000),
+			installmentCount: 0,
+			wantLoan:         nil,
+			wantErr:          ErrLoanInvalidInstallmentCount,
 		},
 		{
-			name:                 "normal case",
-			userID:               userID,
-			amount:               decimal.NewFromInt(5_000_000),
-			paymentDurationWeeks: 50,
+			name:             "invalid interest rate",
+			product:          invalidRateProduct,
+			userID:           userID,
+			amount:           decimal.NewFromInt(5_000_000),
+			installmentCount: 50,
+			wantLoan:         nil,
+			wantErr:          ErrLoanInvalidInterestRate,
+		},
+		{
+			name:             "invalid billing calendar",
+			product:          invalidCalendarProduct,
+			userID:           userID,
+			amount:           decimal.NewFromInt(5_000_000),
+			installmentCount: 50,
+			wantLoan:         nil,
+			wantErr:          ErrLoanInvalidBillingCalendar,
+		},
+		{
+			name:             "invalid delinquency rule",
+			product:          invalidDelinquencyRuleProduct,
+			userID:           userID,
+			amount:           decimal.NewFromInt(5_000_000),
+			installmentCount: 50,
+			wantLoan:         nil,
+			wantErr:          ErrLoanInvalidDelinquencyRule,
+		},
+		{
+			name:             "amount out of product range",
+			product:          flatProduct,
+			userID:           userID,
+			amount:           decimal.NewFromInt(500_000),
+			installmentCount: 50,
+			wantLoan:         nil,
+			wantErr:          ErrLoanAmountOutOfProductRange,
+		},
+		{
+			name:             "normal case",
+			product:          flatProduct,
+			userID:           userID,
+			amount:           decimal.NewFromInt(5_000_000),
+			installmentCount: 50,
 			wantLoan: &Loan{
-				UserID:               userID,
-				Amount:               decimal.NewFromInt(5_000_000),
-				PaymentDurationWeeks: 50,
-				PaymentAmount:        decimal.NewFromInt(5_500_000),
+				UserID:           userID,
+				ProductID:        flatProduct.ID,
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				InterestModel:    InterestModelFlat,
+				InterestRate:     decimal.NewFromFloat(0.1),
+				PaymentAmount:    decimal.NewFromInt(5_500_000),
+				OriginationFee:   decimal.NewFromInt(60_000),
+				BillingCalendar:  &defaultBillingCalendar,
+				DelinquencyRule:  &defaultDelinquencyRule,
+				Status:           LoanStatusPendingApproval,
+				TermsVersion:     1,
+			},
+			wantErr: nil,
+		},
+		{
+			name:             "declining balance interest",
+			product:          decliningProduct,
+			userID:           userID,
+			amount:           decimal.NewFromInt(1_000_000),
+			installmentCount: 2,
+			wantLoan: &Loan{
+				UserID:           userID,
+				ProductID:        decliningProduct.ID,
+				Amount:           decimal.NewFromInt(1_000_000),
+				InstallmentCount: 2,
+				InterestModel:    InterestModelDecliningBalance,
+				InterestRate:     decimal.NewFromFloat(0.1),
+				RoundingPolicy:   RoundingPolicy{Method: RoundingMethodRemainderOnFirst},
+				PaymentAmount:    decimal.NewFromInt(1_152_381),
+				OriginationFee:   decimal.Zero,
+				BillingCalendar:  &BillingCalendar{Timezone: "Asia/Jakarta", WeekStartDay: time.Sunday},
+				DelinquencyRule:  &defaultDelinquencyRule,
+				Status:           LoanStatusPendingApproval,
+				TermsVersion:     1,
+			},
+			wantErr: nil,
+		},
+		{
+			name:             "zero interest",
+			product:          zeroInterestProduct,
+			userID:           userID,
+			amount:           decimal.NewFromInt(5_000_000),
+			installmentCount: 50,
+			wantLoan: &Loan{
+				UserID:           userID,
+				ProductID:        zeroInterestProduct.ID,
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 50,
+				InterestModel:    InterestModelZeroInterest,
+				InterestRate:     decimal.Zero,
+				PaymentAmount:    decimal.NewFromInt(5_000_000),
+				OriginationFee:   decimal.Zero,
+				BillingCalendar:  &defaultBillingCalendar,
+				DelinquencyRule:  &defaultDelinquencyRule,
+				Status:           LoanStatusPendingApproval,
+				TermsVersion:     1,
+			},
+			wantErr: nil,
+		},
+		{
+			name:             "monthly billing frequency",
+			product:          monthlyProduct,
+			userID:           userID,
+			amount:           decimal.NewFromInt(1_000_000),
+			installmentCount: 2,
+			wantLoan: &Loan{
+				UserID:            userID,
+				ProductID:         monthlyProduct.ID,
+				Amount:            decimal.NewFromInt(1_000_000),
+				BillingFrequency:  BillingFrequencyMonthly,
+				InstallmentCount:  2,
+				InterestModel:     InterestModelDecliningBalance,
+				InterestRate:      decimal.NewFromFloat(0.02),
+				PaymentAmount:     decimal.NewFromInt(1_030_100),
+				OriginationFee:    decimal.Zero,
+				BillingCalendar:   &defaultBillingCalendar,
+				DelinquencyRule:   &DelinquencyRule{MissedInstallmentThreshold: 1, PartialPaymentRule: PartialPaymentCountsAsPaid},
+				DefaultAfterWeeks: 12,
+				Status:            LoanStatusPendingApproval,
+				TermsVersion:      1,
 			},
 			wantErr: nil,
 		},
@@ -219,7 +654,7 @@ func TestCreateLoan(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			loan, err := CreateLoan(test.userID, test.amount, test.paymentDurationWeeks)
+			loan, err := CreateLoan(test.product, test.userID, test.amount, test.installmentCount, now)
 			if !errors.Is(err, test.wantErr) {
 				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
 			}
@@ -227,19 +662,30 @@ func TestCreateLoan(t *testing.T) {
 			if err == nil {
 				if diff := cmp.Diff(
 					test.wantLoan, loan,
-					cmpopts.IgnoreFields(Loan{}, "ID", "CreatedAt", "UpdatedAt"),
+					cmpopts.IgnoreFields(Loan{}, "ID", "Installments", "CreatedAt", "UpdatedAt"),
 				); diff != "" {
 					t.Fatalf("loan compare mismatch (-want/+got)\n%s", diff)
 				}
 
+				if len(loan.Installments) != int(test.installmentCount) {
+					t.Fatalf("expecting %d installments, got %d", test.installmentCount, len(loan.Installments))
+				}
+				scheduledAmount := decimal.Zero
+				for _, installment := range loan.Installments {
+					scheduledAmount = scheduledAmount.Add(installment.AmountDue)
+				}
+				if !scheduledAmount.Equal(loan.PaymentAmount) {
+					t.Fatalf("expecting installments to add up to %s, got %s", loan.PaymentAmount, scheduledAmount)
+				}
+
 				if loan.ID == uuid.Nil {
 					t.Fatalf("expecting loan id not to be empty")
 				}
-				if loan.CreatedAt.IsZero() {
-					t.Fatalf("expecting loan created at not to be zero")
+				if !loan.CreatedAt.Equal(now) || loan.CreatedAt.Location() != time.UTC {
+					t.Fatalf("expecting loan created at to be %v in UTC, got %v", now, loan.CreatedAt)
 				}
-				if loan.UpdatedAt.IsZero() {
-					t.Fatalf("expecting loan updated at not to be zero")
+				if !loan.UpdatedAt.Equal(now) {
+					t.Fatalf("expecting loan updated at to be %v, got %v", now, loan.UpdatedAt)
 				}
 			}
 		})
@@ -276,6 +722,15 @@ func TestLoan_ValidateLatestLoan(t *testing.T) {
 			},
 			wantErr: ErrLoanStillHasOngoingLoan,
 		},
+		{
+			name: "loan pending approval",
+			loan: &Loan{UserID: userID},
+			latestLoan: &Loan{
+				UserID: userID,
+				Status: LoanStatusPendingApproval,
+			},
+			wantErr: ErrLoanStillHasOngoingLoan,
+		},
 		{
 			name: "no ongoing loan",
 			loan: &Loan{UserID: userID},
@@ -285,6 +740,24 @@ func TestLoan_ValidateLatestLoan(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "rejected loan",
+			loan: &Loan{UserID: userID},
+			latestLoan: &Loan{
+				UserID: userID,
+				Status: LoanStatusRejected,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "cancelled loan",
+			loan: &Loan{UserID: userID},
+			latestLoan: &Loan{
+				UserID: userID,
+				Status: LoanStatusCancelled,
+			},
+			wantErr: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -334,6 +807,21 @@ func TestLoan_OutstandingAmount(t *testing.T) {
 			paidAmount: decimal.NewFromInt(1200),
 			wantAmount: decimal.Zero,
 		},
+		{
+			name: "unpaid charges",
+			loan: &Loan{
+				PaymentAmount: decimal.NewFromInt(1000),
+				Charges:       []*LoanCharge{{Amount: decimal.NewFromInt(30)}},
+			},
+			paidAmount: decimal.NewFromInt(400),
+			wantAmount: decimal.NewFromInt(630),
+		},
+		{
+			name:       "paid off with rebate",
+			loan:       &Loan{PaymentAmount: decimal.NewFromInt(1000), RebateAmount: decimal.NewFromInt(50)},
+			paidAmount: decimal.NewFromInt(950),
+			wantAmount: decimal.Zero,
+		},
 	}
 
 	for _, test := range tests {
@@ -346,6 +834,19 @@ func TestLoan_OutstandingAmount(t *testing.T) {
 	}
 }
 
+// payLoan allocates the paid amount to the installments of the loan the way its payments are applied,
+// storing its generated schedule first if it has none.
+func payLoan(loan *Loan, paidAmount decimal.Decimal, now time.Time) {
+	if loan == nil {
+		return
+	}
+	if len(loan.Installments) == 0 {
+		loan.Installments = loan.generateSchedule()
+	}
+
+	loan.allocatePayment(paidAmount, now)
+}
+
 func TestLoan_IsDelinquent(t *testing.T) {
 	now := time.Now().UTC()
 
@@ -364,10 +865,10 @@ func TestLoan_IsDelinquent(t *testing.T) {
 		{
 			name: "loan is paid",
 			loan: &Loan{
-				Status:               LoanStatusPaid,
-				PaymentAmount:        decimal.NewFromInt(1000),
-				PaymentDurationWeeks: 10,
-				CreatedAt:            now.Add(-time.Hour * 24 * 7), // 1 week ago
+				Status:           LoanStatusPaid,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 7), // 1 week ago
 			},
 			paidAmount: decimal.NewFromInt(500),
 			want:       false,
@@ -375,10 +876,10 @@ func TestLoan_IsDelinquent(t *testing.T) {
 		{
 			name: "paid amount equals payment amount",
 			loan: &Loan{
-				Status:               LoanStatusOngoing,
-				PaymentAmount:        decimal.NewFromInt(1000),
-				PaymentDurationWeeks: 10,
-				CreatedAt:            now.Add(-time.Hour * 24 * 7), // 1 week ago
+				Status:           LoanStatusOngoing,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 7), // 1 week ago
 			},
 			paidAmount: decimal.NewFromInt(1000),
 			want:       false,
@@ -386,10 +887,10 @@ func TestLoan_IsDelinquent(t *testing.T) {
 		{
 			name: "delinquent - more than 2 weeks unpaid",
 			loan: &Loan{
-				Status:               LoanStatusOngoing,
-				PaymentAmount:        decimal.NewFromInt(1000),
-				PaymentDurationWeeks: 10,
-				CreatedAt:            now.Add(-time.Hour * 24 * 21), // 3 weeks ago
+				Status:           LoanStatusOngoing,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 21), // 3 weeks ago
 			},
 			paidAmount: decimal.NewFromInt(0),
 			want:       true,
@@ -397,10 +898,10 @@ func TestLoan_IsDelinquent(t *testing.T) {
 		{
 			name: "not delinquent - less than 2 weeks unpaid",
 			loan: &Loan{
-				Status:               LoanStatusOngoing,
-				PaymentAmount:        decimal.NewFromInt(1000),
-				PaymentDurationWeeks: 10,
-				CreatedAt:            now.Add(-time.Hour * 24 * 7), // 1 week ago
+				Status:           LoanStatusOngoing,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 7), // 1 week ago
 			},
 			paidAmount: decimal.NewFromInt(0),
 			want:       false,
@@ -408,19 +909,101 @@ func TestLoan_IsDelinquent(t *testing.T) {
 		{
 			name: "exactly 2 weeks unpaid",
 			loan: &Loan{
-				Status:               LoanStatusOngoing,
-				PaymentAmount:        decimal.NewFromInt(1000),
-				PaymentDurationWeeks: 10,
-				CreatedAt:            now.Add(-time.Hour * 24 * 14), // 2 weeks ago
+				Status:           LoanStatusOngoing,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 14), // 2 weeks ago
+			},
+			paidAmount: decimal.NewFromInt(0),
+			want:       false,
+		},
+		{
+			name: "delinquent - short payment leaves every due installment unpaid",
+			loan: &Loan{
+				Status:           LoanStatusOngoing,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 3,
+				CreatedAt:        now.Add(-time.Hour * 24 * 21), // 3 weeks ago, installments of 333, 333, 334
+			},
+			paidAmount: decimal.NewFromInt(332),
+			want:       true,
+		},
+		{
+			name: "not delinquent - first installment fully paid",
+			loan: &Loan{
+				Status:           LoanStatusOngoing,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 3,
+				CreatedAt:        now.Add(-time.Hour * 24 * 21), // 3 weeks ago, installments of 333, 333, 334
+			},
+			paidAmount: decimal.NewFromInt(333),
+			want:       false,
+		},
+		{
+			name: "not delinquent - partially paid installment counts as paid by the loan's rule",
+			loan: &Loan{
+				Status:           LoanStatusOngoing,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 3,
+				CreatedAt:        now.Add(-time.Hour * 24 * 21), // 3 weeks ago, installments of 333, 333, 334
+				DelinquencyRule:  &DelinquencyRule{MissedInstallmentThreshold: 2, PartialPaymentRule: PartialPaymentCountsAsPaid},
+			},
+			paidAmount: decimal.NewFromInt(332),
+			want:       false,
+		},
+		{
+			name: "delinquent - 2 weeks unpaid exceeds the loan's threshold",
+			loan: &Loan{
+				Status:           LoanStatusOngoing,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 14), // 2 weeks ago
+				DelinquencyRule:  &DelinquencyRule{MissedInstallmentThreshold: 1},
+			},
+			paidAmount: decimal.NewFromInt(0),
+			want:       true,
+		},
+		{
+			name: "not delinquent - latest installment within grace period",
+			loan: &Loan{
+				Status:           LoanStatusOngoing,
+				PaymentAmount:    decimal.NewFromInt(300),
+				InstallmentCount: 3,
+				GracePeriodDays:  3,
+				CreatedAt:        now.Add(-time.Hour * 24 * 21), // 3 weeks ago
+				Installments: []*LoanInstallment{
+					{Number: 1, DueDate: now.Add(-time.Hour * 24 * 15), AmountDue: decimal.NewFromInt(100)},
+					{Number: 2, DueDate: now.Add(-time.Hour * 24 * 8), AmountDue: decimal.NewFromInt(100)},
+					{Number: 3, DueDate: now.Add(-time.Hour * 24 * 1), AmountDue: decimal.NewFromInt(100)},
+				},
 			},
 			paidAmount: decimal.NewFromInt(0),
 			want:       false,
 		},
+		{
+			name: "delinquent - grace period of the latest installment ended",
+			loan: &Loan{
+				Status:           LoanStatusOngoing,
+				PaymentAmount:    decimal.NewFromInt(300),
+				InstallmentCount: 3,
+				GracePeriodDays:  3,
+				CreatedAt:        now.Add(-time.Hour * 24 * 21), // 3 weeks ago
+				Installments: []*LoanInstallment{
+					{Number: 1, DueDate: now.Add(-time.Hour * 24 * 18), AmountDue: decimal.NewFromInt(100)},
+					{Number: 2, DueDate: now.Add(-time.Hour * 24 * 11), AmountDue: decimal.NewFromInt(100)},
+					{Number: 3, DueDate: now.Add(-time.Hour * 24 * 4), AmountDue: decimal.NewFromInt(100)},
+				},
+			},
+			paidAmount: decimal.NewFromInt(0),
+			want:       true,
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := test.loan.IsDelinquent(now, test.paidAmount)
+			payLoan(test.loan, test.paidAmount, now)
+
+			got := test.loan.IsDelinquent(now)
 			if got != test.want {
 				t.Fatalf("expecting delinquency to be %t, got %t", test.want, got)
 			}
@@ -446,9 +1029,9 @@ func TestLoan_CurrentBillAmount(t *testing.T) {
 		{
 			name: "first week, no payment",
 			loan: &Loan{
-				PaymentAmount:        decimal.NewFromInt(1000),
-				PaymentDurationWeeks: 10,
-				CreatedAt:            now.Add(-time.Hour * 24 * 7), // now is loan week 1
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 7), // now is loan week 1
 			},
 			paidAmount:         decimal.Zero,
 			expectedBillAmount: decimal.NewFromInt(100), // weekly payment amount
@@ -456,19 +1039,30 @@ func TestLoan_CurrentBillAmount(t *testing.T) {
 		{
 			name: "mid-duration, partial payment",
 			loan: &Loan{
-				PaymentAmount:        decimal.NewFromInt(1000),
-				PaymentDurationWeeks: 10,
-				CreatedAt:            now.Add(-time.Hour * 24 * 21), // now is loan week 3
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 21), // now is loan week 3
 			},
 			paidAmount:         decimal.NewFromInt(100),
 			expectedBillAmount: decimal.NewFromInt(200),
 		},
+		{
+			name: "mid-duration, with late fee charges",
+			loan: &Loan{
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 21), // now is loan week 3
+				Charges:          []*LoanCharge{{Type: LoanChargeTypeLateFee, InstallmentNumber: 1, Amount: decimal.NewFromInt(15)}},
+			},
+			paidAmount:         decimal.NewFromInt(100),
+			expectedBillAmount: decimal.NewFromInt(215),
+		},
 		{
 			name: "after duration, no payment",
 			loan: &Loan{
-				PaymentAmount:        decimal.NewFromInt(1000),
-				PaymentDurationWeeks: 10,
-				CreatedAt:            now.Add(-time.Hour * 24 * 84), // now is loan week 12
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 84), // now is loan week 12
 			},
 			paidAmount:         decimal.Zero,
 			expectedBillAmount: decimal.NewFromInt(1000),
@@ -476,9 +1070,9 @@ func TestLoan_CurrentBillAmount(t *testing.T) {
 		{
 			name: "after duration, partial payment",
 			loan: &Loan{
-				PaymentAmount:        decimal.NewFromInt(1000),
-				PaymentDurationWeeks: 10,
-				CreatedAt:            now.Add(-time.Hour * 24 * 84), // now is loan week 12
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 84), // now is loan week 12
 			},
 			paidAmount:         decimal.NewFromInt(600),
 			expectedBillAmount: decimal.NewFromInt(400),
@@ -486,9 +1080,9 @@ func TestLoan_CurrentBillAmount(t *testing.T) {
 		{
 			name: "fully paid for the week",
 			loan: &Loan{
-				PaymentAmount:        decimal.NewFromInt(1000),
-				PaymentDurationWeeks: 10,
-				CreatedAt:            now.Add(-time.Hour * 24 * 21), // now is loan week 3
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 21), // now is loan week 3
 			},
 			paidAmount:         decimal.NewFromInt(300),
 			expectedBillAmount: decimal.Zero,
@@ -496,13 +1090,38 @@ func TestLoan_CurrentBillAmount(t *testing.T) {
 		{
 			name: "fully paid",
 			loan: &Loan{
-				PaymentAmount:        decimal.NewFromInt(1000),
-				PaymentDurationWeeks: 10,
-				CreatedAt:            now.Add(-time.Hour * 24 * 21), // now is loan week 3
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 21), // now is loan week 3
 			},
 			paidAmount:         decimal.NewFromInt(1000),
 			expectedBillAmount: decimal.Zero,
 		},
+		{
+			name: "first week with remainder on first installment",
+			loan: &Loan{
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 3,
+				RoundingPolicy:   RoundingPolicy{Method: RoundingMethodRemainderOnFirst},
+				CreatedAt:        now.Add(-time.Hour * 24 * 7), // now is loan week 1
+			},
+			paidAmount:         decimal.Zero,
+			expectedBillAmount: decimal.NewFromInt(334),
+		},
+		{
+			name: "uses the stored installments",
+			loan: &Loan{
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 2,
+				CreatedAt:        now.Add(-time.Hour * 24 * 7), // now is loan week 1
+				Installments: []*LoanInstallment{
+					{Number: 1, AmountDue: decimal.NewFromInt(400)},
+					{Number: 2, AmountDue: decimal.NewFromInt(600)},
+				},
+			},
+			paidAmount:         decimal.Zero,
+			expectedBillAmount: decimal.NewFromInt(400),
+		},
 	}
 
 	for _, test := range tests {
@@ -515,17 +1134,144 @@ func TestLoan_CurrentBillAmount(t *testing.T) {
 	}
 }
 
+func TestLoan_DueBy(t *testing.T) {
+	now := time.Now().UTC()
+
+	// installments 1 and 2 are due, installment 3 is not due yet
+	newLoan := func(status LoanStatus) *Loan {
+		return &Loan{
+			Status:           status,
+			PaymentAmount:    decimal.NewFromInt(300),
+			InstallmentCount: 3,
+			GracePeriodDays:  3,
+			CreatedAt:        now.Add(-time.Hour * 24 * 14), // now is loan week 2
+			Installments: []*LoanInstallment{
+				{Number: 1, DueDate: now.Add(-time.Hour * 24 * 8), AmountDue: decimal.NewFromInt(100)},
+				{Number: 2, DueDate: now.Add(-time.Hour * 24 * 1), AmountDue: decimal.NewFromInt(100)},
+				{Number: 3, DueDate: now.Add(time.Hour * 24 * 6), AmountDue: decimal.NewFromInt(100)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		loan       *Loan
+		paidAmount decimal.Decimal
+		want       time.Time
+	}{
+		{
+			name:       "nil loan",
+			loan:       nil,
+			paidAmount: decimal.Zero,
+			want:       time.Time{},
+		},
+		{
+			name:       "paid loan",
+			loan:       newLoan(LoanStatusPaid),
+			paidAmount: decimal.Zero,
+			want:       time.Time{},
+		},
+		{
+			name:       "oldest unpaid installment",
+			loan:       newLoan(LoanStatusOngoing),
+			paidAmount: decimal.NewFromInt(50),
+			want:       now.Add(-time.Hour * 24 * 5),
+		},
+		{
+			name:       "oldest installment covered",
+			loan:       newLoan(LoanStatusOngoing),
+			paidAmount: decimal.NewFromInt(100),
+			want:       now.Add(time.Hour * 24 * 2),
+		},
+		{
+			name:       "every due installment covered",
+			loan:       newLoan(LoanStatusOngoing),
+			paidAmount: decimal.NewFromInt(200),
+			want:       time.Time{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			payLoan(test.loan, test.paidAmount, now)
+
+			got := test.loan.DueBy(now)
+			if !got.Equal(test.want) {
+				t.Fatalf("expecting due by to be %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestLoan_RemainingDueAmount(t *testing.T) {
+	now := time.Now().UTC()
+
+	newLoan := func() *Loan {
+		return &Loan{
+			PaymentAmount:    decimal.NewFromInt(1000),
+			InstallmentCount: 3, // installments of 333, 333, 334
+			CreatedAt:        now,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		loan       *Loan
+		paidAmount decimal.Decimal
+		want       decimal.Decimal
+	}{
+		{
+			name:       "nil loan",
+			loan:       nil,
+			paidAmount: decimal.Zero,
+			want:       decimal.Zero,
+		},
+		{
+			name:       "no payment",
+			loan:       newLoan(),
+			paidAmount: decimal.Zero,
+			want:       decimal.NewFromInt(333),
+		},
+		{
+			name:       "partially paid installment",
+			loan:       newLoan(),
+			paidAmount: decimal.NewFromInt(400),
+			want:       decimal.NewFromInt(266),
+		},
+		{
+			name:       "fully paid",
+			loan:       newLoan(),
+			paidAmount: decimal.NewFromInt(1000),
+			want:       decimal.Zero,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			payLoan(test.loan, test.paidAmount, now)
+
+			got := test.loan.RemainingDueAmount()
+			if !got.Equal(test.want) {
+				t.Fatalf("expecting remaining due amount to be %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
 func TestLoan_MakePayment(t *testing.T) {
 	now := time.Now().UTC()
 	loanID := uuid.New()
+	userID := uuid.New()
 
 	tests := []struct {
 		name            string
 		loan            *Loan
 		paidAmount      decimal.Decimal
 		paymentAmount   decimal.Decimal
+		mode            PaymentMode
 		wantLoanPayment *LoanPayment
 		wantUpdateLoan  bool
+		wantRebate      decimal.Decimal
 		wantErr         error
 	}{
 		{
@@ -540,10 +1286,10 @@ func TestLoan_MakePayment(t *testing.T) {
 		{
 			name: "current week already paid",
 			loan: &Loan{
-				ID:                   loanID,
-				PaymentAmount:        decimal.NewFromInt(1000),
-				PaymentDurationWeeks: 10,
-				CreatedAt:            now.Add(-time.Hour * 24 * 7), // now is loan week 1
+				ID:               loanID,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 7), // now is loan week 1
 			},
 			paidAmount:      decimal.NewFromInt(100), // week 1 already paid
 			paymentAmount:   decimal.NewFromInt(100),
@@ -554,10 +1300,10 @@ func TestLoan_MakePayment(t *testing.T) {
 		{
 			name: "payment amount does not match bill amount",
 			loan: &Loan{
-				ID:                   loanID,
-				PaymentAmount:        decimal.NewFromInt(1000),
-				PaymentDurationWeeks: 10,
-				CreatedAt:            now.Add(-time.Hour * 24 * 7), // now is loan week 1
+				ID:               loanID,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 7), // now is loan week 1
 			},
 			paidAmount:      decimal.Zero,
 			paymentAmount:   decimal.NewFromInt(50), // should be 100 every week
@@ -565,13 +1311,69 @@ func TestLoan_MakePayment(t *testing.T) {
 			wantUpdateLoan:  false,
 			wantErr:         ErrLoanNotExactPaymentAmount,
 		},
+		{
+			name: "partial payment exceeds bill amount",
+			loan: &Loan{
+				ID:                    loanID,
+				PaymentAmount:         decimal.NewFromInt(1000),
+				InstallmentCount:      10,
+				PaymentAcceptanceMode: PaymentAcceptanceModePartial,
+				CreatedAt:             now.Add(-time.Hour * 24 * 7), // now is loan week 1
+			},
+			paidAmount:      decimal.Zero,
+			paymentAmount:   decimal.NewFromInt(150),
+			wantLoanPayment: nil,
+			wantUpdateLoan:  false,
+			wantErr:         ErrLoanPaymentExceedsBillAmount,
+		},
+		{
+			name: "partial payment is accepted and allocated",
+			loan: &Loan{
+				ID:                    loanID,
+				PaymentAmount:         decimal.NewFromInt(300),
+				InstallmentCount:      3,
+				PaymentAcceptanceMode: PaymentAcceptanceModePartial,
+				CreatedAt:             now.Add(-time.Hour * 24 * 14), // now is loan week 2
+				Installments: []*LoanInstallment{
+					{Number: 1, AmountDue: decimal.NewFromInt(100), AmountPaid: decimal.NewFromInt(100), Status: LoanInstallmentStatusPaid},
+					{Number: 2, AmountDue: decimal.NewFromInt(100), AmountPaid: decimal.Zero},
+					{Number: 3, AmountDue: decimal.NewFromInt(100), AmountPaid: decimal.Zero},
+				},
+			},
+			paidAmount:    decimal.NewFromInt(100),
+			paymentAmount: decimal.NewFromInt(40),
+			wantLoanPayment: &LoanPayment{
+				LoanID: loanID,
+				Amount: decimal.NewFromInt(40),
+				Allocations: []InstallmentAllocation{
+					{InstallmentNumber: 2, Amount: decimal.NewFromInt(40)},
+				},
+			},
+			wantUpdateLoan: false,
+			wantErr:        nil,
+		},
+		{
+			name: "loan not disbursed yet",
+			loan: &Loan{
+				ID:               loanID,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				Status:           LoanStatusApproved,
+				CreatedAt:        now.Add(-time.Hour * 24 * 7),
+			},
+			paidAmount:      decimal.Zero,
+			paymentAmount:   decimal.NewFromInt(100),
+			wantLoanPayment: nil,
+			wantUpdateLoan:  false,
+			wantErr:         ErrLoanNotDisbursed,
+		},
 		{
 			name: "not the last week's payment, should not update loan",
 			loan: &Loan{
-				ID:                   loanID,
-				PaymentAmount:        decimal.NewFromInt(1000),
-				PaymentDurationWeeks: 10,
-				CreatedAt:            now.Add(-time.Hour * 24 * 7), // now is loan week 1
+				ID:               loanID,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 7), // now is loan week 1
 			},
 			paidAmount:    decimal.Zero,
 			paymentAmount: decimal.NewFromInt(100),
@@ -585,10 +1387,10 @@ func TestLoan_MakePayment(t *testing.T) {
 		{
 			name: "last week's payment, should update loan",
 			loan: &Loan{
-				ID:                   loanID,
-				PaymentAmount:        decimal.NewFromInt(1000),
-				PaymentDurationWeeks: 10,
-				CreatedAt:            now.Add(-time.Hour * 24 * 70), // now is loan week 10
+				ID:               loanID,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 70), // now is loan week 10
 			},
 			paidAmount:    decimal.NewFromInt(800),
 			paymentAmount: decimal.NewFromInt(200),
@@ -599,11 +1401,252 @@ func TestLoan_MakePayment(t *testing.T) {
 			wantUpdateLoan: true,
 			wantErr:        nil,
 		},
+		{
+			name: "payment is allocated to the oldest unpaid installments",
+			loan: &Loan{
+				ID:               loanID,
+				PaymentAmount:    decimal.NewFromInt(300),
+				InstallmentCount: 3,
+				CreatedAt:        now.Add(-time.Hour * 24 * 14), // now is loan week 2
+				Installments: []*LoanInstallment{
+					{Number: 1, AmountDue: decimal.NewFromInt(100), AmountPaid: decimal.Zero},
+					{Number: 2, AmountDue: decimal.NewFromInt(100), AmountPaid: decimal.Zero},
+					{Number: 3, AmountDue: decimal.NewFromInt(100), AmountPaid: decimal.Zero},
+				},
+			},
+			paidAmount:    decimal.Zero,
+			paymentAmount: decimal.NewFromInt(200),
+			wantLoanPayment: &LoanPayment{
+				LoanID: loanID,
+				Amount: decimal.NewFromInt(200),
+				Allocations: []InstallmentAllocation{
+					{InstallmentNumber: 1, Amount: decimal.NewFromInt(100)},
+					{InstallmentNumber: 2, Amount: decimal.NewFromInt(100)},
+				},
+			},
+			wantUpdateLoan: false,
+			wantErr:        nil,
+		},
+		{
+			name: "invalid payment mode",
+			loan: &Loan{
+				ID:               loanID,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 7), // now is loan week 1
+			},
+			paidAmount:      decimal.Zero,
+			paymentAmount:   decimal.NewFromInt(100),
+			mode:            PaymentMode(-1),
+			wantLoanPayment: nil,
+			wantUpdateLoan:  false,
+			wantErr:         ErrLoanInvalidPaymentMode,
+		},
+		{
+			name: "prepayment when current week is already paid",
+			loan: &Loan{
+				ID:               loanID,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 7), // now is loan week 1
+			},
+			paidAmount:    decimal.NewFromInt(100), // week 1 already paid
+			paymentAmount: decimal.NewFromInt(250),
+			mode:          PaymentModePrepayment,
+			wantLoanPayment: &LoanPayment{
+				LoanID: loanID,
+				Mode:   PaymentModePrepayment,
+				Amount: decimal.NewFromInt(250),
+			},
+			wantUpdateLoan: false,
+			wantErr:        nil,
+		},
+		{
+			name: "prepayment exceeds outstanding amount",
+			loan: &Loan{
+				ID:               loanID,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 7), // now is loan week 1
+			},
+			paidAmount:      decimal.NewFromInt(100),
+			paymentAmount:   decimal.NewFromInt(1000),
+			mode:            PaymentModePrepayment,
+			wantLoanPayment: nil,
+			wantUpdateLoan:  false,
+			wantErr:         ErrLoanPaymentExceedsOutstanding,
+		},
+		{
+			name: "prepayment of the outstanding amount, should update loan",
+			loan: &Loan{
+				ID:               loanID,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 7), // now is loan week 1
+			},
+			paidAmount:    decimal.NewFromInt(100),
+			paymentAmount: decimal.NewFromInt(900),
+			mode:          PaymentModePrepayment,
+			wantLoanPayment: &LoanPayment{
+				LoanID: loanID,
+				Mode:   PaymentModePrepayment,
+				Amount: decimal.NewFromInt(900),
+			},
+			wantUpdateLoan: true,
+			wantErr:        nil,
+		},
+		{
+			name: "prepayment on a paid loan",
+			loan: &Loan{
+				ID:               loanID,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				Status:           LoanStatusPaid,
+				CreatedAt:        now.Add(-time.Hour * 24 * 7), // now is loan week 1
+			},
+			paidAmount:      decimal.NewFromInt(1000),
+			paymentAmount:   decimal.NewFromInt(100),
+			mode:            PaymentModePrepayment,
+			wantLoanPayment: nil,
+			wantUpdateLoan:  false,
+			wantErr:         ErrLoanAlreadyPaid,
+		},
+		{
+			name: "payoff amount does not match quote",
+			loan: &Loan{
+				ID:                        loanID,
+				PaymentAmount:             decimal.NewFromInt(330),
+				InstallmentCount:          3,
+				EarlySettlementRebateRate: decimal.NewFromFloat(0.5),
+				CreatedAt:                 now.Add(-time.Hour * 24 * 7), // now is loan week 1
+				Installments: []*LoanInstallment{
+					{Number: 1, PrincipalAmount: decimal.NewFromInt(100), InterestAmount: decimal.NewFromInt(10), AmountDue: decimal.NewFromInt(110), AmountPaid: decimal.NewFromInt(110), Status: LoanInstallmentStatusPaid},
+					{Number: 2, PrincipalAmount: decimal.NewFromInt(100), InterestAmount: decimal.NewFromInt(10), AmountDue: decimal.NewFromInt(110), AmountPaid: decimal.Zero},
+					{Number: 3, PrincipalAmount: decimal.NewFromInt(100), InterestAmount: decimal.NewFromInt(10), AmountDue: decimal.NewFromInt(110), AmountPaid: decimal.Zero},
+				},
+			},
+			paidAmount:      decimal.NewFromInt(110),
+			paymentAmount:   decimal.NewFromInt(220), // payoff amount is 210 after the rebate
+			mode:            PaymentModePayoff,
+			wantLoanPayment: nil,
+			wantUpdateLoan:  false,
+			wantErr:         ErrLoanNotExactPayoffAmount,
+		},
+		{
+			name: "payoff with rebate closes the loan",
+			loan: &Loan{
+				ID:                        loanID,
+				PaymentAmount:             decimal.NewFromInt(330),
+				InstallmentCount:          3,
+				EarlySettlementRebateRate: decimal.NewFromFloat(0.5),
+				CreatedAt:                 now.Add(-time.Hour * 24 * 7), // now is loan week 1
+				Installments: []*LoanInstallment{
+					{Number: 1, PrincipalAmount: decimal.NewFromInt(100), InterestAmount: decimal.NewFromInt(10), AmountDue: decimal.NewFromInt(110), AmountPaid: decimal.NewFromInt(110), Status: LoanInstallmentStatusPaid},
+					{Number: 2, PrincipalAmount: decimal.NewFromInt(100), InterestAmount: decimal.NewFromInt(10), AmountDue: decimal.NewFromInt(110), AmountPaid: decimal.Zero},
+					{Number: 3, PrincipalAmount: decimal.NewFromInt(100), InterestAmount: decimal.NewFromInt(10), AmountDue: decimal.NewFromInt(110), AmountPaid: decimal.Zero},
+				},
+			},
+			paidAmount:    decimal.NewFromInt(110),
+			paymentAmount: decimal.NewFromInt(210),
+			mode:          PaymentModePayoff,
+			wantLoanPayment: &LoanPayment{
+				LoanID: loanID,
+				Mode:   PaymentModePayoff,
+				Amount: decimal.NewFromInt(210),
+				Allocations: []InstallmentAllocation{
+					{InstallmentNumber: 2, Amount: decimal.NewFromInt(105)},
+					{InstallmentNumber: 3, Amount: decimal.NewFromInt(105)},
+				},
+			},
+			wantUpdateLoan: true,
+			wantRebate:     decimal.NewFromInt(10),
+			wantErr:        nil,
+		},
+		{
+			name: "overpayment is rejected by default",
+			loan: &Loan{
+				ID:               loanID,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				CreatedAt:        now.Add(-time.Hour * 24 * 7), // now is loan week 1
+			},
+			paidAmount:      decimal.Zero,
+			paymentAmount:   decimal.NewFromInt(150),
+			wantLoanPayment: nil,
+			wantUpdateLoan:  false,
+			wantErr:         ErrLoanNotExactPaymentAmount,
+		},
+		{
+			name: "overpayment excess is credited",
+			loan: &Loan{
+				ID:                loanID,
+				UserID:            userID,
+				PaymentAmount:     decimal.NewFromInt(1000),
+				InstallmentCount:  10,
+				OverpaymentPolicy: OverpaymentPolicyCredit,
+				CreatedAt:         now.Add(-time.Hour * 24 * 7), // now is loan week 1
+			},
+			paidAmount:    decimal.Zero,
+			paymentAmount: decimal.NewFromInt(150),
+			wantLoanPayment: &LoanPayment{
+				LoanID:         loanID,
+				Amount:         decimal.NewFromInt(100),
+				ExcessAmount:   decimal.NewFromInt(50),
+				ExcessHandling: OverpaymentPolicyCredit,
+				CreditEntry: &CreditEntry{
+					UserID: userID,
+					LoanID: loanID,
+					Type:   CreditEntryTypeOverpayment,
+					Amount: decimal.NewFromInt(50),
+				},
+			},
+			wantUpdateLoan: false,
+			wantErr:        nil,
+		},
+		{
+			name: "overpayment excess is marked for refund",
+			loan: &Loan{
+				ID:                loanID,
+				UserID:            userID,
+				PaymentAmount:     decimal.NewFromInt(1000),
+				InstallmentCount:  10,
+				OverpaymentPolicy: OverpaymentPolicyRefund,
+				CreatedAt:         now.Add(-time.Hour * 24 * 7), // now is loan week 1
+			},
+			paidAmount:    decimal.NewFromInt(900),
+			paymentAmount: decimal.NewFromInt(130),
+			mode:          PaymentModePrepayment,
+			wantLoanPayment: &LoanPayment{
+				LoanID:         loanID,
+				Mode:           PaymentModePrepayment,
+				Amount:         decimal.NewFromInt(100),
+				ExcessAmount:   decimal.NewFromInt(30),
+				ExcessHandling: OverpaymentPolicyRefund,
+			},
+			wantUpdateLoan: true,
+			wantErr:        nil,
+		},
+		{
+			name: "payoff on a paid loan",
+			loan: &Loan{
+				ID:               loanID,
+				PaymentAmount:    decimal.NewFromInt(1000),
+				InstallmentCount: 10,
+				Status:           LoanStatusPaid,
+				CreatedAt:        now.Add(-time.Hour * 24 * 7), // now is loan week 1
+			},
+			paidAmount:      decimal.NewFromInt(1000),
+			paymentAmount:   decimal.Zero,
+			mode:            PaymentModePayoff,
+			wantLoanPayment: nil,
+			wantUpdateLoan:  false,
+			wantErr:         ErrLoanAlreadyPaid,
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			loanPayment, shouldUpdateLoan, err := test.loan.MakePayment(now, test.paidAmount, test.paymentAmount)
+			loanPayment, shouldUpdateLoan, err := test.loan.MakePayment(now, test.paidAmount, test.paymentAmount, test.mode, PaymentDetails{})
 
 			if !errors.Is(err, test.wantErr) {
 				t.Fatalf("expecting error %v, got %v", test.wantErr, err)
@@ -614,28 +1657,38 @@ func TestLoan_MakePayment(t *testing.T) {
 				if diff := cmp.Diff(
 					test.wantLoanPayment, loanPayment,
 					cmpopts.IgnoreFields(LoanPayment{}, "ID", "CreatedAt", "UpdatedAt"),
+					cmpopts.IgnoreFields(PaymentDetails{}, "PaidAt"),
+					cmpopts.IgnoreFields(CreditEntry{}, "ID", "PaymentID", "CreatedAt"),
 				); diff != "" {
 					t.Fatalf("LoanPayment missmatch (-want +got):\n%s", diff)
 				}
 
+				if !loanPayment.Details.PaidAt.Equal(now) {
+					t.Errorf("MakePayment() loanPayment.Details.PaidAt = %v, want %v", loanPayment.Details.PaidAt, now)
+				}
+
 				if loanPayment.ID == uuid.Nil {
 					t.Fatalf("expecting loanPayment.ID to be non-zero")
 				}
 
-				if loanPayment.CreatedAt.IsZero() {
-					t.Errorf("MakePayment() loanPayment.CreatedAt should not be zero")
+				if !loanPayment.CreatedAt.Equal(now) {
+					t.Errorf("MakePayment() loanPayment.CreatedAt = %v, want %v", loanPayment.CreatedAt, now)
 				}
 
 				if shouldUpdateLoan != test.wantUpdateLoan {
 					t.Errorf("MakePayment() shouldUpdateLoan = %v, want %v", shouldUpdateLoan, test.wantUpdateLoan)
 				}
 
+				if !test.loan.RebateAmount.Equal(test.wantRebate) {
+					t.Errorf("MakePayment() loan RebateAmount = %s, want %s", test.loan.RebateAmount, test.wantRebate)
+				}
+
 				if test.wantUpdateLoan {
 					if test.loan.Status != LoanStatusPaid {
 						t.Errorf("MakePayment() loan status should be LoanStatusPaid")
 					}
-					if test.loan.UpdatedAt.IsZero() {
-						t.Errorf("MakePayment() loan UpdatedAt should not be zero")
+					if !test.loan.UpdatedAt.Equal(now) {
+						t.Errorf("MakePayment() loan UpdatedAt = %v, want %v", test.loan.UpdatedAt, now)
 					}
 				}
 			}
@@ -643,62 +1696,49 @@ func TestLoan_MakePayment(t *testing.T) {
 	}
 }
 
-func TestLoan_weeklyPaymentAmount(t *testing.T) {
+func TestLoan_PaymentDurationWeeks(t *testing.T) {
 	tests := []struct {
 		name string
 		loan *Loan
-		want decimal.Decimal
+		want int32
 	}{
 		{
 			name: "nil loan",
 			loan: nil,
-			want: decimal.Zero,
+			want: 0,
 		},
 		{
-			name: "even division",
-			loan: &Loan{
-				PaymentAmount:        decimal.NewFromInt(10000),
-				PaymentDurationWeeks: 10,
-			},
-			want: decimal.NewFromInt(1000),
+			name: "weekly",
+			loan: &Loan{BillingFrequency: BillingFrequencyWeekly, InstallmentCount: 10},
+			want: 10,
 		},
 		{
-			name: "uneven division",
-			loan: &Loan{
-				PaymentAmount:        decimal.NewFromInt(10000),
-				PaymentDurationWeeks: 3,
-			},
-			want: decimal.NewFromInt(3333),
+			name: "bi-weekly",
+			loan: &Loan{BillingFrequency: BillingFrequencyBiWeekly, InstallmentCount: 10},
+			want: 20,
 		},
 		{
-			name: "one week duration",
-			loan: &Loan{
-				PaymentAmount:        decimal.NewFromInt(5000),
-				PaymentDurationWeeks: 1,
-			},
-			want: decimal.NewFromInt(5000),
+			name: "daily",
+			loan: &Loan{BillingFrequency: BillingFrequencyDaily, InstallmentCount: 10},
+			want: 0,
 		},
 		{
-			name: "zero payment amount",
-			loan: &Loan{
-				PaymentAmount:        decimal.Zero,
-				PaymentDurationWeeks: 5,
-			},
-			want: decimal.Zero,
+			name: "monthly",
+			loan: &Loan{BillingFrequency: BillingFrequencyMonthly, InstallmentCount: 10},
+			want: 0,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := test.loan.weeklyPaymentAmount()
-			if !got.Equal(test.want) {
-				t.Fatalf("expecting weekly payment amount to be %s, got %s", test.want, got)
+			if got := test.loan.PaymentDurationWeeks(); got != test.want {
+				t.Fatalf("expecting %d, got %d", test.want, got)
 			}
 		})
 	}
 }
 
-func TestLoan_currentWeek(t *testing.T) {
+func TestLoan_currentPeriod(t *testing.T) {
 	tests := []struct {
 		name string
 		loan *Loan
@@ -743,11 +1783,75 @@ func TestLoan_currentWeek(t *testing.T) {
 			now:  time.Date(2023, 4, 30, 23, 59, 59, 0, time.UTC), // Monday of the previous week
 			want: 0,
 		},
+		{
+			name: "billing timezone ahead of UTC",
+			loan: &Loan{
+				CreatedAt:       time.Date(2023, 5, 3, 3, 0, 0, 0, time.UTC), // Wednesday
+				BillingCalendar: &BillingCalendar{Timezone: "Asia/Jakarta", WeekStartDay: time.Monday},
+			},
+			now:  time.Date(2023, 5, 7, 23, 0, 0, 0, time.UTC), // Monday 06:00 in UTC+7
+			want: 1,
+		},
+		{
+			name: "week starting on Sunday",
+			loan: &Loan{
+				CreatedAt:       time.Date(2023, 5, 3, 0, 0, 0, 0, time.UTC), // Wednesday
+				BillingCalendar: &BillingCalendar{Timezone: "UTC", WeekStartDay: time.Sunday},
+			},
+			now:  time.Date(2023, 5, 7, 0, 0, 0, 0, time.UTC), // Sunday
+			want: 1,
+		},
+		{
+			name: "week across daylight saving time change",
+			loan: &Loan{
+				CreatedAt:       time.Date(2023, 3, 6, 12, 0, 0, 0, time.UTC), // Monday
+				BillingCalendar: &BillingCalendar{Timezone: "America/New_York", WeekStartDay: time.Monday},
+			},
+			now:  time.Date(2023, 3, 13, 4, 0, 0, 0, time.UTC), // Monday 00:00 in UTC-4 after the change
+			want: 1,
+		},
+		{
+			name: "daily billing frequency",
+			loan: &Loan{
+				BillingFrequency: BillingFrequencyDaily,
+				CreatedAt:        time.Date(2023, 5, 3, 18, 0, 0, 0, time.UTC), // Wednesday
+			},
+			now:  time.Date(2023, 5, 5, 0, 0, 0, 0, time.UTC), // Friday
+			want: 2,
+		},
+		{
+			name: "monthly billing frequency",
+			loan: &Loan{
+				BillingFrequency: BillingFrequencyMonthly,
+				CreatedAt:        time.Date(2023, 1, 31, 10, 0, 0, 0, time.UTC),
+			},
+			now:  time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC), // last day of a shorter month
+			want: 1,
+		},
+		{
+			name: "counted from the disbursement",
+			loan: &Loan{
+				Status:      LoanStatusDisbursed,
+				CreatedAt:   time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),  // Monday
+				DisbursedAt: time.Date(2023, 5, 10, 0, 0, 0, 0, time.UTC), // Wednesday of the next week
+			},
+			now:  time.Date(2023, 5, 15, 0, 0, 0, 0, time.UTC), // Monday after the disbursement
+			want: 1,
+		},
+		{
+			name: "loan not disbursed yet",
+			loan: &Loan{
+				Status:    LoanStatusApproved,
+				CreatedAt: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC), // Monday
+			},
+			now:  time.Date(2023, 5, 15, 0, 0, 0, 0, time.UTC), // 2 weeks later
+			want: 0,
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := test.loan.currentWeek(test.now)
+			got := test.loan.currentPeriod(test.now)
 			if got != test.want {
 				t.Fatalf("expecting current week to be %d, got %d", test.want, got)
 			}
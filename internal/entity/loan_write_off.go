@@ -0,0 +1,165 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/common/businesserror"
+)
+
+var (
+	ErrLoanNotPastDefaultPeriod = businesserror.New("loan has not been past due for longer than its default period", businesserror.KindUnprocessableEntity)
+	ErrLoanWrittenOff           = businesserror.New("loan is written off, only recovery payments are accepted", businesserror.KindUnprocessableEntity)
+	ErrLoanAlreadyRecovered     = businesserror.New("loan written-off amount is already recovered", businesserror.KindUnprocessableEntity)
+)
+
+// CanBeDefaulted checks if the loan is defaulted once it has been past due for longer than its default period,
+// regardless of how long it has been past due.
+//
+// Returns:
+//   - bool: true if the loan has a default period and is being repaid without having been defaulted, false otherwise.
+func (l *Loan) CanBeDefaulted() bool {
+	return l != nil && l.DefaultAfterWeeks > 0 && l.Status.canTransitionTo(LoanStatusDefaulted) && l.Status.isRepaying()
+}
+
+// IsPastDefaultPeriod checks if the loan has been past due for longer than its default period, and should be defaulted.
+//
+// The loan is past its default period when its days past due reach its default weeks, and it is being repaid
+// and has not been defaulted yet.
+//
+// Parameters:
+//   - now: The current time used to determine the days past due.
+//
+// Returns:
+//   - bool: true if the loan should be defaulted, false otherwise or if the loan has no default period.
+func (l *Loan) IsPastDefaultPeriod(now time.Time) bool {
+	if !l.CanBeDefaulted() {
+		return false
+	}
+
+	return l.DaysPastDue(now) >= 7*l.DefaultAfterWeeks
+}
+
+// Default moves the loan to defaulted once it has been past due for longer than its default period.
+// A defaulted loan is still being repaid, and is closed when it is paid or written off.
+//
+// Parameters:
+//   - now: The time the loan is defaulted at, used to determine the days past due.
+//
+// Returns:
+//   - error: ErrLoanNotFound if the loan is nil, ErrLoanIllegalStatusTransition if the loan is not being repaid,
+//     ErrLoanNotPastDefaultPeriod if the loan is not past its default period, nil otherwise.
+func (l *Loan) Default(now time.Time) error {
+	if l == nil {
+		return ErrLoanNotFound
+	}
+	if !l.Status.isRepaying() || !l.Status.canTransitionTo(LoanStatusDefaulted) {
+		return ErrLoanIllegalStatusTransition
+	}
+	if !l.IsPastDefaultPeriod(now) {
+		return ErrLoanNotPastDefaultPeriod
+	}
+
+	if err := l.transitionTo(LoanStatusDefaulted, now); err != nil {
+		return err
+	}
+	l.DefaultedAt = l.UpdatedAt
+
+	return nil
+}
+
+// WriteOff writes the outstanding amount of a defaulted loan off as a loss, closing it.
+//
+// The principal and interest outstanding on the installments, with the paid amount of each installment
+// applied to its interest first, and the charges outstanding are recorded as the written-off amounts of the loan.
+// Only recovery payments are accepted towards the loan afterwards.
+//
+// Parameters:
+//   - now: The time the loan is written off at.
+//
+// Returns:
+//   - error: ErrLoanNotFound if the loan is nil, ErrLoanIllegalStatusTransition if the loan is not defaulted,
+//     nil otherwise.
+func (l *Loan) WriteOff(now time.Time) error {
+	if err := l.transitionTo(LoanStatusWrittenOff, now); err != nil {
+		return err
+	}
+	l.WrittenOffAt = l.UpdatedAt
+
+	l.WrittenOffPrincipal, l.WrittenOffInterest, l.WrittenOffCharges = decimal.Zero, decimal.Zero, decimal.Zero
+	for _, installment := range l.Installments {
+		outstandingAmount := installment.OutstandingAmount()
+		outstandingInterest := decimal.Min(outstandingAmount, decimal.Max(decimal.Zero, installment.InterestAmount.Sub(installment.AmountPaid)))
+
+		l.WrittenOffInterest = l.WrittenOffInterest.Add(outstandingInterest)
+		l.WrittenOffPrincipal = l.WrittenOffPrincipal.Add(outstandingAmount.Sub(outstandingInterest))
+	}
+	for _, charge := range l.Charges {
+		l.WrittenOffCharges = l.WrittenOffCharges.Add(charge.OutstandingAmount())
+	}
+
+	return nil
+}
+
+// WrittenOffAmount calculates the total amount written off when the loan was written off.
+//
+// Returns:
+//   - decimal.Decimal: The sum of the written-off principal, interest and charges, zero if the loan is nil
+//     or has not been written off.
+func (l *Loan) WrittenOffAmount() decimal.Decimal {
+	if l == nil {
+		return decimal.Zero
+	}
+
+	return l.WrittenOffPrincipal.Add(l.WrittenOffInterest).Add(l.WrittenOffCharges)
+}
+
+// makeRecoveryPayment records a payment recovering part of the written-off amount of a written-off loan.
+//
+// The payment is not allocated to the installments and charges of the loan, and is added to its recovered amount
+// instead of its paid amount. A payment over the written-off amount not recovered yet is handled according to
+// the loan's overpayment policy.
+//
+// Parameters:
+//   - now: The time the payment is made at.
+//   - paymentAmount: The amount being paid in this transaction.
+//   - mode: The payment mode, which must be PaymentModeRecovery.
+//   - details: The details of the payment reported by the channel it was received through.
+//
+// Returns:
+//   - loanPayment: The newly created LoanPayment instance.
+//   - shouldUpdateLoan: A boolean indicating whether any changes being made to the loan instance, always true on success.
+//   - err: ErrLoanWrittenOff if the mode is not PaymentModeRecovery, ErrLoanAlreadyRecovered if the whole written-off
+//     amount has been recovered, ErrLoanPaymentExceedsOutstanding if the payment is over the amount not recovered yet,
+//     or an error if the payment cannot be created, nil otherwise.
+func (l *Loan) makeRecoveryPayment(now time.Time, paymentAmount decimal.Decimal, mode PaymentMode, details PaymentDetails) (loanPayment *LoanPayment, shouldUpdateLoan bool, err error) {
+	if mode != PaymentModeRecovery {
+		return nil, false, ErrLoanWrittenOff
+	}
+
+	unrecoveredAmount := decimal.Max(decimal.Zero, l.WrittenOffAmount().Sub(l.RecoveredAmount))
+	if unrecoveredAmount.IsZero() {
+		return nil, false, ErrLoanAlreadyRecovered
+	}
+	appliedAmount, excessAmount := l.OverpaymentPolicy.split(unrecoveredAmount, paymentAmount)
+	if appliedAmount.GreaterThan(unrecoveredAmount) {
+		return nil, false, ErrLoanPaymentExceedsOutstanding
+	}
+
+	loanPayment, err = CreateLoanPayment(l.ID, appliedAmount, details, now)
+	if err != nil {
+		return nil, false, err
+	}
+	loanPayment.Mode = PaymentModeRecovery
+	if excessAmount.IsPositive() {
+		if err = l.handleExcess(loanPayment, excessAmount); err != nil {
+			return nil, false, err
+		}
+	}
+
+	l.RecoveredAmount = l.RecoveredAmount.Add(appliedAmount)
+	l.UpdatedAt = loanPayment.CreatedAt
+
+	return loanPayment, true, nil
+}
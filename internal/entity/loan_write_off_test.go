@@ -0,0 +1,444 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestLoan_IsPastDefaultPeriod(t *testing.T) {
+	createdAt := time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC) // Monday, first installment due on March 11
+
+	newLoan := func(status LoanStatus, defaultAfterWeeks int32) *Loan {
+		return &Loan{
+			PaymentAmount:     decimal.NewFromInt(1000),
+			InstallmentCount:  10,
+			DefaultAfterWeeks: defaultAfterWeeks,
+			Status:            status,
+			CreatedAt:         createdAt,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		loan       *Loan
+		now        time.Time
+		paidAmount decimal.Decimal
+		want       bool
+	}{
+		{
+			name:       "nil loan",
+			loan:       nil,
+			now:        createdAt.AddDate(0, 0, 60),
+			paidAmount: decimal.Zero,
+			want:       false,
+		},
+		{
+			name:       "no default period",
+			loan:       newLoan(LoanStatusOngoing, 0),
+			now:        createdAt.AddDate(0, 0, 60),
+			paidAmount: decimal.Zero,
+			want:       false,
+		},
+		{
+			name:       "past due for less than the default period",
+			loan:       newLoan(LoanStatusOngoing, 2),
+			now:        time.Date(2024, time.March, 24, 23, 0, 0, 0, time.UTC), // 13 days past due
+			paidAmount: decimal.Zero,
+			want:       false,
+		},
+		{
+			name:       "past due for the default period",
+			loan:       newLoan(LoanStatusOngoing, 2),
+			now:        time.Date(2024, time.March, 25, 10, 0, 0, 0, time.UTC), // 14 days past due
+			paidAmount: decimal.Zero,
+			want:       true,
+		},
+		{
+			name:       "disbursed loan past due for the default period",
+			loan:       newLoan(LoanStatusDisbursed, 2),
+			now:        time.Date(2024, time.March, 25, 10, 0, 0, 0, time.UTC),
+			paidAmount: decimal.Zero,
+			want:       true,
+		},
+		{
+			name:       "oldest installments paid",
+			loan:       newLoan(LoanStatusOngoing, 2),
+			now:        time.Date(2024, time.March, 25, 10, 0, 0, 0, time.UTC), // installment 2 is 7 days past due
+			paidAmount: decimal.NewFromInt(100),
+			want:       false,
+		},
+		{
+			name:       "already defaulted",
+			loan:       newLoan(LoanStatusDefaulted, 2),
+			now:        time.Date(2024, time.March, 25, 10, 0, 0, 0, time.UTC),
+			paidAmount: decimal.Zero,
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payLoan(tt.loan, tt.paidAmount, tt.now)
+
+			if got := tt.loan.IsPastDefaultPeriod(tt.now); got != tt.want {
+				t.Fatalf("expecting %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLoan_Default(t *testing.T) {
+	createdAt := time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC) // Monday, first installment due on March 11
+	now := time.Date(2024, time.March, 25, 10, 0, 0, 0, time.UTC)      // 14 days past due
+
+	newLoan := func(status LoanStatus, defaultAfterWeeks int32) *Loan {
+		return &Loan{
+			PaymentAmount:     decimal.NewFromInt(1000),
+			InstallmentCount:  10,
+			DefaultAfterWeeks: defaultAfterWeeks,
+			Status:            status,
+			CreatedAt:         createdAt,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		loan    *Loan
+		wantErr error
+	}{
+		{
+			name:    "nil loan",
+			loan:    nil,
+			wantErr: ErrLoanNotFound,
+		},
+		{
+			name:    "loan pending approval",
+			loan:    newLoan(LoanStatusPendingApproval, 2),
+			wantErr: ErrLoanIllegalStatusTransition,
+		},
+		{
+			name:    "loan already defaulted",
+			loan:    newLoan(LoanStatusDefaulted, 2),
+			wantErr: ErrLoanIllegalStatusTransition,
+		},
+		{
+			name:    "loan not past its default period",
+			loan:    newLoan(LoanStatusOngoing, 3),
+			wantErr: ErrLoanNotPastDefaultPeriod,
+		},
+		{
+			name:    "loan past its default period",
+			loan:    newLoan(LoanStatusOngoing, 2),
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.loan.Default(now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if tt.loan.Status != LoanStatusDefaulted {
+				t.Errorf("expecting status %v, got %v", LoanStatusDefaulted, tt.loan.Status)
+			}
+			if !tt.loan.DefaultedAt.Equal(now) || !tt.loan.UpdatedAt.Equal(now) {
+				t.Errorf("expecting defaulted at and updated at to be %v, got %v and %v", now, tt.loan.DefaultedAt, tt.loan.UpdatedAt)
+			}
+			if !tt.loan.Status.IsOpen() {
+				t.Errorf("expecting defaulted loan to be open")
+			}
+		})
+	}
+}
+
+func TestLoan_WriteOff(t *testing.T) {
+	now := time.Date(2024, time.May, 6, 10, 0, 0, 0, time.UTC)
+
+	// installment 1 is paid, installment 2 is partially paid and installment 3 is unpaid
+	newLoan := func(status LoanStatus) *Loan {
+		return &Loan{
+			ID:               uuid.New(),
+			PaymentAmount:    decimal.NewFromInt(330),
+			InstallmentCount: 3,
+			Status:           status,
+			Installments: []*LoanInstallment{
+				{Number: 1, PrincipalAmount: decimal.NewFromInt(100), InterestAmount: decimal.NewFromInt(10), AmountDue: decimal.NewFromInt(110), AmountPaid: decimal.NewFromInt(110)},
+				{Number: 2, PrincipalAmount: decimal.NewFromInt(100), InterestAmount: decimal.NewFromInt(10), AmountDue: decimal.NewFromInt(110), AmountPaid: decimal.NewFromInt(5)},
+				{Number: 3, PrincipalAmount: decimal.NewFromInt(100), InterestAmount: decimal.NewFromInt(10), AmountDue: decimal.NewFromInt(110), AmountPaid: decimal.Zero},
+			},
+			Charges: []*LoanCharge{
+				{ID: uuid.New(), Type: LoanChargeTypeLateFee, InstallmentNumber: 2, Amount: decimal.NewFromInt(20), AmountPaid: decimal.NewFromInt(5)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		loan          *Loan
+		wantPrincipal decimal.Decimal
+		wantInterest  decimal.Decimal
+		wantCharges   decimal.Decimal
+		wantErr       error
+	}{
+		{
+			name:    "nil loan",
+			loan:    nil,
+			wantErr: ErrLoanNotFound,
+		},
+		{
+			name:    "ongoing loan",
+			loan:    newLoan(LoanStatusOngoing),
+			wantErr: ErrLoanIllegalStatusTransition,
+		},
+		{
+			name:    "loan already written off",
+			loan:    newLoan(LoanStatusWrittenOff),
+			wantErr: ErrLoanIllegalStatusTransition,
+		},
+		{
+			name:          "defaulted loan",
+			loan:          newLoan(LoanStatusDefaulted),
+			wantPrincipal: decimal.NewFromInt(200),
+			wantInterest:  decimal.NewFromInt(15),
+			wantCharges:   decimal.NewFromInt(15),
+			wantErr:       nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.loan.WriteOff(now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if tt.loan.Status != LoanStatusWrittenOff {
+				t.Errorf("expecting status %v, got %v", LoanStatusWrittenOff, tt.loan.Status)
+			}
+			if !tt.loan.WrittenOffAt.Equal(now) {
+				t.Errorf("expecting written off at to be %v, got %v", now, tt.loan.WrittenOffAt)
+			}
+			if !tt.loan.WrittenOffPrincipal.Equal(tt.wantPrincipal) || !tt.loan.WrittenOffInterest.Equal(tt.wantInterest) ||
+				!tt.loan.WrittenOffCharges.Equal(tt.wantCharges) {
+				t.Errorf("expecting written-off principal, interest and charges %s, %s and %s, got %s, %s and %s",
+					tt.wantPrincipal, tt.wantInterest, tt.wantCharges,
+					tt.loan.WrittenOffPrincipal, tt.loan.WrittenOffInterest, tt.loan.WrittenOffCharges)
+			}
+			if !tt.loan.OutstandingAmount(decimal.NewFromInt(120)).IsZero() || !tt.loan.CurrentBillAmount(now, decimal.NewFromInt(120)).IsZero() {
+				t.Errorf("expecting nothing outstanding or billed on a written-off loan")
+			}
+			if tt.loan.Status.IsOpen() {
+				t.Errorf("expecting written-off loan to be closed")
+			}
+		})
+	}
+}
+
+func TestLoan_MakePayment_writtenOffLoan(t *testing.T) {
+	now := time.Date(2024, time.May, 6, 10, 0, 0, 0, time.UTC)
+
+	newLoan := func(policy OverpaymentPolicy, recoveredAmount int64) *Loan {
+		return &Loan{
+			ID:                  uuid.New(),
+			UserID:              uuid.New(),
+			PaymentAmount:       decimal.NewFromInt(330),
+			InstallmentCount:    3,
+			OverpaymentPolicy:   policy,
+			Status:              LoanStatusWrittenOff,
+			WrittenOffPrincipal: decimal.NewFromInt(200),
+			WrittenOffInterest:  decimal.NewFromInt(15),
+			WrittenOffCharges:   decimal.NewFromInt(15),
+			RecoveredAmount:     decimal.NewFromInt(recoveredAmount),
+		}
+	}
+
+	tests := []struct {
+		name          string
+		loan          *Loan
+		paymentAmount decimal.Decimal
+		mode          PaymentMode
+		wantAmount    decimal.Decimal
+		wantExcess    decimal.Decimal
+		wantRecovered decimal.Decimal
+		wantErr       error
+	}{
+		{
+			name:          "regular payment",
+			loan:          newLoan(OverpaymentPolicyReject, 0),
+			paymentAmount: decimal.NewFromInt(100),
+			mode:          PaymentModeRegular,
+			wantErr:       ErrLoanWrittenOff,
+		},
+		{
+			name:          "recovery payment",
+			loan:          newLoan(OverpaymentPolicyReject, 0),
+			paymentAmount: decimal.NewFromInt(100),
+			mode:          PaymentModeRecovery,
+			wantAmount:    decimal.NewFromInt(100),
+			wantExcess:    decimal.Zero,
+			wantRecovered: decimal.NewFromInt(100),
+			wantErr:       nil,
+		},
+		{
+			name:          "recovery payment over the amount not recovered yet",
+			loan:          newLoan(OverpaymentPolicyReject, 100),
+			paymentAmount: decimal.NewFromInt(200),
+			mode:          PaymentModeRecovery,
+			wantErr:       ErrLoanPaymentExceedsOutstanding,
+		},
+		{
+			name:          "recovery payment over the amount not recovered yet, credited",
+			loan:          newLoan(OverpaymentPolicyCredit, 100),
+			paymentAmount: decimal.NewFromInt(200),
+			mode:          PaymentModeRecovery,
+			wantAmount:    decimal.NewFromInt(130),
+			wantExcess:    decimal.NewFromInt(70),
+			wantRecovered: decimal.NewFromInt(230),
+			wantErr:       nil,
+		},
+		{
+			name:          "written-off amount already recovered",
+			loan:          newLoan(OverpaymentPolicyReject, 230),
+			paymentAmount: decimal.NewFromInt(10),
+			mode:          PaymentModeRecovery,
+			wantErr:       ErrLoanAlreadyRecovered,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payment, shouldUpdateLoan, err := tt.loan.MakePayment(now, decimal.NewFromInt(100), tt.paymentAmount, tt.mode, PaymentDetails{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if !shouldUpdateLoan {
+				t.Errorf("expecting the loan to be updated")
+			}
+			if payment.Mode != PaymentModeRecovery || len(payment.Allocations) != 0 || len(payment.ChargeAllocations) != 0 {
+				t.Errorf("expecting an unallocated recovery payment, got mode %v and %d allocations", payment.Mode, len(payment.Allocations)+len(payment.ChargeAllocations))
+			}
+			if !payment.Amount.Equal(tt.wantAmount) || !payment.ExcessAmount.Equal(tt.wantExcess) {
+				t.Errorf("expecting amount %s and excess %s, got %s and %s", tt.wantAmount, tt.wantExcess, payment.Amount, payment.ExcessAmount)
+			}
+			if tt.wantExcess.IsPositive() && (payment.CreditEntry == nil || !payment.CreditEntry.Amount.Equal(tt.wantExcess)) {
+				t.Errorf("expecting the excess to be credited, got credit entry %v", payment.CreditEntry)
+			}
+			if !tt.loan.RecoveredAmount.Equal(tt.wantRecovered) {
+				t.Errorf("expecting recovered amount %s, got %s", tt.wantRecovered, tt.loan.RecoveredAmount)
+			}
+			if tt.loan.Status != LoanStatusWrittenOff {
+				t.Errorf("expecting the loan to stay written off, got status %v", tt.loan.Status)
+			}
+		})
+	}
+}
+
+func TestLoan_MakePayment_recoveryModeOnOngoingLoan(t *testing.T) {
+	loan := &Loan{
+		ID:               uuid.New(),
+		PaymentAmount:    decimal.NewFromInt(1000),
+		InstallmentCount: 10,
+		Status:           LoanStatusOngoing,
+		CreatedAt:        time.Now().UTC().Add(-time.Hour * 24 * 7),
+	}
+
+	_, _, err := loan.MakePayment(time.Now().UTC(), decimal.Zero, decimal.NewFromInt(100), PaymentModeRecovery, PaymentDetails{})
+	if !errors.Is(err, ErrLoanInvalidPaymentMode) {
+		t.Fatalf("expecting error to be %v, got %v", ErrLoanInvalidPaymentMode, err)
+	}
+}
+
+func TestLoan_ReversePayment_defaultedAndWrittenOffLoans(t *testing.T) {
+	now := time.Date(2024, time.May, 6, 10, 0, 0, 0, time.UTC)
+	loanID := uuid.New()
+
+	tests := []struct {
+		name          string
+		loan          *Loan
+		payment       *LoanPayment
+		paidAmount    decimal.Decimal
+		wantStatus    LoanStatus
+		wantRecovered decimal.Decimal
+		wantErr       error
+	}{
+		{
+			name: "payment paying off a defaulted loan",
+			loan: &Loan{
+				ID:               loanID,
+				PaymentAmount:    decimal.NewFromInt(330),
+				InstallmentCount: 3,
+				Status:           LoanStatusPaid,
+				DefaultedAt:      now.AddDate(0, 0, -7),
+			},
+			payment:       &LoanPayment{LoanID: loanID, Amount: decimal.NewFromInt(110), Mode: PaymentModeRegular},
+			paidAmount:    decimal.NewFromInt(330),
+			wantStatus:    LoanStatusDefaulted,
+			wantRecovered: decimal.Zero,
+			wantErr:       nil,
+		},
+		{
+			name: "recovery payment",
+			loan: &Loan{
+				ID:                  loanID,
+				PaymentAmount:       decimal.NewFromInt(330),
+				InstallmentCount:    3,
+				Status:              LoanStatusWrittenOff,
+				WrittenOffPrincipal: decimal.NewFromInt(200),
+				RecoveredAmount:     decimal.NewFromInt(150),
+			},
+			payment:       &LoanPayment{LoanID: loanID, Amount: decimal.NewFromInt(100), Mode: PaymentModeRecovery},
+			paidAmount:    decimal.NewFromInt(130),
+			wantStatus:    LoanStatusWrittenOff,
+			wantRecovered: decimal.NewFromInt(50),
+			wantErr:       nil,
+		},
+		{
+			name: "payment made before the write-off",
+			loan: &Loan{
+				ID:                  loanID,
+				PaymentAmount:       decimal.NewFromInt(330),
+				InstallmentCount:    3,
+				Status:              LoanStatusWrittenOff,
+				WrittenOffPrincipal: decimal.NewFromInt(200),
+			},
+			payment:    &LoanPayment{LoanID: loanID, Amount: decimal.NewFromInt(110), Mode: PaymentModeRegular},
+			paidAmount: decimal.NewFromInt(130),
+			wantErr:    ErrLoanWrittenOff,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.loan.ReversePayment(tt.payment, tt.paidAmount, decimal.Zero, "bounced transfer", now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if tt.loan.Status != tt.wantStatus {
+				t.Errorf("expecting status %v, got %v", tt.wantStatus, tt.loan.Status)
+			}
+			if !tt.loan.RecoveredAmount.Equal(tt.wantRecovered) {
+				t.Errorf("expecting recovered amount %s, got %s", tt.wantRecovered, tt.loan.RecoveredAmount)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// OverpaymentPolicy represents how a payment exceeding the amount accepted towards a loan is handled.
+type OverpaymentPolicy int
+
+const (
+	// OverpaymentPolicyReject rejects the payments exceeding the amount accepted towards the loan.
+	OverpaymentPolicyReject OverpaymentPolicy = iota
+
+	// OverpaymentPolicyCredit accepts the payment and holds the excess as a credit balance of the user,
+	// which is applied to the next installments due on the loan or on the user's next loan.
+	OverpaymentPolicyCredit
+
+	// OverpaymentPolicyRefund accepts the payment and marks the excess to be refunded to the payer.
+	OverpaymentPolicyRefund
+)
+
+// IsValid checks if the OverpaymentPolicy is a valid overpayment policy.
+//
+// Returns:
+//   - bool: true if the policy is one of the predefined overpayment policies, false otherwise.
+func (p OverpaymentPolicy) IsValid() bool {
+	return p == OverpaymentPolicyReject || p == OverpaymentPolicyCredit || p == OverpaymentPolicyRefund
+}
+
+// split splits a payment into the amount applied to the loan and the excess handled by the policy.
+//
+// Parameters:
+//   - acceptedAmount: The maximum amount accepted towards the loan.
+//   - paymentAmount: The amount being paid.
+//
+// Returns:
+//   - applied: The amount applied to the loan, which is the whole payment if it is not over the accepted amount
+//     or if the policy rejects overpayments.
+//   - excess: The amount of the payment over the accepted amount, zero if there is none or if the policy rejects overpayments.
+func (p OverpaymentPolicy) split(acceptedAmount, paymentAmount decimal.Decimal) (applied, excess decimal.Decimal) {
+	if p == OverpaymentPolicyReject || !paymentAmount.GreaterThan(acceptedAmount) {
+		return paymentAmount, decimal.Zero
+	}
+
+	return acceptedAmount, paymentAmount.Sub(acceptedAmount)
+}
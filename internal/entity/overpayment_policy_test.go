@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestOverpaymentPolicy_IsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy OverpaymentPolicy
+		want   bool
+	}{
+		{
+			name:   "reject",
+			policy: OverpaymentPolicyReject,
+			want:   true,
+		},
+		{
+			name:   "credit",
+			policy: OverpaymentPolicyCredit,
+			want:   true,
+		},
+		{
+			name:   "refund",
+			policy: OverpaymentPolicyRefund,
+			want:   true,
+		},
+		{
+			name:   "unknown policy",
+			policy: OverpaymentPolicy(-1),
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.IsValid(); got != tt.want {
+				t.Fatalf("expecting %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOverpaymentPolicy_split(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        OverpaymentPolicy
+		paymentAmount decimal.Decimal
+		wantApplied   decimal.Decimal
+		wantExcess    decimal.Decimal
+	}{
+		{
+			name:          "reject keeps the whole payment",
+			policy:        OverpaymentPolicyReject,
+			paymentAmount: decimal.NewFromInt(150),
+			wantApplied:   decimal.NewFromInt(150),
+			wantExcess:    decimal.Zero,
+		},
+		{
+			name:          "credit splits the excess",
+			policy:        OverpaymentPolicyCredit,
+			paymentAmount: decimal.NewFromInt(150),
+			wantApplied:   decimal.NewFromInt(100),
+			wantExcess:    decimal.NewFromInt(50),
+		},
+		{
+			name:          "refund splits the excess",
+			policy:        OverpaymentPolicyRefund,
+			paymentAmount: decimal.NewFromInt(120),
+			wantApplied:   decimal.NewFromInt(100),
+			wantExcess:    decimal.NewFromInt(20),
+		},
+		{
+			name:          "payment not over the accepted amount",
+			policy:        OverpaymentPolicyCredit,
+			paymentAmount: decimal.NewFromInt(80),
+			wantApplied:   decimal.NewFromInt(80),
+			wantExcess:    decimal.Zero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applied, excess := tt.policy.split(decimal.NewFromInt(100), tt.paymentAmount)
+			if !applied.Equal(tt.wantApplied) {
+				t.Errorf("split() applied = %s, want %s", applied, tt.wantApplied)
+			}
+			if !excess.Equal(tt.wantExcess) {
+				t.Errorf("split() excess = %s, want %s", excess, tt.wantExcess)
+			}
+		})
+	}
+}
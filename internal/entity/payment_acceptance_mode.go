@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// PaymentAcceptanceMode represents which payment amounts are accepted towards a loan's current bill.
+type PaymentAcceptanceMode int
+
+const (
+	// PaymentAcceptanceModeExact only accepts payments matching the current bill amount exactly.
+	PaymentAcceptanceModeExact PaymentAcceptanceMode = iota
+
+	// PaymentAcceptanceModePartial also accepts payments lower than the current bill amount,
+	// which are allocated to the oldest outstanding installment first.
+	PaymentAcceptanceModePartial
+)
+
+// IsValid checks if the PaymentAcceptanceMode is a valid acceptance mode.
+//
+// Returns:
+//   - bool: true if the mode is either PaymentAcceptanceModeExact or PaymentAcceptanceModePartial, false otherwise.
+func (m PaymentAcceptanceMode) IsValid() bool {
+	return m == PaymentAcceptanceModeExact || m == PaymentAcceptanceModePartial
+}
+
+// accepts checks whether a payment amount can be accepted towards the bill amount.
+//
+// Parameters:
+//   - billAmount: The current bill amount of the loan.
+//   - paymentAmount: The amount being paid.
+//
+// Returns:
+//   - error: ErrLoanNotExactPaymentAmount if the mode is exact and the amounts differ,
+//     ErrLoanPaymentExceedsBillAmount if the mode is partial and the payment is more than the bill, nil otherwise.
+func (m PaymentAcceptanceMode) accepts(billAmount, paymentAmount decimal.Decimal) error {
+	if m == PaymentAcceptanceModePartial {
+		if paymentAmount.GreaterThan(billAmount) {
+			return ErrLoanPaymentExceedsBillAmount
+		}
+
+		return nil
+	}
+
+	if !billAmount.Equal(paymentAmount) {
+		return ErrLoanNotExactPaymentAmount
+	}
+
+	return nil
+}
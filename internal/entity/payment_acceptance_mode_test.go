@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestPaymentAcceptanceMode_IsValid(t *testing.T) {
+	tests := []struct {
+		name string
+		mode PaymentAcceptanceMode
+		want bool
+	}{
+		{
+			name: "exact",
+			mode: PaymentAcceptanceModeExact,
+			want: true,
+		},
+		{
+			name: "partial",
+			mode: PaymentAcceptanceModePartial,
+			want: true,
+		},
+		{
+			name: "unknown mode",
+			mode: PaymentAcceptanceMode(-1),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.mode.IsValid(); got != tt.want {
+				t.Fatalf("expecting %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPaymentAcceptanceMode_accepts(t *testing.T) {
+	tests := []struct {
+		name          string
+		mode          PaymentAcceptanceMode
+		billAmount    decimal.Decimal
+		paymentAmount decimal.Decimal
+		wantErr       error
+	}{
+		{
+			name:          "exact with matching amount",
+			mode:          PaymentAcceptanceModeExact,
+			billAmount:    decimal.NewFromInt(100),
+			paymentAmount: decimal.NewFromInt(100),
+			wantErr:       nil,
+		},
+		{
+			name:          "exact with lower amount",
+			mode:          PaymentAcceptanceModeExact,
+			billAmount:    decimal.NewFromInt(100),
+			paymentAmount: decimal.NewFromInt(40),
+			wantErr:       ErrLoanNotExactPaymentAmount,
+		},
+		{
+			name:          "partial with lower amount",
+			mode:          PaymentAcceptanceModePartial,
+			billAmount:    decimal.NewFromInt(100),
+			paymentAmount: decimal.NewFromInt(40),
+			wantErr:       nil,
+		},
+		{
+			name:          "partial with matching amount",
+			mode:          PaymentAcceptanceModePartial,
+			billAmount:    decimal.NewFromInt(100),
+			paymentAmount: decimal.NewFromInt(100),
+			wantErr:       nil,
+		},
+		{
+			name:          "partial with higher amount",
+			mode:          PaymentAcceptanceModePartial,
+			billAmount:    decimal.NewFromInt(100),
+			paymentAmount: decimal.NewFromInt(140),
+			wantErr:       ErrLoanPaymentExceedsBillAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.mode.accepts(tt.billAmount, tt.paymentAmount); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expecting error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"maps"
+	"time"
+)
+
+const (
+	maxExternalReferenceLength = 255 // Maximum length of the external reference of a payment
+	maxPaymentMetadataEntries  = 20  // Maximum number of metadata entries of a payment
+)
+
+// PaymentChannel represents the channel a payment was received through.
+type PaymentChannel int
+
+const (
+	// PaymentChannelUnspecified is used for the payments whose channel is not known.
+	PaymentChannelUnspecified PaymentChannel = iota
+
+	// PaymentChannelBankTransfer is a payment received by a bank transfer.
+	PaymentChannelBankTransfer
+
+	// PaymentChannelVirtualAccount is a payment received through a virtual account.
+	PaymentChannelVirtualAccount
+
+	// PaymentChannelCard is a payment received by a debit or credit card.
+	PaymentChannelCard
+
+	// PaymentChannelCashAgent is a payment received in cash by an agent.
+	PaymentChannelCashAgent
+)
+
+// IsValid checks if the PaymentChannel is a valid payment channel.
+//
+// Returns:
+//   - bool: true if the channel is one of the predefined payment channels, false otherwise.
+func (c PaymentChannel) IsValid() bool {
+	return c >= PaymentChannelUnspecified && c <= PaymentChannelCashAgent
+}
+
+// PaymentDetails represents the details of a payment as reported by the channel it was received through,
+// used to reconcile the payment with the channel's statements.
+type PaymentDetails struct {
+	// ExternalReference is the reference of the payment in the channel, such as a bank transaction ID.
+	// It is unique per channel, and empty if the channel has no reference for the payment.
+	ExternalReference string
+
+	// Channel is the channel the payment was received through.
+	Channel PaymentChannel
+
+	// PaidAt is the value date of the payment in the channel. The time the payment is recorded at is used when it is zero.
+	PaidAt time.Time
+
+	// Metadata is free-form information about the payment.
+	Metadata map[string]string
+}
+
+// sameAs checks if the details are the same as the details of another request.
+//
+// Parameters:
+//   - other: The details to compare to.
+//
+// Returns:
+//   - bool: true if the reference, channel and metadata are equal, and the value dates are equal
+//     when the other details have one, false otherwise.
+func (d PaymentDetails) sameAs(other PaymentDetails) bool {
+	if d.ExternalReference != other.ExternalReference || d.Channel != other.Channel {
+		return false
+	}
+
+	if !other.PaidAt.IsZero() && !d.PaidAt.Equal(other.PaidAt) {
+		return false
+	}
+
+	return maps.Equal(d.Metadata, other.Metadata)
+}
@@ -0,0 +1,225 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/axopadyani/billing-engine/internal/common/businesserror"
+)
+
+const (
+	maxPaymentHolidayPeriods      = 52  // Maximum number of billing periods a payment holiday can skip
+	maxPaymentHolidayReasonLength = 500 // Maximum length of the reason a payment holiday is granted for
+)
+
+var (
+	ErrPaymentHolidayInvalidPeriods = businesserror.New("payment holiday must skip between 1 and 52 billing periods", businesserror.KindBadRequest)
+	ErrPaymentHolidayInvalidReason  = businesserror.New("payment holiday reason must be between 1 and 500 characters", businesserror.KindBadRequest)
+	ErrLoanNotDeferrable            = businesserror.New("loan can only be granted a payment holiday while it is being repaid", businesserror.KindUnprocessableEntity)
+	ErrLoanOnPaymentHoliday         = businesserror.New("loan already has a payment holiday that has not ended", businesserror.KindUnprocessableEntity)
+)
+
+// PaymentHoliday represents a number of billing periods a loan is not billed for, such as a relief after a natural
+// disaster. The installments not due yet when the payment holiday is granted are deferred by its billing periods,
+// no installment becomes due and the days past due of the loan do not grow during the payment holiday, and the
+// maturity date of the loan is pushed back.
+type PaymentHoliday struct {
+	// ID is the unique identifier for the payment holiday.
+	ID uuid.UUID
+
+	// LoanID is the unique identifier of the loan the payment holiday is granted on.
+	LoanID uuid.UUID
+
+	// FirstInstallmentNumber is the number of the first installment deferred by the payment holiday.
+	FirstInstallmentNumber int32
+
+	// StartPeriod is the 1-based number of the first billing period skipped by the payment holiday,
+	// counted from the loan's first billing period including the periods skipped by its earlier payment holidays.
+	StartPeriod int32
+
+	// Periods is the number of consecutive billing periods skipped by the payment holiday, weeks for a weekly loan.
+	Periods int32
+
+	// StartsAt is the timestamp when the payment holiday starts, which is the end of the billing period
+	// it was granted in.
+	StartsAt time.Time
+
+	// EndsAt is the timestamp when the payment holiday ends, and the deferred installments start becoming due again.
+	EndsAt time.Time
+
+	// Reason is the explanation of why the payment holiday was granted.
+	Reason string
+
+	// CreatedAt is the timestamp when the payment holiday was granted.
+	CreatedAt time.Time
+}
+
+// ValidatePaymentHoliday checks that a payment holiday with the given billing periods and reason can be granted.
+//
+// Parameters:
+//   - periods: The number of billing periods skipped by the payment holiday.
+//   - reason: The explanation of why the payment holiday is granted.
+//
+// Returns:
+//   - error: ErrPaymentHolidayInvalidPeriods if the number of periods is out of range, ErrPaymentHolidayInvalidReason
+//     if the reason is empty or too long, nil otherwise.
+func ValidatePaymentHoliday(periods int32, reason string) error {
+	if periods < 1 || periods > maxPaymentHolidayPeriods {
+		return ErrPaymentHolidayInvalidPeriods
+	}
+	if reason == "" || len(reason) > maxPaymentHolidayReasonLength {
+		return ErrPaymentHolidayInvalidReason
+	}
+
+	return nil
+}
+
+// GrantPaymentHoliday grants a payment holiday on the loan, skipping the given number of billing periods
+// from the next billing period.
+//
+// The installments not due yet are deferred by the skipped periods, and their due dates are updated.
+// The installments already due are not affected, and the amount still owed on them stays due, but their days
+// past due and late fees are frozen during the payment holiday.
+//
+// Parameters:
+//   - now: The time the payment holiday is granted at.
+//   - periods: The number of billing periods skipped by the payment holiday.
+//   - reason: The explanation of why the payment holiday is granted.
+//
+// Returns:
+//   - *PaymentHoliday: The newly granted payment holiday, which is appended to the payment holidays of the loan.
+//   - error: An error if the payment holiday cannot be granted. Possible errors include: ErrLoanNotFound,
+//     ErrLoanNotDeferrable, ErrPaymentHolidayInvalidPeriods, ErrPaymentHolidayInvalidReason and ErrLoanOnPaymentHoliday.
+func (l *Loan) GrantPaymentHoliday(now time.Time, periods int32, reason string) (*PaymentHoliday, error) {
+	if l == nil {
+		return nil, ErrLoanNotFound
+	}
+	if !l.Status.isRepaying() {
+		return nil, ErrLoanNotDeferrable
+	}
+	if err := ValidatePaymentHoliday(periods, reason); err != nil {
+		return nil, err
+	}
+
+	now = now.UTC()
+	for _, holiday := range l.PaymentHolidays {
+		if now.Before(holiday.EndsAt) {
+			return nil, ErrLoanOnPaymentHoliday
+		}
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	beginningOfPeriod := l.beginningOfBillingPeriod()
+	startPeriod := l.elapsedPeriods(now) + 1
+	holiday := &PaymentHoliday{
+		ID:                     id,
+		LoanID:                 l.ID,
+		FirstInstallmentNumber: l.currentPeriod(now) + 1,
+		StartPeriod:            startPeriod,
+		Periods:                periods,
+		StartsAt:               l.BillingFrequency.dueDate(beginningOfPeriod, startPeriod),
+		EndsAt:                 l.BillingFrequency.dueDate(beginningOfPeriod, startPeriod+periods),
+		Reason:                 reason,
+		CreatedAt:              now,
+	}
+	l.PaymentHolidays = append(l.PaymentHolidays, holiday)
+
+	for _, installment := range l.Installments {
+		if installment.Number < holiday.FirstInstallmentNumber {
+			continue
+		}
+
+		installment.DueDate = l.BillingFrequency.dueDate(beginningOfPeriod, installment.Number+l.deferredPeriods(installment.Number))
+		installment.UpdatedAt = now
+	}
+	l.UpdatedAt = now
+
+	return holiday, nil
+}
+
+// MaturityDate determines the time the last installment of the loan becomes due,
+// which is pushed back by the payment holidays granted on the loan.
+//
+// Returns:
+//   - time.Time: The due date of the last installment of the loan, or the zero time if the loan is nil
+//     or has no installment.
+func (l *Loan) MaturityDate() time.Time {
+	if l == nil {
+		return time.Time{}
+	}
+
+	schedule := l.schedule()
+	if len(schedule) == 0 {
+		return time.Time{}
+	}
+
+	return schedule[len(schedule)-1].DueDate
+}
+
+// skippedPeriods calculates the number of billing periods skipped by the payment holidays of the loan
+// when the given number of billing periods have passed.
+//
+// Parameters:
+//   - elapsedPeriods: The number of billing periods that have passed, including the skipped periods.
+//
+// Returns:
+//   - int32: The number of the passed billing periods that are skipped by the payment holidays.
+func (l *Loan) skippedPeriods(elapsedPeriods int32) int32 {
+	skippedPeriods := int32(0)
+	for _, holiday := range l.PaymentHolidays {
+		skippedPeriods += max(0, min(holiday.Periods, elapsedPeriods-holiday.StartPeriod+1))
+	}
+
+	return skippedPeriods
+}
+
+// deferredPeriods calculates the number of billing periods the installment with the given number
+// is deferred by the payment holidays of the loan.
+//
+// Parameters:
+//   - number: The 1-based number of the installment.
+//
+// Returns:
+//   - int32: The total billing periods of the payment holidays granted before the installment became due.
+func (l *Loan) deferredPeriods(number int32) int32 {
+	deferredPeriods := int32(0)
+	for _, holiday := range l.PaymentHolidays {
+		if holiday.FirstInstallmentNumber <= number {
+			deferredPeriods += holiday.Periods
+		}
+	}
+
+	return deferredPeriods
+}
+
+// holidayDaysBetween calculates the number of calendar days within the payment holidays of the loan
+// between the given times.
+//
+// Parameters:
+//   - from: The time to count from.
+//   - to: The time to count to.
+//
+// Returns:
+//   - int32: The number of days between the two times that are within a payment holiday, never negative.
+func (l *Loan) holidayDaysBetween(from, to time.Time) int32 {
+	days := int32(0)
+	for _, holiday := range l.PaymentHolidays {
+		start, end := holiday.StartsAt, holiday.EndsAt
+		if from.After(start) {
+			start = from
+		}
+		if to.Before(end) {
+			end = to
+		}
+		if start.Before(end) {
+			days += l.billingCalendar().daysBetween(start, end)
+		}
+	}
+
+	return days
+}
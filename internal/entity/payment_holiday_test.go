@@ -0,0 +1,217 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestValidatePaymentHoliday(t *testing.T) {
+	tests := []struct {
+		name    string
+		periods int32
+		reason  string
+		wantErr error
+	}{
+		{name: "valid payment holiday", periods: 2, reason: "flood relief", wantErr: nil},
+		{name: "no periods", periods: 0, reason: "flood relief", wantErr: ErrPaymentHolidayInvalidPeriods},
+		{name: "maximum periods", periods: maxPaymentHolidayPeriods, reason: "flood relief", wantErr: nil},
+		{name: "too many periods", periods: maxPaymentHolidayPeriods + 1, reason: "flood relief", wantErr: ErrPaymentHolidayInvalidPeriods},
+		{name: "empty reason", periods: 2, reason: "", wantErr: ErrPaymentHolidayInvalidReason},
+		{name: "too long reason", periods: 2, reason: strings.Repeat("a", maxPaymentHolidayReasonLength+1), wantErr: ErrPaymentHolidayInvalidReason},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidatePaymentHoliday(tt.periods, tt.reason); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestLoan_GrantPaymentHoliday(t *testing.T) {
+	createdAt := time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC) // Monday, installments due from March 11
+	now := time.Date(2024, time.March, 13, 10, 0, 0, 0, time.UTC)      // installment 1 is due
+
+	// newLoan creates a disbursed loan of 1000 at 10% flat interest repaid in 4 weekly installments of 275,
+	// charged a late fee of 10 for every missed installment.
+	newLoan := func(status LoanStatus) *Loan {
+		loan := &Loan{
+			ID:               uuid.New(),
+			Amount:           decimal.NewFromInt(1000),
+			InterestModel:    InterestModelFlat,
+			InterestRate:     decimal.NewFromFloat(0.1),
+			InstallmentCount: 4,
+			PaymentAmount:    decimal.NewFromInt(1100),
+			LateFeePolicy:    LateFeePolicy{Method: LateFeeMethodFixedPerMissedInstallment, Amount: decimal.NewFromInt(10)},
+			Status:           status,
+			DisbursedAt:      createdAt,
+			CreatedAt:        createdAt,
+			UpdatedAt:        createdAt,
+		}
+		loan.Installments = loan.generateSchedule()
+
+		return loan
+	}
+
+	onHoliday := newLoan(LoanStatusOngoing)
+	if _, err := onHoliday.GrantPaymentHoliday(createdAt, 2, "flood relief"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		loan    *Loan
+		periods int32
+		reason  string
+		wantErr error
+	}{
+		{
+			name:    "nil loan",
+			loan:    nil,
+			periods: 2,
+			reason:  "flood relief",
+			wantErr: ErrLoanNotFound,
+		},
+		{
+			name:    "loan pending approval",
+			loan:    newLoan(LoanStatusPendingApproval),
+			periods: 2,
+			reason:  "flood relief",
+			wantErr: ErrLoanNotDeferrable,
+		},
+		{
+			name:    "paid loan",
+			loan:    newLoan(LoanStatusPaid),
+			periods: 2,
+			reason:  "flood relief",
+			wantErr: ErrLoanNotDeferrable,
+		},
+		{
+			name:    "no periods",
+			loan:    newLoan(LoanStatusOngoing),
+			periods: 0,
+			reason:  "flood relief",
+			wantErr: ErrPaymentHolidayInvalidPeriods,
+		},
+		{
+			name:    "empty reason",
+			loan:    newLoan(LoanStatusOngoing),
+			periods: 2,
+			reason:  "",
+			wantErr: ErrPaymentHolidayInvalidReason,
+		},
+		{
+			name:    "payment holiday not ended",
+			loan:    onHoliday,
+			periods: 2,
+			reason:  "flood relief",
+			wantErr: ErrLoanOnPaymentHoliday,
+		},
+		{
+			name:    "ongoing loan",
+			loan:    newLoan(LoanStatusOngoing),
+			periods: 2,
+			reason:  "flood relief",
+			wantErr: nil,
+		},
+		{
+			name:    "defaulted loan",
+			loan:    newLoan(LoanStatusDefaulted),
+			periods: 2,
+			reason:  "flood relief",
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			holiday, err := tt.loan.GrantPaymentHoliday(now, tt.periods, tt.reason)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			loan := tt.loan
+			wantStartsAt := time.Date(2024, time.March, 18, 0, 0, 0, 0, time.UTC)
+			wantEndsAt := time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+			if holiday.LoanID != loan.ID || holiday.FirstInstallmentNumber != 2 || holiday.StartPeriod != 2 || holiday.Periods != 2 {
+				t.Errorf("expecting 2 periods skipped from period 2 deferring installments from 2, got %+v", holiday)
+			}
+			if !holiday.StartsAt.Equal(wantStartsAt) || !holiday.EndsAt.Equal(wantEndsAt) || holiday.Reason != tt.reason || !holiday.CreatedAt.Equal(now) {
+				t.Errorf("expecting payment holiday from %v to %v, got %+v", wantStartsAt, wantEndsAt, holiday)
+			}
+			if len(loan.PaymentHolidays) != 1 || loan.PaymentHolidays[0] != holiday || !loan.UpdatedAt.Equal(now) {
+				t.Errorf("expecting the payment holiday to be recorded on the loan, got %d payment holidays", len(loan.PaymentHolidays))
+			}
+
+			// the installment already due is kept, the others are deferred by two weeks
+			wantDueDates := []time.Time{
+				time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, time.April, 8, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, time.April, 15, 0, 0, 0, 0, time.UTC),
+			}
+			for i, installment := range loan.Installments {
+				if !installment.DueDate.Equal(wantDueDates[i]) {
+					t.Errorf("expecting installment %d due on %v, got %v", installment.Number, wantDueDates[i], installment.DueDate)
+				}
+			}
+			if got := loan.MaturityDate(); !got.Equal(wantDueDates[3]) {
+				t.Errorf("expecting maturity date %v, got %v", wantDueDates[3], got)
+			}
+
+			// the bill does not grow during the payment holiday
+			billTests := []struct {
+				at       time.Time
+				wantBill decimal.Decimal
+				wantDPD  int32
+			}{
+				{at: now, wantBill: decimal.NewFromInt(275), wantDPD: 2},
+				{at: time.Date(2024, time.March, 25, 10, 0, 0, 0, time.UTC), wantBill: decimal.NewFromInt(275), wantDPD: 7},
+				{at: time.Date(2024, time.March, 31, 10, 0, 0, 0, time.UTC), wantBill: decimal.NewFromInt(275), wantDPD: 7},
+				{at: time.Date(2024, time.April, 3, 10, 0, 0, 0, time.UTC), wantBill: decimal.NewFromInt(550), wantDPD: 9},
+				{at: time.Date(2024, time.April, 17, 10, 0, 0, 0, time.UTC), wantBill: decimal.NewFromInt(1100), wantDPD: 23},
+			}
+			for _, bt := range billTests {
+				if got := loan.CurrentBillAmount(bt.at, decimal.Zero); !got.Equal(bt.wantBill) {
+					t.Errorf("expecting bill of %s at %v, got %s", bt.wantBill, bt.at, got)
+				}
+				if got := loan.DaysPastDue(bt.at); got != bt.wantDPD {
+					t.Errorf("expecting %d days past due at %v, got %d", bt.wantDPD, bt.at, got)
+				}
+			}
+
+			// the installment missed before the payment holiday is charged a late fee, and no other installment is missed
+			// until the deferred installments become due again
+			if charges, err := loan.AccrueLateFees(time.Date(2024, time.March, 31, 10, 0, 0, 0, time.UTC)); err != nil || len(charges) != 1 || charges[0].InstallmentNumber != 1 {
+				t.Errorf("expecting a late fee for installment 1 during the payment holiday, got %d charges and error %v", len(charges), err)
+			}
+			if charges, err := loan.AccrueLateFees(time.Date(2024, time.April, 1, 10, 0, 0, 0, time.UTC)); err != nil || len(charges) != 1 || charges[0].InstallmentNumber != 2 {
+				t.Errorf("expecting a late fee for installment 2 after the payment holiday, got %d charges and error %v", len(charges), err)
+			}
+
+			// another payment holiday can be granted once the previous one has ended
+			next, err := loan.GrantPaymentHoliday(time.Date(2024, time.April, 3, 10, 0, 0, 0, time.UTC), 1, "flood relief")
+			if err != nil {
+				t.Fatalf("expecting no error for a payment holiday after the previous one, got %v", err)
+			}
+			if next.StartPeriod != 5 || next.FirstInstallmentNumber != 3 {
+				t.Errorf("expecting payment holiday skipping period 5 and deferring installments from 3, got %+v", next)
+			}
+			if got, want := loan.MaturityDate(), time.Date(2024, time.April, 22, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+				t.Errorf("expecting maturity date %v, got %v", want, got)
+			}
+			if got := loan.generateSchedule()[3].DueDate; !got.Equal(loan.Installments[3].DueDate) {
+				t.Errorf("expecting the generated schedule to match the deferred installments, got %v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+const maxRoundingPrecision = 8 // Maximum number of decimal places of a currency minor unit
+
+// RoundingMethod represents how an amount is split into installments that cannot be divided evenly.
+type RoundingMethod int
+
+const (
+	// RoundingMethodRemainderOnLast rounds every installment down to the minor unit
+	// and adds the remainder to the last installment.
+	RoundingMethodRemainderOnLast RoundingMethod = iota
+
+	// RoundingMethodRemainderOnFirst rounds every installment down to the minor unit
+	// and adds the remainder to the first installment.
+	RoundingMethodRemainderOnFirst
+
+	// RoundingMethodBankers rounds every installment half to even to the minor unit
+	// and settles the difference on the last installment.
+	RoundingMethodBankers
+)
+
+// IsValid checks if the RoundingMethod is a valid rounding method.
+//
+// Returns:
+//   - bool: true if the method is one of the predefined rounding methods, false otherwise.
+func (m RoundingMethod) IsValid() bool {
+	return m == RoundingMethodRemainderOnLast || m == RoundingMethodRemainderOnFirst || m == RoundingMethodBankers
+}
+
+// RoundingPolicy represents how installment amounts are rounded.
+type RoundingPolicy struct {
+	// Method is the method used to round the installment amounts.
+	Method RoundingMethod
+
+	// Precision is the number of decimal places of the currency minor unit the amounts are rounded to.
+	Precision int32
+}
+
+// IsValid checks if the RoundingPolicy has a valid method and precision.
+//
+// Returns:
+//   - bool: true if the method is valid and the precision is between 0 and maxRoundingPrecision, false otherwise.
+func (p RoundingPolicy) IsValid() bool {
+	return p.Method.IsValid() && p.Precision >= 0 && p.Precision <= maxRoundingPrecision
+}
+
+// round rounds the amount to the minor unit according to the rounding method.
+//
+// Parameters:
+//   - amount: The amount to be rounded.
+//
+// Returns:
+//   - decimal.Decimal: The rounded amount.
+func (p RoundingPolicy) round(amount decimal.Decimal) decimal.Decimal {
+	if p.Method == RoundingMethodBankers {
+		return amount.RoundBank(p.Precision)
+	}
+
+	return amount.RoundDown(p.Precision)
+}
+
+// split divides the total amount into the given number of installment amounts.
+//
+// Every installment gets the total divided by the number of installments, rounded according to
+// the policy, and the installment picked by the rounding method absorbs the difference, so the
+// amounts always add up to the total.
+//
+// Parameters:
+//   - total: The amount to be divided.
+//   - count: The number of installments.
+//
+// Returns:
+//   - []decimal.Decimal: The installment amounts, or nil if count is not positive.
+func (p RoundingPolicy) split(total decimal.Decimal, count int32) []decimal.Decimal {
+	if count <= 0 {
+		return nil
+	}
+
+	base := p.round(total.Div(decimal.NewFromInt32(count)))
+	remainderAmount := total.Sub(base.Mul(decimal.NewFromInt32(count - 1)))
+
+	amounts := make([]decimal.Decimal, count)
+	for i := range amounts {
+		amounts[i] = base
+	}
+
+	if p.Method == RoundingMethodRemainderOnFirst {
+		amounts[0] = remainderAmount
+	} else {
+		amounts[count-1] = remainderAmount
+	}
+
+	return amounts
+}
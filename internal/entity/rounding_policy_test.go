@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shopspring/decimal"
+)
+
+func TestRoundingPolicy_IsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy RoundingPolicy
+		want   bool
+	}{
+		{
+			name:   "remainder on last",
+			policy: RoundingPolicy{Method: RoundingMethodRemainderOnLast},
+			want:   true,
+		},
+		{
+			name:   "remainder on first",
+			policy: RoundingPolicy{Method: RoundingMethodRemainderOnFirst},
+			want:   true,
+		},
+		{
+			name:   "banker's rounding with minor unit",
+			policy: RoundingPolicy{Method: RoundingMethodBankers, Precision: 2},
+			want:   true,
+		},
+		{
+			name:   "unknown method",
+			policy: RoundingPolicy{Method: RoundingMethod(-1)},
+			want:   false,
+		},
+		{
+			name:   "negative precision",
+			policy: RoundingPolicy{Method: RoundingMethodRemainderOnLast, Precision: -1},
+			want:   false,
+		},
+		{
+			name:   "precision too large",
+			policy: RoundingPolicy{Method: RoundingMethodRemainderOnLast, Precision: maxRoundingPrecision + 1},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.IsValid(); got != tt.want {
+				t.Fatalf("expecting %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRoundingPolicy_split(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy RoundingPolicy
+		total  decimal.Decimal
+		count  int32
+		want   []decimal.Decimal
+	}{
+		{
+			name:   "zero count",
+			policy: RoundingPolicy{Method: RoundingMethodRemainderOnLast},
+			total:  decimal.NewFromInt(1_000),
+			count:  0,
+			want:   nil,
+		},
+		{
+			name:   "even division",
+			policy: RoundingPolicy{Method: RoundingMethodRemainderOnLast},
+			total:  decimal.NewFromInt(1_000),
+			count:  4,
+			want: []decimal.Decimal{
+				decimal.NewFromInt(250), decimal.NewFromInt(250), decimal.NewFromInt(250), decimal.NewFromInt(250),
+			},
+		},
+		{
+			name:   "remainder on last",
+			policy: RoundingPolicy{Method: RoundingMethodRemainderOnLast},
+			total:  decimal.NewFromInt(5_500_000),
+			count:  7,
+			want: []decimal.Decimal{
+				decimal.NewFromInt(785_714), decimal.NewFromInt(785_714), decimal.NewFromInt(785_714),
+				decimal.NewFromInt(785_714), decimal.NewFromInt(785_714), decimal.NewFromInt(785_714),
+				decimal.NewFromInt(785_716),
+			},
+		},
+		{
+			name:   "remainder on first",
+			policy: RoundingPolicy{Method: RoundingMethodRemainderOnFirst},
+			total:  decimal.NewFromInt(5_500_000),
+			count:  7,
+			want: []decimal.Decimal{
+				decimal.NewFromInt(785_716), decimal.NewFromInt(785_714), decimal.NewFromInt(785_714),
+				decimal.NewFromInt(785_714), decimal.NewFromInt(785_714), decimal.NewFromInt(785_714),
+				decimal.NewFromInt(785_714),
+			},
+		},
+		{
+			name:   "banker's rounding to cents",
+			policy: RoundingPolicy{Method: RoundingMethodBankers, Precision: 2},
+			total:  decimal.NewFromInt(100),
+			count:  3,
+			want: []decimal.Decimal{
+				decimal.RequireFromString("33.33"), decimal.RequireFromString("33.33"), decimal.RequireFromString("33.34"),
+			},
+		},
+		{
+			name:   "banker's rounding rounds half to even",
+			policy: RoundingPolicy{Method: RoundingMethodBankers},
+			total:  decimal.NewFromInt(10),
+			count:  4,
+			want: []decimal.Decimal{
+				decimal.NewFromInt(2), decimal.NewFromInt(2), decimal.NewFromInt(2), decimal.NewFromInt(4),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.policy.split(tt.total, tt.count)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("split mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -39,6 +39,8 @@ func toGrpcError(err error) error {
 			code = codes.NotFound
 		case businesserror.KindAlreadyExists:
 			code = codes.AlreadyExists
+		case businesserror.KindConflict:
+			code = codes.Aborted
 		}
 	}
 
@@ -46,6 +46,11 @@ func TestToGrpcError(t *testing.T) {
 			inputErr: businesserror.New("already exists", businesserror.KindAlreadyExists),
 			wantErr:  status.New(codes.AlreadyExists, "already exists"),
 		},
+		{
+			name:     "conflict error",
+			inputErr: businesserror.New("conflict", businesserror.KindConflict),
+			wantErr:  status.New(codes.Aborted, "conflict"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,6 +1,8 @@
 package grpc
 
 import (
+	"errors"
+
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/axopadyani/billing-engine/internal/service"
@@ -15,15 +17,57 @@ import (
 // Returns:
 //   - *v1.Loan: A pointer to a v1.Loan struct with the converted loan data.
 func parseLoan(loan service.Loan) *v1.Loan {
+	var approvedAt, disbursedAt, defaultedAt, writtenOffAt *timestamppb.Timestamp
+	if !loan.ApprovedAt.IsZero() {
+		approvedAt = timestamppb.New(loan.ApprovedAt)
+	}
+	if !loan.DisbursedAt.IsZero() {
+		disbursedAt = timestamppb.New(loan.DisbursedAt)
+	}
+	if !loan.DefaultedAt.IsZero() {
+		defaultedAt = timestamppb.New(loan.DefaultedAt)
+	}
+	if !loan.WrittenOffAt.IsZero() {
+		writtenOffAt = timestamppb.New(loan.WrittenOffAt)
+	}
+
 	return &v1.Loan{
-		Id:                   loan.ID.String(),
-		UserId:               loan.UserID.String(),
-		Amount:               loan.Amount.String(),
-		PaymentDurationWeeks: loan.PaymentDurationWeeks,
-		PaymentAmount:        loan.PaymentAmount.String(),
-		Status:               parseLoanStatus(loan.Status),
-		CreatedAt:            timestamppb.New(loan.CreatedAt),
-		UpdatedAt:            timestamppb.New(loan.UpdatedAt),
+		Id:                          loan.ID.String(),
+		UserId:                      loan.UserID.String(),
+		ProductId:                   loan.ProductID.String(),
+		Amount:                      loan.Amount.String(),
+		PaymentDurationWeeks:        loan.PaymentDurationWeeks,
+		BillingFrequency:            parseBillingFrequency(loan.BillingFrequency),
+		InstallmentCount:            loan.InstallmentCount,
+		InterestModel:               parseInterestModel(loan.InterestModel),
+		InterestRate:                loan.InterestRate.String(),
+		PaymentAmount:               loan.PaymentAmount.String(),
+		OriginationFee:              loan.OriginationFee.String(),
+		LateFeePolicy:               parseLateFeePolicy(loan.LateFeePolicy),
+		EarlySettlementRebateRate:   loan.EarlySettlementRebateRate.String(),
+		RebateAmount:                loan.RebateAmount.String(),
+		GracePeriodDays:             loan.GracePeriodDays,
+		CoolingOffDays:              loan.CoolingOffDays,
+		DefaultAfterWeeks:           loan.DefaultAfterWeeks,
+		BillingCalendar:             parseBillingCalendar(loan.BillingCalendar),
+		DelinquencyRule:             parseDelinquencyRule(loan.DelinquencyRule),
+		RoundingPolicy:              parseRoundingPolicy(loan.RoundingPolicy),
+		PaymentAcceptanceMode:       parsePaymentAcceptanceMode(loan.PaymentAcceptanceMode),
+		OverpaymentPolicy:           parseOverpaymentPolicy(loan.OverpaymentPolicy),
+		Status:                      parseLoanStatus(loan.Status),
+		CreatedAt:                   timestamppb.New(loan.CreatedAt),
+		UpdatedAt:                   timestamppb.New(loan.UpdatedAt),
+		ApprovedAt:                  approvedAt,
+		DisbursedAt:                 disbursedAt,
+		DefaultedAt:                 defaultedAt,
+		WrittenOffAt:                writtenOffAt,
+		WrittenOffPrincipal:         loan.WrittenOffPrincipal.String(),
+		WrittenOffInterest:          loan.WrittenOffInterest.String(),
+		WrittenOffCharges:           loan.WrittenOffCharges.String(),
+		RecoveredAmount:             loan.RecoveredAmount.String(),
+		TermsVersion:                loan.TermsVersion,
+		RestructuredPrincipal:       loan.RestructuredPrincipal.String(),
+		RestructuredFromInstallment: loan.RestructuredFrom,
 	}
 }
 
@@ -41,11 +85,179 @@ func parseLoanStatus(status service.LoanStatus) v1.LoanStatus {
 		res = v1.LoanStatus_ONGOING
 	case service.LoanStatusPaid:
 		res = v1.LoanStatus_PAID
+	case service.LoanStatusPendingApproval:
+		res = v1.LoanStatus_PENDING_APPROVAL
+	case service.LoanStatusApproved:
+		res = v1.LoanStatus_APPROVED
+	case service.LoanStatusDisbursed:
+		res = v1.LoanStatus_DISBURSED
+	case service.LoanStatusRejected:
+		res = v1.LoanStatus_REJECTED
+	case service.LoanStatusCancelled:
+		res = v1.LoanStatus_CANCELLED
+	case service.LoanStatusDefaulted:
+		res = v1.LoanStatus_DEFAULTED
+	case service.LoanStatusWrittenOff:
+		res = v1.LoanStatus_WRITTEN_OFF
 	}
 
 	return res
 }
 
+// parseInterestModel converts a service.InterestModel to a v1.InterestModel protobuf enum.
+//
+// Parameters:
+//   - model: A service.InterestModel representing the internal interest model.
+//
+// Returns:
+//   - v1.InterestModel: The corresponding v1.InterestModel enum value.
+func parseInterestModel(model service.InterestModel) v1.InterestModel {
+	var res v1.InterestModel
+	switch model {
+	case service.InterestModelFlat:
+		res = v1.InterestModel_FLAT
+	case service.InterestModelDecliningBalance:
+		res = v1.InterestModel_DECLINING_BALANCE
+	case service.InterestModelZeroInterest:
+		res = v1.InterestModel_ZERO_INTEREST
+	}
+
+	return res
+}
+
+// parseRoundingPolicy converts a service.RoundingPolicy to a v1.RoundingPolicy protobuf message.
+//
+// Parameters:
+//   - policy: A service.RoundingPolicy representing the internal rounding policy.
+//
+// Returns:
+//   - *v1.RoundingPolicy: A pointer to a v1.RoundingPolicy struct with the converted rounding policy data.
+func parseRoundingPolicy(policy service.RoundingPolicy) *v1.RoundingPolicy {
+	var method v1.RoundingMethod
+	switch policy.Method {
+	case service.RoundingMethodRemainderOnLast:
+		method = v1.RoundingMethod_REMAINDER_ON_LAST
+	case service.RoundingMethodRemainderOnFirst:
+		method = v1.RoundingMethod_REMAINDER_ON_FIRST
+	case service.RoundingMethodBankers:
+		method = v1.RoundingMethod_BANKERS
+	}
+
+	return &v1.RoundingPolicy{
+		Method:    method,
+		Precision: policy.Precision,
+	}
+}
+
+// parsePaymentAcceptanceMode converts a service.PaymentAcceptanceMode to a v1.PaymentAcceptanceMode protobuf enum.
+//
+// Parameters:
+//   - mode: A service.PaymentAcceptanceMode representing the internal payment acceptance mode.
+//
+// Returns:
+//   - v1.PaymentAcceptanceMode: The corresponding v1.PaymentAcceptanceMode enum value.
+func parsePaymentAcceptanceMode(mode service.PaymentAcceptanceMode) v1.PaymentAcceptanceMode {
+	var res v1.PaymentAcceptanceMode
+	switch mode {
+	case service.PaymentAcceptanceModeExact:
+		res = v1.PaymentAcceptanceMode_EXACT
+	case service.PaymentAcceptanceModePartial:
+		res = v1.PaymentAcceptanceMode_PARTIAL
+	}
+
+	return res
+}
+
+// parseOverpaymentPolicy converts a service.OverpaymentPolicy to a v1.OverpaymentPolicy protobuf enum.
+//
+// Parameters:
+//   - policy: A service.OverpaymentPolicy representing the internal overpayment policy.
+//
+// Returns:
+//   - v1.OverpaymentPolicy: The corresponding v1.OverpaymentPolicy enum value.
+func parseOverpaymentPolicy(policy service.OverpaymentPolicy) v1.OverpaymentPolicy {
+	var res v1.OverpaymentPolicy
+	switch policy {
+	case service.OverpaymentPolicyReject:
+		res = v1.OverpaymentPolicy_OVERPAYMENT_REJECT
+	case service.OverpaymentPolicyCredit:
+		res = v1.OverpaymentPolicy_OVERPAYMENT_CREDIT
+	case service.OverpaymentPolicyRefund:
+		res = v1.OverpaymentPolicy_OVERPAYMENT_REFUND
+	}
+
+	return res
+}
+
+// parseBillingFrequency converts a service.BillingFrequency to a v1.BillingFrequency protobuf enum.
+//
+// Parameters:
+//   - frequency: A service.BillingFrequency representing the internal billing frequency.
+//
+// Returns:
+//   - v1.BillingFrequency: The corresponding v1.BillingFrequency enum value.
+func parseBillingFrequency(frequency service.BillingFrequency) v1.BillingFrequency {
+	var res v1.BillingFrequency
+	switch frequency {
+	case service.BillingFrequencyWeekly:
+		res = v1.BillingFrequency_FREQUENCY_WEEKLY
+	case service.BillingFrequencyDaily:
+		res = v1.BillingFrequency_FREQUENCY_DAILY
+	case service.BillingFrequencyBiWeekly:
+		res = v1.BillingFrequency_FREQUENCY_BI_WEEKLY
+	case service.BillingFrequencyMonthly:
+		res = v1.BillingFrequency_FREQUENCY_MONTHLY
+	}
+
+	return res
+}
+
+// parseBillingCalendar converts a service.BillingCalendar to a v1.BillingCalendar protobuf message.
+//
+// Parameters:
+//   - calendar: A pointer to a service.BillingCalendar representing the internal billing calendar.
+//
+// Returns:
+//   - *v1.BillingCalendar: A pointer to a v1.BillingCalendar struct with the converted billing calendar data,
+//     or nil if calendar is nil.
+func parseBillingCalendar(calendar *service.BillingCalendar) *v1.BillingCalendar {
+	if calendar == nil {
+		return nil
+	}
+
+	return &v1.BillingCalendar{
+		Timezone:     calendar.Timezone,
+		WeekStartDay: v1.Weekday(calendar.WeekStartDay),
+	}
+}
+
+// parseDelinquencyRule converts a service.DelinquencyRule to a v1.DelinquencyRule protobuf message.
+//
+// Parameters:
+//   - rule: A pointer to a service.DelinquencyRule representing the internal delinquency rule.
+//
+// Returns:
+//   - *v1.DelinquencyRule: A pointer to a v1.DelinquencyRule struct with the converted delinquency rule data,
+//     or nil if rule is nil.
+func parseDelinquencyRule(rule *service.DelinquencyRule) *v1.DelinquencyRule {
+	if rule == nil {
+		return nil
+	}
+
+	var partialPaymentRule v1.PartialPaymentRule
+	switch rule.PartialPaymentRule {
+	case service.PartialPaymentCountsAsMissed:
+		partialPaymentRule = v1.PartialPaymentRule_PARTIAL_PAYMENT_COUNTS_AS_MISSED
+	case service.PartialPaymentCountsAsPaid:
+		partialPaymentRule = v1.PartialPaymentRule_PARTIAL_PAYMENT_COUNTS_AS_PAID
+	}
+
+	return &v1.DelinquencyRule{
+		MissedInstallmentThreshold: rule.MissedInstallmentThreshold,
+		PartialPaymentRule:         partialPaymentRule,
+	}
+}
+
 // parseLoanDetail converts a service.LoanDetail to a v1.LoanDetail protobuf message.
 //
 // Parameters:
@@ -54,10 +266,435 @@ func parseLoanStatus(status service.LoanStatus) v1.LoanStatus {
 // Returns:
 //   - *v1.LoanDetail: A pointer to a v1.LoanDetail struct with the converted loan detail data.
 func parseLoanDetail(loanDetail service.LoanDetail) *v1.LoanDetail {
+	charges := make([]*v1.LoanCharge, 0, len(loanDetail.Charges))
+	for _, charge := range loanDetail.Charges {
+		charges = append(charges, parseLoanCharge(charge))
+	}
+
+	var dueBy, oldestUnpaidInstallmentDate, maturityDate *timestamppb.Timestamp
+	if !loanDetail.DueBy.IsZero() {
+		dueBy = timestamppb.New(loanDetail.DueBy)
+	}
+	if !loanDetail.OldestUnpaidInstallmentDate.IsZero() {
+		oldestUnpaidInstallmentDate = timestamppb.New(loanDetail.OldestUnpaidInstallmentDate)
+	}
+	if !loanDetail.MaturityDate.IsZero() {
+		maturityDate = timestamppb.New(loanDetail.MaturityDate)
+	}
+
 	return &v1.LoanDetail{
-		Loan:              parseLoan(loanDetail.Loan),
-		OutstandingAmount: loanDetail.OutstandingAmount.String(),
-		CurrentBillAmount: loanDetail.CurrentBillAmount.String(),
-		IsDelinquent:      loanDetail.IsDelinquent,
+		Loan:                        parseLoan(loanDetail.Loan),
+		OutstandingAmount:           loanDetail.OutstandingAmount.String(),
+		CurrentBillAmount:           loanDetail.CurrentBillAmount.String(),
+		IsDelinquent:                loanDetail.IsDelinquent,
+		DaysPastDue:                 loanDetail.DaysPastDue,
+		OldestUnpaidInstallmentDate: oldestUnpaidInstallmentDate,
+		DelinquencyBucket:           loanDetail.DelinquencyBucket,
+		PaidAmount:                  loanDetail.PaidAmount.String(),
+		RemainingDueAmount:          loanDetail.RemainingDueAmount.String(),
+		DueBy:                       dueBy,
+		MaturityDate:                maturityDate,
+		Charges:                     charges,
+		OutstandingChargeAmount:     loanDetail.OutstandingChargeAmount.String(),
+		CreditBalance:               loanDetail.CreditBalance.String(),
+	}
+}
+
+// parseLateFeePolicy converts a service.LateFeePolicy to a v1.LateFeePolicy protobuf message.
+//
+// Parameters:
+//   - policy: A service.LateFeePolicy representing the internal late fee policy.
+//
+// Returns:
+//   - *v1.LateFeePolicy: A pointer to a v1.LateFeePolicy struct with the converted late fee policy data.
+func parseLateFeePolicy(policy service.LateFeePolicy) *v1.LateFeePolicy {
+	var method v1.LateFeeMethod
+	switch policy.Method {
+	case service.LateFeeMethodNone:
+		method = v1.LateFeeMethod_LATE_FEE_NONE
+	case service.LateFeeMethodFixedPerMissedInstallment:
+		method = v1.LateFeeMethod_LATE_FEE_FIXED_PER_MISSED_INSTALLMENT
+	case service.LateFeeMethodPercentageOfOverdue:
+		method = v1.LateFeeMethod_LATE_FEE_PERCENTAGE_OF_OVERDUE
+	}
+
+	return &v1.LateFeePolicy{
+		Method: method,
+		Amount: policy.Amount.String(),
+		Cap:    policy.Cap.String(),
+	}
+}
+
+// parseLoanChargeType converts a service.LoanChargeType to a v1.LoanChargeType protobuf enum.
+//
+// Parameters:
+//   - chargeType: A service.LoanChargeType representing the internal charge type.
+//
+// Returns:
+//   - v1.LoanChargeType: The corresponding v1.LoanChargeType enum value.
+func parseLoanChargeType(chargeType service.LoanChargeType) v1.LoanChargeType {
+	var res v1.LoanChargeType
+	switch chargeType {
+	case service.LoanChargeTypeLateFee:
+		res = v1.LoanChargeType_CHARGE_LATE_FEE
+	}
+
+	return res
+}
+
+// parseLoanCharge converts a service.LoanCharge to a v1.LoanCharge protobuf message.
+//
+// Parameters:
+//   - charge: A service.LoanCharge struct containing the charge information.
+//
+// Returns:
+//   - *v1.LoanCharge: A pointer to a v1.LoanCharge struct with the converted charge data.
+func parseLoanCharge(charge service.LoanCharge) *v1.LoanCharge {
+	return &v1.LoanCharge{
+		Id:                charge.ID.String(),
+		ChargeType:        parseLoanChargeType(charge.Type),
+		InstallmentNumber: charge.InstallmentNumber,
+		Amount:            charge.Amount.String(),
+		AmountPaid:        charge.AmountPaid.String(),
+		CapitalizedAmount: charge.CapitalizedAmount.String(),
+		CreatedAt:         timestamppb.New(charge.CreatedAt),
+	}
+}
+
+// parseLoanProduct converts a service.LoanProduct to a v1.LoanProduct protobuf message.
+//
+// Parameters:
+//   - product: A service.LoanProduct struct containing the loan product information.
+//
+// Returns:
+//   - *v1.LoanProduct: A pointer to a v1.LoanProduct struct with the converted loan product data.
+func parseLoanProduct(product service.LoanProduct) *v1.LoanProduct {
+	// the allowed durations in weeks only make sense for the products billed every week
+	var allowedDurationWeeks []int32
+	if product.BillingFrequency == service.BillingFrequencyWeekly {
+		allowedDurationWeeks = product.AllowedInstallmentCounts
+	}
+
+	return &v1.LoanProduct{
+		Id:                       product.ID.String(),
+		Name:                     product.Name,
+		MinPrincipal:             product.MinPrincipal.String(),
+		MaxPrincipal:             product.MaxPrincipal.String(),
+		AllowedDurationWeeks:     allowedDurationWeeks,
+		BillingFrequency:         parseBillingFrequency(product.BillingFrequency),
+		AllowedInstallmentCounts: product.AllowedInstallmentCounts,
+		InterestModel:            parseInterestModel(product.InterestModel),
+		InterestRate:             product.InterestRate.String(),
+		RoundingPolicy:           parseRoundingPolicy(product.RoundingPolicy),
+		PaymentAcceptanceMode:    parsePaymentAcceptanceMode(product.PaymentAcceptanceMode),
+		OverpaymentPolicy:        parseOverpaymentPolicy(product.OverpaymentPolicy),
+		FeeSchedule: &v1.FeeSchedule{
+			OriginationFeeRate:  product.FeeSchedule.OriginationFeeRate.String(),
+			OriginationFeeFixed: product.FeeSchedule.OriginationFeeFixed.String(),
+			LateFeePolicy:       parseLateFeePolicy(product.FeeSchedule.LateFeePolicy),
+		},
+		EarlySettlementRebateRate: product.EarlySettlementRebateRate.String(),
+		GracePeriodDays:           product.GracePeriodDays,
+		CoolingOffDays:            product.CoolingOffDays,
+		DefaultAfterWeeks:         product.DefaultAfterWeeks,
+		BillingCalendar:           parseBillingCalendar(product.BillingCalendar),
+		DelinquencyRule:           parseDelinquencyRule(product.DelinquencyRule),
+		CreatedAt:                 timestamppb.New(product.CreatedAt),
+		UpdatedAt:                 timestamppb.New(product.UpdatedAt),
+	}
+}
+
+// parseLoanInstallmentStatus converts a service.LoanInstallmentStatus to a v1.LoanInstallmentStatus protobuf enum.
+//
+// Parameters:
+//   - status: A service.LoanInstallmentStatus representing the internal installment status.
+//
+// Returns:
+//   - v1.LoanInstallmentStatus: The corresponding v1.LoanInstallmentStatus enum value.
+func parseLoanInstallmentStatus(status service.LoanInstallmentStatus) v1.LoanInstallmentStatus {
+	var res v1.LoanInstallmentStatus
+	switch status {
+	case service.LoanInstallmentStatusUnpaid:
+		res = v1.LoanInstallmentStatus_INSTALLMENT_UNPAID
+	case service.LoanInstallmentStatusPaid:
+		res = v1.LoanInstallmentStatus_INSTALLMENT_PAID
+	case service.LoanInstallmentStatusPartiallyPaid:
+		res = v1.LoanInstallmentStatus_INSTALLMENT_PARTIALLY_PAID
+	case service.LoanInstallmentStatusRestructured:
+		res = v1.LoanInstallmentStatus_INSTALLMENT_RESTRUCTURED
+	}
+
+	return res
+}
+
+// parseLoanInstallment converts a service.LoanInstallment to a v1.LoanInstallment protobuf message.
+//
+// Parameters:
+//   - installment: A service.LoanInstallment struct containing the installment information.
+//
+// Returns:
+//   - *v1.LoanInstallment: A pointer to a v1.LoanInstallment struct with the converted installment data.
+func parseLoanInstallment(installment service.LoanInstallment) *v1.LoanInstallment {
+	return &v1.LoanInstallment{
+		InstallmentNumber: installment.Number,
+		DueDate:           timestamppb.New(installment.DueDate),
+		PrincipalAmount:   installment.PrincipalAmount.String(),
+		InterestAmount:    installment.InterestAmount.String(),
+		AmountDue:         installment.AmountDue.String(),
+		AmountPaid:        installment.AmountPaid.String(),
+		Status:            parseLoanInstallmentStatus(installment.Status),
+	}
+}
+
+// parseLoanTerms converts a service.LoanTerms to a v1.LoanTerms protobuf message.
+//
+// Parameters:
+//   - terms: A service.LoanTerms struct containing the version of the loan terms.
+//
+// Returns:
+//   - *v1.LoanTerms: A pointer to a v1.LoanTerms struct with the converted terms data.
+func parseLoanTerms(terms service.LoanTerms) *v1.LoanTerms {
+	return &v1.LoanTerms{
+		LoanId:                 terms.LoanID.String(),
+		Version:                terms.Version,
+		Principal:              terms.Principal.String(),
+		InterestRate:           terms.InterestRate.String(),
+		InstallmentCount:       terms.InstallmentCount,
+		PaymentAmount:          terms.PaymentAmount.String(),
+		FirstInstallmentNumber: terms.FirstInstallmentNumber,
+		CapitalizedCharges:     terms.CapitalizedCharges.String(),
+		Reason:                 terms.Reason,
+		CreatedAt:              timestamppb.New(terms.CreatedAt),
+	}
+}
+
+// parsePaymentHoliday converts a service.PaymentHoliday to a v1.PaymentHoliday protobuf message.
+//
+// Parameters:
+//   - holiday: A service.PaymentHoliday struct containing the payment holiday information.
+//
+// Returns:
+//   - *v1.PaymentHoliday: A pointer to a v1.PaymentHoliday struct with the converted payment holiday data.
+func parsePaymentHoliday(holiday service.PaymentHoliday) *v1.PaymentHoliday {
+	return &v1.PaymentHoliday{
+		Id:                     holiday.ID.String(),
+		LoanId:                 holiday.LoanID.String(),
+		FirstInstallmentNumber: holiday.FirstInstallmentNumber,
+		Periods:                holiday.Periods,
+		StartsAt:               timestamppb.New(holiday.StartsAt),
+		EndsAt:                 timestamppb.New(holiday.EndsAt),
+		Reason:                 holiday.Reason,
+		CreatedAt:              timestamppb.New(holiday.CreatedAt),
+	}
+}
+
+// toServiceLoanStatus converts a v1.LoanStatus to a service.LoanStatus.
+//
+// Parameters:
+//   - status: A v1.LoanStatus enum value.
+//
+// Returns:
+//   - service.LoanStatus: The corresponding service.LoanStatus value.
+//   - error: An error if the loan status is unknown, nil otherwise.
+func toServiceLoanStatus(status v1.LoanStatus) (service.LoanStatus, error) {
+	switch status {
+	case v1.LoanStatus_ONGOING:
+		return service.LoanStatusOngoing, nil
+	case v1.LoanStatus_PAID:
+		return service.LoanStatusPaid, nil
+	case v1.LoanStatus_PENDING_APPROVAL:
+		return service.LoanStatusPendingApproval, nil
+	case v1.LoanStatus_APPROVED:
+		return service.LoanStatusApproved, nil
+	case v1.LoanStatus_DISBURSED:
+		return service.LoanStatusDisbursed, nil
+	case v1.LoanStatus_REJECTED:
+		return service.LoanStatusRejected, nil
+	case v1.LoanStatus_CANCELLED:
+		return service.LoanStatusCancelled, nil
+	case v1.LoanStatus_DEFAULTED:
+		return service.LoanStatusDefaulted, nil
+	case v1.LoanStatus_WRITTEN_OFF:
+		return service.LoanStatusWrittenOff, nil
+	}
+
+	return 0, errors.New("unknown loan status")
+}
+
+// parseLoanSummary converts a service.LoanSummary to a v1.LoanSummary protobuf message.
+//
+// Parameters:
+//   - summary: A service.LoanSummary struct containing the loan and its paid amount.
+//
+// Returns:
+//   - *v1.LoanSummary: A pointer to a v1.LoanSummary struct with the converted loan summary data.
+func parseLoanSummary(summary service.LoanSummary) *v1.LoanSummary {
+	return &v1.LoanSummary{
+		Loan:       parseLoan(summary.Loan),
+		PaidAmount: summary.PaidAmount.String(),
+	}
+}
+
+// toServicePaymentMode converts a v1.PaymentMode protobuf enum to a service.PaymentMode.
+//
+// Parameters:
+//   - mode: A v1.PaymentMode representing the requested payment mode.
+//
+// Returns:
+//   - service.PaymentMode: The corresponding service.PaymentMode value.
+//   - error: An error if the payment mode is unknown or cannot be requested, such as CREDIT, nil otherwise.
+func toServicePaymentMode(mode v1.PaymentMode) (service.PaymentMode, error) {
+	switch mode {
+	case v1.PaymentMode_REGULAR:
+		return service.PaymentModeRegular, nil
+	case v1.PaymentMode_PREPAYMENT:
+		return service.PaymentModePrepayment, nil
+	case v1.PaymentMode_PAYOFF:
+		return service.PaymentModePayoff, nil
+	case v1.PaymentMode_RECOVERY:
+		return service.PaymentModeRecovery, nil
+	}
+
+	return 0, errors.New("unknown payment mode")
+}
+
+// toServicePaymentChannel converts a v1.PaymentChannel to a service.PaymentChannel.
+//
+// Parameters:
+//   - channel: A v1.PaymentChannel enum value.
+//
+// Returns:
+//   - service.PaymentChannel: The corresponding service.PaymentChannel value.
+//   - error: An error if the payment channel is unknown, nil otherwise.
+func toServicePaymentChannel(channel v1.PaymentChannel) (service.PaymentChannel, error) {
+	switch channel {
+	case v1.PaymentChannel_CHANNEL_UNSPECIFIED:
+		return service.PaymentChannelUnspecified, nil
+	case v1.PaymentChannel_CHANNEL_BANK_TRANSFER:
+		return service.PaymentChannelBankTransfer, nil
+	case v1.PaymentChannel_CHANNEL_VIRTUAL_ACCOUNT:
+		return service.PaymentChannelVirtualAccount, nil
+	case v1.PaymentChannel_CHANNEL_CARD:
+		return service.PaymentChannelCard, nil
+	case v1.PaymentChannel_CHANNEL_CASH_AGENT:
+		return service.PaymentChannelCashAgent, nil
+	}
+
+	return 0, errors.New("unknown payment channel")
+}
+
+// parsePaymentMode converts a service.PaymentMode to a v1.PaymentMode.
+//
+// Parameters:
+//   - mode: A service.PaymentMode value to be converted.
+//
+// Returns:
+//   - v1.PaymentMode: The corresponding v1.PaymentMode value.
+func parsePaymentMode(mode service.PaymentMode) v1.PaymentMode {
+	var res v1.PaymentMode
+	switch mode {
+	case service.PaymentModeRegular:
+		res = v1.PaymentMode_REGULAR
+	case service.PaymentModePrepayment:
+		res = v1.PaymentMode_PREPAYMENT
+	case service.PaymentModePayoff:
+		res = v1.PaymentMode_PAYOFF
+	case service.PaymentModeCredit:
+		res = v1.PaymentMode_CREDIT
+	case service.PaymentModeRecovery:
+		res = v1.PaymentMode_RECOVERY
+	}
+
+	return res
+}
+
+// parsePaymentChannel converts a service.PaymentChannel to a v1.PaymentChannel.
+//
+// Parameters:
+//   - channel: A service.PaymentChannel value to be converted.
+//
+// Returns:
+//   - v1.PaymentChannel: The corresponding v1.PaymentChannel value.
+func parsePaymentChannel(channel service.PaymentChannel) v1.PaymentChannel {
+	var res v1.PaymentChannel
+	switch channel {
+	case service.PaymentChannelUnspecified:
+		res = v1.PaymentChannel_CHANNEL_UNSPECIFIED
+	case service.PaymentChannelBankTransfer:
+		res = v1.PaymentChannel_CHANNEL_BANK_TRANSFER
+	case service.PaymentChannelVirtualAccount:
+		res = v1.PaymentChannel_CHANNEL_VIRTUAL_ACCOUNT
+	case service.PaymentChannelCard:
+		res = v1.PaymentChannel_CHANNEL_CARD
+	case service.PaymentChannelCashAgent:
+		res = v1.PaymentChannel_CHANNEL_CASH_AGENT
+	}
+
+	return res
+}
+
+// parseLoanPayment converts a service.LoanPayment to a v1.LoanPayment protobuf message.
+//
+// Parameters:
+//   - payment: A service.LoanPayment struct containing the payment information.
+//
+// Returns:
+//   - *v1.LoanPayment: A pointer to a v1.LoanPayment struct with the converted payment data,
+//     including the portions of the payment applied to the installments and charges.
+func parseLoanPayment(payment service.LoanPayment) *v1.LoanPayment {
+	var allocations []*v1.InstallmentAllocation
+	for _, allocation := range payment.Allocations {
+		allocations = append(allocations, &v1.InstallmentAllocation{
+			InstallmentNumber: allocation.InstallmentNumber,
+			Amount:            allocation.Amount.String(),
+		})
+	}
+
+	var chargeAllocations []*v1.ChargeAllocation
+	for _, allocation := range payment.ChargeAllocations {
+		chargeAllocations = append(chargeAllocations, &v1.ChargeAllocation{
+			ChargeId: allocation.ChargeID.String(),
+			Amount:   allocation.Amount.String(),
+		})
+	}
+
+	var reversedAt *timestamppb.Timestamp
+	if !payment.ReversedAt.IsZero() {
+		reversedAt = timestamppb.New(payment.ReversedAt)
+	}
+
+	return &v1.LoanPayment{
+		Id:                payment.ID.String(),
+		LoanId:            payment.LoanID.String(),
+		Amount:            payment.Amount.String(),
+		ExcessAmount:      payment.ExcessAmount.String(),
+		ExcessHandling:    parseOverpaymentPolicy(payment.ExcessHandling),
+		Mode:              parsePaymentMode(payment.Mode),
+		ExternalReference: payment.ExternalReference,
+		Channel:           parsePaymentChannel(payment.Channel),
+		PaidAt:            timestamppb.New(payment.PaidAt),
+		Metadata:          payment.Metadata,
+		Allocations:       allocations,
+		ChargeAllocations: chargeAllocations,
+		CreatedAt:         timestamppb.New(payment.CreatedAt),
+		UpdatedAt:         timestamppb.New(payment.UpdatedAt),
+		ReversedAt:        reversedAt,
+	}
+}
+
+// parsePayoffQuote converts a service.PayoffQuote to a v1.PayoffQuote protobuf message.
+//
+// Parameters:
+//   - quote: A service.PayoffQuote struct containing the payoff quote information.
+//
+// Returns:
+//   - *v1.PayoffQuote: A pointer to a v1.PayoffQuote struct with the converted payoff quote data.
+func parsePayoffQuote(quote service.PayoffQuote) *v1.PayoffQuote {
+	return &v1.PayoffQuote{
+		LoanId:            quote.LoanID.String(),
+		OutstandingAmount: quote.OutstandingAmount.String(),
+		RebateAmount:      quote.RebateAmount.String(),
+		PayoffAmount:      quote.PayoffAmount.String(),
+		QuotedAt:          timestamppb.New(quote.QuotedAt),
 	}
 }
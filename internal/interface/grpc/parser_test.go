@@ -19,30 +19,92 @@ func TestParseLoan(t *testing.T) {
 	input := service.Loan{
 		ID:                   uuid.New(),
 		UserID:               uuid.New(),
+		ProductID:            uuid.New(),
 		Amount:               decimal.NewFromInt(5000000),
+		BillingFrequency:     service.BillingFrequencyBiWeekly,
+		InstallmentCount:     25,
 		PaymentDurationWeeks: 50,
+		InterestModel:        service.InterestModelFlat,
+		InterestRate:         decimal.NewFromFloat(0.1),
 		PaymentAmount:        decimal.NewFromInt(5500000),
-		Status:               service.LoanStatusOngoing,
-		CreatedAt:            now,
-		UpdatedAt:            now,
+		OriginationFee:       decimal.NewFromInt(50000),
+		LateFeePolicy: service.LateFeePolicy{
+			Method: service.LateFeeMethodFixedPerMissedInstallment,
+			Amount: decimal.NewFromInt(25000),
+			Cap:    decimal.NewFromInt(100000),
+		},
+		RoundingPolicy:            service.RoundingPolicy{Method: service.RoundingMethodBankers, Precision: 2},
+		EarlySettlementRebateRate: decimal.NewFromFloat(0.5),
+		RebateAmount:              decimal.NewFromInt(25000),
+		GracePeriodDays:           3,
+		CoolingOffDays:            14,
+		DefaultAfterWeeks:         12,
+		BillingCalendar:           &service.BillingCalendar{Timezone: "Asia/Jakarta", WeekStartDay: time.Monday},
+		DelinquencyRule:           &service.DelinquencyRule{MissedInstallmentThreshold: 2, PartialPaymentRule: service.PartialPaymentCountsAsMissed},
+		Status:                    service.LoanStatusOngoing,
+		CreatedAt:                 now,
+		UpdatedAt:                 now,
+		ApprovedAt:                now,
+		DisbursedAt:               now,
+		DefaultedAt:               now,
+		WrittenOffAt:              now,
+		WrittenOffPrincipal:       decimal.NewFromInt(2000000),
+		WrittenOffInterest:        decimal.NewFromInt(200000),
+		WrittenOffCharges:         decimal.NewFromInt(75000),
+		RecoveredAmount:           decimal.NewFromInt(100000),
+		TermsVersion:              2,
+		RestructuredPrincipal:     decimal.NewFromInt(3300000),
+		RestructuredFrom:          11,
 	}
 
 	want := &v1.Loan{
 		Id:                   input.ID.String(),
 		UserId:               input.UserID.String(),
+		ProductId:            input.ProductID.String(),
 		Amount:               "5000000",
+		BillingFrequency:     v1.BillingFrequency_FREQUENCY_BI_WEEKLY,
+		InstallmentCount:     25,
 		PaymentDurationWeeks: 50,
+		InterestModel:        v1.InterestModel_FLAT,
+		InterestRate:         "0.1",
 		PaymentAmount:        "5500000",
-		Status:               v1.LoanStatus_ONGOING,
-		CreatedAt:            timestamppb.New(now),
-		UpdatedAt:            timestamppb.New(now),
+		OriginationFee:       "50000",
+		LateFeePolicy: &v1.LateFeePolicy{
+			Method: v1.LateFeeMethod_LATE_FEE_FIXED_PER_MISSED_INSTALLMENT,
+			Amount: "25000",
+			Cap:    "100000",
+		},
+		RoundingPolicy:              &v1.RoundingPolicy{Method: v1.RoundingMethod_BANKERS, Precision: 2},
+		EarlySettlementRebateRate:   "0.5",
+		RebateAmount:                "25000",
+		GracePeriodDays:             3,
+		CoolingOffDays:              14,
+		DefaultAfterWeeks:           12,
+		BillingCalendar:             &v1.BillingCalendar{Timezone: "Asia/Jakarta", WeekStartDay: v1.Weekday_MONDAY},
+		DelinquencyRule:             &v1.DelinquencyRule{MissedInstallmentThreshold: 2, PartialPaymentRule: v1.PartialPaymentRule_PARTIAL_PAYMENT_COUNTS_AS_MISSED},
+		Status:                      v1.LoanStatus_ONGOING,
+		CreatedAt:                   timestamppb.New(now),
+		UpdatedAt:                   timestamppb.New(now),
+		ApprovedAt:                  timestamppb.New(now),
+		DisbursedAt:                 timestamppb.New(now),
+		DefaultedAt:                 timestamppb.New(now),
+		WrittenOffAt:                timestamppb.New(now),
+		WrittenOffPrincipal:         "2000000",
+		WrittenOffInterest:          "200000",
+		WrittenOffCharges:           "75000",
+		RecoveredAmount:             "100000",
+		TermsVersion:                2,
+		RestructuredPrincipal:       "3300000",
+		RestructuredFromInstallment: 11,
 	}
 
 	got := parseLoan(input)
 
 	if diff := cmp.Diff(
 		want, got,
-		cmpopts.IgnoreUnexported(v1.Loan{}, timestamppb.Timestamp{}),
+		cmpopts.IgnoreUnexported(
+			v1.Loan{}, v1.RoundingPolicy{}, v1.LateFeePolicy{}, v1.BillingCalendar{}, v1.DelinquencyRule{}, timestamppb.Timestamp{},
+		),
 	); diff != "" {
 		t.Fatalf("parseLoan() mismatch (-want +got):\n%s", diff)
 	}
@@ -64,6 +126,41 @@ func TestParseLoanStatus(t *testing.T) {
 			status: service.LoanStatusPaid,
 			want:   v1.LoanStatus_PAID,
 		},
+		{
+			name:   "pending approval",
+			status: service.LoanStatusPendingApproval,
+			want:   v1.LoanStatus_PENDING_APPROVAL,
+		},
+		{
+			name:   "approved",
+			status: service.LoanStatusApproved,
+			want:   v1.LoanStatus_APPROVED,
+		},
+		{
+			name:   "disbursed",
+			status: service.LoanStatusDisbursed,
+			want:   v1.LoanStatus_DISBURSED,
+		},
+		{
+			name:   "rejected",
+			status: service.LoanStatusRejected,
+			want:   v1.LoanStatus_REJECTED,
+		},
+		{
+			name:   "cancelled",
+			status: service.LoanStatusCancelled,
+			want:   v1.LoanStatus_CANCELLED,
+		},
+		{
+			name:   "defaulted",
+			status: service.LoanStatusDefaulted,
+			want:   v1.LoanStatus_DEFAULTED,
+		},
+		{
+			name:   "written off",
+			status: service.LoanStatusWrittenOff,
+			want:   v1.LoanStatus_WRITTEN_OFF,
+		},
 		{
 			name:   "unknown status",
 			status: service.LoanStatus(-1),
@@ -81,46 +178,861 @@ func TestParseLoanStatus(t *testing.T) {
 	}
 }
 
+func TestParseInterestModel(t *testing.T) {
+	tests := []struct {
+		name  string
+		model service.InterestModel
+		want  v1.InterestModel
+	}{
+		{
+			name:  "flat",
+			model: service.InterestModelFlat,
+			want:  v1.InterestModel_FLAT,
+		},
+		{
+			name:  "declining balance",
+			model: service.InterestModelDecliningBalance,
+			want:  v1.InterestModel_DECLINING_BALANCE,
+		},
+		{
+			name:  "zero interest",
+			model: service.InterestModelZeroInterest,
+			want:  v1.InterestModel_ZERO_INTEREST,
+		},
+		{
+			name:  "unknown model",
+			model: service.InterestModel(-1),
+			want:  v1.InterestModel(0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseInterestModel(tt.model)
+			if got != tt.want {
+				t.Fatalf("parseInterestModel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRoundingPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy service.RoundingPolicy
+		want   *v1.RoundingPolicy
+	}{
+		{
+			name:   "remainder on last",
+			policy: service.RoundingPolicy{Method: service.RoundingMethodRemainderOnLast},
+			want:   &v1.RoundingPolicy{Method: v1.RoundingMethod_REMAINDER_ON_LAST},
+		},
+		{
+			name:   "remainder on first",
+			policy: service.RoundingPolicy{Method: service.RoundingMethodRemainderOnFirst},
+			want:   &v1.RoundingPolicy{Method: v1.RoundingMethod_REMAINDER_ON_FIRST},
+		},
+		{
+			name:   "banker's rounding",
+			policy: service.RoundingPolicy{Method: service.RoundingMethodBankers, Precision: 2},
+			want:   &v1.RoundingPolicy{Method: v1.RoundingMethod_BANKERS, Precision: 2},
+		},
+		{
+			name:   "unknown",
+			policy: service.RoundingPolicy{Method: service.RoundingMethod(999)},
+			want:   &v1.RoundingPolicy{Method: v1.RoundingMethod(0)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parseRoundingPolicy(test.policy)
+			if diff := cmp.Diff(test.want, got, cmpopts.IgnoreUnexported(v1.RoundingPolicy{})); diff != "" {
+				t.Fatalf("parseRoundingPolicy() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseOverpaymentPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy service.OverpaymentPolicy
+		want   v1.OverpaymentPolicy
+	}{
+		{
+			name:   "reject",
+			policy: service.OverpaymentPolicyReject,
+			want:   v1.OverpaymentPolicy_OVERPAYMENT_REJECT,
+		},
+		{
+			name:   "credit",
+			policy: service.OverpaymentPolicyCredit,
+			want:   v1.OverpaymentPolicy_OVERPAYMENT_CREDIT,
+		},
+		{
+			name:   "refund",
+			policy: service.OverpaymentPolicyRefund,
+			want:   v1.OverpaymentPolicy_OVERPAYMENT_REFUND,
+		},
+		{
+			name:   "unknown",
+			policy: service.OverpaymentPolicy(999),
+			want:   v1.OverpaymentPolicy(0),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := parseOverpaymentPolicy(test.policy); got != test.want {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParsePaymentAcceptanceMode(t *testing.T) {
+	tests := []struct {
+		name string
+		mode service.PaymentAcceptanceMode
+		want v1.PaymentAcceptanceMode
+	}{
+		{
+			name: "exact",
+			mode: service.PaymentAcceptanceModeExact,
+			want: v1.PaymentAcceptanceMode_EXACT,
+		},
+		{
+			name: "partial",
+			mode: service.PaymentAcceptanceModePartial,
+			want: v1.PaymentAcceptanceMode_PARTIAL,
+		},
+		{
+			name: "unknown",
+			mode: service.PaymentAcceptanceMode(999),
+			want: v1.PaymentAcceptanceMode(0),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := parsePaymentAcceptanceMode(test.mode); got != test.want {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseLateFeePolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy service.LateFeePolicy
+		want   *v1.LateFeePolicy
+	}{
+		{
+			name:   "none",
+			policy: service.LateFeePolicy{Method: service.LateFeeMethodNone},
+			want:   &v1.LateFeePolicy{Method: v1.LateFeeMethod_LATE_FEE_NONE, Amount: "0", Cap: "0"},
+		},
+		{
+			name: "fixed per missed installment",
+			policy: service.LateFeePolicy{
+				Method: service.LateFeeMethodFixedPerMissedInstallment,
+				Amount: decimal.NewFromInt(25000),
+				Cap:    decimal.NewFromInt(100000),
+			},
+			want: &v1.LateFeePolicy{Method: v1.LateFeeMethod_LATE_FEE_FIXED_PER_MISSED_INSTALLMENT, Amount: "25000", Cap: "100000"},
+		},
+		{
+			name: "percentage of overdue",
+			policy: service.LateFeePolicy{
+				Method: service.LateFeeMethodPercentageOfOverdue,
+				Amount: decimal.NewFromFloat(0.05),
+			},
+			want: &v1.LateFeePolicy{Method: v1.LateFeeMethod_LATE_FEE_PERCENTAGE_OF_OVERDUE, Amount: "0.05", Cap: "0"},
+		},
+		{
+			name:   "unknown",
+			policy: service.LateFeePolicy{Method: service.LateFeeMethod(999)},
+			want:   &v1.LateFeePolicy{Method: v1.LateFeeMethod(0), Amount: "0", Cap: "0"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parseLateFeePolicy(test.policy)
+			if diff := cmp.Diff(test.want, got, cmpopts.IgnoreUnexported(v1.LateFeePolicy{})); diff != "" {
+				t.Fatalf("parseLateFeePolicy() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseLoanChargeType(t *testing.T) {
+	tests := []struct {
+		name       string
+		chargeType service.LoanChargeType
+		want       v1.LoanChargeType
+	}{
+		{
+			name:       "late fee",
+			chargeType: service.LoanChargeTypeLateFee,
+			want:       v1.LoanChargeType_CHARGE_LATE_FEE,
+		},
+		{
+			name:       "unknown",
+			chargeType: service.LoanChargeType(999),
+			want:       v1.LoanChargeType(0),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := parseLoanChargeType(test.chargeType); got != test.want {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseBillingFrequency(t *testing.T) {
+	tests := []struct {
+		name      string
+		frequency service.BillingFrequency
+		want      v1.BillingFrequency
+	}{
+		{
+			name:      "weekly",
+			frequency: service.BillingFrequencyWeekly,
+			want:      v1.BillingFrequency_FREQUENCY_WEEKLY,
+		},
+		{
+			name:      "daily",
+			frequency: service.BillingFrequencyDaily,
+			want:      v1.BillingFrequency_FREQUENCY_DAILY,
+		},
+		{
+			name:      "bi-weekly",
+			frequency: service.BillingFrequencyBiWeekly,
+			want:      v1.BillingFrequency_FREQUENCY_BI_WEEKLY,
+		},
+		{
+			name:      "monthly",
+			frequency: service.BillingFrequencyMonthly,
+			want:      v1.BillingFrequency_FREQUENCY_MONTHLY,
+		},
+		{
+			name:      "unknown",
+			frequency: service.BillingFrequency(999),
+			want:      v1.BillingFrequency(0),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := parseBillingFrequency(test.frequency); got != test.want {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
 func TestParseLoanDetail(t *testing.T) {
 	now := time.Now()
+	chargeID := uuid.New()
 	input := service.LoanDetail{
 		Loan: service.Loan{
-			ID:                   uuid.New(),
-			UserID:               uuid.New(),
-			Amount:               decimal.NewFromInt(5000000),
-			PaymentDurationWeeks: 50,
-			PaymentAmount:        decimal.NewFromInt(5500000),
-			Status:               service.LoanStatusOngoing,
-			CreatedAt:            now,
-			UpdatedAt:            now,
+			ID:                        uuid.New(),
+			UserID:                    uuid.New(),
+			ProductID:                 uuid.New(),
+			Amount:                    decimal.NewFromInt(5000000),
+			PaymentDurationWeeks:      50,
+			InterestModel:             service.InterestModelFlat,
+			InterestRate:              decimal.NewFromFloat(0.1),
+			PaymentAmount:             decimal.NewFromInt(5500000),
+			OriginationFee:            decimal.NewFromInt(50000),
+			RoundingPolicy:            service.RoundingPolicy{Method: service.RoundingMethodBankers, Precision: 2},
+			PaymentAcceptanceMode:     service.PaymentAcceptanceModePartial,
+			EarlySettlementRebateRate: decimal.Zero,
+			RebateAmount:              decimal.Zero,
+			Status:                    service.LoanStatusOngoing,
+			CreatedAt:                 now,
+			UpdatedAt:                 now,
+		},
+		OutstandingAmount:           decimal.NewFromInt(3000000),
+		CurrentBillAmount:           decimal.NewFromInt(100000),
+		IsDelinquent:                false,
+		DaysPastDue:                 9,
+		OldestUnpaidInstallmentDate: now.AddDate(0, 0, -9),
+		DelinquencyBucket:           "8-30",
+		PaidAmount:                  decimal.NewFromInt(2500000),
+		RemainingDueAmount:          decimal.NewFromInt(60000),
+		DueBy:                       now,
+		MaturityDate:                now.AddDate(0, 0, 35),
+		Charges: []service.LoanCharge{
+			{
+				ID:                chargeID,
+				Type:              service.LoanChargeTypeLateFee,
+				InstallmentNumber: 3,
+				Amount:            decimal.NewFromInt(25000),
+				AmountPaid:        decimal.NewFromInt(10000),
+				CapitalizedAmount: decimal.NewFromInt(5000),
+				CreatedAt:         now,
+			},
 		},
-		OutstandingAmount: decimal.NewFromInt(3000000),
-		CurrentBillAmount: decimal.NewFromInt(100000),
-		IsDelinquent:      false,
+		OutstandingChargeAmount: decimal.NewFromInt(10000),
+		CreditBalance:           decimal.NewFromInt(200000),
 	}
 
 	want := &v1.LoanDetail{
 		Loan: &v1.Loan{
-			Id:                   input.Loan.ID.String(),
-			UserId:               input.Loan.UserID.String(),
-			Amount:               "5000000",
-			PaymentDurationWeeks: 50,
-			PaymentAmount:        "5500000",
-			Status:               v1.LoanStatus_ONGOING,
-			CreatedAt:            timestamppb.New(now),
-			UpdatedAt:            timestamppb.New(now),
+			Id:                        input.Loan.ID.String(),
+			UserId:                    input.Loan.UserID.String(),
+			ProductId:                 input.Loan.ProductID.String(),
+			Amount:                    "5000000",
+			PaymentDurationWeeks:      50,
+			InterestModel:             v1.InterestModel_FLAT,
+			InterestRate:              "0.1",
+			PaymentAmount:             "5500000",
+			OriginationFee:            "50000",
+			RoundingPolicy:            &v1.RoundingPolicy{Method: v1.RoundingMethod_BANKERS, Precision: 2},
+			PaymentAcceptanceMode:     v1.PaymentAcceptanceMode_PARTIAL,
+			EarlySettlementRebateRate: "0",
+			RebateAmount:              "0",
+			LateFeePolicy:             &v1.LateFeePolicy{Amount: "0", Cap: "0"},
+			WrittenOffPrincipal:       "0",
+			WrittenOffInterest:        "0",
+			WrittenOffCharges:         "0",
+			RecoveredAmount:           "0",
+			RestructuredPrincipal:     "0",
+			Status:                    v1.LoanStatus_ONGOING,
+			CreatedAt:                 timestamppb.New(now),
+			UpdatedAt:                 timestamppb.New(now),
 		},
-		OutstandingAmount: "3000000",
-		CurrentBillAmount: "100000",
-		IsDelinquent:      false,
+		OutstandingAmount:           "3000000",
+		CurrentBillAmount:           "100000",
+		IsDelinquent:                false,
+		DaysPastDue:                 9,
+		OldestUnpaidInstallmentDate: timestamppb.New(now.AddDate(0, 0, -9)),
+		DelinquencyBucket:           "8-30",
+		PaidAmount:                  "2500000",
+		RemainingDueAmount:          "60000",
+		DueBy:                       timestamppb.New(now),
+		MaturityDate:                timestamppb.New(now.AddDate(0, 0, 35)),
+		Charges: []*v1.LoanCharge{
+			{
+				Id:                chargeID.String(),
+				ChargeType:        v1.LoanChargeType_CHARGE_LATE_FEE,
+				InstallmentNumber: 3,
+				Amount:            "25000",
+				AmountPaid:        "10000",
+				CapitalizedAmount: "5000",
+				CreatedAt:         timestamppb.New(now),
+			},
+		},
+		OutstandingChargeAmount: "10000",
+		CreditBalance:           "200000",
 	}
 
 	got := parseLoanDetail(input)
 
 	if diff := cmp.Diff(
 		want, got,
-		cmpopts.IgnoreUnexported(v1.LoanDetail{}, v1.Loan{}, timestamppb.Timestamp{}),
+		cmpopts.IgnoreUnexported(
+			v1.LoanDetail{}, v1.Loan{}, v1.RoundingPolicy{}, v1.LateFeePolicy{}, v1.LoanCharge{}, timestamppb.Timestamp{},
+		),
 	); diff != "" {
 		t.Fatalf("parseLoanDetail() mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestParseLoanProduct(t *testing.T) {
+	now := time.Now()
+	input := service.LoanProduct{
+		ID:                       uuid.New(),
+		Name:                     "Standard Weekly Loan",
+		MinPrincipal:             decimal.NewFromInt(1000000),
+		MaxPrincipal:             decimal.NewFromInt(10000000),
+		BillingFrequency:         service.BillingFrequencyWeekly,
+		AllowedInstallmentCounts: []int32{25, 50},
+		InterestModel:            service.InterestModelFlat,
+		InterestRate:             decimal.NewFromFloat(0.1),
+		RoundingPolicy:           service.RoundingPolicy{Method: service.RoundingMethodRemainderOnFirst},
+		FeeSchedule: service.FeeSchedule{
+			OriginationFeeRate:  decimal.NewFromFloat(0.01),
+			OriginationFeeFixed: decimal.NewFromInt(10000),
+			LateFeePolicy: service.LateFeePolicy{
+				Method: service.LateFeeMethodPercentageOfOverdue,
+				Amount: decimal.NewFromFloat(0.05),
+			},
+		},
+		EarlySettlementRebateRate: decimal.NewFromFloat(0.25),
+		GracePeriodDays:           2,
+		CoolingOffDays:            14,
+		DefaultAfterWeeks:         12,
+		BillingCalendar:           &service.BillingCalendar{Timezone: "UTC", WeekStartDay: time.Sunday},
+		DelinquencyRule:           &service.DelinquencyRule{MissedInstallmentThreshold: 4, PartialPaymentRule: service.PartialPaymentCountsAsPaid},
+		CreatedAt:                 now,
+		UpdatedAt:                 now,
+	}
+
+	want := &v1.LoanProduct{
+		Id:                       input.ID.String(),
+		Name:                     "Standard Weekly Loan",
+		MinPrincipal:             "1000000",
+		MaxPrincipal:             "10000000",
+		AllowedDurationWeeks:     []int32{25, 50},
+		BillingFrequency:         v1.BillingFrequency_FREQUENCY_WEEKLY,
+		AllowedInstallmentCounts: []int32{25, 50},
+		InterestModel:            v1.InterestModel_FLAT,
+		InterestRate:             "0.1",
+		RoundingPolicy:           &v1.RoundingPolicy{Method: v1.RoundingMethod_REMAINDER_ON_FIRST},
+		FeeSchedule: &v1.FeeSchedule{
+			OriginationFeeRate:  "0.01",
+			OriginationFeeFixed: "10000",
+			LateFeePolicy: &v1.LateFeePolicy{
+				Method: v1.LateFeeMethod_LATE_FEE_PERCENTAGE_OF_OVERDUE,
+				Amount: "0.05",
+				Cap:    "0",
+			},
+		},
+		EarlySettlementRebateRate: "0.25",
+		GracePeriodDays:           2,
+		CoolingOffDays:            14,
+		DefaultAfterWeeks:         12,
+		BillingCalendar:           &v1.BillingCalendar{Timezone: "UTC", WeekStartDay: v1.Weekday_SUNDAY},
+		DelinquencyRule:           &v1.DelinquencyRule{MissedInstallmentThreshold: 4, PartialPaymentRule: v1.PartialPaymentRule_PARTIAL_PAYMENT_COUNTS_AS_PAID},
+		CreatedAt:                 timestamppb.New(now),
+		UpdatedAt:                 timestamppb.New(now),
+	}
+
+	got := parseLoanProduct(input)
+
+	if diff := cmp.Diff(
+		want, got,
+		cmpopts.IgnoreUnexported(
+			v1.LoanProduct{}, v1.RoundingPolicy{}, v1.FeeSchedule{}, v1.LateFeePolicy{}, v1.BillingCalendar{}, v1.DelinquencyRule{},
+			timestamppb.Timestamp{},
+		),
+	); diff != "" {
+		t.Fatalf("parseLoanProduct() mismatch (-want +got):\n%s", diff)
+	}
+
+	input.BillingFrequency = service.BillingFrequencyMonthly
+	got = parseLoanProduct(input)
+	if got.GetAllowedDurationWeeks() != nil {
+		t.Fatalf("expecting no allowed duration weeks for a monthly product, got %v", got.GetAllowedDurationWeeks())
+	}
+	if diff := cmp.Diff(input.AllowedInstallmentCounts, got.GetAllowedInstallmentCounts()); diff != "" {
+		t.Fatalf("allowed installment counts mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseLoanInstallmentStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		status service.LoanInstallmentStatus
+		want   v1.LoanInstallmentStatus
+	}{
+		{
+			name:   "unpaid",
+			status: service.LoanInstallmentStatusUnpaid,
+			want:   v1.LoanInstallmentStatus_INSTALLMENT_UNPAID,
+		},
+		{
+			name:   "paid",
+			status: service.LoanInstallmentStatusPaid,
+			want:   v1.LoanInstallmentStatus_INSTALLMENT_PAID,
+		},
+		{
+			name:   "partially paid",
+			status: service.LoanInstallmentStatusPartiallyPaid,
+			want:   v1.LoanInstallmentStatus_INSTALLMENT_PARTIALLY_PAID,
+		},
+		{
+			name:   "restructured",
+			status: service.LoanInstallmentStatusRestructured,
+			want:   v1.LoanInstallmentStatus_INSTALLMENT_RESTRUCTURED,
+		},
+		{
+			name:   "unknown",
+			status: service.LoanInstallmentStatus(999),
+			want:   v1.LoanInstallmentStatus(0),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := parseLoanInstallmentStatus(test.status); got != test.want {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseLoanInstallment(t *testing.T) {
+	dueDate := time.Now()
+	input := service.LoanInstallment{
+		Number:          7,
+		DueDate:         dueDate,
+		PrincipalAmount: decimal.NewFromInt(100000),
+		InterestAmount:  decimal.NewFromInt(10000),
+		AmountDue:       decimal.NewFromInt(110000),
+		AmountPaid:      decimal.Zero,
+		Status:          service.LoanInstallmentStatusUnpaid,
+	}
+
+	want := &v1.LoanInstallment{
+		InstallmentNumber: 7,
+		DueDate:           timestamppb.New(dueDate),
+		PrincipalAmount:   "100000",
+		InterestAmount:    "10000",
+		AmountDue:         "110000",
+		AmountPaid:        "0",
+		Status:            v1.LoanInstallmentStatus_INSTALLMENT_UNPAID,
+	}
+
+	got := parseLoanInstallment(input)
+
+	if diff := cmp.Diff(
+		want, got,
+		cmpopts.IgnoreUnexported(v1.LoanInstallment{}, timestamppb.Timestamp{}),
+	); diff != "" {
+		t.Fatalf("parseLoanInstallment() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseLoanTerms(t *testing.T) {
+	now := time.Now()
+	input := service.LoanTerms{
+		LoanID:                 uuid.New(),
+		Version:                2,
+		Principal:              decimal.NewFromInt(3300000),
+		InterestRate:           decimal.NewFromFloat(0.05),
+		InstallmentCount:       10,
+		PaymentAmount:          decimal.NewFromInt(3465000),
+		FirstInstallmentNumber: 11,
+		CapitalizedCharges:     decimal.NewFromInt(50000),
+		Reason:                 "income reduced",
+		CreatedAt:              now,
+	}
+
+	want := &v1.LoanTerms{
+		LoanId:                 input.LoanID.String(),
+		Version:                2,
+		Principal:              "3300000",
+		InterestRate:           "0.05",
+		InstallmentCount:       10,
+		PaymentAmount:          "3465000",
+		FirstInstallmentNumber: 11,
+		CapitalizedCharges:     "50000",
+		Reason:                 "income reduced",
+		CreatedAt:              timestamppb.New(now),
+	}
+
+	got := parseLoanTerms(input)
+
+	if diff := cmp.Diff(
+		want, got,
+		cmpopts.IgnoreUnexported(v1.LoanTerms{}, timestamppb.Timestamp{}),
+	); diff != "" {
+		t.Fatalf("parseLoanTerms() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParsePaymentHoliday(t *testing.T) {
+	now := time.Now()
+	input := service.PaymentHoliday{
+		ID:                     uuid.New(),
+		LoanID:                 uuid.New(),
+		FirstInstallmentNumber: 4,
+		Periods:                2,
+		StartsAt:               now.AddDate(0, 0, 5),
+		EndsAt:                 now.AddDate(0, 0, 19),
+		Reason:                 "flood relief",
+		CreatedAt:              now,
+	}
+
+	want := &v1.PaymentHoliday{
+		Id:                     input.ID.String(),
+		LoanId:                 input.LoanID.String(),
+		FirstInstallmentNumber: 4,
+		Periods:                2,
+		StartsAt:               timestamppb.New(input.StartsAt),
+		EndsAt:                 timestamppb.New(input.EndsAt),
+		Reason:                 "flood relief",
+		CreatedAt:              timestamppb.New(now),
+	}
+
+	got := parsePaymentHoliday(input)
+
+	if diff := cmp.Diff(
+		want, got,
+		cmpopts.IgnoreUnexported(v1.PaymentHoliday{}, timestamppb.Timestamp{}),
+	); diff != "" {
+		t.Fatalf("parsePaymentHoliday() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestToServiceLoanStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  v1.LoanStatus
+		want    service.LoanStatus
+		wantErr bool
+	}{
+		{
+			name:   "ongoing",
+			status: v1.LoanStatus_ONGOING,
+			want:   service.LoanStatusOngoing,
+		},
+		{
+			name:   "paid",
+			status: v1.LoanStatus_PAID,
+			want:   service.LoanStatusPaid,
+		},
+		{
+			name:   "pending approval",
+			status: v1.LoanStatus_PENDING_APPROVAL,
+			want:   service.LoanStatusPendingApproval,
+		},
+		{
+			name:   "approved",
+			status: v1.LoanStatus_APPROVED,
+			want:   service.LoanStatusApproved,
+		},
+		{
+			name:   "disbursed",
+			status: v1.LoanStatus_DISBURSED,
+			want:   service.LoanStatusDisbursed,
+		},
+		{
+			name:   "rejected",
+			status: v1.LoanStatus_REJECTED,
+			want:   service.LoanStatusRejected,
+		},
+		{
+			name:   "cancelled",
+			status: v1.LoanStatus_CANCELLED,
+			want:   service.LoanStatusCancelled,
+		},
+		{
+			name:   "defaulted",
+			status: v1.LoanStatus_DEFAULTED,
+			want:   service.LoanStatusDefaulted,
+		},
+		{
+			name:   "written off",
+			status: v1.LoanStatus_WRITTEN_OFF,
+			want:   service.LoanStatusWrittenOff,
+		},
+		{
+			name:    "unknown",
+			status:  v1.LoanStatus(999),
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := toServiceLoanStatus(test.status)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expecting error %v, got %v", test.wantErr, err)
+			}
+			if got != test.want {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestToServicePaymentMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    v1.PaymentMode
+		want    service.PaymentMode
+		wantErr bool
+	}{
+		{
+			name: "regular",
+			mode: v1.PaymentMode_REGULAR,
+			want: service.PaymentModeRegular,
+		},
+		{
+			name: "prepayment",
+			mode: v1.PaymentMode_PREPAYMENT,
+			want: service.PaymentModePrepayment,
+		},
+		{
+			name: "payoff",
+			mode: v1.PaymentMode_PAYOFF,
+			want: service.PaymentModePayoff,
+		},
+		{
+			name:    "credit",
+			mode:    v1.PaymentMode_CREDIT,
+			wantErr: true,
+		},
+		{
+			name: "recovery",
+			mode: v1.PaymentMode_RECOVERY,
+			want: service.PaymentModeRecovery,
+		},
+		{
+			name:    "unknown",
+			mode:    v1.PaymentMode(999),
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := toServicePaymentMode(test.mode)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expecting error %v, got %v", test.wantErr, err)
+			}
+			if got != test.want {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestToServicePaymentChannel(t *testing.T) {
+	tests := []struct {
+		name    string
+		channel v1.PaymentChannel
+		want    service.PaymentChannel
+		wantErr bool
+	}{
+		{
+			name:    "unspecified",
+			channel: v1.PaymentChannel_CHANNEL_UNSPECIFIED,
+			want:    service.PaymentChannelUnspecified,
+		},
+		{
+			name:    "bank transfer",
+			channel: v1.PaymentChannel_CHANNEL_BANK_TRANSFER,
+			want:    service.PaymentChannelBankTransfer,
+		},
+		{
+			name:    "virtual account",
+			channel: v1.PaymentChannel_CHANNEL_VIRTUAL_ACCOUNT,
+			want:    service.PaymentChannelVirtualAccount,
+		},
+		{
+			name:    "card",
+			channel: v1.PaymentChannel_CHANNEL_CARD,
+			want:    service.PaymentChannelCard,
+		},
+		{
+			name:    "cash agent",
+			channel: v1.PaymentChannel_CHANNEL_CASH_AGENT,
+			want:    service.PaymentChannelCashAgent,
+		},
+		{
+			name:    "unknown",
+			channel: v1.PaymentChannel(999),
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := toServicePaymentChannel(test.channel)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expecting error %v, got %v", test.wantErr, err)
+			}
+			if got != test.want {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseLoanPayment(t *testing.T) {
+	now := time.Now()
+	input := service.LoanPayment{
+		ID:                uuid.New(),
+		LoanID:            uuid.New(),
+		Amount:            decimal.NewFromInt(1150000),
+		ExcessAmount:      decimal.NewFromInt(50000),
+		ExcessHandling:    service.OverpaymentPolicyCredit,
+		Mode:              service.PaymentModeRegular,
+		ExternalReference: "TRX-1",
+		Channel:           service.PaymentChannelCashAgent,
+		PaidAt:            now.Add(-time.Hour),
+		Metadata:          map[string]string{"agent": "A-1"},
+		Allocations: []service.InstallmentAllocation{
+			{InstallmentNumber: 1, Amount: decimal.NewFromInt(1100000)},
+		},
+		ChargeAllocations: []service.ChargeAllocation{
+			{ChargeID: uuid.New(), Amount: decimal.NewFromInt(50000)},
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	want := &v1.LoanPayment{
+		Id:                input.ID.String(),
+		LoanId:            input.LoanID.String(),
+		Amount:            "1150000",
+		ExcessAmount:      "50000",
+		ExcessHandling:    v1.OverpaymentPolicy_OVERPAYMENT_CREDIT,
+		Mode:              v1.PaymentMode_REGULAR,
+		ExternalReference: "TRX-1",
+		Channel:           v1.PaymentChannel_CHANNEL_CASH_AGENT,
+		PaidAt:            timestamppb.New(now.Add(-time.Hour)),
+		Metadata:          map[string]string{"agent": "A-1"},
+		Allocations: []*v1.InstallmentAllocation{
+			{InstallmentNumber: 1, Amount: "1100000"},
+		},
+		ChargeAllocations: []*v1.ChargeAllocation{
+			{ChargeId: input.ChargeAllocations[0].ChargeID.String(), Amount: "50000"},
+		},
+		CreatedAt: timestamppb.New(now),
+		UpdatedAt: timestamppb.New(now),
+	}
+
+	got := parseLoanPayment(input)
+
+	if diff := cmp.Diff(
+		want, got,
+		cmpopts.IgnoreUnexported(v1.LoanPayment{}, v1.InstallmentAllocation{}, v1.ChargeAllocation{}, timestamppb.Timestamp{}),
+	); diff != "" {
+		t.Fatalf("parseLoanPayment() mismatch (-want +got):\n%s", diff)
+	}
+
+	input.ReversedAt = now
+	if got := parseLoanPayment(input).GetReversedAt(); !got.AsTime().Equal(now) {
+		t.Fatalf("parseLoanPayment() reversed_at = %v, want %v", got.AsTime(), now)
+	}
+}
+
+func TestParsePayoffQuote(t *testing.T) {
+	now := time.Now()
+	input := service.PayoffQuote{
+		LoanID:            uuid.New(),
+		OutstandingAmount: decimal.NewFromInt(2200000),
+		RebateAmount:      decimal.NewFromInt(100000),
+		PayoffAmount:      decimal.NewFromInt(2100000),
+		QuotedAt:          now,
+	}
+
+	want := &v1.PayoffQuote{
+		LoanId:            input.LoanID.String(),
+		OutstandingAmount: "2200000",
+		RebateAmount:      "100000",
+		PayoffAmount:      "2100000",
+		QuotedAt:          timestamppb.New(now),
+	}
+
+	got := parsePayoffQuote(input)
+
+	if diff := cmp.Diff(
+		want, got,
+		cmpopts.IgnoreUnexported(v1.PayoffQuote{}, timestamppb.Timestamp{}),
+	); diff != "" {
+		t.Fatalf("parsePayoffQuote() mismatch (-want +got):\n%s", diff)
+	}
+}
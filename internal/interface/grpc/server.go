@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"net"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -55,10 +56,170 @@ func (s *Server) CreateLoan(ctx context.Context, in *v1.CreateLoanRequest) (*v1.
 		return nil, status.Error(codes.InvalidArgument, "invalid amount")
 	}
 
+	productID, err := uuid.Parse(in.GetProductId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product id")
+	}
+
 	res, err := s.svc.CreateLoan(ctx, service.CreateLoanCommand{
 		UserID:               userID,
+		ProductID:            productID,
 		Amount:               amount,
+		InstallmentCount:     in.GetInstallmentCount(),
 		PaymentDurationWeeks: in.GetPaymentDurationWeeks(),
+		IdempotencyKey:       in.GetIdempotencyKey(),
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	return parseLoan(res), nil
+}
+
+// ApproveLoan approves a loan that is pending approval.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.ApproveLoanRequest protobuf message.
+//
+// Returns:
+//   - The approved loan as v1.Loan protobuf message.
+//   - An error if the transition fails or input is invalid.
+func (s *Server) ApproveLoan(ctx context.Context, in *v1.ApproveLoanRequest) (*v1.Loan, error) {
+	loanID, err := uuid.Parse(in.GetLoanId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid loan id")
+	}
+
+	res, err := s.svc.ApproveLoan(ctx, service.ApproveLoanCommand{LoanID: loanID})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	return parseLoan(res), nil
+}
+
+// DisburseLoan records the disbursement of an approved loan, which starts its billing.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.DisburseLoanRequest protobuf message.
+//
+// Returns:
+//   - The disbursed loan as v1.Loan protobuf message.
+//   - An error if the transition fails or input is invalid.
+func (s *Server) DisburseLoan(ctx context.Context, in *v1.DisburseLoanRequest) (*v1.Loan, error) {
+	loanID, err := uuid.Parse(in.GetLoanId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid loan id")
+	}
+
+	res, err := s.svc.DisburseLoan(ctx, service.DisburseLoanCommand{LoanID: loanID})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	return parseLoan(res), nil
+}
+
+// RejectLoan rejects a loan that has not been disbursed yet.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.RejectLoanRequest protobuf message.
+//
+// Returns:
+//   - The rejected loan as v1.Loan protobuf message.
+//   - An error if the transition fails or input is invalid.
+func (s *Server) RejectLoan(ctx context.Context, in *v1.RejectLoanRequest) (*v1.Loan, error) {
+	loanID, err := uuid.Parse(in.GetLoanId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid loan id")
+	}
+
+	res, err := s.svc.RejectLoan(ctx, service.RejectLoanCommand{LoanID: loanID})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	return parseLoan(res), nil
+}
+
+// CancelLoan cancels a loan at the request of its user, within the cooling-off period and before it is disbursed.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.CancelLoanRequest protobuf message.
+//
+// Returns:
+//   - The cancelled loan as v1.Loan protobuf message.
+//   - An error if the cancellation fails or input is invalid.
+func (s *Server) CancelLoan(ctx context.Context, in *v1.CancelLoanRequest) (*v1.Loan, error) {
+	loanID, err := uuid.Parse(in.GetLoanId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid loan id")
+	}
+
+	res, err := s.svc.CancelLoan(ctx, service.CancelLoanCommand{LoanID: loanID})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	return parseLoan(res), nil
+}
+
+// WriteOffLoan writes the outstanding amount of a defaulted loan off as a loss, closing the loan.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.WriteOffLoanRequest protobuf message.
+//
+// Returns:
+//   - The written-off loan as v1.Loan protobuf message.
+//   - An error if the write-off fails or input is invalid.
+func (s *Server) WriteOffLoan(ctx context.Context, in *v1.WriteOffLoanRequest) (*v1.Loan, error) {
+	loanID, err := uuid.Parse(in.GetLoanId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid loan id")
+	}
+
+	res, err := s.svc.WriteOffLoan(ctx, service.WriteOffLoanCommand{LoanID: loanID})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	return parseLoan(res), nil
+}
+
+// RestructureLoan repays the outstanding amount of a loan being repaid in a new schedule under new terms.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.RestructureLoanRequest protobuf message.
+//
+// Returns:
+//   - The restructured loan as v1.Loan protobuf message.
+//   - An error if the restructure fails or input is invalid.
+func (s *Server) RestructureLoan(ctx context.Context, in *v1.RestructureLoanRequest) (*v1.Loan, error) {
+	loanID, err := uuid.Parse(in.GetLoanId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid loan id")
+	}
+
+	var interestRate *decimal.Decimal
+	if in.GetInterestRate() != "" {
+		rate, err := decimal.NewFromString(in.GetInterestRate())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid interest rate")
+		}
+		interestRate = &rate
+	}
+
+	res, err := s.svc.RestructureLoan(ctx, service.RestructureLoanCommand{
+		LoanID:           loanID,
+		InstallmentCount: in.GetInstallmentCount(),
+		InterestRate:     interestRate,
+		Reason:           in.GetReason(),
 	})
 	if err != nil {
 		return nil, toGrpcError(err)
@@ -67,6 +228,33 @@ func (s *Server) CreateLoan(ctx context.Context, in *v1.CreateLoanRequest) (*v1.
 	return parseLoan(res), nil
 }
 
+// GrantPaymentHoliday grants a payment holiday on a loan being repaid, deferring its installments not due yet.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.GrantPaymentHolidayRequest protobuf message.
+//
+// Returns:
+//   - The granted payment holiday as v1.PaymentHoliday protobuf message.
+//   - An error if granting the payment holiday fails or input is invalid.
+func (s *Server) GrantPaymentHoliday(ctx context.Context, in *v1.GrantPaymentHolidayRequest) (*v1.PaymentHoliday, error) {
+	loanID, err := uuid.Parse(in.GetLoanId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid loan id")
+	}
+
+	res, err := s.svc.GrantPaymentHoliday(ctx, service.GrantPaymentHolidayCommand{
+		LoanID:  loanID,
+		Periods: in.GetPeriods(),
+		Reason:  in.GetReason(),
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	return parsePaymentHoliday(res), nil
+}
+
 // GetCurrentLoan retrieves the current loan details for a user.
 //
 // Parameters:
@@ -110,9 +298,30 @@ func (s *Server) MakePayment(ctx context.Context, in *v1.MakePaymentRequest) (*v
 		return nil, status.Error(codes.InvalidArgument, "invalid payment amount")
 	}
 
+	mode, err := toServicePaymentMode(in.GetMode())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid payment mode")
+	}
+
+	channel, err := toServicePaymentChannel(in.GetChannel())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid payment channel")
+	}
+
+	var paidAt time.Time
+	if in.GetPaidAt() != nil {
+		paidAt = in.GetPaidAt().AsTime()
+	}
+
 	res, err := s.svc.MakePayment(ctx, service.MakePaymentCommand{
-		LoanID:        loanID,
-		PaymentAmount: paymentAmount,
+		LoanID:            loanID,
+		PaymentAmount:     paymentAmount,
+		Mode:              mode,
+		IdempotencyKey:    in.GetIdempotencyKey(),
+		ExternalReference: in.GetExternalReference(),
+		Channel:           channel,
+		PaidAt:            paidAt,
+		Metadata:          in.GetMetadata(),
 	})
 	if err != nil {
 		return nil, toGrpcError(err)
@@ -121,6 +330,305 @@ func (s *Server) MakePayment(ctx context.Context, in *v1.MakePaymentRequest) (*v
 	return parseLoanDetail(res), nil
 }
 
+// GetLoan retrieves the details of a specific loan regardless of its status.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.GetLoanRequest protobuf message.
+//
+// Returns:
+//   - The loan detail as v1.LoanDetail protobuf message.
+//   - An error if retrieval fails or input is invalid.
+func (s *Server) GetLoan(ctx context.Context, in *v1.GetLoanRequest) (*v1.LoanDetail, error) {
+	loanID, err := uuid.Parse(in.GetLoanId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid loan id")
+	}
+
+	res, err := s.svc.GetLoan(ctx, service.GetLoanQuery{LoanID: loanID})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	return parseLoanDetail(res), nil
+}
+
+// ReversePayment reverses a payment made towards a loan, such as a bounced transfer or a chargeback.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.ReversePaymentRequest protobuf message.
+//
+// Returns:
+//   - The updated loan detail as v1.LoanDetail protobuf message.
+//   - An error if the reversal fails or input is invalid.
+func (s *Server) ReversePayment(ctx context.Context, in *v1.ReversePaymentRequest) (*v1.LoanDetail, error) {
+	paymentID, err := uuid.Parse(in.GetPaymentId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid payment id")
+	}
+
+	res, err := s.svc.ReversePayment(ctx, service.ReversePaymentCommand{
+		PaymentID: paymentID,
+		Reason:    in.GetReason(),
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	return parseLoanDetail(res), nil
+}
+
+// ListProducts retrieves all the available loan products.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.ListProductsRequest protobuf message.
+//
+// Returns:
+//   - The loan products as v1.ListProductsResponse protobuf message.
+//   - An error if retrieval fails.
+func (s *Server) ListProducts(ctx context.Context, _ *v1.ListProductsRequest) (*v1.ListProductsResponse, error) {
+	res, err := s.svc.ListProducts(ctx, service.ListProductsQuery{})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	products := make([]*v1.LoanProduct, 0, len(res))
+	for _, product := range res {
+		products = append(products, parseLoanProduct(product))
+	}
+
+	return &v1.ListProductsResponse{Products: products}, nil
+}
+
+// GetProduct retrieves a specific loan product.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.GetProductRequest protobuf message.
+//
+// Returns:
+//   - The loan product as v1.LoanProduct protobuf message.
+//   - An error if retrieval fails or input is invalid.
+func (s *Server) GetProduct(ctx context.Context, in *v1.GetProductRequest) (*v1.LoanProduct, error) {
+	productID, err := uuid.Parse(in.GetProductId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product id")
+	}
+
+	res, err := s.svc.GetProduct(ctx, service.GetProductQuery{ProductID: productID})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	return parseLoanProduct(res), nil
+}
+
+// GetLoanSchedule retrieves the repayment schedule of a specific loan.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.GetLoanScheduleRequest protobuf message.
+//
+// Returns:
+//   - The loan schedule as v1.GetLoanScheduleResponse protobuf message.
+//   - An error if retrieval fails or input is invalid.
+func (s *Server) GetLoanSchedule(ctx context.Context, in *v1.GetLoanScheduleRequest) (*v1.GetLoanScheduleResponse, error) {
+	loanID, err := uuid.Parse(in.GetLoanId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid loan id")
+	}
+
+	res, err := s.svc.GetLoanSchedule(ctx, service.GetLoanScheduleQuery{LoanID: loanID})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	installments := make([]*v1.LoanInstallment, 0, len(res))
+	for _, installment := range res {
+		installments = append(installments, parseLoanInstallment(installment))
+	}
+
+	return &v1.GetLoanScheduleResponse{
+		LoanId:       loanID.String(),
+		Installments: installments,
+	}, nil
+}
+
+// GetPayoffQuote retrieves the amount needed to close a specific loan today.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.GetPayoffQuoteRequest protobuf message.
+//
+// Returns:
+//   - The payoff quote as v1.PayoffQuote protobuf message.
+//   - An error if retrieval fails or input is invalid.
+func (s *Server) GetPayoffQuote(ctx context.Context, in *v1.GetPayoffQuoteRequest) (*v1.PayoffQuote, error) {
+	loanID, err := uuid.Parse(in.GetLoanId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid loan id")
+	}
+
+	res, err := s.svc.GetPayoffQuote(ctx, service.GetPayoffQuoteQuery{LoanID: loanID})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	return parsePayoffQuote(res), nil
+}
+
+// ListLoanPayments retrieves the payments made towards a specific loan, newest first, a page at a time.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.ListLoanPaymentsRequest protobuf message.
+//
+// Returns:
+//   - The page of the loan payments as v1.ListLoanPaymentsResponse protobuf message.
+//   - An error if retrieval fails or input is invalid.
+func (s *Server) ListLoanPayments(ctx context.Context, in *v1.ListLoanPaymentsRequest) (*v1.ListLoanPaymentsResponse, error) {
+	loanID, err := uuid.Parse(in.GetLoanId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid loan id")
+	}
+
+	if in.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid page size")
+	}
+
+	res, err := s.svc.ListLoanPayments(ctx, service.ListLoanPaymentsQuery{
+		LoanID:    loanID,
+		PageToken: in.GetPageToken(),
+		PageSize:  in.GetPageSize(),
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	payments := make([]*v1.LoanPayment, 0, len(res.Payments))
+	for _, payment := range res.Payments {
+		payments = append(payments, parseLoanPayment(payment))
+	}
+
+	return &v1.ListLoanPaymentsResponse{
+		Payments:      payments,
+		NextPageToken: res.NextPageToken,
+	}, nil
+}
+
+// ListUserLoans retrieves the loans of a user of any status, newest first, a page at a time.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.ListUserLoansRequest protobuf message.
+//
+// Returns:
+//   - The page of the user's loans as v1.ListUserLoansResponse protobuf message.
+//   - An error if retrieval fails or input is invalid.
+func (s *Server) ListUserLoans(ctx context.Context, in *v1.ListUserLoansRequest) (*v1.ListUserLoansResponse, error) {
+	userID, err := uuid.Parse(in.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	statuses := make([]service.LoanStatus, 0, len(in.GetStatusFilter()))
+	for _, loanStatus := range in.GetStatusFilter() {
+		serviceStatus, err := toServiceLoanStatus(loanStatus)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid loan status")
+		}
+		statuses = append(statuses, serviceStatus)
+	}
+
+	if in.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid page size")
+	}
+
+	res, err := s.svc.ListUserLoans(ctx, service.ListUserLoansQuery{
+		UserID:    userID,
+		Statuses:  statuses,
+		PageToken: in.GetPageToken(),
+		PageSize:  in.GetPageSize(),
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	loans := make([]*v1.LoanSummary, 0, len(res.Loans))
+	for _, loan := range res.Loans {
+		loans = append(loans, parseLoanSummary(loan))
+	}
+
+	return &v1.ListUserLoansResponse{
+		Loans:         loans,
+		NextPageToken: res.NextPageToken,
+	}, nil
+}
+
+// ListLoanTerms retrieves every version of the terms of a specific loan, from its original terms to its latest restructure.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.ListLoanTermsRequest protobuf message.
+//
+// Returns:
+//   - The versions of the loan terms as v1.ListLoanTermsResponse protobuf message.
+//   - An error if retrieval fails or input is invalid.
+func (s *Server) ListLoanTerms(ctx context.Context, in *v1.ListLoanTermsRequest) (*v1.ListLoanTermsResponse, error) {
+	loanID, err := uuid.Parse(in.GetLoanId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid loan id")
+	}
+
+	res, err := s.svc.ListLoanTerms(ctx, service.ListLoanTermsQuery{LoanID: loanID})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	terms := make([]*v1.LoanTerms, 0, len(res))
+	for _, version := range res {
+		terms = append(terms, parseLoanTerms(version))
+	}
+
+	return &v1.ListLoanTermsResponse{
+		LoanId: loanID.String(),
+		Terms:  terms,
+	}, nil
+}
+
+// ListPaymentHolidays retrieves the payment holidays granted on a specific loan.
+//
+// Parameters:
+//   - ctx: The context for the request.
+//   - in: The v1.ListPaymentHolidaysRequest protobuf message.
+//
+// Returns:
+//   - The payment holidays of the loan as v1.ListPaymentHolidaysResponse protobuf message.
+//   - An error if retrieval fails or input is invalid.
+func (s *Server) ListPaymentHolidays(ctx context.Context, in *v1.ListPaymentHolidaysRequest) (*v1.ListPaymentHolidaysResponse, error) {
+	loanID, err := uuid.Parse(in.GetLoanId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid loan id")
+	}
+
+	res, err := s.svc.ListPaymentHolidays(ctx, service.ListPaymentHolidaysQuery{LoanID: loanID})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	holidays := make([]*v1.PaymentHoliday, 0, len(res))
+	for _, holiday := range res {
+		holidays = append(holidays, parsePaymentHoliday(holiday))
+	}
+
+	return &v1.ListPaymentHolidaysResponse{
+		LoanId:   loanID.String(),
+		Holidays: holidays,
+	}, nil
+}
+
 // Serve starts the gRPC server and begins listening for incoming requests.
 //
 // Parameters:
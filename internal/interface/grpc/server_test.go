@@ -10,7 +10,9 @@ import (
 	"github.com/shopspring/decimal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/axopadyani/billing-engine/internal/common/businesserror"
 	"github.com/axopadyani/billing-engine/internal/service"
 	mock "github.com/axopadyani/billing-engine/internal/test/mock/service"
 	v1 "github.com/axopadyani/billing-engine/proto/v1"
@@ -36,6 +38,7 @@ func TestServer_CreateLoan(t *testing.T) {
 	mockRes := service.Loan{
 		ID:                   uuid.New(),
 		UserID:               uuid.New(),
+		ProductID:            uuid.New(),
 		Amount:               decimal.NewFromInt(5_000_000),
 		PaymentDurationWeeks: 5,
 		PaymentAmount:        decimal.NewFromInt(5_500_000),
@@ -70,6 +73,17 @@ func TestServer_CreateLoan(t *testing.T) {
 			},
 			wantErr: status.New(codes.InvalidArgument, "invalid amount"),
 		},
+		{
+			name:      "invalid product id",
+			setupMock: nil,
+			request: &v1.CreateLoanRequest{
+				UserId:               mockRes.UserID.String(),
+				ProductId:            "invalid",
+				Amount:               mockRes.Amount.String(),
+				PaymentDurationWeeks: mockRes.PaymentDurationWeeks,
+			},
+			wantErr: status.New(codes.InvalidArgument, "invalid product id"),
+		},
 		{
 			name: "loan service error",
 			setupMock: func(mockSvc *mock.MockService) {
@@ -77,6 +91,7 @@ func TestServer_CreateLoan(t *testing.T) {
 			},
 			request: &v1.CreateLoanRequest{
 				UserId:               mockRes.UserID.String(),
+				ProductId:            mockRes.ProductID.String(),
 				Amount:               mockRes.Amount.String(),
 				PaymentDurationWeeks: mockRes.PaymentDurationWeeks,
 			},
@@ -89,6 +104,7 @@ func TestServer_CreateLoan(t *testing.T) {
 			},
 			request: &v1.CreateLoanRequest{
 				UserId:               mockRes.UserID.String(),
+				ProductId:            mockRes.ProductID.String(),
 				Amount:               mockRes.Amount.String(),
 				PaymentDurationWeeks: mockRes.PaymentDurationWeeks,
 			},
@@ -250,6 +266,12 @@ func TestServer_MakePayment(t *testing.T) {
 			req:       &v1.MakePaymentRequest{LoanId: uuid.New().String(), PaymentAmount: "invalid"},
 			wantErr:   status.New(codes.InvalidArgument, "invalid payment amount"),
 		},
+		{
+			name:      "invalid payment mode",
+			setupMock: nil,
+			req:       &v1.MakePaymentRequest{LoanId: uuid.New().String(), PaymentAmount: "1000000", Mode: v1.PaymentMode(999)},
+			wantErr:   status.New(codes.InvalidArgument, "invalid payment mode"),
+		},
 		{
 			name: "service error",
 			setupMock: func(mockSvc *mock.MockService) {
@@ -266,6 +288,83 @@ func TestServer_MakePayment(t *testing.T) {
 			req:     &v1.MakePaymentRequest{LoanId: uuid.New().String(), PaymentAmount: "1000000"},
 			wantErr: nil,
 		},
+		{
+			name: "payoff",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().
+					MakePayment(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, cmd service.MakePaymentCommand) (service.LoanDetail, error) {
+						if cmd.Mode != service.PaymentModePayoff {
+							t.Fatalf("expecting payment mode %v, got %v", service.PaymentModePayoff, cmd.Mode)
+						}
+						return mockLoanDetail, nil
+					})
+			},
+			req:     &v1.MakePaymentRequest{LoanId: uuid.New().String(), PaymentAmount: "4400000", Mode: v1.PaymentMode_PAYOFF},
+			wantErr: nil,
+		},
+		{
+			name: "idempotency key conflict",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().
+					MakePayment(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, cmd service.MakePaymentCommand) (service.LoanDetail, error) {
+						if cmd.IdempotencyKey != "payment-1" {
+							t.Fatalf("expecting idempotency key %q, got %q", "payment-1", cmd.IdempotencyKey)
+						}
+						return service.LoanDetail{}, businesserror.New("idempotency key conflict", businesserror.KindConflict)
+					})
+			},
+			req:     &v1.MakePaymentRequest{LoanId: uuid.New().String(), PaymentAmount: "1000000", IdempotencyKey: "payment-1"},
+			wantErr: status.New(codes.Aborted, "idempotency key conflict"),
+		},
+		{
+			name:      "invalid payment channel",
+			setupMock: nil,
+			req:       &v1.MakePaymentRequest{LoanId: uuid.New().String(), PaymentAmount: "1000000", Channel: v1.PaymentChannel(999)},
+			wantErr:   status.New(codes.InvalidArgument, "invalid payment channel"),
+		},
+		{
+			name: "payment details",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().
+					MakePayment(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, cmd service.MakePaymentCommand) (service.LoanDetail, error) {
+						if cmd.ExternalReference != "TRX-1" {
+							t.Fatalf("expecting external reference %q, got %q", "TRX-1", cmd.ExternalReference)
+						}
+						if cmd.Channel != service.PaymentChannelVirtualAccount {
+							t.Fatalf("expecting channel %v, got %v", service.PaymentChannelVirtualAccount, cmd.Channel)
+						}
+						if !cmd.PaidAt.Equal(time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)) {
+							t.Fatalf("expecting paid at %v, got %v", time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), cmd.PaidAt)
+						}
+						if cmd.Metadata["bank"] != "BCA" {
+							t.Fatalf("expecting metadata %v, got %v", map[string]string{"bank": "BCA"}, cmd.Metadata)
+						}
+						return mockLoanDetail, nil
+					})
+			},
+			req: &v1.MakePaymentRequest{
+				LoanId:            uuid.New().String(),
+				PaymentAmount:     "1000000",
+				ExternalReference: "TRX-1",
+				Channel:           v1.PaymentChannel_CHANNEL_VIRTUAL_ACCOUNT,
+				PaidAt:            timestamppb.New(time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)),
+				Metadata:          map[string]string{"bank": "BCA"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "duplicate external reference",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().
+					MakePayment(gomock.Any(), gomock.Any()).
+					Return(service.LoanDetail{}, businesserror.New("duplicate external reference", businesserror.KindAlreadyExists))
+			},
+			req:     &v1.MakePaymentRequest{LoanId: uuid.New().String(), PaymentAmount: "1000000", ExternalReference: "TRX-1", Channel: v1.PaymentChannel_CHANNEL_CARD},
+			wantErr: status.New(codes.AlreadyExists, "duplicate external reference"),
+		},
 	}
 
 	for _, test := range tests {
@@ -298,3 +397,1551 @@ func TestServer_MakePayment(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_ApproveLoan(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockLoan := service.Loan{
+		ID:                   uuid.New(),
+		UserID:               uuid.New(),
+		Amount:               decimal.NewFromInt(5_000_000),
+		PaymentDurationWeeks: 5,
+		PaymentAmount:        decimal.NewFromInt(5_500_000),
+		Status:               service.LoanStatusApproved,
+		CreatedAt:            time.Now(),
+		UpdatedAt:            time.Now(),
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(mockSvc *mock.MockService)
+		req       *v1.ApproveLoanRequest
+		wantErr   *status.Status
+	}{
+		{
+			name:      "invalid loan id",
+			setupMock: nil,
+			req:       &v1.ApproveLoanRequest{LoanId: "invalid"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid loan id"),
+		},
+		{
+			name: "illegal status transition",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ApproveLoan(gomock.Any(), gomock.Any()).Return(service.Loan{}, businesserror.New("illegal transition", businesserror.KindUnprocessableEntity))
+			},
+			req:     &v1.ApproveLoanRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.FailedPrecondition, "illegal transition"),
+		},
+		{
+			name: "service error",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ApproveLoan(gomock.Any(), gomock.Any()).Return(service.Loan{}, service.UnexpectedError)
+			},
+			req:     &v1.ApproveLoanRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.Internal, service.UnexpectedError.Error()),
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ApproveLoan(gomock.Any(), service.ApproveLoanCommand{LoanID: mockLoan.ID}).Return(mockLoan, nil)
+			},
+			req:     &v1.ApproveLoanRequest{LoanId: mockLoan.ID.String()},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			res, err := server.ApproveLoan(ctx, test.req)
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			} else if res.GetStatus() != v1.LoanStatus_APPROVED {
+				t.Fatalf("expecting loan status %v, got %v", v1.LoanStatus_APPROVED, res.GetStatus())
+			}
+		})
+	}
+}
+
+func TestServer_DisburseLoan(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockLoan := service.Loan{
+		ID:                   uuid.New(),
+		UserID:               uuid.New(),
+		Amount:               decimal.NewFromInt(5_000_000),
+		PaymentDurationWeeks: 5,
+		PaymentAmount:        decimal.NewFromInt(5_500_000),
+		Status:               service.LoanStatusDisbursed,
+		CreatedAt:            time.Now(),
+		UpdatedAt:            time.Now(),
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(mockSvc *mock.MockService)
+		req       *v1.DisburseLoanRequest
+		wantErr   *status.Status
+	}{
+		{
+			name:      "invalid loan id",
+			setupMock: nil,
+			req:       &v1.DisburseLoanRequest{LoanId: "invalid"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid loan id"),
+		},
+		{
+			name: "illegal status transition",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().DisburseLoan(gomock.Any(), gomock.Any()).Return(service.Loan{}, businesserror.New("illegal transition", businesserror.KindUnprocessableEntity))
+			},
+			req:     &v1.DisburseLoanRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.FailedPrecondition, "illegal transition"),
+		},
+		{
+			name: "service error",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().DisburseLoan(gomock.Any(), gomock.Any()).Return(service.Loan{}, service.UnexpectedError)
+			},
+			req:     &v1.DisburseLoanRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.Internal, service.UnexpectedError.Error()),
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().DisburseLoan(gomock.Any(), service.DisburseLoanCommand{LoanID: mockLoan.ID}).Return(mockLoan, nil)
+			},
+			req:     &v1.DisburseLoanRequest{LoanId: mockLoan.ID.String()},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			res, err := server.DisburseLoan(ctx, test.req)
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			} else if res.GetStatus() != v1.LoanStatus_DISBURSED {
+				t.Fatalf("expecting loan status %v, got %v", v1.LoanStatus_DISBURSED, res.GetStatus())
+			}
+		})
+	}
+}
+
+func TestServer_RejectLoan(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockLoan := service.Loan{
+		ID:                   uuid.New(),
+		UserID:               uuid.New(),
+		Amount:               decimal.NewFromInt(5_000_000),
+		PaymentDurationWeeks: 5,
+		PaymentAmount:        decimal.NewFromInt(5_500_000),
+		Status:               service.LoanStatusRejected,
+		CreatedAt:            time.Now(),
+		UpdatedAt:            time.Now(),
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(mockSvc *mock.MockService)
+		req       *v1.RejectLoanRequest
+		wantErr   *status.Status
+	}{
+		{
+			name:      "invalid loan id",
+			setupMock: nil,
+			req:       &v1.RejectLoanRequest{LoanId: "invalid"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid loan id"),
+		},
+		{
+			name: "illegal status transition",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().RejectLoan(gomock.Any(), gomock.Any()).Return(service.Loan{}, businesserror.New("illegal transition", businesserror.KindUnprocessableEntity))
+			},
+			req:     &v1.RejectLoanRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.FailedPrecondition, "illegal transition"),
+		},
+		{
+			name: "service error",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().RejectLoan(gomock.Any(), gomock.Any()).Return(service.Loan{}, service.UnexpectedError)
+			},
+			req:     &v1.RejectLoanRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.Internal, service.UnexpectedError.Error()),
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().RejectLoan(gomock.Any(), service.RejectLoanCommand{LoanID: mockLoan.ID}).Return(mockLoan, nil)
+			},
+			req:     &v1.RejectLoanRequest{LoanId: mockLoan.ID.String()},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			res, err := server.RejectLoan(ctx, test.req)
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			} else if res.GetStatus() != v1.LoanStatus_REJECTED {
+				t.Fatalf("expecting loan status %v, got %v", v1.LoanStatus_REJECTED, res.GetStatus())
+			}
+		})
+	}
+}
+
+func TestServer_CancelLoan(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockLoan := service.Loan{
+		ID:                   uuid.New(),
+		UserID:               uuid.New(),
+		Amount:               decimal.NewFromInt(5_000_000),
+		PaymentDurationWeeks: 5,
+		PaymentAmount:        decimal.NewFromInt(5_500_000),
+		Status:               service.LoanStatusCancelled,
+		CreatedAt:            time.Now(),
+		UpdatedAt:            time.Now(),
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(mockSvc *mock.MockService)
+		req       *v1.CancelLoanRequest
+		wantErr   *status.Status
+	}{
+		{
+			name:      "invalid loan id",
+			setupMock: nil,
+			req:       &v1.CancelLoanRequest{LoanId: "invalid"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid loan id"),
+		},
+		{
+			name: "illegal status transition",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().CancelLoan(gomock.Any(), gomock.Any()).Return(service.Loan{}, businesserror.New("illegal transition", businesserror.KindUnprocessableEntity))
+			},
+			req:     &v1.CancelLoanRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.FailedPrecondition, "illegal transition"),
+		},
+		{
+			name: "service error",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().CancelLoan(gomock.Any(), gomock.Any()).Return(service.Loan{}, service.UnexpectedError)
+			},
+			req:     &v1.CancelLoanRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.Internal, service.UnexpectedError.Error()),
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().CancelLoan(gomock.Any(), service.CancelLoanCommand{LoanID: mockLoan.ID}).Return(mockLoan, nil)
+			},
+			req:     &v1.CancelLoanRequest{LoanId: mockLoan.ID.String()},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			res, err := server.CancelLoan(ctx, test.req)
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			} else if res.GetStatus() != v1.LoanStatus_CANCELLED {
+				t.Fatalf("expecting loan status %v, got %v", v1.LoanStatus_CANCELLED, res.GetStatus())
+			}
+		})
+	}
+}
+
+func TestServer_WriteOffLoan(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockLoan := service.Loan{
+		ID:                   uuid.New(),
+		UserID:               uuid.New(),
+		Amount:               decimal.NewFromInt(5_000_000),
+		PaymentDurationWeeks: 5,
+		PaymentAmount:        decimal.NewFromInt(5_500_000),
+		Status:               service.LoanStatusWrittenOff,
+		CreatedAt:            time.Now(),
+		UpdatedAt:            time.Now(),
+		WrittenOffAt:         time.Now(),
+		WrittenOffPrincipal:  decimal.NewFromInt(4_000_000),
+		WrittenOffInterest:   decimal.NewFromInt(400_000),
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(mockSvc *mock.MockService)
+		req       *v1.WriteOffLoanRequest
+		wantErr   *status.Status
+	}{
+		{
+			name:      "invalid loan id",
+			setupMock: nil,
+			req:       &v1.WriteOffLoanRequest{LoanId: "invalid"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid loan id"),
+		},
+		{
+			name: "illegal status transition",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().WriteOffLoan(gomock.Any(), gomock.Any()).Return(service.Loan{}, businesserror.New("illegal transition", businesserror.KindUnprocessableEntity))
+			},
+			req:     &v1.WriteOffLoanRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.FailedPrecondition, "illegal transition"),
+		},
+		{
+			name: "service error",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().WriteOffLoan(gomock.Any(), gomock.Any()).Return(service.Loan{}, service.UnexpectedError)
+			},
+			req:     &v1.WriteOffLoanRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.Internal, service.UnexpectedError.Error()),
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().WriteOffLoan(gomock.Any(), service.WriteOffLoanCommand{LoanID: mockLoan.ID}).Return(mockLoan, nil)
+			},
+			req:     &v1.WriteOffLoanRequest{LoanId: mockLoan.ID.String()},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			res, err := server.WriteOffLoan(ctx, test.req)
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			} else if res.GetStatus() != v1.LoanStatus_WRITTEN_OFF {
+				t.Fatalf("expecting loan status %v, got %v", v1.LoanStatus_WRITTEN_OFF, res.GetStatus())
+			}
+		})
+	}
+}
+
+func TestServer_RestructureLoan(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	interestRate := decimal.RequireFromString("0.05")
+	mockLoan := service.Loan{
+		ID:                    uuid.New(),
+		UserID:                uuid.New(),
+		Amount:                decimal.NewFromInt(5_000_000),
+		InstallmentCount:      13,
+		InterestRate:          interestRate,
+		PaymentAmount:         decimal.NewFromInt(5_775_000),
+		Status:                service.LoanStatusOngoing,
+		CreatedAt:             time.Now(),
+		UpdatedAt:             time.Now(),
+		TermsVersion:          2,
+		RestructuredPrincipal: decimal.NewFromInt(5_500_000),
+		RestructuredFrom:      4,
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(mockSvc *mock.MockService)
+		req       *v1.RestructureLoanRequest
+		wantErr   *status.Status
+	}{
+		{
+			name:      "invalid loan id",
+			setupMock: nil,
+			req:       &v1.RestructureLoanRequest{LoanId: "invalid", InstallmentCount: 10, Reason: "income reduced"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid loan id"),
+		},
+		{
+			name:      "invalid interest rate",
+			setupMock: nil,
+			req:       &v1.RestructureLoanRequest{LoanId: uuid.NewString(), InstallmentCount: 10, InterestRate: "invalid", Reason: "income reduced"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid interest rate"),
+		},
+		{
+			name: "loan not restructurable",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().RestructureLoan(gomock.Any(), gomock.Any()).Return(service.Loan{}, businesserror.New("not restructurable", businesserror.KindUnprocessableEntity))
+			},
+			req:     &v1.RestructureLoanRequest{LoanId: uuid.NewString(), InstallmentCount: 10, Reason: "income reduced"},
+			wantErr: status.New(codes.FailedPrecondition, "not restructurable"),
+		},
+		{
+			name: "service error",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().RestructureLoan(gomock.Any(), gomock.Any()).Return(service.Loan{}, service.UnexpectedError)
+			},
+			req:     &v1.RestructureLoanRequest{LoanId: uuid.NewString(), InstallmentCount: 10, Reason: "income reduced"},
+			wantErr: status.New(codes.Internal, service.UnexpectedError.Error()),
+		},
+		{
+			name: "current interest rate kept",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().RestructureLoan(gomock.Any(), service.RestructureLoanCommand{
+					LoanID:           mockLoan.ID,
+					InstallmentCount: 10,
+					Reason:           "income reduced",
+				}).Return(mockLoan, nil)
+			},
+			req:     &v1.RestructureLoanRequest{LoanId: mockLoan.ID.String(), InstallmentCount: 10, Reason: "income reduced"},
+			wantErr: nil,
+		},
+		{
+			name: "new interest rate",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().RestructureLoan(gomock.Any(), service.RestructureLoanCommand{
+					LoanID:           mockLoan.ID,
+					InstallmentCount: 10,
+					InterestRate:     &interestRate,
+					Reason:           "income reduced",
+				}).Return(mockLoan, nil)
+			},
+			req:     &v1.RestructureLoanRequest{LoanId: mockLoan.ID.String(), InstallmentCount: 10, InterestRate: "0.05", Reason: "income reduced"},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			res, err := server.RestructureLoan(ctx, test.req)
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			} else if res.GetTermsVersion() != 2 || res.GetRestructuredFromInstallment() != 4 {
+				t.Fatalf("expecting terms version 2 restructured from installment 4, got %d and %d", res.GetTermsVersion(), res.GetRestructuredFromInstallment())
+			}
+		})
+	}
+}
+
+func TestServer_GrantPaymentHoliday(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockHoliday := service.PaymentHoliday{
+		ID:                     uuid.New(),
+		LoanID:                 uuid.New(),
+		FirstInstallmentNumber: 4,
+		Periods:                2,
+		StartsAt:               time.Now().AddDate(0, 0, 5),
+		EndsAt:                 time.Now().AddDate(0, 0, 19),
+		Reason:                 "flood relief",
+		CreatedAt:              time.Now(),
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(mockSvc *mock.MockService)
+		req       *v1.GrantPaymentHolidayRequest
+		wantErr   *status.Status
+	}{
+		{
+			name:      "invalid loan id",
+			setupMock: nil,
+			req:       &v1.GrantPaymentHolidayRequest{LoanId: "invalid", Periods: 2, Reason: "flood relief"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid loan id"),
+		},
+		{
+			name: "invalid periods",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().GrantPaymentHoliday(gomock.Any(), gomock.Any()).Return(service.PaymentHoliday{}, businesserror.New("invalid periods", businesserror.KindBadRequest))
+			},
+			req:     &v1.GrantPaymentHolidayRequest{LoanId: uuid.NewString(), Periods: 0, Reason: "flood relief"},
+			wantErr: status.New(codes.InvalidArgument, "invalid periods"),
+		},
+		{
+			name: "loan on payment holiday",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().GrantPaymentHoliday(gomock.Any(), gomock.Any()).Return(service.PaymentHoliday{}, businesserror.New("on payment holiday", businesserror.KindUnprocessableEntity))
+			},
+			req:     &v1.GrantPaymentHolidayRequest{LoanId: uuid.NewString(), Periods: 2, Reason: "flood relief"},
+			wantErr: status.New(codes.FailedPrecondition, "on payment holiday"),
+		},
+		{
+			name: "service error",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().GrantPaymentHoliday(gomock.Any(), gomock.Any()).Return(service.PaymentHoliday{}, service.UnexpectedError)
+			},
+			req:     &v1.GrantPaymentHolidayRequest{LoanId: uuid.NewString(), Periods: 2, Reason: "flood relief"},
+			wantErr: status.New(codes.Internal, service.UnexpectedError.Error()),
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().GrantPaymentHoliday(gomock.Any(), service.GrantPaymentHolidayCommand{
+					LoanID:  mockHoliday.LoanID,
+					Periods: 2,
+					Reason:  "flood relief",
+				}).Return(mockHoliday, nil)
+			},
+			req:     &v1.GrantPaymentHolidayRequest{LoanId: mockHoliday.LoanID.String(), Periods: 2, Reason: "flood relief"},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			res, err := server.GrantPaymentHoliday(ctx, test.req)
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			} else if res.GetId() != mockHoliday.ID.String() || res.GetPeriods() != 2 || res.GetFirstInstallmentNumber() != 4 {
+				t.Fatalf("expecting payment holiday %s of 2 periods deferring installments from 4, got %s, %d and %d",
+					mockHoliday.ID, res.GetId(), res.GetPeriods(), res.GetFirstInstallmentNumber())
+			}
+		})
+	}
+}
+
+func TestServer_GetLoan(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockLoan := service.Loan{
+		ID:                   uuid.New(),
+		UserID:               uuid.New(),
+		Amount:               decimal.NewFromInt(5_000_000),
+		PaymentDurationWeeks: 5,
+		PaymentAmount:        decimal.NewFromInt(5_500_000),
+		Status:               service.LoanStatusPaid,
+		CreatedAt:            time.Now(),
+		UpdatedAt:            time.Now(),
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(mockSvc *mock.MockService)
+		req       *v1.GetLoanRequest
+		wantErr   *status.Status
+	}{
+		{
+			name:      "invalid loan id",
+			setupMock: nil,
+			req:       &v1.GetLoanRequest{LoanId: "invalid"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid loan id"),
+		},
+		{
+			name: "loan not found",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().GetLoan(gomock.Any(), gomock.Any()).Return(service.LoanDetail{}, businesserror.New("loan not found", businesserror.KindNotFound))
+			},
+			req:     &v1.GetLoanRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.NotFound, "loan not found"),
+		},
+		{
+			name: "service error",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().GetLoan(gomock.Any(), gomock.Any()).Return(service.LoanDetail{}, service.UnexpectedError)
+			},
+			req:     &v1.GetLoanRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.Internal, service.UnexpectedError.Error()),
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().GetLoan(gomock.Any(), service.GetLoanQuery{LoanID: mockLoan.ID}).Return(
+					service.LoanDetail{
+						Loan:              mockLoan,
+						OutstandingAmount: decimal.Zero,
+						CurrentBillAmount: decimal.Zero,
+						IsDelinquent:      false,
+						PaidAmount:        mockLoan.PaymentAmount,
+					},
+					nil,
+				)
+			},
+			req:     &v1.GetLoanRequest{LoanId: mockLoan.ID.String()},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			res, err := server.GetLoan(ctx, test.req)
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			} else if res.GetLoan().GetStatus() != v1.LoanStatus_PAID {
+				t.Fatalf("expecting loan status %v, got %v", v1.LoanStatus_PAID, res.GetLoan().GetStatus())
+			}
+		})
+	}
+}
+
+func TestServer_ReversePayment(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	paymentID := uuid.New()
+	mockLoan := service.Loan{
+		ID:                   uuid.New(),
+		UserID:               uuid.New(),
+		Amount:               decimal.NewFromInt(5_000_000),
+		PaymentDurationWeeks: 5,
+		PaymentAmount:        decimal.NewFromInt(5_500_000),
+		Status:               service.LoanStatusOngoing,
+		CreatedAt:            time.Now(),
+		UpdatedAt:            time.Now(),
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(mockSvc *mock.MockService)
+		req       *v1.ReversePaymentRequest
+		wantErr   *status.Status
+	}{
+		{
+			name:      "invalid payment id",
+			setupMock: nil,
+			req:       &v1.ReversePaymentRequest{PaymentId: "invalid", Reason: "chargeback"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid payment id"),
+		},
+		{
+			name: "payment not found",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ReversePayment(gomock.Any(), gomock.Any()).Return(service.LoanDetail{}, businesserror.New("loan payment not found", businesserror.KindNotFound))
+			},
+			req:     &v1.ReversePaymentRequest{PaymentId: uuid.NewString(), Reason: "chargeback"},
+			wantErr: status.New(codes.NotFound, "loan payment not found"),
+		},
+		{
+			name: "payment already reversed",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ReversePayment(gomock.Any(), gomock.Any()).Return(service.LoanDetail{}, businesserror.New("loan payment is already reversed", businesserror.KindUnprocessableEntity))
+			},
+			req:     &v1.ReversePaymentRequest{PaymentId: uuid.NewString(), Reason: "chargeback"},
+			wantErr: status.New(codes.FailedPrecondition, "loan payment is already reversed"),
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ReversePayment(gomock.Any(), service.ReversePaymentCommand{PaymentID: paymentID, Reason: "chargeback"}).Return(
+					service.LoanDetail{
+						Loan:              mockLoan,
+						OutstandingAmount: mockLoan.PaymentAmount,
+						CurrentBillAmount: decimal.NewFromInt(1_100_000),
+						IsDelinquent:      false,
+						PaidAmount:        decimal.Zero,
+					},
+					nil,
+				)
+			},
+			req:     &v1.ReversePaymentRequest{PaymentId: paymentID.String(), Reason: "chargeback"},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			res, err := server.ReversePayment(ctx, test.req)
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			} else if res.GetLoan().GetStatus() != v1.LoanStatus_ONGOING {
+				t.Fatalf("expecting loan status %v, got %v", v1.LoanStatus_ONGOING, res.GetLoan().GetStatus())
+			}
+		})
+	}
+}
+
+func TestServer_ListProducts(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockProduct := service.LoanProduct{
+		ID:            uuid.New(),
+		Name:          "Standard Weekly Loan",
+		MinPrincipal:  decimal.NewFromInt(1_000_000),
+		MaxPrincipal:  decimal.NewFromInt(10_000_000),
+		InterestModel: service.InterestModelFlat,
+		InterestRate:  decimal.NewFromFloat(0.1),
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*mock.MockService)
+		wantLen   int
+		wantErr   *status.Status
+	}{
+		{
+			name: "service error",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ListProducts(gomock.Any(), gomock.Any()).Return(nil, service.UnexpectedError)
+			},
+			wantErr: status.New(codes.Internal, service.UnexpectedError.Error()),
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ListProducts(gomock.Any(), gomock.Any()).Return([]service.LoanProduct{mockProduct}, nil)
+			},
+			wantLen: 1,
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			res, err := server.ListProducts(ctx, &v1.ListProductsRequest{})
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			} else if len(res.GetProducts()) != test.wantLen {
+				t.Fatalf("expecting %d products, got %d", test.wantLen, len(res.GetProducts()))
+			}
+		})
+	}
+}
+
+func TestServer_GetProduct(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockProduct := service.LoanProduct{
+		ID:            uuid.New(),
+		Name:          "Standard Weekly Loan",
+		MinPrincipal:  decimal.NewFromInt(1_000_000),
+		MaxPrincipal:  decimal.NewFromInt(10_000_000),
+		InterestModel: service.InterestModelFlat,
+		InterestRate:  decimal.NewFromFloat(0.1),
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*mock.MockService)
+		req       *v1.GetProductRequest
+		wantErr   *status.Status
+	}{
+		{
+			name:      "invalid product id",
+			setupMock: nil,
+			req:       &v1.GetProductRequest{ProductId: "invalid"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid product id"),
+		},
+		{
+			name: "service error",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().GetProduct(gomock.Any(), gomock.Any()).Return(service.LoanProduct{}, service.UnexpectedError)
+			},
+			req:     &v1.GetProductRequest{ProductId: uuid.NewString()},
+			wantErr: status.New(codes.Internal, service.UnexpectedError.Error()),
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().GetProduct(gomock.Any(), gomock.Any()).Return(mockProduct, nil)
+			},
+			req:     &v1.GetProductRequest{ProductId: mockProduct.ID.String()},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			_, err := server.GetProduct(ctx, test.req)
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			}
+		})
+	}
+}
+
+func TestServer_GetLoanSchedule(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockInstallments := []service.LoanInstallment{
+		{
+			Number:          1,
+			DueDate:         time.Now(),
+			PrincipalAmount: decimal.NewFromInt(1_000_000),
+			InterestAmount:  decimal.NewFromInt(100_000),
+			AmountDue:       decimal.NewFromInt(1_100_000),
+			AmountPaid:      decimal.Zero,
+			Status:          service.LoanInstallmentStatusUnpaid,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*mock.MockService)
+		req       *v1.GetLoanScheduleRequest
+		wantLen   int
+		wantErr   *status.Status
+	}{
+		{
+			name:      "invalid loan id",
+			setupMock: nil,
+			req:       &v1.GetLoanScheduleRequest{LoanId: "invalid"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid loan id"),
+		},
+		{
+			name: "service error",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().GetLoanSchedule(gomock.Any(), gomock.Any()).Return(nil, service.UnexpectedError)
+			},
+			req:     &v1.GetLoanScheduleRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.Internal, service.UnexpectedError.Error()),
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().GetLoanSchedule(gomock.Any(), gomock.Any()).Return(mockInstallments, nil)
+			},
+			req:     &v1.GetLoanScheduleRequest{LoanId: uuid.NewString()},
+			wantLen: 1,
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			res, err := server.GetLoanSchedule(ctx, test.req)
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			} else if len(res.GetInstallments()) != test.wantLen {
+				t.Fatalf("expecting %d installments, got %d", test.wantLen, len(res.GetInstallments()))
+			}
+		})
+	}
+}
+
+func TestServer_GetPayoffQuote(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockQuote := service.PayoffQuote{
+		LoanID:            uuid.New(),
+		OutstandingAmount: decimal.NewFromInt(2_200_000),
+		RebateAmount:      decimal.NewFromInt(100_000),
+		PayoffAmount:      decimal.NewFromInt(2_100_000),
+		QuotedAt:          time.Now(),
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*mock.MockService)
+		req       *v1.GetPayoffQuoteRequest
+		wantErr   *status.Status
+	}{
+		{
+			name:      "invalid loan id",
+			setupMock: nil,
+			req:       &v1.GetPayoffQuoteRequest{LoanId: "invalid"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid loan id"),
+		},
+		{
+			name: "service error",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().GetPayoffQuote(gomock.Any(), gomock.Any()).Return(service.PayoffQuote{}, service.UnexpectedError)
+			},
+			req:     &v1.GetPayoffQuoteRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.Internal, service.UnexpectedError.Error()),
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().GetPayoffQuote(gomock.Any(), gomock.Any()).Return(mockQuote, nil)
+			},
+			req:     &v1.GetPayoffQuoteRequest{LoanId: mockQuote.LoanID.String()},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			res, err := server.GetPayoffQuote(ctx, test.req)
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			} else if res.GetPayoffAmount() != mockQuote.PayoffAmount.String() {
+				t.Fatalf("expecting payoff amount %s, got %s", mockQuote.PayoffAmount, res.GetPayoffAmount())
+			}
+		})
+	}
+}
+
+func TestServer_ListLoanPayments(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockPage := service.LoanPaymentPage{
+		Payments: []service.LoanPayment{
+			{
+				ID:        uuid.New(),
+				LoanID:    uuid.New(),
+				Amount:    decimal.NewFromInt(1_100_000),
+				PaidAt:    time.Now(),
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			},
+		},
+		NextPageToken: "next",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*mock.MockService)
+		req       *v1.ListLoanPaymentsRequest
+		wantLen   int
+		wantErr   *status.Status
+	}{
+		{
+			name:      "invalid loan id",
+			setupMock: nil,
+			req:       &v1.ListLoanPaymentsRequest{LoanId: "invalid"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid loan id"),
+		},
+		{
+			name:      "invalid page size",
+			setupMock: nil,
+			req:       &v1.ListLoanPaymentsRequest{LoanId: uuid.NewString(), PageSize: -1},
+			wantErr:   status.New(codes.InvalidArgument, "invalid page size"),
+		},
+		{
+			name: "invalid page token",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ListLoanPayments(gomock.Any(), gomock.Any()).Return(service.LoanPaymentPage{}, service.ErrInvalidPageToken)
+			},
+			req:     &v1.ListLoanPaymentsRequest{LoanId: uuid.NewString(), PageToken: "invalid"},
+			wantErr: status.New(codes.InvalidArgument, service.ErrInvalidPageToken.Error()),
+		},
+		{
+			name: "service error",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ListLoanPayments(gomock.Any(), gomock.Any()).Return(service.LoanPaymentPage{}, service.UnexpectedError)
+			},
+			req:     &v1.ListLoanPaymentsRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.Internal, service.UnexpectedError.Error()),
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().
+					ListLoanPayments(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, query service.ListLoanPaymentsQuery) (service.LoanPaymentPage, error) {
+						if query.PageToken != "token" || query.PageSize != 10 {
+							t.Fatalf("expecting page token %q and size %d, got %q and %d", "token", 10, query.PageToken, query.PageSize)
+						}
+						return mockPage, nil
+					})
+			},
+			req:     &v1.ListLoanPaymentsRequest{LoanId: uuid.NewString(), PageToken: "token", PageSize: 10},
+			wantLen: 1,
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			res, err := server.ListLoanPayments(ctx, test.req)
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			} else if len(res.GetPayments()) != test.wantLen {
+				t.Fatalf("expecting %d payments, got %d", test.wantLen, len(res.GetPayments()))
+			} else if res.GetNextPageToken() != mockPage.NextPageToken {
+				t.Fatalf("expecting next page token %q, got %q", mockPage.NextPageToken, res.GetNextPageToken())
+			}
+		})
+	}
+}
+
+func TestServer_ListUserLoans(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockPage := service.LoanSummaryPage{
+		Loans: []service.LoanSummary{
+			{
+				Loan: service.Loan{
+					ID:                   uuid.New(),
+					UserID:               uuid.New(),
+					Amount:               decimal.NewFromInt(5_000_000),
+					PaymentDurationWeeks: 5,
+					PaymentAmount:        decimal.NewFromInt(5_500_000),
+					Status:               service.LoanStatusPaid,
+					CreatedAt:            time.Now(),
+					UpdatedAt:            time.Now(),
+				},
+				PaidAmount: decimal.NewFromInt(5_500_000),
+			},
+		},
+		NextPageToken: "next",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*mock.MockService)
+		req       *v1.ListUserLoansRequest
+		wantLen   int
+		wantErr   *status.Status
+	}{
+		{
+			name:      "invalid user id",
+			setupMock: nil,
+			req:       &v1.ListUserLoansRequest{UserId: "invalid"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid user id"),
+		},
+		{
+			name:      "invalid loan status",
+			setupMock: nil,
+			req:       &v1.ListUserLoansRequest{UserId: uuid.NewString(), StatusFilter: []v1.LoanStatus{v1.LoanStatus(999)}},
+			wantErr:   status.New(codes.InvalidArgument, "invalid loan status"),
+		},
+		{
+			name:      "invalid page size",
+			setupMock: nil,
+			req:       &v1.ListUserLoansRequest{UserId: uuid.NewString(), PageSize: -1},
+			wantErr:   status.New(codes.InvalidArgument, "invalid page size"),
+		},
+		{
+			name: "service error",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ListUserLoans(gomock.Any(), gomock.Any()).Return(service.LoanSummaryPage{}, service.UnexpectedError)
+			},
+			req:     &v1.ListUserLoansRequest{UserId: uuid.NewString()},
+			wantErr: status.New(codes.Internal, service.UnexpectedError.Error()),
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().
+					ListUserLoans(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, query service.ListUserLoansQuery) (service.LoanSummaryPage, error) {
+						if len(query.Statuses) != 1 || query.Statuses[0] != service.LoanStatusPaid {
+							t.Fatalf("expecting status filter %v, got %v", []service.LoanStatus{service.LoanStatusPaid}, query.Statuses)
+						}
+						return mockPage, nil
+					})
+			},
+			req:     &v1.ListUserLoansRequest{UserId: uuid.NewString(), StatusFilter: []v1.LoanStatus{v1.LoanStatus_PAID}},
+			wantLen: 1,
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			res, err := server.ListUserLoans(ctx, test.req)
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			} else if len(res.GetLoans()) != test.wantLen {
+				t.Fatalf("expecting %d loans, got %d", test.wantLen, len(res.GetLoans()))
+			} else if res.GetLoans()[0].GetPaidAmount() != "5500000" {
+				t.Fatalf("expecting paid amount %q, got %q", "5500000", res.GetLoans()[0].GetPaidAmount())
+			}
+		})
+	}
+}
+
+func TestServer_ListLoanTerms(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	loanID := uuid.New()
+	mockTerms := []service.LoanTerms{
+		{
+			LoanID:                 loanID,
+			Version:                1,
+			Principal:              decimal.NewFromInt(5_000_000),
+			InterestRate:           decimal.NewFromFloat(0.1),
+			InstallmentCount:       5,
+			PaymentAmount:          decimal.NewFromInt(5_500_000),
+			FirstInstallmentNumber: 1,
+			CreatedAt:              time.Now(),
+		},
+		{
+			LoanID:                 loanID,
+			Version:                2,
+			Principal:              decimal.NewFromInt(5_500_000),
+			InterestRate:           decimal.Zero,
+			InstallmentCount:       10,
+			PaymentAmount:          decimal.NewFromInt(5_500_000),
+			FirstInstallmentNumber: 4,
+			Reason:                 "income reduced",
+			CreatedAt:              time.Now(),
+		},
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*mock.MockService)
+		req       *v1.ListLoanTermsRequest
+		wantLen   int
+		wantErr   *status.Status
+	}{
+		{
+			name:      "invalid loan id",
+			setupMock: nil,
+			req:       &v1.ListLoanTermsRequest{LoanId: "invalid"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid loan id"),
+		},
+		{
+			name: "loan not found",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ListLoanTerms(gomock.Any(), gomock.Any()).Return(nil, businesserror.New("loan not found", businesserror.KindNotFound))
+			},
+			req:     &v1.ListLoanTermsRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.NotFound, "loan not found"),
+		},
+		{
+			name: "service error",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ListLoanTerms(gomock.Any(), gomock.Any()).Return(nil, service.UnexpectedError)
+			},
+			req:     &v1.ListLoanTermsRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.Internal, service.UnexpectedError.Error()),
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ListLoanTerms(gomock.Any(), service.ListLoanTermsQuery{LoanID: loanID}).Return(mockTerms, nil)
+			},
+			req:     &v1.ListLoanTermsRequest{LoanId: loanID.String()},
+			wantLen: 2,
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			res, err := server.ListLoanTerms(ctx, test.req)
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			} else if len(res.GetTerms()) != test.wantLen || res.GetLoanId() != loanID.String() {
+				t.Fatalf("expecting %d versions of the terms of loan %s, got %d of loan %s", test.wantLen, loanID, len(res.GetTerms()), res.GetLoanId())
+			}
+		})
+	}
+}
+
+func TestServer_ListPaymentHolidays(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	loanID := uuid.New()
+	mockHolidays := []service.PaymentHoliday{
+		{
+			ID:                     uuid.New(),
+			LoanID:                 loanID,
+			FirstInstallmentNumber: 4,
+			Periods:                2,
+			StartsAt:               time.Now().AddDate(0, 0, -30),
+			EndsAt:                 time.Now().AddDate(0, 0, -16),
+			Reason:                 "flood relief",
+			CreatedAt:              time.Now().AddDate(0, 0, -32),
+		},
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*mock.MockService)
+		req       *v1.ListPaymentHolidaysRequest
+		wantLen   int
+		wantErr   *status.Status
+	}{
+		{
+			name:      "invalid loan id",
+			setupMock: nil,
+			req:       &v1.ListPaymentHolidaysRequest{LoanId: "invalid"},
+			wantErr:   status.New(codes.InvalidArgument, "invalid loan id"),
+		},
+		{
+			name: "loan not found",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ListPaymentHolidays(gomock.Any(), gomock.Any()).Return(nil, businesserror.New("loan not found", businesserror.KindNotFound))
+			},
+			req:     &v1.ListPaymentHolidaysRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.NotFound, "loan not found"),
+		},
+		{
+			name: "service error",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ListPaymentHolidays(gomock.Any(), gomock.Any()).Return(nil, service.UnexpectedError)
+			},
+			req:     &v1.ListPaymentHolidaysRequest{LoanId: uuid.NewString()},
+			wantErr: status.New(codes.Internal, service.UnexpectedError.Error()),
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockSvc *mock.MockService) {
+				mockSvc.EXPECT().ListPaymentHolidays(gomock.Any(), service.ListPaymentHolidaysQuery{LoanID: loanID}).Return(mockHolidays, nil)
+			},
+			req:     &v1.ListPaymentHolidaysRequest{LoanId: loanID.String()},
+			wantLen: 1,
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mock.NewMockService(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockSvc)
+			}
+
+			server := NewServer(mockSvc)
+			res, err := server.ListPaymentHolidays(ctx, test.req)
+			if err != nil {
+				statusErr, ok := status.FromError(err)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if test.wantErr.Message() != statusErr.Message() {
+					t.Fatalf("expecting error message %q, got %q", test.wantErr.Message(), statusErr.Message())
+				}
+				if test.wantErr.Code() != statusErr.Code() {
+					t.Fatalf("expecting error code %v, got %v", test.wantErr.Code(), statusErr.Code())
+				}
+			} else if err == nil && test.wantErr != nil {
+				t.Fatal("expecting error not to be nil")
+			} else if len(res.GetHolidays()) != test.wantLen || res.GetLoanId() != loanID.String() {
+				t.Fatalf("expecting %d payment holidays of loan %s, got %d of loan %s", test.wantLen, loanID, len(res.GetHolidays()), res.GetLoanId())
+			}
+		})
+	}
+}
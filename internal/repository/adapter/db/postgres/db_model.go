@@ -1,77 +1,658 @@
 package postgres
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/huandu/go-sqlbuilder"
+	"github.com/lib/pq"
 	"github.com/shopspring/decimal"
 
 	"github.com/axopadyani/billing-engine/internal/entity"
 )
 
 const (
-	loansTable        = "loans"
-	loanPaymentsTable = "loan_payments"
+	loansTable            = "loans"
+	loanPaymentsTable     = "loan_payments"
+	loanProductsTable     = "loan_products"
+	loanInstallmentsTable = "loan_installments"
+	loanChargesTable      = "loan_charges"
+	loanTermsTable        = "loan_terms"
+	paymentHolidaysTable  = "payment_holidays"
+
+	loanPaymentAllocationsTable       = "loan_payment_allocations"
+	loanPaymentChargeAllocationsTable = "loan_payment_charge_allocations"
+	loanPaymentReversalsTable         = "loan_payment_reversals"
+	userCreditEntriesTable            = "user_credit_entries"
 )
 
 // postgresLoan represents a loan record in the PostgreSQL database.
 type postgresLoan struct {
-	ID                   uuid.UUID       `db:"id"`
-	UserID               uuid.UUID       `db:"user_id"`
-	Amount               decimal.Decimal `db:"amount"`
-	PaymentDurationWeeks int32           `db:"payment_duration_weeks"`
-	PaymentAmount        decimal.Decimal `db:"payment_amount"`
-	Status               int             `db:"status"`
-	CreatedAt            time.Time       `db:"created_at"`
-	UpdatedAt            time.Time       `db:"updated_at"`
+	ID                uuid.UUID       `db:"id"`
+	UserID            uuid.UUID       `db:"user_id"`
+	ProductID         uuid.UUID       `db:"product_id"`
+	Amount            decimal.Decimal `db:"amount"`
+	BillingFrequency  int             `db:"billing_frequency"`
+	InstallmentCount  int32           `db:"installment_count"`
+	InterestModel     int             `db:"interest_model"`
+	InterestRate      decimal.Decimal `db:"interest_rate"`
+	PaymentAmount     decimal.Decimal `db:"payment_amount"`
+	RoundingMethod    int             `db:"rounding_method"`
+	RoundingPrecision int32           `db:"rounding_precision"`
+	AcceptanceMode    int             `db:"payment_acceptance_mode"`
+	OverpaymentPolicy int             `db:"overpayment_policy"`
+	OriginationFee    decimal.Decimal `db:"origination_fee"`
+	LateFeeMethod     int             `db:"late_fee_method"`
+	LateFeeAmount     decimal.Decimal `db:"late_fee_amount"`
+	LateFeeCap        decimal.Decimal `db:"late_fee_cap"`
+	RebateRate        decimal.Decimal `db:"early_settlement_rebate_rate"`
+	RebateAmount      decimal.Decimal `db:"rebate_amount"`
+	GracePeriodDays   int32           `db:"grace_period_days"`
+	CoolingOffDays    int32           `db:"cooling_off_days"`
+	DefaultAfterWeeks int32           `db:"default_after_weeks"`
+	BillingTimezone   string          `db:"billing_timezone"`
+	WeekStartDay      int             `db:"week_start_day"`
+	MissedThreshold   int32           `db:"delinquency_threshold"`
+	PartialRule       int             `db:"delinquency_partial_payment_rule"`
+	Status            int             `db:"status"`
+	IdempotencyKey    sql.NullString  `db:"idempotency_key"`
+	CreatedAt         time.Time       `db:"created_at"`
+	UpdatedAt         time.Time       `db:"updated_at"`
+	ApprovedAt        sql.NullTime    `db:"approved_at"`
+	DisbursedAt       sql.NullTime    `db:"disbursed_at"`
+	DefaultedAt       sql.NullTime    `db:"defaulted_at"`
+	WrittenOffAt      sql.NullTime    `db:"written_off_at"`
+	WriteOffPrincipal decimal.Decimal `db:"written_off_principal"`
+	WriteOffInterest  decimal.Decimal `db:"written_off_interest"`
+	WriteOffCharges   decimal.Decimal `db:"written_off_charges"`
+	RecoveredAmount   decimal.Decimal `db:"recovered_amount"`
+	TermsVersion      int32           `db:"terms_version"`
+	TermsPrincipal    decimal.Decimal `db:"restructured_principal"`
+	RestructuredFrom  int32           `db:"restructured_from_installment"`
 }
 
 var loanStruct = sqlbuilder.NewStruct(new(postgresLoan))
 
 func toPostgresLoan(loan *entity.Loan) *postgresLoan {
+	billingCalendar := entity.DefaultBillingCalendar()
+	if loan.BillingCalendar != nil {
+		billingCalendar = *loan.BillingCalendar
+	}
+
+	delinquencyRule := entity.DefaultDelinquencyRule()
+	if loan.DelinquencyRule != nil {
+		delinquencyRule = *loan.DelinquencyRule
+	}
+
 	return &postgresLoan{
-		ID:                   loan.ID,
-		UserID:               loan.UserID,
-		Amount:               loan.Amount,
-		PaymentDurationWeeks: loan.PaymentDurationWeeks,
-		PaymentAmount:        loan.PaymentAmount,
-		Status:               int(loan.Status),
-		CreatedAt:            loan.CreatedAt,
-		UpdatedAt:            loan.UpdatedAt,
+		ID:                loan.ID,
+		UserID:            loan.UserID,
+		ProductID:         loan.ProductID,
+		Amount:            loan.Amount,
+		BillingFrequency:  int(loan.BillingFrequency),
+		InstallmentCount:  loan.InstallmentCount,
+		InterestModel:     int(loan.InterestModel),
+		InterestRate:      loan.InterestRate,
+		PaymentAmount:     loan.PaymentAmount,
+		RoundingMethod:    int(loan.RoundingPolicy.Method),
+		RoundingPrecision: loan.RoundingPolicy.Precision,
+		AcceptanceMode:    int(loan.PaymentAcceptanceMode),
+		OverpaymentPolicy: int(loan.OverpaymentPolicy),
+		OriginationFee:    loan.OriginationFee,
+		LateFeeMethod:     int(loan.LateFeePolicy.Method),
+		LateFeeAmount:     loan.LateFeePolicy.Amount,
+		LateFeeCap:        loan.LateFeePolicy.Cap,
+		RebateRate:        loan.EarlySettlementRebateRate,
+		RebateAmount:      loan.RebateAmount,
+		GracePeriodDays:   loan.GracePeriodDays,
+		CoolingOffDays:    loan.CoolingOffDays,
+		DefaultAfterWeeks: loan.DefaultAfterWeeks,
+		BillingTimezone:   billingCalendar.Timezone,
+		WeekStartDay:      int(billingCalendar.WeekStartDay),
+		MissedThreshold:   delinquencyRule.MissedInstallmentThreshold,
+		PartialRule:       int(delinquencyRule.PartialPaymentRule),
+		Status:            int(loan.Status),
+		IdempotencyKey:    toNullString(loan.IdempotencyKey),
+		CreatedAt:         loan.CreatedAt,
+		UpdatedAt:         loan.UpdatedAt,
+		ApprovedAt:        toNullTime(loan.ApprovedAt),
+		DisbursedAt:       toNullTime(loan.DisbursedAt),
+		DefaultedAt:       toNullTime(loan.DefaultedAt),
+		WrittenOffAt:      toNullTime(loan.WrittenOffAt),
+		WriteOffPrincipal: loan.WrittenOffPrincipal,
+		WriteOffInterest:  loan.WrittenOffInterest,
+		WriteOffCharges:   loan.WrittenOffCharges,
+		RecoveredAmount:   loan.RecoveredAmount,
+		TermsVersion:      loan.TermsVersion,
+		TermsPrincipal:    loan.RestructuredPrincipal,
+		RestructuredFrom:  loan.RestructuredFromInstallment,
 	}
 }
 
 func (l postgresLoan) toEntityLoan() *entity.Loan {
 	return &entity.Loan{
-		ID:                   l.ID,
-		UserID:               l.UserID,
-		Amount:               l.Amount,
-		PaymentDurationWeeks: l.PaymentDurationWeeks,
-		PaymentAmount:        l.PaymentAmount,
-		Status:               entity.LoanStatus(l.Status),
-		CreatedAt:            l.CreatedAt,
-		UpdatedAt:            l.UpdatedAt,
+		ID:               l.ID,
+		UserID:           l.UserID,
+		ProductID:        l.ProductID,
+		Amount:           l.Amount,
+		BillingFrequency: entity.BillingFrequency(l.BillingFrequency),
+		InstallmentCount: l.InstallmentCount,
+		InterestModel:    entity.InterestModel(l.InterestModel),
+		InterestRate:     l.InterestRate,
+		PaymentAmount:    l.PaymentAmount,
+		RoundingPolicy: entity.RoundingPolicy{
+			Method:    entity.RoundingMethod(l.RoundingMethod),
+			Precision: l.RoundingPrecision,
+		},
+		PaymentAcceptanceMode: entity.PaymentAcceptanceMode(l.AcceptanceMode),
+		OverpaymentPolicy:     entity.OverpaymentPolicy(l.OverpaymentPolicy),
+		OriginationFee:        l.OriginationFee,
+		LateFeePolicy: entity.LateFeePolicy{
+			Method: entity.LateFeeMethod(l.LateFeeMethod),
+			Amount: l.LateFeeAmount,
+			Cap:    l.LateFeeCap,
+		},
+		EarlySettlementRebateRate: l.RebateRate,
+		RebateAmount:              l.RebateAmount,
+		GracePeriodDays:           l.GracePeriodDays,
+		CoolingOffDays:            l.CoolingOffDays,
+		DefaultAfterWeeks:         l.DefaultAfterWeeks,
+		BillingCalendar: &entity.BillingCalendar{
+			Timezone:     l.BillingTimezone,
+			WeekStartDay: time.Weekday(l.WeekStartDay),
+		},
+		DelinquencyRule: &entity.DelinquencyRule{
+			MissedInstallmentThreshold: l.MissedThreshold,
+			PartialPaymentRule:         entity.PartialPaymentRule(l.PartialRule),
+		},
+		Status:                      entity.LoanStatus(l.Status),
+		IdempotencyKey:              l.IdempotencyKey.String,
+		CreatedAt:                   l.CreatedAt,
+		UpdatedAt:                   l.UpdatedAt,
+		ApprovedAt:                  l.ApprovedAt.Time,
+		DisbursedAt:                 l.DisbursedAt.Time,
+		DefaultedAt:                 l.DefaultedAt.Time,
+		WrittenOffAt:                l.WrittenOffAt.Time,
+		WrittenOffPrincipal:         l.WriteOffPrincipal,
+		WrittenOffInterest:          l.WriteOffInterest,
+		WrittenOffCharges:           l.WriteOffCharges,
+		RecoveredAmount:             l.RecoveredAmount,
+		TermsVersion:                l.TermsVersion,
+		RestructuredPrincipal:       l.TermsPrincipal,
+		RestructuredFromInstallment: l.RestructuredFrom,
 	}
 }
 
 // postgresLoanPayment represents a loan payment record in the PostgreSQL database.
 type postgresLoanPayment struct {
+	ID                uuid.UUID        `db:"id"`
+	LoanID            uuid.UUID        `db:"loan_id"`
+	Amount            decimal.Decimal  `db:"amount"`
+	ExcessAmount      decimal.Decimal  `db:"excess_amount"`
+	ExcessHandling    int              `db:"excess_handling"`
+	PaymentMode       int              `db:"payment_mode"`
+	IdempotencyKey    sql.NullString   `db:"idempotency_key"`
+	ExternalReference sql.NullString   `db:"external_reference"`
+	Channel           int              `db:"channel"`
+	PaidAt            time.Time        `db:"paid_at"`
+	Metadata          postgresMetadata `db:"metadata"`
+	ReversedAt        sql.NullTime     `db:"reversed_at"`
+	CreatedAt         time.Time        `db:"created_at"`
+	UpdatedAt         time.Time        `db:"updated_at"`
+}
+
+var loanPaymentStruct = sqlbuilder.NewStruct(new(postgresLoanPayment))
+
+func toPostgresLoanPayment(loanPayment *entity.LoanPayment) *postgresLoanPayment {
+	return &postgresLoanPayment{
+		ID:                loanPayment.ID,
+		LoanID:            loanPayment.LoanID,
+		Amount:            loanPayment.Amount,
+		ExcessAmount:      loanPayment.ExcessAmount,
+		ExcessHandling:    int(loanPayment.ExcessHandling),
+		PaymentMode:       int(loanPayment.Mode),
+		IdempotencyKey:    toNullString(loanPayment.IdempotencyKey),
+		ExternalReference: toNullString(loanPayment.Details.ExternalReference),
+		Channel:           int(loanPayment.Details.Channel),
+		PaidAt:            loanPayment.Details.PaidAt,
+		Metadata:          loanPayment.Details.Metadata,
+		ReversedAt:        toNullTime(loanPayment.ReversedAt),
+		CreatedAt:         loanPayment.CreatedAt,
+		UpdatedAt:         loanPayment.UpdatedAt,
+	}
+}
+
+func (p postgresLoanPayment) toEntityLoanPayment() *entity.LoanPayment {
+	return &entity.LoanPayment{
+		ID:             p.ID,
+		LoanID:         p.LoanID,
+		Amount:         p.Amount,
+		ExcessAmount:   p.ExcessAmount,
+		ExcessHandling: entity.OverpaymentPolicy(p.ExcessHandling),
+		Mode:           entity.PaymentMode(p.PaymentMode),
+		IdempotencyKey: p.IdempotencyKey.String,
+		Details: entity.PaymentDetails{
+			ExternalReference: p.ExternalReference.String,
+			Channel:           entity.PaymentChannel(p.Channel),
+			PaidAt:            p.PaidAt,
+			Metadata:          p.Metadata,
+		},
+		ReversedAt: p.ReversedAt.Time,
+		CreatedAt:  p.CreatedAt,
+		UpdatedAt:  p.UpdatedAt,
+	}
+}
+
+// postgresLoanPaymentAllocation represents the portion of a loan payment applied to an installment
+// in the PostgreSQL database.
+type postgresLoanPaymentAllocation struct {
+	PaymentID         uuid.UUID       `db:"payment_id"`
+	InstallmentNumber int32           `db:"installment_number"`
+	Amount            decimal.Decimal `db:"amount"`
+}
+
+var loanPaymentAllocationStruct = sqlbuilder.NewStruct(new(postgresLoanPaymentAllocation))
+
+func toPostgresLoanPaymentAllocation(paymentID uuid.UUID, allocation entity.InstallmentAllocation) *postgresLoanPaymentAllocation {
+	return &postgresLoanPaymentAllocation{
+		PaymentID:         paymentID,
+		InstallmentNumber: allocation.InstallmentNumber,
+		Amount:            allocation.Amount,
+	}
+}
+
+func (a postgresLoanPaymentAllocation) toEntityInstallmentAllocation() entity.InstallmentAllocation {
+	return entity.InstallmentAllocation{
+		InstallmentNumber: a.InstallmentNumber,
+		Amount:            a.Amount,
+	}
+}
+
+// postgresLoanPaymentChargeAllocation represents the portion of a loan payment applied to a charge
+// in the PostgreSQL database.
+type postgresLoanPaymentChargeAllocation struct {
+	PaymentID uuid.UUID       `db:"payment_id"`
+	ChargeID  uuid.UUID       `db:"charge_id"`
+	Amount    decimal.Decimal `db:"amount"`
+}
+
+var loanPaymentChargeAllocationStruct = sqlbuilder.NewStruct(new(postgresLoanPaymentChargeAllocation))
+
+func toPostgresLoanPaymentChargeAllocation(paymentID uuid.UUID, allocation entity.ChargeAllocation) *postgresLoanPaymentChargeAllocation {
+	return &postgresLoanPaymentChargeAllocation{
+		PaymentID: paymentID,
+		ChargeID:  allocation.ChargeID,
+		Amount:    allocation.Amount,
+	}
+}
+
+func (a postgresLoanPaymentChargeAllocation) toEntityChargeAllocation() entity.ChargeAllocation {
+	return entity.ChargeAllocation{
+		ChargeID: a.ChargeID,
+		Amount:   a.Amount,
+	}
+}
+
+// postgresLoanPaymentReversal represents a loan payment reversal record in the PostgreSQL database.
+type postgresLoanPaymentReversal struct {
 	ID        uuid.UUID       `db:"id"`
+	PaymentID uuid.UUID       `db:"payment_id"`
 	LoanID    uuid.UUID       `db:"loan_id"`
 	Amount    decimal.Decimal `db:"amount"`
+	Reason    string          `db:"reason"`
 	CreatedAt time.Time       `db:"created_at"`
-	UpdatedAt time.Time       `db:"updated_at"`
 }
 
-var loanPaymentStruct = sqlbuilder.NewStruct(new(postgresLoanPayment))
+var loanPaymentReversalStruct = sqlbuilder.NewStruct(new(postgresLoanPaymentReversal))
 
-func toPostgresLoanPayment(loanPayment *entity.LoanPayment) *postgresLoanPayment {
-	return &postgresLoanPayment{
-		ID:        loanPayment.ID,
-		LoanID:    loanPayment.LoanID,
-		Amount:    loanPayment.Amount,
-		CreatedAt: loanPayment.CreatedAt,
-		UpdatedAt: loanPayment.UpdatedAt,
+func toPostgresLoanPaymentReversal(reversal *entity.LoanPaymentReversal) *postgresLoanPaymentReversal {
+	return &postgresLoanPaymentReversal{
+		ID:        reversal.ID,
+		PaymentID: reversal.PaymentID,
+		LoanID:    reversal.LoanID,
+		Amount:    reversal.Amount,
+		Reason:    reversal.Reason,
+		CreatedAt: reversal.CreatedAt,
+	}
+}
+
+// postgresCreditEntry represents a change to the credit balance of a user in the PostgreSQL database.
+type postgresCreditEntry struct {
+	ID        uuid.UUID       `db:"id"`
+	UserID    uuid.UUID       `db:"user_id"`
+	LoanID    uuid.UUID       `db:"loan_id"`
+	PaymentID uuid.UUID       `db:"payment_id"`
+	EntryType int             `db:"entry_type"`
+	Amount    decimal.Decimal `db:"amount"`
+	CreatedAt time.Time       `db:"created_at"`
+}
+
+var creditEntryStruct = sqlbuilder.NewStruct(new(postgresCreditEntry))
+
+func toPostgresCreditEntry(entry *entity.CreditEntry) *postgresCreditEntry {
+	return &postgresCreditEntry{
+		ID:        entry.ID,
+		UserID:    entry.UserID,
+		LoanID:    entry.LoanID,
+		PaymentID: entry.PaymentID,
+		EntryType: int(entry.Type),
+		Amount:    entry.Amount,
+		CreatedAt: entry.CreatedAt,
 	}
 }
+
+// postgresLoanInstallment represents a loan installment record in the PostgreSQL database.
+type postgresLoanInstallment struct {
+	LoanID            uuid.UUID       `db:"loan_id"`
+	InstallmentNumber int32           `db:"installment_number"`
+	DueDate           time.Time       `db:"due_date"`
+	PrincipalAmount   decimal.Decimal `db:"principal_amount"`
+	InterestAmount    decimal.Decimal `db:"interest_amount"`
+	AmountDue         decimal.Decimal `db:"amount_due"`
+	AmountPaid        decimal.Decimal `db:"amount_paid"`
+	Status            int             `db:"status"`
+	CreatedAt         time.Time       `db:"created_at"`
+	UpdatedAt         time.Time       `db:"updated_at"`
+}
+
+var loanInstallmentStruct = sqlbuilder.NewStruct(new(postgresLoanInstallment))
+
+func toPostgresLoanInstallment(installment *entity.LoanInstallment) *postgresLoanInstallment {
+	return &postgresLoanInstallment{
+		LoanID:            installment.LoanID,
+		InstallmentNumber: installment.Number,
+		DueDate:           installment.DueDate,
+		PrincipalAmount:   installment.PrincipalAmount,
+		InterestAmount:    installment.InterestAmount,
+		AmountDue:         installment.AmountDue,
+		AmountPaid:        installment.AmountPaid,
+		Status:            int(installment.Status),
+		CreatedAt:         installment.CreatedAt,
+		UpdatedAt:         installment.UpdatedAt,
+	}
+}
+
+func (i postgresLoanInstallment) toEntityLoanInstallment() *entity.LoanInstallment {
+	return &entity.LoanInstallment{
+		LoanID:          i.LoanID,
+		Number:          i.InstallmentNumber,
+		DueDate:         i.DueDate,
+		PrincipalAmount: i.PrincipalAmount,
+		InterestAmount:  i.InterestAmount,
+		AmountDue:       i.AmountDue,
+		AmountPaid:      i.AmountPaid,
+		Status:          entity.LoanInstallmentStatus(i.Status),
+		CreatedAt:       i.CreatedAt,
+		UpdatedAt:       i.UpdatedAt,
+	}
+}
+
+// postgresLoanCharge represents a loan charge record in the PostgreSQL database.
+type postgresLoanCharge struct {
+	ID                uuid.UUID       `db:"id"`
+	LoanID            uuid.UUID       `db:"loan_id"`
+	ChargeType        int             `db:"charge_type"`
+	InstallmentNumber int32           `db:"installment_number"`
+	Amount            decimal.Decimal `db:"amount"`
+	AmountPaid        decimal.Decimal `db:"amount_paid"`
+	CapitalizedAmount decimal.Decimal `db:"capitalized_amount"`
+	CreatedAt         time.Time       `db:"created_at"`
+	UpdatedAt         time.Time       `db:"updated_at"`
+}
+
+var loanChargeStruct = sqlbuilder.NewStruct(new(postgresLoanCharge))
+
+func toPostgresLoanCharge(charge *entity.LoanCharge) *postgresLoanCharge {
+	return &postgresLoanCharge{
+		ID:                charge.ID,
+		LoanID:            charge.LoanID,
+		ChargeType:        int(charge.Type),
+		InstallmentNumber: charge.InstallmentNumber,
+		Amount:            charge.Amount,
+		AmountPaid:        charge.AmountPaid,
+		CapitalizedAmount: charge.CapitalizedAmount,
+		CreatedAt:         charge.CreatedAt,
+		UpdatedAt:         charge.UpdatedAt,
+	}
+}
+
+func (c postgresLoanCharge) toEntityLoanCharge() *entity.LoanCharge {
+	return &entity.LoanCharge{
+		ID:                c.ID,
+		LoanID:            c.LoanID,
+		Type:              entity.LoanChargeType(c.ChargeType),
+		InstallmentNumber: c.InstallmentNumber,
+		Amount:            c.Amount,
+		AmountPaid:        c.AmountPaid,
+		CapitalizedAmount: c.CapitalizedAmount,
+		CreatedAt:         c.CreatedAt,
+		UpdatedAt:         c.UpdatedAt,
+	}
+}
+
+// postgresLoanTerms represents a version of the terms of a loan in the PostgreSQL database.
+type postgresLoanTerms struct {
+	LoanID                 uuid.UUID       `db:"loan_id"`
+	Version                int32           `db:"version"`
+	Principal              decimal.Decimal `db:"principal"`
+	InterestRate           decimal.Decimal `db:"interest_rate"`
+	InstallmentCount       int32           `db:"installment_count"`
+	PaymentAmount          decimal.Decimal `db:"payment_amount"`
+	FirstInstallmentNumber int32           `db:"first_installment_number"`
+	CapitalizedCharges     decimal.Decimal `db:"capitalized_charges"`
+	Reason                 string          `db:"reason"`
+	CreatedAt              time.Time       `db:"created_at"`
+}
+
+var loanTermsStruct = sqlbuilder.NewStruct(new(postgresLoanTerms))
+
+func toPostgresLoanTerms(terms *entity.LoanTerms) *postgresLoanTerms {
+	return &postgresLoanTerms{
+		LoanID:                 terms.LoanID,
+		Version:                terms.Version,
+		Principal:              terms.Principal,
+		InterestRate:           terms.InterestRate,
+		InstallmentCount:       terms.InstallmentCount,
+		PaymentAmount:          terms.PaymentAmount,
+		FirstInstallmentNumber: terms.FirstInstallmentNumber,
+		CapitalizedCharges:     terms.CapitalizedCharges,
+		Reason:                 terms.Reason,
+		CreatedAt:              terms.CreatedAt,
+	}
+}
+
+func (t postgresLoanTerms) toEntityLoanTerms() *entity.LoanTerms {
+	return &entity.LoanTerms{
+		LoanID:                 t.LoanID,
+		Version:                t.Version,
+		Principal:              t.Principal,
+		InterestRate:           t.InterestRate,
+		InstallmentCount:       t.InstallmentCount,
+		PaymentAmount:          t.PaymentAmount,
+		FirstInstallmentNumber: t.FirstInstallmentNumber,
+		CapitalizedCharges:     t.CapitalizedCharges,
+		Reason:                 t.Reason,
+		CreatedAt:              t.CreatedAt,
+	}
+}
+
+// postgresPaymentHoliday represents a payment holiday granted on a loan in the PostgreSQL database.
+type postgresPaymentHoliday struct {
+	ID                     uuid.UUID `db:"id"`
+	LoanID                 uuid.UUID `db:"loan_id"`
+	FirstInstallmentNumber int32     `db:"first_installment_number"`
+	StartPeriod            int32     `db:"start_period"`
+	Periods                int32     `db:"periods"`
+	StartsAt               time.Time `db:"starts_at"`
+	EndsAt                 time.Time `db:"ends_at"`
+	Reason                 string    `db:"reason"`
+	CreatedAt              time.Time `db:"created_at"`
+}
+
+var paymentHolidayStruct = sqlbuilder.NewStruct(new(postgresPaymentHoliday))
+
+func toPostgresPaymentHoliday(holiday *entity.PaymentHoliday) *postgresPaymentHoliday {
+	return &postgresPaymentHoliday{
+		ID:                     holiday.ID,
+		LoanID:                 holiday.LoanID,
+		FirstInstallmentNumber: holiday.FirstInstallmentNumber,
+		StartPeriod:            holiday.StartPeriod,
+		Periods:                holiday.Periods,
+		StartsAt:               holiday.StartsAt,
+		EndsAt:                 holiday.EndsAt,
+		Reason:                 holiday.Reason,
+		CreatedAt:              holiday.CreatedAt,
+	}
+}
+
+func (h postgresPaymentHoliday) toEntityPaymentHoliday() *entity.PaymentHoliday {
+	return &entity.PaymentHoliday{
+		ID:                     h.ID,
+		LoanID:                 h.LoanID,
+		FirstInstallmentNumber: h.FirstInstallmentNumber,
+		StartPeriod:            h.StartPeriod,
+		Periods:                h.Periods,
+		StartsAt:               h.StartsAt,
+		EndsAt:                 h.EndsAt,
+		Reason:                 h.Reason,
+		CreatedAt:              h.CreatedAt,
+	}
+}
+
+// postgresLoanProduct represents a loan product record in the PostgreSQL database.
+type postgresLoanProduct struct {
+	ID                  uuid.UUID       `db:"id"`
+	Name                string          `db:"name"`
+	MinPrincipal        decimal.Decimal `db:"min_principal"`
+	MaxPrincipal        decimal.Decimal `db:"max_principal"`
+	BillingFrequency    int             `db:"billing_frequency"`
+	AllowedInstallments pq.Int32Array   `db:"allowed_installment_counts"`
+	InterestModel       int             `db:"interest_model"`
+	InterestRate        decimal.Decimal `db:"interest_rate"`
+	RoundingMethod      int             `db:"rounding_method"`
+	RoundingPrecision   int32           `db:"rounding_precision"`
+	AcceptanceMode      int             `db:"payment_acceptance_mode"`
+	OverpaymentPolicy   int             `db:"overpayment_policy"`
+	OriginationFeeRate  decimal.Decimal `db:"origination_fee_rate"`
+	OriginationFeeFixed decimal.Decimal `db:"origination_fee_fixed"`
+	LateFeeMethod       int             `db:"late_fee_method"`
+	LateFeeAmount       decimal.Decimal `db:"late_fee_amount"`
+	LateFeeCap          decimal.Decimal `db:"late_fee_cap"`
+	RebateRate          decimal.Decimal `db:"early_settlement_rebate_rate"`
+	GracePeriodDays     int32           `db:"grace_period_days"`
+	CoolingOffDays      int32           `db:"cooling_off_days"`
+	DefaultAfterWeeks   int32           `db:"default_after_weeks"`
+	BillingTimezone     sql.NullString  `db:"billing_timezone"`
+	WeekStartDay        sql.NullInt16   `db:"week_start_day"`
+	MissedThreshold     sql.NullInt16   `db:"delinquency_threshold"`
+	PartialRule         sql.NullInt16   `db:"delinquency_partial_payment_rule"`
+	CreatedAt           time.Time       `db:"created_at"`
+	UpdatedAt           time.Time       `db:"updated_at"`
+}
+
+var loanProductStruct = sqlbuilder.NewStruct(new(postgresLoanProduct))
+
+func (p postgresLoanProduct) toEntityLoanProduct() *entity.LoanProduct {
+	var billingCalendar *entity.BillingCalendar
+	if p.BillingTimezone.Valid && p.WeekStartDay.Valid {
+		billingCalendar = &entity.BillingCalendar{
+			Timezone:     p.BillingTimezone.String,
+			WeekStartDay: time.Weekday(p.WeekStartDay.Int16),
+		}
+	}
+
+	var delinquencyRule *entity.DelinquencyRule
+	if p.MissedThreshold.Valid && p.PartialRule.Valid {
+		delinquencyRule = &entity.DelinquencyRule{
+			MissedInstallmentThreshold: int32(p.MissedThreshold.Int16),
+			PartialPaymentRule:         entity.PartialPaymentRule(p.PartialRule.Int16),
+		}
+	}
+
+	return &entity.LoanProduct{
+		ID:                       p.ID,
+		Name:                     p.Name,
+		MinPrincipal:             p.MinPrincipal,
+		MaxPrincipal:             p.MaxPrincipal,
+		BillingFrequency:         entity.BillingFrequency(p.BillingFrequency),
+		AllowedInstallmentCounts: p.AllowedInstallments,
+		InterestModel:            entity.InterestModel(p.InterestModel),
+		InterestRate:             p.InterestRate,
+		RoundingPolicy: entity.RoundingPolicy{
+			Method:    entity.RoundingMethod(p.RoundingMethod),
+			Precision: p.RoundingPrecision,
+		},
+		PaymentAcceptanceMode: entity.PaymentAcceptanceMode(p.AcceptanceMode),
+		OverpaymentPolicy:     entity.OverpaymentPolicy(p.OverpaymentPolicy),
+		FeeSchedule: entity.FeeSchedule{
+			OriginationFeeRate:  p.OriginationFeeRate,
+			OriginationFeeFixed: p.OriginationFeeFixed,
+			LateFeePolicy: entity.LateFeePolicy{
+				Method: entity.LateFeeMethod(p.LateFeeMethod),
+				Amount: p.LateFeeAmount,
+				Cap:    p.LateFeeCap,
+			},
+		},
+		EarlySettlementRebateRate: p.RebateRate,
+		GracePeriodDays:           p.GracePeriodDays,
+		CoolingOffDays:            p.CoolingOffDays,
+		DefaultAfterWeeks:         p.DefaultAfterWeeks,
+		BillingCalendar:           billingCalendar,
+		DelinquencyRule:           delinquencyRule,
+		CreatedAt:                 p.CreatedAt,
+		UpdatedAt:                 p.UpdatedAt,
+	}
+}
+
+// toNullString converts an optional string to a sql.NullString, storing NULL for the empty string.
+func toNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// toNullTime converts an optional time to a sql.NullTime, storing NULL for the zero time.
+func toNullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+// postgresMetadata represents free-form metadata stored in a JSONB column.
+type postgresMetadata map[string]string
+
+// Value encodes the metadata as a JSON object, storing an empty object for nil metadata.
+func (m postgresMetadata) Value() (driver.Value, error) {
+	if m == nil {
+		return []byte("{}"), nil
+	}
+
+	return json.Marshal(m)
+}
+
+// Scan decodes the metadata from a JSON object, leaving it nil for an empty object.
+func (m *postgresMetadata) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		*m = nil
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into metadata", src)
+	}
+
+	var metadata map[string]string
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return err
+	}
+	if len(metadata) == 0 {
+		metadata = nil
+	}
+
+	*m = metadata
+	return nil
+}
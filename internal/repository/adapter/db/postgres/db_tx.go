@@ -3,13 +3,34 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 
+	"github.com/lib/pq"
 	"go.uber.org/multierr"
+
+	"github.com/axopadyani/billing-engine/internal/repository"
+)
+
+const (
+	maxTransactionAttempts = 2 // Number of times a transaction is run before its conflict is returned
+
+	uniqueViolationCode      = "23505" // SQLSTATE of a unique constraint violation
+	serializationFailureCode = "40001" // SQLSTATE of a serialization failure
 )
 
+// idempotencyKeyConstraints is the set of unique constraints on the idempotency keys of the requests.
+var idempotencyKeyConstraints = map[string]bool{
+	"loans_user_id_idempotency_key_key":         true,
+	"loan_payments_loan_id_idempotency_key_key": true,
+}
+
+// errLoanChargeConflict is returned when a charge accrued on a loan has already been stored by a concurrent transaction.
+var errLoanChargeConflict = errors.New("loan charge has been stored by a concurrent transaction")
+
 // executor is an interface for database executor, which should be implemented by *sql.DB and *sql.Tx.
 type executor interface {
 	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
 	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
 }
 
@@ -37,3 +58,55 @@ func finishTransaction(err error, tx *sql.Tx) error {
 		return nil
 	}
 }
+
+// retryTransaction runs a serializable transaction, and runs it again when it conflicts with a concurrent transaction,
+// so that the data changed by the other transaction is reloaded.
+//
+// Parameters:
+//   - fn: The function running the transaction, which reloads its data every time it is run.
+//
+// Returns:
+//   - An error returned by the last run of the transaction, nil if it succeeds.
+func retryTransaction(fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if attempt == maxTransactionAttempts || !isTransactionConflict(err) {
+			return err
+		}
+	}
+}
+
+// isTransactionConflict checks whether a transaction failed because of a concurrent transaction, either by a charge
+// it accrued on a loan being already stored or by a serialization failure.
+//
+// Parameters:
+//   - err: The error returned by the transaction.
+//
+// Returns:
+//   - A boolean indicating whether running the transaction again may succeed.
+func isTransactionConflict(err error) bool {
+	if errors.Is(err, errLoanChargeConflict) {
+		return true
+	}
+
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == serializationFailureCode
+}
+
+// toIdempotencyKeyError converts the violation of the unique constraint on an idempotency key, raised when
+// a concurrent request with the same idempotency key has been stored first, to repository.ErrIdempotencyKeyUsed.
+//
+// Parameters:
+//   - err: The error returned by the insert of the request's record.
+//
+// Returns:
+//   - repository.ErrIdempotencyKeyUsed if the error is a violation of an idempotency key constraint,
+//     the original error otherwise.
+func toIdempotencyKeyError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode && idempotencyKeyConstraints[pqErr.Constraint] {
+		return repository.ErrIdempotencyKeyUsed
+	}
+
+	return err
+}
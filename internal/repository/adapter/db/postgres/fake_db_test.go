@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+)
+
+// fakeResult is the scripted result of a statement run against a fakeConnector.
+type fakeResult struct {
+	// columns is the names of the columns of the rows returned by a query.
+	columns []string
+
+	// rows is the rows returned by a query, empty if it returns none.
+	rows [][]driver.Value
+
+	// err is the error the statement fails with, nil if it succeeds.
+	err error
+}
+
+// fakeConnector is a database connector answering the statements run through it with its scripted results in order,
+// and counting the transactions committed and rolled back.
+type fakeConnector struct {
+	t         *testing.T
+	results   []fakeResult
+	commits   int
+	rollbacks int
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{connector: c}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver {
+	return fakeDriver{}
+}
+
+// next returns the next scripted result, failing the test if the script has run out.
+func (c *fakeConnector) next(query string) fakeResult {
+	if len(c.results) == 0 {
+		c.t.Errorf("unexpected statement %q", query)
+		return fakeResult{err: errors.New("unexpected statement")}
+	}
+
+	result := c.results[0]
+	c.results = c.results[1:]
+	return result
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("fake driver can only be used through its connector")
+}
+
+type fakeConn struct {
+	connector *fakeConnector
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("fake connection does not support prepared statements")
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return &fakeTx{connector: c.connector}, nil
+}
+
+func (c *fakeConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	return &fakeTx{connector: c.connector}, nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	result := c.connector.next(query)
+	if result.err != nil {
+		return nil, result.err
+	}
+
+	return &fakeRows{columns: result.columns, rows: result.rows}, nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	result := c.connector.next(query)
+	if result.err != nil {
+		return nil, result.err
+	}
+
+	return driver.RowsAffected(1), nil
+}
+
+type fakeTx struct {
+	connector *fakeConnector
+}
+
+func (tx *fakeTx) Commit() error {
+	tx.connector.commits++
+	return nil
+}
+
+func (tx *fakeTx) Rollback() error {
+	tx.connector.rollbacks++
+	return nil
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
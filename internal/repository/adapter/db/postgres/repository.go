@@ -4,12 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/huandu/go-sqlbuilder"
 	"github.com/shopspring/decimal"
 
 	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/repository"
 )
 
 // Repository represents a data access layer for interacting with a PostgreSQL database.
@@ -39,9 +41,13 @@ func NewRepository(db *sql.DB) *Repository {
 //
 // The function executes the following steps:
 // 1. Starts a new transaction with serializable isolation level.
-// 2. Retrieves the latest loan for the user.
-// 3. Validates the new loan using the provided validation function.
-// 4. Inserts the new loan into the database if validation passes.
+// 2. Checks that the user has not created a loan with the idempotency key of the loan yet.
+// 3. Retrieves the latest loan for the user.
+// 4. Validates the new loan using the provided validation function.
+// 5. Inserts the new loan, its installments and the first version of its terms into the database if validation passes.
+//
+// If the transaction conflicts with a concurrent one, such as a concurrent retry of the request, it is rolled back
+// and run again.
 //
 // Parameters:
 //   - ctx: A context.Context for handling cancellation and timeouts.
@@ -51,18 +57,33 @@ func NewRepository(db *sql.DB) *Repository {
 // Returns:
 //
 //	An error if any step in the process fails, including database errors, validation errors,
-//	or transaction errors. Returns nil if the loan is successfully created.
+//	or transaction errors. Returns repository.ErrIdempotencyKeyUsed if the user has already created a loan
+//	with the idempotency key, or nil if the loan is successfully created.
 func (r *Repository) CreateLoan(
 	ctx context.Context,
 	loan *entity.Loan,
 	validateFn func(latestLoan *entity.Loan) error,
 ) error {
+	return retryTransaction(func() error {
+		return r.createLoan(ctx, loan, validateFn)
+	})
+}
+
+func (r *Repository) createLoan(
+	ctx context.Context,
+	loan *entity.Loan,
+	validateFn func(latestLoan *entity.Loan) error,
+) (err error) {
 	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
 		return err
 	}
 	defer func() { err = finishTransaction(err, tx) }()
 
+	if err = ensureUnusedLoanIdempotencyKey(ctx, tx, loan); err != nil {
+		return err
+	}
+
 	latestLoan, err := getLatestLoan(ctx, tx, loan.UserID)
 	if err != nil {
 		return err
@@ -73,10 +94,102 @@ func (r *Repository) CreateLoan(
 	}
 
 	query, args := loanStruct.InsertInto(loansTable, toPostgresLoan(loan)).BuildWithFlavor(sqlbuilder.PostgreSQL)
-	_, err = tx.ExecContext(ctx, query, args...)
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return toIdempotencyKeyError(err)
+	}
+
+	if err = insertLoanInstallments(ctx, tx, loan.Installments); err != nil {
+		return err
+	}
+
+	return insertLoanTerms(ctx, tx, loan.InitialTerms())
+}
+
+func ensureUnusedLoanIdempotencyKey(ctx context.Context, executor executor, loan *entity.Loan) error {
+	if loan.IdempotencyKey == "" {
+		return nil
+	}
+
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select("1").
+		From(loansTable).
+		Where(sb.Equal("user_id", loan.UserID), sb.Equal("idempotency_key", loan.IdempotencyKey)).
+		Limit(1)
+
+	return ensureUnusedIdempotencyKey(ctx, executor, sb)
+}
+
+func insertLoanInstallments(ctx context.Context, executor executor, installments []*entity.LoanInstallment) error {
+	if len(installments) == 0 {
+		return nil
+	}
+
+	pgInstallments := make([]interface{}, 0, len(installments))
+	for _, installment := range installments {
+		pgInstallments = append(pgInstallments, toPostgresLoanInstallment(installment))
+	}
+
+	query, args := loanInstallmentStruct.InsertInto(loanInstallmentsTable, pgInstallments...).BuildWithFlavor(sqlbuilder.PostgreSQL)
+	_, err := executor.ExecContext(ctx, query, args...)
 	return err
 }
 
+// GetLoanProduct retrieves a loan product by its ID from the database.
+//
+// Parameters:
+//   - ctx: A context.Context for handling cancellation and timeouts.
+//   - productID: The UUID of the loan product to be retrieved.
+//
+// Returns:
+//   - *entity.LoanProduct: The loan product entity if found, or nil if it does not exist.
+//   - error: An error object if any database operation fails, or nil if successful.
+func (r *Repository) GetLoanProduct(ctx context.Context, productID uuid.UUID) (*entity.LoanProduct, error) {
+	sb := loanProductStruct.SelectFrom(loanProductsTable)
+	query, args := sb.Where(sb.Equal("id", productID)).BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	var pgProduct postgresLoanProduct
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(loanProductStruct.Addr(&pgProduct)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return pgProduct.toEntityLoanProduct(), nil
+}
+
+// ListLoanProducts retrieves all the loan products from the database, ordered by their name.
+//
+// Parameters:
+//   - ctx: A context.Context for handling cancellation and timeouts.
+//
+// Returns:
+//   - []*entity.LoanProduct: The loan product entities, or an empty slice if there is none.
+//   - error: An error object if any database operation fails, or nil if successful.
+func (r *Repository) ListLoanProducts(ctx context.Context) ([]*entity.LoanProduct, error) {
+	query, args := loanProductStruct.SelectFrom(loanProductsTable).
+		OrderBy("name").
+		BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	products := make([]*entity.LoanProduct, 0)
+	for rows.Next() {
+		var pgProduct postgresLoanProduct
+		if err = rows.Scan(loanProductStruct.Addr(&pgProduct)...); err != nil {
+			return nil, err
+		}
+
+		products = append(products, pgProduct.toEntityLoanProduct())
+	}
+
+	return products, rows.Err()
+}
+
 // GetLatestLoan retrieves the most recent loan for a given user from the database.
 //
 // This function constructs and executes a SQL query to fetch the latest loan
@@ -109,13 +222,231 @@ func getLatestLoan(ctx context.Context, executor executor, userID uuid.UUID) (*e
 		return nil, err
 	}
 
-	return pgLoan.toEntityLoan(), nil
+	loan := pgLoan.toEntityLoan()
+	loan.Installments, err = getLoanInstallments(ctx, executor, loan.ID)
+	if err != nil {
+		return nil, err
+	}
+	loan.Charges, err = getLoanCharges(ctx, executor, loan.ID)
+	if err != nil {
+		return nil, err
+	}
+	loan.PaymentHolidays, err = getPaymentHolidays(ctx, executor, loan.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return loan, nil
+}
+
+// ListUserLoans retrieves a page of the loans of a user from the database, newest first,
+// along with the total amount paid for each loan.
+//
+// The loans are paged by their keys: a page continues after the creation time and ID of the last loan
+// of the previous page, so loans created while paging do not shift the pages. The installments and charges
+// of the loans are not retrieved.
+//
+// Parameters:
+//   - ctx: A context.Context for handling cancellation and timeouts.
+//   - userID: The UUID of the user whose loans are being retrieved.
+//   - statuses: The statuses of the loans to be retrieved, or empty to retrieve the loans of any status.
+//   - beforeID: The UUID of the last loan of the previous page, or uuid.Nil to retrieve the first page.
+//   - limit: The maximum number of loans to be retrieved.
+//
+// Returns:
+//   - []*entity.Loan: The loan entities, or an empty slice if there is none.
+//   - map[uuid.UUID]decimal.Decimal: The total amount paid for each loan by its ID, missing for the loans without payments.
+//   - error: An error object if any database operation fails, or nil if successful.
+func (r *Repository) ListUserLoans(
+	ctx context.Context,
+	userID uuid.UUID,
+	statuses []entity.LoanStatus,
+	beforeID uuid.UUID,
+	limit int,
+) ([]*entity.Loan, map[uuid.UUID]decimal.Decimal, error) {
+	sb := loanStruct.SelectFrom(loansTable)
+	sb.Where(sb.Equal("user_id", userID))
+	if len(statuses) > 0 {
+		pgStatuses := make([]interface{}, 0, len(statuses))
+		for _, status := range statuses {
+			pgStatuses = append(pgStatuses, int(status))
+		}
+		sb.Where(sb.In("status", pgStatuses...))
+	}
+	if beforeID != uuid.Nil {
+		sb.Where(fmt.Sprintf("(created_at, id) < (SELECT created_at, id FROM %s WHERE id = %s)", loansTable, sb.Var(beforeID)))
+	}
+	query, args := sb.OrderBy("created_at DESC", "id DESC").
+		Limit(limit).
+		BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	loans := make([]*entity.Loan, 0)
+	for rows.Next() {
+		var pgLoan postgresLoan
+		if err = rows.Scan(loanStruct.Addr(&pgLoan)...); err != nil {
+			return nil, nil, err
+		}
+
+		loans = append(loans, pgLoan.toEntityLoan())
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	paidAmounts, err := getLoansPaidAmounts(ctx, r.db, loans)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return loans, paidAmounts, nil
+}
+
+func getLoansPaidAmounts(ctx context.Context, executor executor, loans []*entity.Loan) (map[uuid.UUID]decimal.Decimal, error) {
+	paidAmounts := make(map[uuid.UUID]decimal.Decimal, len(loans))
+	if len(loans) == 0 {
+		return paidAmounts, nil
+	}
+
+	loanIDs := make([]interface{}, 0, len(loans))
+	for _, loan := range loans {
+		loanIDs = append(loanIDs, loan.ID)
+	}
+
+	sb := sqlbuilder.NewSelectBuilder()
+	query, args := sb.Select("loan_id", "SUM(amount)").
+		From(loanPaymentsTable).
+		Where(sb.In("loan_id", loanIDs...), sb.IsNull("reversed_at"), sb.NotEqual("payment_mode", int(entity.PaymentModeRecovery))).
+		GroupBy("loan_id").
+		BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	rows, err := executor.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var loanID uuid.UUID
+		var paidAmount decimal.Decimal
+		if err = rows.Scan(&loanID, &paidAmount); err != nil {
+			return nil, err
+		}
+
+		paidAmounts[loanID] = paidAmount
+	}
+
+	return paidAmounts, rows.Err()
+}
+
+// GetLoanInstallments retrieves the repayment schedule of a loan from the database.
+//
+// Parameters:
+//   - ctx: A context.Context for handling cancellation and timeouts.
+//   - loanID: The UUID of the loan whose installments are being retrieved.
+//
+// Returns:
+//   - []*entity.LoanInstallment: The installments of the loan ordered by their number, or an empty slice if there is none.
+//   - error: An error object if any database operation fails, or nil if successful.
+func (r *Repository) GetLoanInstallments(ctx context.Context, loanID uuid.UUID) ([]*entity.LoanInstallment, error) {
+	return getLoanInstallments(ctx, r.db, loanID)
+}
+
+func getLoanInstallments(ctx context.Context, executor executor, loanID uuid.UUID) ([]*entity.LoanInstallment, error) {
+	sb := loanInstallmentStruct.SelectFrom(loanInstallmentsTable)
+	query, args := sb.Where(sb.Equal("loan_id", loanID)).
+		OrderBy("installment_number").
+		BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	rows, err := executor.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	installments := make([]*entity.LoanInstallment, 0)
+	for rows.Next() {
+		var pgInstallment postgresLoanInstallment
+		if err = rows.Scan(loanInstallmentStruct.Addr(&pgInstallment)...); err != nil {
+			return nil, err
+		}
+
+		installments = append(installments, pgInstallment.toEntityLoanInstallment())
+	}
+
+	return installments, rows.Err()
+}
+
+func getLoanCharges(ctx context.Context, executor executor, loanID uuid.UUID) ([]*entity.LoanCharge, error) {
+	sb := loanChargeStruct.SelectFrom(loanChargesTable)
+	query, args := sb.Where(sb.Equal("loan_id", loanID)).
+		OrderBy("created_at", "installment_number").
+		BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	rows, err := executor.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	charges := make([]*entity.LoanCharge, 0)
+	for rows.Next() {
+		var pgCharge postgresLoanCharge
+		if err = rows.Scan(loanChargeStruct.Addr(&pgCharge)...); err != nil {
+			return nil, err
+		}
+
+		charges = append(charges, pgCharge.toEntityLoanCharge())
+	}
+
+	return charges, rows.Err()
+}
+
+func insertLoanCharges(ctx context.Context, executor executor, charges []*entity.LoanCharge) error {
+	if len(charges) == 0 {
+		return nil
+	}
+
+	pgCharges := make([]interface{}, 0, len(charges))
+	for _, charge := range charges {
+		pgCharges = append(pgCharges, toPostgresLoanCharge(charge))
+	}
+
+	query, args := loanChargeStruct.InsertInto(loanChargesTable, pgCharges...).
+		SQL("ON CONFLICT DO NOTHING RETURNING id").
+		BuildWithFlavor(sqlbuilder.PostgreSQL)
+	rows, err := executor.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	inserted := 0
+	for rows.Next() {
+		inserted++
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	// a charge not inserted has been accrued and stored by a concurrent billing of the loan
+	if inserted < len(charges) {
+		return errLoanChargeConflict
+	}
+
+	return nil
 }
 
 // GetLoanPaidAmount retrieves the total amount paid for a specific loan.
 //
 // This function constructs and executes a SQL query to calculate the sum of all
-// payments made for the given loan ID.
+// payments made for the given loan ID, except the reversed ones and the recovery payments
+// made after the loan was written off, which are tracked in the recovered amount of the loan.
 //
 // Parameters:
 //   - ctx: A context.Context for handling cancellation and timeouts.
@@ -130,11 +461,16 @@ func (r *Repository) GetLoanPaidAmount(ctx context.Context, loanID uuid.UUID) (d
 
 func getLoanPaidAmount(ctx context.Context, executor executor, loanID uuid.UUID) (decimal.Decimal, error) {
 	sb := sqlbuilder.NewSelectBuilder()
-	query, args := sb.Select("SUM(amount)").
+	sb.Select("SUM(amount)").
 		From(loanPaymentsTable).
-		Where(sb.Equal("loan_id", loanID)).
-		GroupBy("loan_id").
-		BuildWithFlavor(sqlbuilder.PostgreSQL)
+		Where(sb.Equal("loan_id", loanID), sb.IsNull("reversed_at"), sb.NotEqual("payment_mode", int(entity.PaymentModeRecovery))).
+		GroupBy("loan_id")
+
+	return sumLoanPaidAmount(ctx, executor, sb)
+}
+
+func sumLoanPaidAmount(ctx context.Context, executor executor, sb *sqlbuilder.SelectBuilder) (decimal.Decimal, error) {
+	query, args := sb.BuildWithFlavor(sqlbuilder.PostgreSQL)
 
 	var sum decimal.Decimal
 	err := executor.QueryRowContext(ctx, query, args...).Scan(&sum)
@@ -147,31 +483,201 @@ func getLoanPaidAmount(ctx context.Context, executor executor, loanID uuid.UUID)
 	return sum, nil
 }
 
+// GetLoanPaymentByIdempotencyKey retrieves the payment made towards a loan by a request with the given idempotency key.
+//
+// Parameters:
+//   - ctx: A context.Context for handling cancellation and timeouts.
+//   - loanID: The UUID of the loan the payment was made towards.
+//   - idempotencyKey: The idempotency key of the request.
+//
+// Returns:
+//   - *entity.LoanPayment: The loan payment entity if found, or nil if no payment was made with the key.
+//   - error: An error object if any database operation fails, or nil if successful.
+func (r *Repository) GetLoanPaymentByIdempotencyKey(ctx context.Context, loanID uuid.UUID, idempotencyKey string) (*entity.LoanPayment, error) {
+	sb := loanPaymentStruct.SelectFrom(loanPaymentsTable)
+	query, args := sb.Where(
+		sb.Equal("loan_id", loanID),
+		sb.Equal("idempotency_key", idempotencyKey),
+	).BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	var pgPayment postgresLoanPayment
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(loanPaymentStruct.Addr(&pgPayment)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return pgPayment.toEntityLoanPayment(), nil
+}
+
+// ListLoanPayments retrieves a page of the payments made towards a loan from the database, newest first,
+// along with the installments and charges each payment was allocated to.
+//
+// The payments are paged by their keys: the payment IDs are UUIDv7, so the payments made before a payment
+// are the ones with an ID lower than it.
+//
+// Parameters:
+//   - ctx: A context.Context for handling cancellation and timeouts.
+//   - loanID: The UUID of the loan whose payments are being retrieved.
+//   - beforeID: The UUID of the last payment of the previous page, or uuid.Nil to retrieve the first page.
+//   - limit: The maximum number of payments to be retrieved.
+//
+// Returns:
+//   - []*entity.LoanPayment: The loan payment entities, or an empty slice if there is none.
+//   - error: An error object if any database operation fails, or nil if successful.
+func (r *Repository) ListLoanPayments(ctx context.Context, loanID uuid.UUID, beforeID uuid.UUID, limit int) ([]*entity.LoanPayment, error) {
+	sb := loanPaymentStruct.SelectFrom(loanPaymentsTable)
+	sb.Where(sb.Equal("loan_id", loanID))
+	if beforeID != uuid.Nil {
+		sb.Where(sb.LessThan("id", beforeID))
+	}
+	query, args := sb.OrderBy("id").Desc().
+		Limit(limit).
+		BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	payments := make([]*entity.LoanPayment, 0)
+	for rows.Next() {
+		var pgPayment postgresLoanPayment
+		if err = rows.Scan(loanPaymentStruct.Addr(&pgPayment)...); err != nil {
+			return nil, err
+		}
+
+		payments = append(payments, pgPayment.toEntityLoanPayment())
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err = getLoanPaymentAllocations(ctx, r.db, payments); err != nil {
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+func getLoanPaymentAllocations(ctx context.Context, executor executor, payments []*entity.LoanPayment) error {
+	if len(payments) == 0 {
+		return nil
+	}
+
+	paymentsByID := make(map[uuid.UUID]*entity.LoanPayment, len(payments))
+	paymentIDs := make([]interface{}, 0, len(payments))
+	for _, payment := range payments {
+		paymentsByID[payment.ID] = payment
+		paymentIDs = append(paymentIDs, payment.ID)
+	}
+
+	sb := loanPaymentAllocationStruct.SelectFrom(loanPaymentAllocationsTable)
+	query, args := sb.Where(sb.In("payment_id", paymentIDs...)).
+		OrderBy("payment_id", "installment_number").
+		BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	rows, err := executor.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pgAllocation postgresLoanPaymentAllocation
+		if err = rows.Scan(loanPaymentAllocationStruct.Addr(&pgAllocation)...); err != nil {
+			return err
+		}
+
+		payment := paymentsByID[pgAllocation.PaymentID]
+		payment.Allocations = append(payment.Allocations, pgAllocation.toEntityInstallmentAllocation())
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	csb := loanPaymentChargeAllocationStruct.SelectFrom(loanPaymentChargeAllocationsTable)
+	query, args = csb.Where(csb.In("payment_id", paymentIDs...)).
+		OrderBy("payment_id", "charge_id").
+		BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	chargeRows, err := executor.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer chargeRows.Close()
+
+	for chargeRows.Next() {
+		var pgAllocation postgresLoanPaymentChargeAllocation
+		if err = chargeRows.Scan(loanPaymentChargeAllocationStruct.Addr(&pgAllocation)...); err != nil {
+			return err
+		}
+
+		payment := paymentsByID[pgAllocation.PaymentID]
+		payment.ChargeAllocations = append(payment.ChargeAllocations, pgAllocation.toEntityChargeAllocation())
+	}
+
+	return chargeRows.Err()
+}
+
 // MakePayment processes a payment for a loan, updates the loan record if necessary, and returns the updated loan information.
 //
 // This function performs the following operations within a transaction:
-// 1. Retrieves the loan information.
-// 2. Calculates the current paid amount for the loan.
-// 3. Executes the provided makePaymentFn to process the payment.
-// 4. Inserts a new loan payment record.
-// 5. Updates the loan record if required.
+// 1. Checks that no payment has been made towards the loan with the idempotency key yet, before the payment
+// is processed, so that a concurrent retry of the request is replayed rather than rejected by the loan.
+// 2. Retrieves the loan information.
+// 3. Calculates the current paid amount for the loan and the credit balance of its user.
+// 4. Executes the provided makePaymentFn to bill the loan and process the payment.
+// 5. Inserts the charges newly accrued on the loan by the billing, and the payment made from the credit balance
+// by the billing, if any.
+// 6. Checks that the external reference of the payment is not used yet in its channel.
+// 7. Inserts the payment, along with its credit entry and allocations, and updates the installments and charges
+// it is allocated to.
+// 8. Updates the loan record if required.
+//
+// If the transaction conflicts with a concurrent one, such as a concurrent billing of the loan storing a charge
+// accrued by the billing or a concurrent retry of the request, it is rolled back and run again, so that the loan
+// is reloaded along with the changes of the other transaction.
 //
 // Parameters:
 //   - ctx: A context.Context for handling cancellation and timeouts.
 //   - loanID: The UUID of the loan for which the payment is being made.
 //   - paymentAmount: The amount of the payment being made, as a decimal.Decimal.
-//   - makePaymentFn: A function that processes the payment, determines if the loan should be updated,
-//     and returns the payment details. It takes the current loan and paid amount as arguments.
+//   - idempotencyKey: The idempotency key of the request, empty if the request is not idempotent.
+//   - makePaymentFn: A function that bills the loan and processes the payment, determines if the loan should be updated,
+//     and returns the billing and the payment details. It takes the current loan, paid amount and credit balance as arguments.
 //
 // Returns:
 //   - loan: An entity.Loan instance representing the updated loan information.
-//   - newPaidAmount: A decimal.Decimal representing the new total paid amount for the loan after this payment.
-//   - err: An error object if any step in the process fails, or nil if the payment is successfully processed.
+//   - newPaidAmount: A decimal.Decimal representing the new total paid amount for the loan after the credit payment
+//     and this payment.
+//   - err: An error object if any step in the process fails, entity.ErrLoanNotFound if the loan does not exist,
+//     repository.ErrIdempotencyKeyUsed if a payment has already been made towards the loan with the idempotency key,
+//     or nil if the payment is successfully processed.
 func (r *Repository) MakePayment(
 	ctx context.Context,
 	loanID uuid.UUID,
 	paymentAmount decimal.Decimal,
-	makePaymentFn func(loan *entity.Loan, currPaidAmount decimal.Decimal) (payment *entity.LoanPayment, shouldUpdateLoan bool, err error),
+	idempotencyKey string,
+	makePaymentFn func(loan *entity.Loan, currPaidAmount, creditBalance decimal.Decimal) (billing *entity.LoanBilling, payment *entity.LoanPayment, shouldUpdateLoan bool, err error),
+) (loan *entity.Loan, newPaidAmount decimal.Decimal, err error) {
+	err = retryTransaction(func() error {
+		var attemptErr error
+		loan, newPaidAmount, attemptErr = r.makePayment(ctx, loanID, paymentAmount, idempotencyKey, makePaymentFn)
+		return attemptErr
+	})
+
+	return loan, newPaidAmount, err
+}
+
+func (r *Repository) makePayment(
+	ctx context.Context,
+	loanID uuid.UUID,
+	paymentAmount decimal.Decimal,
+	idempotencyKey string,
+	makePaymentFn func(loan *entity.Loan, currPaidAmount, creditBalance decimal.Decimal) (billing *entity.LoanBilling, payment *entity.LoanPayment, shouldUpdateLoan bool, err error),
 ) (loan *entity.Loan, newPaidAmount decimal.Decimal, err error) {
 	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
@@ -179,31 +685,52 @@ func (r *Repository) MakePayment(
 	}
 	defer func() { err = finishTransaction(err, tx) }()
 
+	if err = ensureUnusedPaymentIdempotencyKey(ctx, tx, loanID, idempotencyKey); err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+
 	loan, err = getLoan(ctx, tx, loanID)
 	if err != nil {
 		return nil, decimal.Decimal{}, err
 	}
+	if loan == nil {
+		return nil, decimal.Decimal{}, entity.ErrLoanNotFound
+	}
 
 	currPaidAmount, err := getLoanPaidAmount(ctx, tx, loanID)
 	if err != nil {
 		return nil, decimal.Decimal{}, err
 	}
 
-	loanPayment, shouldUpdateLoan, err := makePaymentFn(loan, currPaidAmount)
+	creditBalance, err := getUserCreditBalance(ctx, tx, loan.UserID)
 	if err != nil {
 		return nil, decimal.Decimal{}, err
 	}
 
-	query, args := loanPaymentStruct.InsertInto(loanPaymentsTable, toPostgresLoanPayment(loanPayment)).BuildWithFlavor(sqlbuilder.PostgreSQL)
-	_, err = tx.ExecContext(ctx, query, args...)
+	billing, loanPayment, shouldUpdateLoan, err := makePaymentFn(loan, currPaidAmount, creditBalance)
 	if err != nil {
 		return nil, decimal.Decimal{}, err
 	}
 
-	newPaidAmount = currPaidAmount.Add(loanPayment.Amount)
+	if err = insertLoanBilling(ctx, tx, loan, billing); err != nil {
+		return nil, decimal.Decimal{}, err
+	}
 
-	if shouldUpdateLoan {
-		if err = updateLoan(ctx, tx, loan); err != nil {
+	if err = ensureUniqueExternalReference(ctx, tx, loanPayment); err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+
+	if err = insertLoanPayment(ctx, tx, loan, loanPayment); err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+
+	newPaidAmount = currPaidAmount.Add(billing.CreditAmount())
+	if loanPayment.Mode != entity.PaymentModeRecovery {
+		newPaidAmount = newPaidAmount.Add(loanPayment.Amount)
+	}
+
+	if shouldUpdateLoan {
+		if err = updateLoan(ctx, tx, loan); err != nil {
 			return nil, decimal.Decimal{}, err
 		}
 	}
@@ -211,9 +738,766 @@ func (r *Repository) MakePayment(
 	return loan, newPaidAmount, nil
 }
 
+func insertLoanBilling(ctx context.Context, executor executor, loan *entity.Loan, billing *entity.LoanBilling) error {
+	if err := insertLoanCharges(ctx, executor, billing.Charges); err != nil {
+		return err
+	}
+
+	if billing.CreditPayment == nil {
+		return nil
+	}
+
+	return insertLoanPayment(ctx, executor, loan, billing.CreditPayment)
+}
+
+func insertLoanPayment(ctx context.Context, executor executor, loan *entity.Loan, loanPayment *entity.LoanPayment) error {
+	query, args := loanPaymentStruct.InsertInto(loanPaymentsTable, toPostgresLoanPayment(loanPayment)).BuildWithFlavor(sqlbuilder.PostgreSQL)
+	if _, err := executor.ExecContext(ctx, query, args...); err != nil {
+		return toIdempotencyKeyError(err)
+	}
+
+	if err := insertCreditEntry(ctx, executor, loanPayment.CreditEntry); err != nil {
+		return err
+	}
+
+	if err := insertLoanPaymentAllocations(ctx, executor, loanPayment); err != nil {
+		return err
+	}
+
+	for _, allocation := range loanPayment.Allocations {
+		if err := updateLoanInstallment(ctx, executor, loan.Installment(allocation.InstallmentNumber)); err != nil {
+			return err
+		}
+	}
+
+	for _, allocation := range loanPayment.ChargeAllocations {
+		if err := updateLoanCharge(ctx, executor, loan.Charge(allocation.ChargeID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insertCreditEntry(ctx context.Context, executor executor, entry *entity.CreditEntry) error {
+	if entry == nil {
+		return nil
+	}
+
+	query, args := creditEntryStruct.InsertInto(userCreditEntriesTable, toPostgresCreditEntry(entry)).BuildWithFlavor(sqlbuilder.PostgreSQL)
+	_, err := executor.ExecContext(ctx, query, args...)
+	return err
+}
+
+func insertLoanPaymentAllocations(ctx context.Context, executor executor, loanPayment *entity.LoanPayment) error {
+	if len(loanPayment.Allocations) > 0 {
+		pgAllocations := make([]interface{}, 0, len(loanPayment.Allocations))
+		for _, allocation := range loanPayment.Allocations {
+			pgAllocations = append(pgAllocations, toPostgresLoanPaymentAllocation(loanPayment.ID, allocation))
+		}
+
+		query, args := loanPaymentAllocationStruct.InsertInto(loanPaymentAllocationsTable, pgAllocations...).BuildWithFlavor(sqlbuilder.PostgreSQL)
+		if _, err := executor.ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
+	}
+
+	if len(loanPayment.ChargeAllocations) > 0 {
+		pgAllocations := make([]interface{}, 0, len(loanPayment.ChargeAllocations))
+		for _, allocation := range loanPayment.ChargeAllocations {
+			pgAllocations = append(pgAllocations, toPostgresLoanPaymentChargeAllocation(loanPayment.ID, allocation))
+		}
+
+		query, args := loanPaymentChargeAllocationStruct.InsertInto(loanPaymentChargeAllocationsTable, pgAllocations...).BuildWithFlavor(sqlbuilder.PostgreSQL)
+		if _, err := executor.ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func ensureUnusedPaymentIdempotencyKey(ctx context.Context, executor executor, loanID uuid.UUID, idempotencyKey string) error {
+	if idempotencyKey == "" {
+		return nil
+	}
+
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select("1").
+		From(loanPaymentsTable).
+		Where(sb.Equal("loan_id", loanID), sb.Equal("idempotency_key", idempotencyKey)).
+		Limit(1)
+
+	return ensureUnusedIdempotencyKey(ctx, executor, sb)
+}
+
+func ensureUnusedIdempotencyKey(ctx context.Context, executor executor, sb *sqlbuilder.SelectBuilder) error {
+	query, args := sb.BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	var exists int
+	err := executor.QueryRowContext(ctx, query, args...).Scan(&exists)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return repository.ErrIdempotencyKeyUsed
+}
+
+func ensureUniqueExternalReference(ctx context.Context, executor executor, loanPayment *entity.LoanPayment) error {
+	if loanPayment.Details.ExternalReference == "" {
+		return nil
+	}
+
+	sb := sqlbuilder.NewSelectBuilder()
+	query, args := sb.Select("1").
+		From(loanPaymentsTable).
+		Where(
+			sb.Equal("channel", int(loanPayment.Details.Channel)),
+			sb.Equal("external_reference", loanPayment.Details.ExternalReference),
+		).
+		Limit(1).
+		BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	var exists int
+	err := executor.QueryRowContext(ctx, query, args...).Scan(&exists)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return entity.ErrLoanPaymentDuplicateExternalReference
+}
+
+// GetUserCreditBalance retrieves the credit balance of a user, held from the excess of their payments.
+//
+// Parameters:
+//   - ctx: A context.Context for handling cancellation and timeouts.
+//   - userID: The UUID of the user whose credit balance is being retrieved.
+//
+// Returns:
+//   - decimal.Decimal: The sum of the user's credit entries. Returns decimal.Zero if the user has none.
+//   - error: An error object if any database operation fails, or nil if successful.
+func (r *Repository) GetUserCreditBalance(ctx context.Context, userID uuid.UUID) (decimal.Decimal, error) {
+	return getUserCreditBalance(ctx, r.db, userID)
+}
+
+func getUserCreditBalance(ctx context.Context, executor executor, userID uuid.UUID) (decimal.Decimal, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select("SUM(amount)").
+		From(userCreditEntriesTable).
+		Where(sb.Equal("user_id", userID)).
+		GroupBy("user_id")
+
+	return sumLoanPaidAmount(ctx, executor, sb)
+}
+
+// BillLoan brings a loan up to date, and returns the updated loan information.
+//
+// This function performs the following operations within a transaction:
+// 1. Retrieves the loan information.
+// 2. Calculates the current paid amount for the loan and the credit balance of its user.
+// 3. Executes the provided billFn to bill the loan.
+// 4. Inserts the charges newly accrued on the loan.
+// 5. Inserts the payment made from the credit balance, if any.
+// 6. Updates the loan record if required.
+//
+// If the transaction conflicts with a concurrent one, such as a concurrent billing of the loan storing a charge
+// accrued by the billing, it is rolled back and run again, so that the loan is reloaded along with the changes
+// of the other transaction.
+//
+// Parameters:
+//   - ctx: A context.Context for handling cancellation and timeouts.
+//   - loanID: The UUID of the loan being billed.
+//   - billFn: A function that bills the loan, along with any change made to it on top of the billing,
+//     and returns the changes to be stored. It takes the current loan, paid amount and credit balance as arguments.
+//
+// Returns:
+//   - loan: An entity.Loan instance representing the updated loan information.
+//   - err: An error object if any step in the process fails, entity.ErrLoanNotFound if the loan does not exist,
+//     or nil if successful.
+func (r *Repository) BillLoan(
+	ctx context.Context,
+	loanID uuid.UUID,
+	billFn func(loan *entity.Loan, currPaidAmount, creditBalance decimal.Decimal) (*entity.LoanBilling, error),
+) (loan *entity.Loan, err error) {
+	err = retryTransaction(func() error {
+		var attemptErr error
+		loan, attemptErr = r.billLoan(ctx, loanID, billFn)
+		return attemptErr
+	})
+
+	return loan, err
+}
+
+func (r *Repository) billLoan(
+	ctx context.Context,
+	loanID uuid.UUID,
+	billFn func(loan *entity.Loan, currPaidAmount, creditBalance decimal.Decimal) (*entity.LoanBilling, error),
+) (loan *entity.Loan, err error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = finishTransaction(err, tx) }()
+
+	loan, err = getLoan(ctx, tx, loanID)
+	if err != nil {
+		return nil, err
+	}
+	if loan == nil {
+		return nil, entity.ErrLoanNotFound
+	}
+
+	currPaidAmount, err := getLoanPaidAmount(ctx, tx, loanID)
+	if err != nil {
+		return nil, err
+	}
+
+	creditBalance, err := getUserCreditBalance(ctx, tx, loan.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	billing, err := billFn(loan, currPaidAmount, creditBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = insertLoanBilling(ctx, tx, loan, billing); err != nil {
+		return nil, err
+	}
+
+	if billing.ShouldUpdateLoan {
+		if err = updateLoan(ctx, tx, loan); err != nil {
+			return nil, err
+		}
+	}
+
+	return loan, nil
+}
+
+// ReversePayment reverses a payment made towards a loan, updates the loan records it was applied to,
+// and returns the updated loan information.
+//
+// This function performs the following operations within a transaction:
+// 1. Retrieves the payment along with its allocations.
+// 2. Retrieves the loan the payment was made towards.
+// 3. Calculates the current paid amount for the loan and the credit balance of its user.
+// 4. Executes the provided reversePaymentFn to reverse the payment.
+// 5. Inserts a new loan payment reversal record, along with its credit entry if any.
+// 6. Marks the payment record as reversed.
+// 7. Updates the installments and charges the payment was allocated to, or every installment for a payoff.
+// 8. Updates the loan record.
+//
+// If the transaction conflicts with a concurrent one, such as a concurrent payment or billing of the loan, it is rolled
+// back and run again, so that the payment and its loan are reloaded along with the changes of the other transaction.
+//
+// Parameters:
+//   - ctx: A context.Context for handling cancellation and timeouts.
+//   - paymentID: The UUID of the payment being reversed.
+//   - reversePaymentFn: A function that reverses the payment on the loan and returns the reversal entry.
+//     It takes the current loan, the payment, the paid amount and the user's credit balance as arguments.
+//
+// Returns:
+//   - loan: An entity.Loan instance representing the updated loan information.
+//   - newPaidAmount: A decimal.Decimal representing the new total paid amount for the loan after the reversal.
+//   - err: An error object if any step in the process fails, entity.ErrLoanPaymentNotFound if the payment
+//     does not exist, or nil if the payment is successfully reversed.
+func (r *Repository) ReversePayment(
+	ctx context.Context,
+	paymentID uuid.UUID,
+	reversePaymentFn func(loan *entity.Loan, payment *entity.LoanPayment, currPaidAmount, creditBalance decimal.Decimal) (*entity.LoanPaymentReversal, error),
+) (loan *entity.Loan, newPaidAmount decimal.Decimal, err error) {
+	err = retryTransaction(func() error {
+		var attemptErr error
+		loan, newPaidAmount, attemptErr = r.reversePayment(ctx, paymentID, reversePaymentFn)
+		return attemptErr
+	})
+
+	return loan, newPaidAmount, err
+}
+
+func (r *Repository) reversePayment(
+	ctx context.Context,
+	paymentID uuid.UUID,
+	reversePaymentFn func(loan *entity.Loan, payment *entity.LoanPayment, currPaidAmount, creditBalance decimal.Decimal) (*entity.LoanPaymentReversal, error),
+) (loan *entity.Loan, newPaidAmount decimal.Decimal, err error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+	defer func() { err = finishTransaction(err, tx) }()
+
+	sb := loanPaymentStruct.SelectFrom(loanPaymentsTable)
+	query, args := sb.Where(sb.Equal("id", paymentID)).BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	var pgPayment postgresLoanPayment
+	err = tx.QueryRowContext(ctx, query, args...).Scan(loanPaymentStruct.Addr(&pgPayment)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, decimal.Decimal{}, entity.ErrLoanPaymentNotFound
+	} else if err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+
+	payment := pgPayment.toEntityLoanPayment()
+	if err = getLoanPaymentAllocations(ctx, tx, []*entity.LoanPayment{payment}); err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+
+	loan, err = getLoan(ctx, tx, payment.LoanID)
+	if err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+
+	currPaidAmount, err := getLoanPaidAmount(ctx, tx, payment.LoanID)
+	if err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+
+	creditBalance, err := getUserCreditBalance(ctx, tx, loan.UserID)
+	if err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+
+	reversal, err := reversePaymentFn(loan, payment, currPaidAmount, creditBalance)
+	if err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+
+	query, args = loanPaymentReversalStruct.InsertInto(loanPaymentReversalsTable, toPostgresLoanPaymentReversal(reversal)).BuildWithFlavor(sqlbuilder.PostgreSQL)
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+
+	if err = insertCreditEntry(ctx, tx, reversal.CreditEntry); err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+
+	ub := loanPaymentStruct.Update(loanPaymentsTable, toPostgresLoanPayment(payment))
+	query, args = ub.Where(ub.Equal("id", payment.ID)).BuildWithFlavor(sqlbuilder.PostgreSQL)
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+
+	if payment.Mode == entity.PaymentModePayoff {
+		// the rebate restored by reversing a payoff changes the installments the payment was not allocated to
+		for _, installment := range loan.Installments {
+			if err = updateLoanInstallment(ctx, tx, installment); err != nil {
+				return nil, decimal.Decimal{}, err
+			}
+		}
+	} else {
+		for _, allocation := range payment.Allocations {
+			if err = updateLoanInstallment(ctx, tx, loan.Installment(allocation.InstallmentNumber)); err != nil {
+				return nil, decimal.Decimal{}, err
+			}
+		}
+	}
+
+	for _, allocation := range payment.ChargeAllocations {
+		if err = updateLoanCharge(ctx, tx, loan.Charge(allocation.ChargeID)); err != nil {
+			return nil, decimal.Decimal{}, err
+		}
+	}
+
+	if err = updateLoan(ctx, tx, loan); err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+
+	newPaidAmount = currPaidAmount
+	if payment.Mode != entity.PaymentModeRecovery {
+		newPaidAmount = newPaidAmount.Sub(payment.Amount)
+	}
+
+	return loan, newPaidAmount, nil
+}
+
+// TransitionLoan moves a loan to another status in its lifecycle, and returns the updated loan information.
+//
+// This function performs the following operations within a transaction:
+// 1. Retrieves the loan information.
+// 2. Executes the provided transitionFn to move the loan to its new status.
+// 3. Updates the loan record.
+// 4. Updates the installments of the loan, whose schedule is generated again on disbursement.
+//
+// If the transaction conflicts with a concurrent one, such as a concurrent payment or billing of the loan, it is rolled
+// back and run again, so that the loan is reloaded along with the changes of the other transaction.
+//
+// Parameters:
+//   - ctx: A context.Context for handling cancellation and timeouts.
+//   - loanID: The UUID of the loan being moved.
+//   - transitionFn: A function that moves the loan to its new status. It takes the current loan as argument.
+//
+// Returns:
+//   - loan: An entity.Loan instance representing the updated loan information.
+//   - err: An error object if any step in the process fails, entity.ErrLoanNotFound if the loan does not exist,
+//     or nil if successful.
+func (r *Repository) TransitionLoan(ctx context.Context, loanID uuid.UUID, transitionFn func(loan *entity.Loan) error) (loan *entity.Loan, err error) {
+	err = retryTransaction(func() error {
+		var attemptErr error
+		loan, attemptErr = r.transitionLoan(ctx, loanID, transitionFn)
+		return attemptErr
+	})
+
+	return loan, err
+}
+
+func (r *Repository) transitionLoan(ctx context.Context, loanID uuid.UUID, transitionFn func(loan *entity.Loan) error) (loan *entity.Loan, err error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = finishTransaction(err, tx) }()
+
+	loan, err = getLoan(ctx, tx, loanID)
+	if err != nil {
+		return nil, err
+	}
+	if loan == nil {
+		return nil, entity.ErrLoanNotFound
+	}
+
+	if err = transitionFn(loan); err != nil {
+		return nil, err
+	}
+
+	if err = updateLoan(ctx, tx, loan); err != nil {
+		return nil, err
+	}
+
+	for _, installment := range loan.Installments {
+		if err = updateLoanInstallment(ctx, tx, installment); err != nil {
+			return nil, err
+		}
+	}
+
+	return loan, nil
+}
+
+// RestructureLoan repays the outstanding amount of a loan under new terms, and returns the updated loan information.
+//
+// This function performs the following operations within a transaction:
+// 1. Retrieves the loan information, the total amount paid towards it and the credit balance of its user.
+// 2. Executes the provided restructureFn to bill and restructure the loan.
+// 3. Inserts the charges newly accrued on the loan by the billing, and the payment made from the credit balance
+// by the billing, if any.
+// 4. Inserts the new version of the terms of the loan.
+// 5. Replaces the installments of the previous terms not kept by the restructure with the new schedule,
+// and updates the kept installments.
+// 6. Updates the charges of the loan, whose outstanding amounts are capitalized.
+// 7. Updates the loan record.
+//
+// If the transaction conflicts with a concurrent one, such as a concurrent payment or billing of the loan, it is rolled
+// back and run again, so that the loan is reloaded along with the changes of the other transaction.
+//
+// Parameters:
+//   - ctx: A context.Context for handling cancellation and timeouts.
+//   - loanID: The UUID of the loan being restructured.
+//   - restructureFn: A function that bills and restructures the loan, and returns the billing and the new version
+//     of its terms. It takes the current loan, its paid amount and the credit balance of its user as arguments.
+//
+// Returns:
+//   - loan: An entity.Loan instance representing the updated loan information.
+//   - err: An error object if any step in the process fails, entity.ErrLoanNotFound if the loan does not exist,
+//     or nil if successful.
+func (r *Repository) RestructureLoan(
+	ctx context.Context,
+	loanID uuid.UUID,
+	restructureFn func(loan *entity.Loan, currPaidAmount, creditBalance decimal.Decimal) (*entity.LoanBilling, *entity.LoanTerms, error),
+) (loan *entity.Loan, err error) {
+	err = retryTransaction(func() error {
+		var attemptErr error
+		loan, attemptErr = r.restructureLoan(ctx, loanID, restructureFn)
+		return attemptErr
+	})
+
+	return loan, err
+}
+
+func (r *Repository) restructureLoan(
+	ctx context.Context,
+	loanID uuid.UUID,
+	restructureFn func(loan *entity.Loan, currPaidAmount, creditBalance decimal.Decimal) (*entity.LoanBilling, *entity.LoanTerms, error),
+) (loan *entity.Loan, err error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = finishTransaction(err, tx) }()
+
+	loan, err = getLoan(ctx, tx, loanID)
+	if err != nil {
+		return nil, err
+	}
+	if loan == nil {
+		return nil, entity.ErrLoanNotFound
+	}
+
+	paidAmount, err := getLoanPaidAmount(ctx, tx, loanID)
+	if err != nil {
+		return nil, err
+	}
+
+	creditBalance, err := getUserCreditBalance(ctx, tx, loan.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	billing, terms, err := restructureFn(loan, paidAmount, creditBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = insertLoanBilling(ctx, tx, loan, billing); err != nil {
+		return nil, err
+	}
+
+	if err = insertLoanTerms(ctx, tx, terms); err != nil {
+		return nil, err
+	}
+
+	delb := sqlbuilder.PostgreSQL.NewDeleteBuilder()
+	query, args := delb.DeleteFrom(loanInstallmentsTable).Where(
+		delb.Equal("loan_id", loanID),
+		delb.GreaterEqualThan("installment_number", terms.FirstInstallmentNumber),
+	).Build()
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return nil, err
+	}
+
+	keptCount := terms.FirstInstallmentNumber - 1
+	for _, installment := range loan.Installments[:keptCount] {
+		if err = updateLoanInstallment(ctx, tx, installment); err != nil {
+			return nil, err
+		}
+	}
+	if err = insertLoanInstallments(ctx, tx, loan.Installments[keptCount:]); err != nil {
+		return nil, err
+	}
+
+	for _, charge := range loan.Charges {
+		if err = updateLoanCharge(ctx, tx, charge); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = updateLoan(ctx, tx, loan); err != nil {
+		return nil, err
+	}
+
+	return loan, nil
+}
+
+// ListLoanTerms retrieves every version of the terms of a loan from the database.
+//
+// Parameters:
+//   - ctx: A context.Context for handling cancellation and timeouts.
+//   - loanID: The UUID of the loan whose terms are being retrieved.
+//
+// Returns:
+//   - []*entity.LoanTerms: The versions of the terms of the loan ordered by their version, or an empty slice if there is none.
+//   - error: An error object if any database operation fails, or nil if successful.
+func (r *Repository) ListLoanTerms(ctx context.Context, loanID uuid.UUID) ([]*entity.LoanTerms, error) {
+	sb := loanTermsStruct.SelectFrom(loanTermsTable)
+	query, args := sb.Where(sb.Equal("loan_id", loanID)).
+		OrderBy("version").
+		BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	terms := make([]*entity.LoanTerms, 0)
+	for rows.Next() {
+		var pgTerms postgresLoanTerms
+		if err = rows.Scan(loanTermsStruct.Addr(&pgTerms)...); err != nil {
+			return nil, err
+		}
+
+		terms = append(terms, pgTerms.toEntityLoanTerms())
+	}
+
+	return terms, rows.Err()
+}
+
+func insertLoanTerms(ctx context.Context, executor executor, terms *entity.LoanTerms) error {
+	if terms == nil {
+		return nil
+	}
+
+	query, args := loanTermsStruct.InsertInto(loanTermsTable, toPostgresLoanTerms(terms)).BuildWithFlavor(sqlbuilder.PostgreSQL)
+	_, err := executor.ExecContext(ctx, query, args...)
+	return err
+}
+
+// GrantPaymentHoliday grants a payment holiday on a loan in the database.
+//
+// This function performs the following operations within a transaction:
+// 1. Retrieves the loan information.
+// 2. Executes the provided grantFn to grant the payment holiday on the loan.
+// 3. Inserts the payment holiday.
+// 4. Updates the installments of the loan deferred by the payment holiday.
+// 5. Updates the loan record.
+//
+// If the transaction conflicts with a concurrent one, such as a concurrent payment or billing of the loan, it is rolled
+// back and run again, so that the loan is reloaded along with the changes of the other transaction.
+//
+// Parameters:
+//   - ctx: A context.Context for handling cancellation and timeouts.
+//   - loanID: The UUID of the loan the payment holiday is granted on.
+//   - grantFn: A function that grants the payment holiday on the loan and returns it.
+//     It takes the current loan as argument.
+//
+// Returns:
+//   - loan: An entity.Loan instance representing the updated loan information.
+//   - err: An error object if any step in the process fails, entity.ErrLoanNotFound if the loan does not exist,
+//     or nil if successful.
+func (r *Repository) GrantPaymentHoliday(
+	ctx context.Context,
+	loanID uuid.UUID,
+	grantFn func(loan *entity.Loan) (*entity.PaymentHoliday, error),
+) (loan *entity.Loan, err error) {
+	err = retryTransaction(func() error {
+		var attemptErr error
+		loan, attemptErr = r.grantPaymentHoliday(ctx, loanID, grantFn)
+		return attemptErr
+	})
+
+	return loan, err
+}
+
+func (r *Repository) grantPaymentHoliday(
+	ctx context.Context,
+	loanID uuid.UUID,
+	grantFn func(loan *entity.Loan) (*entity.PaymentHoliday, error),
+) (loan *entity.Loan, err error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = finishTransaction(err, tx) }()
+
+	loan, err = getLoan(ctx, tx, loanID)
+	if err != nil {
+		return nil, err
+	}
+	if loan == nil {
+		return nil, entity.ErrLoanNotFound
+	}
+
+	holiday, err := grantFn(loan)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args := paymentHolidayStruct.InsertInto(paymentHolidaysTable, toPostgresPaymentHoliday(holiday)).BuildWithFlavor(sqlbuilder.PostgreSQL)
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return nil, err
+	}
+
+	for _, installment := range loan.Installments {
+		if installment.Number < holiday.FirstInstallmentNumber {
+			continue
+		}
+
+		if err = updateLoanInstallment(ctx, tx, installment); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = updateLoan(ctx, tx, loan); err != nil {
+		return nil, err
+	}
+
+	return loan, nil
+}
+
+// ListPaymentHolidays retrieves the payment holidays granted on a loan from the database.
+//
+// Parameters:
+//   - ctx: A context.Context for handling cancellation and timeouts.
+//   - loanID: The UUID of the loan whose payment holidays are being retrieved.
+//
+// Returns:
+//   - []*entity.PaymentHoliday: The payment holidays of the loan ordered by their creation time, or an empty slice if there is none.
+//   - error: An error object if any database operation fails, or nil if successful.
+func (r *Repository) ListPaymentHolidays(ctx context.Context, loanID uuid.UUID) ([]*entity.PaymentHoliday, error) {
+	return getPaymentHolidays(ctx, r.db, loanID)
+}
+
+func getPaymentHolidays(ctx context.Context, executor executor, loanID uuid.UUID) ([]*entity.PaymentHoliday, error) {
+	sb := paymentHolidayStruct.SelectFrom(paymentHolidaysTable)
+	query, args := sb.Where(sb.Equal("loan_id", loanID)).
+		OrderBy("created_at").
+		BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	rows, err := executor.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	holidays := make([]*entity.PaymentHoliday, 0)
+	for rows.Next() {
+		var pgHoliday postgresPaymentHoliday
+		if err = rows.Scan(paymentHolidayStruct.Addr(&pgHoliday)...); err != nil {
+			return nil, err
+		}
+
+		holidays = append(holidays, pgHoliday.toEntityPaymentHoliday())
+	}
+
+	return holidays, rows.Err()
+}
+
+// GetLoan retrieves a loan by its ID from the database, along with its installments.
+//
+// Parameters:
+//   - ctx: A context.Context for handling cancellation and timeouts.
+//   - loanID: The UUID of the loan to be retrieved.
+//
+// Returns:
+//   - *entity.Loan: The loan entity if found, or nil if it does not exist.
+//   - error: An error object if any database operation fails, or nil if successful.
+func (r *Repository) GetLoan(ctx context.Context, loanID uuid.UUID) (*entity.Loan, error) {
+	return getLoan(ctx, r.db, loanID)
+}
+
+// GetLoanByIdempotencyKey retrieves the loan created by a user's request with the given idempotency key
+// from the database, along with its installments.
+//
+// Parameters:
+//   - ctx: A context.Context for handling cancellation and timeouts.
+//   - userID: The UUID of the user who requested the loan.
+//   - idempotencyKey: The idempotency key of the request.
+//
+// Returns:
+//   - *entity.Loan: The loan entity if found, or nil if no loan was created with the key.
+//   - error: An error object if any database operation fails, or nil if successful.
+func (r *Repository) GetLoanByIdempotencyKey(ctx context.Context, userID uuid.UUID, idempotencyKey string) (*entity.Loan, error) {
+	sb := loanStruct.SelectFrom(loansTable)
+	sb.Where(
+		sb.Equal("user_id", userID),
+		sb.Equal("idempotency_key", idempotencyKey),
+	)
+
+	return selectLoan(ctx, r.db, sb)
+}
+
 func getLoan(ctx context.Context, executor executor, loanID uuid.UUID) (*entity.Loan, error) {
 	sb := loanStruct.SelectFrom(loansTable)
-	query, args := sb.Where(sb.Equal("id", loanID)).BuildWithFlavor(sqlbuilder.PostgreSQL)
+	sb.Where(sb.Equal("id", loanID))
+
+	return selectLoan(ctx, executor, sb)
+}
+
+func selectLoan(ctx context.Context, executor executor, sb *sqlbuilder.SelectBuilder) (*entity.Loan, error) {
+	query, args := sb.BuildWithFlavor(sqlbuilder.PostgreSQL)
 
 	var pgLoan postgresLoan
 	err := executor.QueryRowContext(ctx, query, args...).Scan(loanStruct.Addr(&pgLoan)...)
@@ -223,7 +1507,21 @@ func getLoan(ctx context.Context, executor executor, loanID uuid.UUID) (*entity.
 		return nil, err
 	}
 
-	return pgLoan.toEntityLoan(), nil
+	loan := pgLoan.toEntityLoan()
+	loan.Installments, err = getLoanInstallments(ctx, executor, loan.ID)
+	if err != nil {
+		return nil, err
+	}
+	loan.Charges, err = getLoanCharges(ctx, executor, loan.ID)
+	if err != nil {
+		return nil, err
+	}
+	loan.PaymentHolidays, err = getPaymentHolidays(ctx, executor, loan.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return loan, nil
 }
 
 func updateLoan(ctx context.Context, executor executor, loan *entity.Loan) error {
@@ -233,3 +1531,30 @@ func updateLoan(ctx context.Context, executor executor, loan *entity.Loan) error
 	_, err := executor.ExecContext(ctx, query, args...)
 	return err
 }
+
+func updateLoanInstallment(ctx context.Context, executor executor, installment *entity.LoanInstallment) error {
+	if installment == nil {
+		return nil
+	}
+
+	ub := loanInstallmentStruct.Update(loanInstallmentsTable, toPostgresLoanInstallment(installment))
+	query, args := ub.Where(
+		ub.Equal("loan_id", installment.LoanID),
+		ub.Equal("installment_number", installment.Number),
+	).BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	_, err := executor.ExecContext(ctx, query, args...)
+	return err
+}
+
+func updateLoanCharge(ctx context.Context, executor executor, charge *entity.LoanCharge) error {
+	if charge == nil {
+		return nil
+	}
+
+	ub := loanChargeStruct.Update(loanChargesTable, toPostgresLoanCharge(charge))
+	query, args := ub.Where(ub.Equal("id", charge.ID)).BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	_, err := executor.ExecContext(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/repository"
+)
+
+func TestRepository_MakePayment_idempotencyKey(t *testing.T) {
+	ctx := context.Background()
+
+	noPayment := fakeResult{columns: []string{"?column?"}}
+	usedKey := fakeResult{columns: []string{"?column?"}, rows: [][]driver.Value{{int64(1)}}}
+	serializationFailure := fakeResult{err: &pq.Error{Code: serializationFailureCode}}
+
+	tests := []struct {
+		name          string
+		results       []fakeResult
+		wantRollbacks int
+	}{
+		{
+			name:          "key already used",
+			results:       []fakeResult{usedKey},
+			wantRollbacks: 1,
+		},
+		{
+			// the second of two concurrent runs of the same request conflicts with the first one, and is run again
+			// once the first payment is stored
+			name:          "concurrent run of the same key",
+			results:       []fakeResult{noPayment, serializationFailure, usedKey},
+			wantRollbacks: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connector := &fakeConnector{t: t, results: tt.results}
+			db := sql.OpenDB(connector)
+			defer db.Close()
+
+			_, _, err := NewRepository(db).MakePayment(
+				ctx, uuid.New(), decimal.NewFromInt(1000), "payment-1",
+				func(*entity.Loan, decimal.Decimal, decimal.Decimal) (*entity.LoanBilling, *entity.LoanPayment, bool, error) {
+					t.Error("expecting the payment not to be processed for a used idempotency key")
+					return nil, nil, false, errors.New("payment processed")
+				},
+			)
+			if !errors.Is(err, repository.ErrIdempotencyKeyUsed) {
+				t.Fatalf("expecting error to be %v, got %v", repository.ErrIdempotencyKeyUsed, err)
+			}
+			if connector.commits != 0 || connector.rollbacks != tt.wantRollbacks {
+				t.Errorf("expecting %d rollbacks and no commit, got %d and %d", tt.wantRollbacks, connector.rollbacks, connector.commits)
+			}
+			if len(connector.results) != 0 {
+				t.Errorf("expecting every scripted statement to be run, %d left", len(connector.results))
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -9,6 +10,10 @@ import (
 	"github.com/axopadyani/billing-engine/internal/entity"
 )
 
+// ErrIdempotencyKeyUsed is returned when a request is stored with an idempotency key that has already been used,
+// such as by a concurrent retry of the request.
+var ErrIdempotencyKeyUsed = errors.New("idempotency key has already been used")
+
 // Repository defines the interface for repository operations related to loans and payments.
 //
 //go:generate mockgen -package repository -source=repository.go -destination=../test/mock/repository/mock_repository.go
@@ -21,9 +26,29 @@ type Repository interface {
     //   - validateFn: A function to validate the loan before creation.
     //
     // Returns:
-    //   An error if the creation fails, nil otherwise.
+    //   ErrIdempotencyKeyUsed if the user has already created a loan with the idempotency key of the loan,
+    //   an error if the creation fails, nil otherwise.
     CreateLoan(ctx context.Context, loan *entity.Loan, validateFn func(latestLoan *entity.Loan) error) error
 
+    // GetLoanProduct retrieves a loan product by its ID.
+    //
+    // Parameters:
+    //   - ctx: The context for the operation.
+    //   - productID: The UUID of the loan product to be retrieved.
+    //
+    // Returns:
+    //   A pointer to the LoanProduct entity, or nil if it does not exist, and an error if the retrieval fails.
+    GetLoanProduct(ctx context.Context, productID uuid.UUID) (*entity.LoanProduct, error)
+
+    // ListLoanProducts retrieves all the available loan products.
+    //
+    // Parameters:
+    //   - ctx: The context for the operation.
+    //
+    // Returns:
+    //   A slice of LoanProduct entities and an error if the retrieval fails.
+    ListLoanProducts(ctx context.Context) ([]*entity.LoanProduct, error)
+
     // GetLatestLoan retrieves the most recent loan for a given user.
     //
     // Parameters:
@@ -34,6 +59,58 @@ type Repository interface {
     //   A pointer to the latest Loan entity and an error if the retrieval fails.
     GetLatestLoan(ctx context.Context, userID uuid.UUID) (*entity.Loan, error)
 
+    // GetLoan retrieves a loan by its ID.
+    //
+    // Parameters:
+    //   - ctx: The context for the operation.
+    //   - loanID: The UUID of the loan to be retrieved.
+    //
+    // Returns:
+    //   A pointer to the Loan entity, or nil if it does not exist, and an error if the retrieval fails.
+    GetLoan(ctx context.Context, loanID uuid.UUID) (*entity.Loan, error)
+
+    // GetLoanByIdempotencyKey retrieves the loan created by a user's request with the given idempotency key.
+    //
+    // Parameters:
+    //   - ctx: The context for the operation.
+    //   - userID: The UUID of the user who requested the loan.
+    //   - idempotencyKey: The idempotency key of the request.
+    //
+    // Returns:
+    //   A pointer to the Loan entity, or nil if no loan was created with the key, and an error if the retrieval fails.
+    GetLoanByIdempotencyKey(ctx context.Context, userID uuid.UUID, idempotencyKey string) (*entity.Loan, error)
+
+    // ListUserLoans retrieves a page of the loans of a user, newest first, along with the total amount paid for each loan.
+    //
+    // Parameters:
+    //   - ctx: The context for the operation.
+    //   - userID: The UUID of the user whose loans to retrieve.
+    //   - statuses: The statuses of the loans to retrieve, or empty to retrieve the loans of any status.
+    //   - beforeID: The UUID of the last loan of the previous page, or uuid.Nil to retrieve the first page.
+    //   - limit: The maximum number of loans to retrieve.
+    //
+    // Returns:
+    //   A slice of pointers to Loan entities without their installments and charges, the paid amount of each loan by its ID,
+    //   and an error if the retrieval fails.
+    ListUserLoans(
+        ctx context.Context,
+        userID uuid.UUID,
+        statuses []entity.LoanStatus,
+        beforeID uuid.UUID,
+        limit int,
+    ) ([]*entity.Loan, map[uuid.UUID]decimal.Decimal, error)
+
+    // GetLoanInstallments retrieves the repayment schedule of a loan.
+    //
+    // Parameters:
+    //   - ctx: The context for the operation.
+    //   - loanID: The UUID of the loan whose installments are to be retrieved.
+    //
+    // Returns:
+    //   A slice of LoanInstallment entities ordered by their number, empty if the loan does not exist,
+    //   and an error if the retrieval fails.
+    GetLoanInstallments(ctx context.Context, loanID uuid.UUID) ([]*entity.LoanInstallment, error)
+
     // GetLoanPaidAmount retrieves the total amount paid for a specific loan.
     //
     // Parameters:
@@ -44,20 +121,156 @@ type Repository interface {
     //   The paid amount as a decimal.Decimal and an error if the retrieval fails.
     GetLoanPaidAmount(ctx context.Context, loanID uuid.UUID) (decimal.Decimal, error)
 
+    // GetLoanPaymentByIdempotencyKey retrieves the payment made towards a loan by a request with the given idempotency key.
+    //
+    // Parameters:
+    //   - ctx: The context for the operation.
+    //   - loanID: The UUID of the loan the payment was made towards.
+    //   - idempotencyKey: The idempotency key of the request.
+    //
+    // Returns:
+    //   A pointer to the LoanPayment entity, or nil if no payment was made with the key, and an error if the retrieval fails.
+    GetLoanPaymentByIdempotencyKey(ctx context.Context, loanID uuid.UUID, idempotencyKey string) (*entity.LoanPayment, error)
+
+    // ListLoanPayments retrieves a page of the payments made towards a loan, newest first,
+    // along with the installments and charges each payment was allocated to.
+    //
+    // Parameters:
+    //   - ctx: The context for the operation.
+    //   - loanID: The UUID of the loan whose payments to retrieve.
+    //   - beforeID: The UUID of the last payment of the previous page, or uuid.Nil to retrieve the first page.
+    //   - limit: The maximum number of payments to retrieve.
+    //
+    // Returns:
+    //   A slice of pointers to LoanPayment entities and an error if the retrieval fails.
+    ListLoanPayments(ctx context.Context, loanID uuid.UUID, beforeID uuid.UUID, limit int) ([]*entity.LoanPayment, error)
+
     // MakePayment processes a payment for a loan.
     //
     // Parameters:
     //   - ctx: The context for the operation.
     //   - loanID: The UUID of the loan for which the payment is being made.
     //   - paymentAmount: The amount of the payment as a decimal.Decimal.
-    //   - makePaymentFn: A function to process the payment and determine if the loan should be updated.
+    //   - idempotencyKey: The idempotency key of the request, empty if the request is not idempotent.
+    //   - makePaymentFn: A function to bill the loan given its current paid amount and its user's credit balance,
+    //     process the payment and determine if the loan should be updated.
     //
     // Returns:
-    //   The updated Loan entity, the new total paid amount, and an error if the payment processing fails.
+    //   The updated Loan entity, the new total paid amount, and an error if the loan does not exist
+    //   or the payment processing fails. The error is ErrIdempotencyKeyUsed if a payment has already been made
+    //   towards the loan with the idempotency key of the payment.
     MakePayment(
         ctx context.Context,
         loanID uuid.UUID,
         paymentAmount decimal.Decimal,
-        makePaymentFn func(loan *entity.Loan, currPaidAmount decimal.Decimal) (payment *entity.LoanPayment, shouldUpdateLoan bool, err error),
+        idempotencyKey string,
+        makePaymentFn func(loan *entity.Loan, currPaidAmount, creditBalance decimal.Decimal) (billing *entity.LoanBilling, payment *entity.LoanPayment, shouldUpdateLoan bool, err error),
+    ) (loan *entity.Loan, newPaidAmount decimal.Decimal, err error)
+
+    // ReversePayment reverses a payment made towards a loan, keeping the payment and recording a reversal entry.
+    //
+    // Parameters:
+    //   - ctx: The context for the operation.
+    //   - paymentID: The UUID of the payment being reversed.
+    //   - reversePaymentFn: A function to reverse the payment on the loan and create the reversal entry,
+    //     given the loan's current paid amount and its user's credit balance.
+    //
+    // Returns:
+    //   The updated Loan entity, the new total paid amount, and an error if the payment does not exist
+    //   or the reversal fails.
+    ReversePayment(
+        ctx context.Context,
+        paymentID uuid.UUID,
+        reversePaymentFn func(loan *entity.Loan, payment *entity.LoanPayment, currPaidAmount, creditBalance decimal.Decimal) (*entity.LoanPaymentReversal, error),
     ) (loan *entity.Loan, newPaidAmount decimal.Decimal, err error)
+
+    // GetUserCreditBalance retrieves the credit balance of a user, held from the excess of their payments.
+    //
+    // Parameters:
+    //   - ctx: The context for the operation.
+    //   - userID: The UUID of the user whose credit balance is being retrieved.
+    //
+    // Returns:
+    //   The credit balance as a decimal.Decimal and an error if the retrieval fails.
+    GetUserCreditBalance(ctx context.Context, userID uuid.UUID) (decimal.Decimal, error)
+
+    // BillLoan brings a loan up to date, storing the credit applied to it, the late fees accrued on it
+    // and the change of its status.
+    //
+    // Parameters:
+    //   - ctx: The context for the operation.
+    //   - loanID: The UUID of the loan being billed.
+    //   - billFn: A function to bill the loan given its current paid amount and its user's credit balance,
+    //     along with any change made to it on top of the billing.
+    //
+    // Returns:
+    //   The updated Loan entity and an error if the loan does not exist or the billing fails.
+    BillLoan(
+        ctx context.Context,
+        loanID uuid.UUID,
+        billFn func(loan *entity.Loan, currPaidAmount, creditBalance decimal.Decimal) (*entity.LoanBilling, error),
+    ) (*entity.Loan, error)
+
+    // TransitionLoan moves a loan to another status in its lifecycle, such as approving or disbursing it.
+    //
+    // Parameters:
+    //   - ctx: The context for the operation.
+    //   - loanID: The UUID of the loan being moved.
+    //   - transitionFn: A function to move the loan to its new status.
+    //
+    // Returns:
+    //   The updated Loan entity and an error if the loan does not exist or the transition is not allowed.
+    TransitionLoan(ctx context.Context, loanID uuid.UUID, transitionFn func(loan *entity.Loan) error) (*entity.Loan, error)
+
+    // RestructureLoan repays the outstanding amount of a loan under new terms, replacing the part of its
+    // schedule not kept by the restructure and recording the new version of its terms.
+    //
+    // Parameters:
+    //   - ctx: The context for the operation.
+    //   - loanID: The UUID of the loan being restructured.
+    //   - restructureFn: A function to bill and restructure the loan given its current paid amount and its user's credit balance,
+    //     returning the billing and the new version of its terms.
+    //
+    // Returns:
+    //   The updated Loan entity and an error if the loan does not exist or cannot be restructured.
+    RestructureLoan(
+        ctx context.Context,
+        loanID uuid.UUID,
+        restructureFn func(loan *entity.Loan, currPaidAmount, creditBalance decimal.Decimal) (*entity.LoanBilling, *entity.LoanTerms, error),
+    ) (*entity.Loan, error)
+
+    // ListLoanTerms retrieves every version of the terms of a loan, from the terms it was created with
+    // to its latest restructure.
+    //
+    // Parameters:
+    //   - ctx: The context for the operation.
+    //   - loanID: The UUID of the loan whose terms are to be retrieved.
+    //
+    // Returns:
+    //   A slice of LoanTerms entities ordered by their version, empty if the loan does not exist,
+    //   and an error if the retrieval fails.
+    ListLoanTerms(ctx context.Context, loanID uuid.UUID) ([]*entity.LoanTerms, error)
+
+    // GrantPaymentHoliday grants a payment holiday on a loan, recording it and deferring the installments
+    // of the loan not due yet.
+    //
+    // Parameters:
+    //   - ctx: The context for the operation.
+    //   - loanID: The UUID of the loan the payment holiday is granted on.
+    //   - grantFn: A function to grant the payment holiday on the loan, returning the granted payment holiday.
+    //
+    // Returns:
+    //   The updated Loan entity and an error if the loan does not exist or the payment holiday cannot be granted.
+    GrantPaymentHoliday(ctx context.Context, loanID uuid.UUID, grantFn func(loan *entity.Loan) (*entity.PaymentHoliday, error)) (*entity.Loan, error)
+
+    // ListPaymentHolidays retrieves the payment holidays granted on a loan.
+    //
+    // Parameters:
+    //   - ctx: The context for the operation.
+    //   - loanID: The UUID of the loan whose payment holidays are to be retrieved.
+    //
+    // Returns:
+    //   A slice of PaymentHoliday entities ordered by their creation time, empty if the loan does not exist
+    //   or has none, and an error if the retrieval fails.
+    ListPaymentHolidays(ctx context.Context, loanID uuid.UUID) ([]*entity.PaymentHoliday, error)
 }
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+)
+
+// ApproveLoanCommand represents the input data required to approve a loan.
+type ApproveLoanCommand struct {
+	// LoanID is the unique identifier of the loan being approved.
+	LoanID uuid.UUID
+}
+
+// ApproveLoan approves a loan that is pending approval, so that it can be disbursed.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - in: An ApproveLoanCommand struct containing the loan being approved.
+//
+// Returns:
+//   - Loan: A struct containing the updated loan information.
+//   - error: An error if the operation fails, or nil if successful. It returns entity.ErrLoanNotFound if the loan
+//     does not exist, and entity.ErrLoanIllegalStatusTransition if the loan is not pending approval.
+func (s *Impl) ApproveLoan(ctx context.Context, in ApproveLoanCommand) (Loan, error) {
+	now := s.clock.Now()
+
+	loan, err := s.repo.TransitionLoan(ctx, in.LoanID, func(loan *entity.Loan) error {
+		return loan.Approve(now)
+	})
+	if err != nil {
+		return Loan{}, ensureBusinessError(err)
+	}
+
+	return parseLoan(loan), nil
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
+	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
+)
+
+func TestImpl_ApproveLoan(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	newLoan := func(status entity.LoanStatus) *entity.Loan {
+		loan, err := entity.CreateLoan(testProduct, uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -7))
+		if err != nil {
+			t.Fatal(err)
+		}
+		loan.Status = status
+
+		return loan
+	}
+
+	// transitionLoan applies the transition to the given loan, like the repository does to the stored loan
+	transitionLoan := func(loan *entity.Loan) func(context.Context, uuid.UUID, func(*entity.Loan) error) (*entity.Loan, error) {
+		return func(_ context.Context, _ uuid.UUID, transitionFn func(*entity.Loan) error) (*entity.Loan, error) {
+			if err := transitionFn(loan); err != nil {
+				return nil, err
+			}
+			return loan, nil
+		}
+	}
+
+	tests := []struct {
+		name      string
+		cmd       ApproveLoanCommand
+		setupMock func(*repository.MockRepository)
+		wantErr   error
+	}{
+		{
+			name: "loan not found",
+			cmd:  ApproveLoanCommand{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().TransitionLoan(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, entity.ErrLoanNotFound)
+			},
+			wantErr: entity.ErrLoanNotFound,
+		},
+		{
+			name: "repository unexpected error",
+			cmd:  ApproveLoanCommand{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().TransitionLoan(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name: "loan already rejected",
+			cmd:  ApproveLoanCommand{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().TransitionLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(transitionLoan(newLoan(entity.LoanStatusRejected)))
+			},
+			wantErr: entity.ErrLoanIllegalStatusTransition,
+		},
+		{
+			name: "success",
+			cmd:  ApproveLoanCommand{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().TransitionLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(transitionLoan(newLoan(entity.LoanStatusPendingApproval)))
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repository.NewMockRepository(ctrl)
+			test.setupMock(mockRepo)
+
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
+
+			got, err := s.ApproveLoan(ctx, test.cmd)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if got.Status != LoanStatusApproved {
+				t.Fatalf("expecting status %v, got %v", LoanStatusApproved, got.Status)
+			}
+			if !got.ApprovedAt.Equal(testNow) {
+				t.Fatalf("expecting approved at to be %v, got %v", testNow, got.ApprovedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+)
+
+// CancelLoanCommand represents the input data required to cancel a loan.
+type CancelLoanCommand struct {
+	// LoanID is the unique identifier of the loan being cancelled.
+	LoanID uuid.UUID
+}
+
+// CancelLoan cancels a loan at the request of its user.
+//
+// A loan can only be cancelled within the cooling-off period of its product, counted from the loan creation,
+// and before it is disbursed. A cancelled loan does not block the user from applying for a new loan.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - in: A CancelLoanCommand struct containing the loan being cancelled.
+//
+// Returns:
+//   - Loan: A struct containing the updated loan information.
+//   - error: An error if the operation fails, or nil if successful. Possible errors include entity.ErrLoanNotFound,
+//     entity.ErrLoanAlreadyDisbursed, entity.ErrLoanIllegalStatusTransition and entity.ErrLoanCoolingOffPeriodEnded.
+func (s *Impl) CancelLoan(ctx context.Context, in CancelLoanCommand) (Loan, error) {
+	now := s.clock.Now()
+
+	loan, err := s.repo.TransitionLoan(ctx, in.LoanID, func(loan *entity.Loan) error {
+		return loan.Cancel(now)
+	})
+	if err != nil {
+		return Loan{}, ensureBusinessError(err)
+	}
+
+	return parseLoan(loan), nil
+}
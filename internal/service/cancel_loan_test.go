@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
+	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
+)
+
+func TestImpl_CancelLoan(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	// the loan is created a week before the test time under a product with the given cooling-off period
+	newLoan := func(coolingOffDays int32) *entity.Loan {
+		product := *testProduct
+		product.CoolingOffDays = coolingOffDays
+
+		loan, err := entity.CreateLoan(&product, uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -7))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return loan
+	}
+
+	// transitionLoan applies the transition to the given loan, like the repository does to the stored loan
+	transitionLoan := func(loan *entity.Loan) func(context.Context, uuid.UUID, func(*entity.Loan) error) (*entity.Loan, error) {
+		return func(_ context.Context, _ uuid.UUID, transitionFn func(*entity.Loan) error) (*entity.Loan, error) {
+			if err := transitionFn(loan); err != nil {
+				return nil, err
+			}
+			return loan, nil
+		}
+	}
+
+	tests := []struct {
+		name      string
+		cmd       CancelLoanCommand
+		setupMock func(*repository.MockRepository)
+		wantErr   error
+	}{
+		{
+			name: "loan not found",
+			cmd:  CancelLoanCommand{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().TransitionLoan(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, entity.ErrLoanNotFound)
+			},
+			wantErr: entity.ErrLoanNotFound,
+		},
+		{
+			name: "repository unexpected error",
+			cmd:  CancelLoanCommand{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().TransitionLoan(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name: "cooling-off period ended",
+			cmd:  CancelLoanCommand{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().TransitionLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(transitionLoan(newLoan(7)))
+			},
+			wantErr: entity.ErrLoanCoolingOffPeriodEnded,
+		},
+		{
+			name: "disbursed loan",
+			cmd:  CancelLoanCommand{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				loan := newLoan(14)
+				if err := loan.Approve(testNow); err != nil {
+					t.Fatal(err)
+				}
+				if err := loan.Disburse(testNow); err != nil {
+					t.Fatal(err)
+				}
+
+				mockRepo.EXPECT().TransitionLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(transitionLoan(loan))
+			},
+			wantErr: entity.ErrLoanAlreadyDisbursed,
+		},
+		{
+			name: "success",
+			cmd:  CancelLoanCommand{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().TransitionLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(transitionLoan(newLoan(14)))
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repository.NewMockRepository(ctrl)
+			test.setupMock(mockRepo)
+
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
+
+			got, err := s.CancelLoan(ctx, test.cmd)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if got.Status != LoanStatusCancelled {
+				t.Fatalf("expecting status %v, got %v", LoanStatusCancelled, got.Status)
+			}
+			if !got.UpdatedAt.Equal(testNow) {
+				t.Fatalf("expecting updated at to be %v, got %v", testNow, got.UpdatedAt)
+			}
+		})
+	}
+}
@@ -2,11 +2,13 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 
 	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/repository"
 )
 
 // CreateLoanCommand represents the input data required to create a new loan.
@@ -14,17 +16,31 @@ type CreateLoanCommand struct {
 	// UserID is the unique identifier of the user requesting the loan.
 	UserID uuid.UUID
 
+	// ProductID is the unique identifier of the loan product the loan is requested under.
+	ProductID uuid.UUID
+
 	// Amount is the decimal representation of the loan amount.
 	Amount decimal.Decimal
 
+	// InstallmentCount is the number of installments the loan is repaid in, billed at the product's billing frequency.
+	InstallmentCount int32
+
 	// PaymentDurationWeeks is the duration of the loan repayment period in weeks.
+	// It is kept for backward compatibility and only used for weekly products when InstallmentCount is zero.
 	PaymentDurationWeeks int32
+
+	// IdempotencyKey is the client-supplied key identifying the request across retries, empty if the request is not idempotent.
+	IdempotencyKey string
 }
 
 // CreateLoan creates a new loan for a user based on the provided command.
 //
-// It first creates a loan entity, then validates it against the latest loan (if any),
-// and finally persists it in the repository.
+// It first retrieves the requested loan product, creates a loan entity validated against the product, delinquent
+// by the delinquency rule of the deployment if it sets one or the product's rule otherwise, then validates it against the latest loan (if any), and finally persists it in the repository.
+//
+// When an idempotency key is given and the user has already created a loan with it, including by a concurrent retry
+// of the request, the original loan is returned in its current state instead if the request payload is the same,
+// or entity.ErrIdempotencyKeyConflict if it is different.
 //
 // Parameters:
 //   - ctx: The context for the operation, which can be used for cancellation or passing values.
@@ -34,18 +50,76 @@ type CreateLoanCommand struct {
 //   - Loan: A Loan struct representing the created loan if successful.
 //   - error: An error if the loan creation fails, or nil if successful.
 func (s *Impl) CreateLoan(ctx context.Context, in CreateLoanCommand) (Loan, error) {
-	loan, err := entity.CreateLoan(in.UserID, in.Amount, in.PaymentDurationWeeks)
+	if err := entity.ValidateIdempotencyKey(in.IdempotencyKey); err != nil {
+		return Loan{}, err
+	}
+
+	product, err := s.repo.GetLoanProduct(ctx, in.ProductID)
+	if err != nil {
+		return Loan{}, ensureBusinessError(err)
+	}
+
+	product = s.config.deploymentProduct(product)
+
+	installmentCount := in.InstallmentCount
+	if installmentCount == 0 && product != nil && product.BillingFrequency == entity.BillingFrequencyWeekly {
+		installmentCount = in.PaymentDurationWeeks
+	}
+
+	if in.IdempotencyKey != "" {
+		if originalLoan, replayed, err := s.replayLoan(ctx, in, installmentCount); replayed || err != nil {
+			return originalLoan, err
+		}
+	}
+
+	loan, err := entity.CreateLoan(product, in.UserID, in.Amount, installmentCount, s.clock.Now())
 	if err != nil {
 		return Loan{}, ensureBusinessError(err)
 	}
+	loan.IdempotencyKey = in.IdempotencyKey
 
 	err = s.repo.CreateLoan(ctx, loan, func(latestLoan *entity.Loan) error {
 		return loan.ValidateLatestLoan(latestLoan)
 	})
 
+	if errors.Is(err, repository.ErrIdempotencyKeyUsed) {
+		// a concurrent request with the same idempotency key has created the loan first
+		if originalLoan, replayed, replayErr := s.replayLoan(ctx, in, installmentCount); replayed || replayErr != nil {
+			return originalLoan, replayErr
+		}
+	}
 	if err != nil {
 		return Loan{}, ensureBusinessError(err)
 	}
 
 	return parseLoan(loan), nil
 }
+
+// replayLoan returns the result of a loan creation replayed with its idempotency key, if the user has already created
+// a loan with it.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - in: The CreateLoanCommand of the replayed request.
+//   - installmentCount: The number of installments requested, resolved from the request.
+//
+// Returns:
+//   - Loan: The original loan in its current state, if the loan creation is replayed.
+//   - bool: A boolean indicating whether the user has already created a loan with the idempotency key.
+//   - error: entity.ErrIdempotencyKeyConflict if the request payload is different from the original one,
+//     or an error if the loan retrieval fails.
+func (s *Impl) replayLoan(ctx context.Context, in CreateLoanCommand, installmentCount int32) (Loan, bool, error) {
+	originalLoan, err := s.repo.GetLoanByIdempotencyKey(ctx, in.UserID, in.IdempotencyKey)
+	if err != nil {
+		return Loan{}, false, ensureBusinessError(err)
+	}
+	if originalLoan == nil {
+		return Loan{}, false, nil
+	}
+
+	if err = originalLoan.ValidateReplay(in.UserID, in.ProductID, in.Amount, installmentCount); err != nil {
+		return Loan{}, true, err
+	}
+
+	return parseLoan(originalLoan), true, nil
+}
@@ -3,13 +3,17 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 
 	"github.com/axopadyani/billing-engine/internal/entity"
+	repo "github.com/axopadyani/billing-engine/internal/repository"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
 	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
 )
 
@@ -19,31 +23,93 @@ func TestImpl_CreateLoan(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
 	defer cancel()
 
+	originalLoan, err := entity.CreateLoan(testProduct, userID, decimal.NewFromInt(5_000_000), 5, testNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	originalLoan.IdempotencyKey = "create-loan-1"
+
+	productRule := entity.DelinquencyRule{MissedInstallmentThreshold: 5, PartialPaymentRule: entity.PartialPaymentCountsAsPaid}
+	deploymentRule := entity.DelinquencyRule{MissedInstallmentThreshold: 1, PartialPaymentRule: entity.PartialPaymentCountsAsMissed}
+	ruleProduct := *testProduct
+	ruleProduct.DelinquencyRule = &productRule
+
+	productCalendar := entity.BillingCalendar{Timezone: "Asia/Jakarta", WeekStartDay: time.Sunday}
+	deploymentCalendar := entity.BillingCalendar{Timezone: "Asia/Jakarta", WeekStartDay: time.Monday}
+	calendarProduct := *testProduct
+	calendarProduct.BillingCalendar = &productCalendar
+
 	tests := []struct {
 		name      string
 		setupMock func(mockRepo *repository.MockRepository)
+		config    Config
 		cmd       CreateLoanCommand
+		wantID    uuid.UUID
 		wantErr   error
 	}{
 		{
-			name:      "validation error",
-			setupMock: nil,
+			name: "get product unexpected error",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(nil, errors.New("unknown error"))
+			},
+			cmd: CreateLoanCommand{
+				UserID:               userID,
+				ProductID:            testProduct.ID,
+				Amount:               decimal.NewFromInt(5_000_000),
+				PaymentDurationWeeks: 5,
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name: "product not found",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			cmd: CreateLoanCommand{
+				UserID:               userID,
+				ProductID:            uuid.New(),
+				Amount:               decimal.NewFromInt(5_000_000),
+				PaymentDurationWeeks: 5,
+			},
+			wantErr: entity.ErrLoanProductNotFound,
+		},
+		{
+			name: "validation error",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(testProduct, nil)
+			},
 			cmd: CreateLoanCommand{
 				UserID:               uuid.Nil,
+				ProductID:            testProduct.ID,
 				Amount:               decimal.NewFromInt(5_000_000),
 				PaymentDurationWeeks: 5,
 			},
 			wantErr: entity.ErrLoanEmptyUserID,
 		},
+		{
+			name: "amount out of product range",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(testProduct, nil)
+			},
+			cmd: CreateLoanCommand{
+				UserID:               userID,
+				ProductID:            testProduct.ID,
+				Amount:               decimal.NewFromInt(50_000_000),
+				PaymentDurationWeeks: 5,
+			},
+			wantErr: entity.ErrLoanAmountOutOfProductRange,
+		},
 		{
 			name: "repo business error",
 			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(testProduct, nil)
 				mockRepo.EXPECT().
 					CreateLoan(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(entity.ErrLoanStillHasOngoingLoan)
 			},
 			cmd: CreateLoanCommand{
 				UserID:               userID,
+				ProductID:            testProduct.ID,
 				Amount:               decimal.NewFromInt(5_000_000),
 				PaymentDurationWeeks: 5,
 			},
@@ -52,26 +118,258 @@ func TestImpl_CreateLoan(t *testing.T) {
 		{
 			name: "repo unexpected error",
 			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(testProduct, nil)
 				mockRepo.EXPECT().
 					CreateLoan(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(errors.New("unknown error"))
 			},
 			cmd: CreateLoanCommand{
 				UserID:               userID,
+				ProductID:            testProduct.ID,
 				Amount:               decimal.NewFromInt(5_000_000),
 				PaymentDurationWeeks: 5,
 			},
 			wantErr: UnexpectedError,
 		},
+		{
+			name: "duration in weeks for non-weekly product",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				monthlyProduct := *testProduct
+				monthlyProduct.BillingFrequency = entity.BillingFrequencyMonthly
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(&monthlyProduct, nil)
+			},
+			cmd: CreateLoanCommand{
+				UserID:               userID,
+				ProductID:            testProduct.ID,
+				Amount:               decimal.NewFromInt(5_000_000),
+				PaymentDurationWeeks: 5,
+			},
+			wantErr: entity.ErrLoanInvalidInstallmentCount,
+		},
+		{
+			name: "installment count",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				monthlyProduct := *testProduct
+				monthlyProduct.BillingFrequency = entity.BillingFrequencyMonthly
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(&monthlyProduct, nil)
+				mockRepo.EXPECT().
+					CreateLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			cmd: CreateLoanCommand{
+				UserID:           userID,
+				ProductID:        testProduct.ID,
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 6,
+			},
+			wantErr: nil,
+		},
+		{
+			name:      "idempotency key too long",
+			setupMock: nil,
+			cmd: CreateLoanCommand{
+				UserID:           userID,
+				ProductID:        testProduct.ID,
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 5,
+				IdempotencyKey:   strings.Repeat("k", 256),
+			},
+			wantErr: entity.ErrInvalidIdempotencyKey,
+		},
+		{
+			name: "get loan by idempotency key unexpected error",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(testProduct, nil)
+				mockRepo.EXPECT().GetLoanByIdempotencyKey(gomock.Any(), userID, "create-loan-1").
+					Return(nil, errors.New("unknown error"))
+			},
+			cmd: CreateLoanCommand{
+				UserID:           userID,
+				ProductID:        testProduct.ID,
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 5,
+				IdempotencyKey:   "create-loan-1",
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name: "first request with idempotency key",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(testProduct, nil)
+				mockRepo.EXPECT().GetLoanByIdempotencyKey(gomock.Any(), userID, "create-loan-1").Return(nil, nil)
+				mockRepo.EXPECT().
+					CreateLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, loan *entity.Loan, _ func(*entity.Loan) error) error {
+						if loan.IdempotencyKey != "create-loan-1" {
+							t.Errorf("expecting the loan to be created with the idempotency key, got %q", loan.IdempotencyKey)
+						}
+						return nil
+					})
+			},
+			cmd: CreateLoanCommand{
+				UserID:           userID,
+				ProductID:        testProduct.ID,
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 5,
+				IdempotencyKey:   "create-loan-1",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "replayed request",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(testProduct, nil)
+				mockRepo.EXPECT().GetLoanByIdempotencyKey(gomock.Any(), userID, "create-loan-1").Return(originalLoan, nil)
+			},
+			cmd: CreateLoanCommand{
+				UserID:               userID,
+				ProductID:            testProduct.ID,
+				Amount:               decimal.NewFromInt(5_000_000),
+				PaymentDurationWeeks: 5,
+				IdempotencyKey:       "create-loan-1",
+			},
+			wantID:  originalLoan.ID,
+			wantErr: nil,
+		},
+		{
+			name: "concurrent request with the same idempotency key",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(testProduct, nil)
+				gomock.InOrder(
+					mockRepo.EXPECT().GetLoanByIdempotencyKey(gomock.Any(), userID, "create-loan-1").Return(nil, nil),
+					mockRepo.EXPECT().CreateLoan(gomock.Any(), gomock.Any(), gomock.Any()).Return(repo.ErrIdempotencyKeyUsed),
+					mockRepo.EXPECT().GetLoanByIdempotencyKey(gomock.Any(), userID, "create-loan-1").Return(originalLoan, nil),
+				)
+			},
+			cmd: CreateLoanCommand{
+				UserID:           userID,
+				ProductID:        testProduct.ID,
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 5,
+				IdempotencyKey:   "create-loan-1",
+			},
+			wantID:  originalLoan.ID,
+			wantErr: nil,
+		},
+		{
+			name: "replayed request with different payload",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(testProduct, nil)
+				mockRepo.EXPECT().GetLoanByIdempotencyKey(gomock.Any(), userID, "create-loan-1").Return(originalLoan, nil)
+			},
+			cmd: CreateLoanCommand{
+				UserID:           userID,
+				ProductID:        testProduct.ID,
+				Amount:           decimal.NewFromInt(6_000_000),
+				InstallmentCount: 5,
+				IdempotencyKey:   "create-loan-1",
+			},
+			wantErr: entity.ErrIdempotencyKeyConflict,
+		},
+		{
+			name: "product delinquency rule",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(&ruleProduct, nil)
+				mockRepo.EXPECT().
+					CreateLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, loan *entity.Loan, _ func(*entity.Loan) error) error {
+						if loan.DelinquencyRule == nil || *loan.DelinquencyRule != productRule {
+							t.Errorf("expecting the loan to be created with the product delinquency rule, got %+v", loan.DelinquencyRule)
+						}
+						return nil
+					})
+			},
+			cmd: CreateLoanCommand{
+				UserID:           userID,
+				ProductID:        ruleProduct.ID,
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 5,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "deployment delinquency rule takes precedence",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(&ruleProduct, nil)
+				mockRepo.EXPECT().
+					CreateLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, loan *entity.Loan, _ func(*entity.Loan) error) error {
+						if loan.DelinquencyRule == nil || *loan.DelinquencyRule != deploymentRule {
+							t.Errorf("expecting the loan to be created with the deployment delinquency rule, got %+v", loan.DelinquencyRule)
+						}
+						if *ruleProduct.DelinquencyRule != productRule {
+							t.Errorf("expecting the product delinquency rule to be unchanged, got %+v", ruleProduct.DelinquencyRule)
+						}
+						return nil
+					})
+			},
+			config: Config{DelinquencyRule: &deploymentRule},
+			cmd: CreateLoanCommand{
+				UserID:           userID,
+				ProductID:        ruleProduct.ID,
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 5,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "deployment billing calendar",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(testProduct, nil)
+				mockRepo.EXPECT().
+					CreateLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, loan *entity.Loan, _ func(*entity.Loan) error) error {
+						if loan.BillingCalendar == nil || *loan.BillingCalendar != deploymentCalendar {
+							t.Errorf("expecting the loan to be created with the deployment billing calendar, got %+v", loan.BillingCalendar)
+						}
+						if testProduct.BillingCalendar != nil {
+							t.Errorf("expecting the product billing calendar to be unchanged, got %+v", testProduct.BillingCalendar)
+						}
+						return nil
+					})
+			},
+			config: Config{BillingCalendar: &deploymentCalendar},
+			cmd: CreateLoanCommand{
+				UserID:           userID,
+				ProductID:        testProduct.ID,
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 5,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "product billing calendar takes precedence",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(&calendarProduct, nil)
+				mockRepo.EXPECT().
+					CreateLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, loan *entity.Loan, _ func(*entity.Loan) error) error {
+						if loan.BillingCalendar == nil || *loan.BillingCalendar != productCalendar {
+							t.Errorf("expecting the loan to be created with the product billing calendar, got %+v", loan.BillingCalendar)
+						}
+						return nil
+					})
+			},
+			config: Config{BillingCalendar: &deploymentCalendar},
+			cmd: CreateLoanCommand{
+				UserID:           userID,
+				ProductID:        calendarProduct.ID,
+				Amount:           decimal.NewFromInt(5_000_000),
+				InstallmentCount: 5,
+			},
+			wantErr: nil,
+		},
 		{
 			name: "normal case",
 			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(testProduct, nil)
 				mockRepo.EXPECT().
 					CreateLoan(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil)
 			},
 			cmd: CreateLoanCommand{
 				UserID:               userID,
+				ProductID:            testProduct.ID,
 				Amount:               decimal.NewFromInt(5_000_000),
 				PaymentDurationWeeks: 5,
 			},
@@ -89,12 +387,15 @@ func TestImpl_CreateLoan(t *testing.T) {
 				test.setupMock(mockRepo)
 			}
 
-			s := NewService(mockRepo)
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), test.config)
 
-			_, err := s.CreateLoan(ctx, test.cmd)
+			got, err := s.CreateLoan(ctx, test.cmd)
 			if !errors.Is(err, test.wantErr) {
 				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
 			}
+			if test.wantID != uuid.Nil && got.ID != test.wantID {
+				t.Fatalf("expecting loan id to be %v, got %v", test.wantID, got.ID)
+			}
 		})
 	}
 }
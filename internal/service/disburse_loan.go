@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+)
+
+// DisburseLoanCommand represents the input data required to record the disbursement of a loan.
+type DisburseLoanCommand struct {
+	// LoanID is the unique identifier of the loan being disbursed.
+	LoanID uuid.UUID
+}
+
+// DisburseLoan records the disbursement of an approved loan, which starts its billing.
+//
+// The billing periods of the loan are counted from the disbursement time, so the repayment schedule of the loan
+// is generated again and its first installment is due a billing period after the disbursement.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - in: A DisburseLoanCommand struct containing the loan being disbursed.
+//
+// Returns:
+//   - Loan: A struct containing the updated loan information.
+//   - error: An error if the operation fails, or nil if successful. It returns entity.ErrLoanNotFound if the loan
+//     does not exist, and entity.ErrLoanIllegalStatusTransition if the loan is not approved.
+func (s *Impl) DisburseLoan(ctx context.Context, in DisburseLoanCommand) (Loan, error) {
+	now := s.clock.Now()
+
+	loan, err := s.repo.TransitionLoan(ctx, in.LoanID, func(loan *entity.Loan) error {
+		return loan.Disburse(now)
+	})
+	if err != nil {
+		return Loan{}, ensureBusinessError(err)
+	}
+
+	return parseLoan(loan), nil
+}
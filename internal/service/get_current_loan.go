@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"time"
 
 	"github.com/google/uuid"
 
@@ -17,8 +16,10 @@ type GetCurrentLoanQuery struct {
 
 // GetCurrentLoan retrieves the current loan details for a given user.
 //
-// It fetches the latest loan for the user, calculates the outstanding amount,
-// current bill amount, and checks if the loan is delinquent.
+// It fetches the latest loan for the user and bills it up to now, showing the current bill paid from the user's credit
+// balance, the late fees of the installments still missed and the loan defaulted if it is past its default period,
+// then calculates the outstanding amount, current bill amount,
+// and checks if the loan is delinquent. Nothing is stored: the billing is stored by the next write to the loan.
 //
 // Parameters:
 //   - ctx: The context for the function call, which can be used for cancellation or passing request-scoped values.
@@ -26,26 +27,34 @@ type GetCurrentLoanQuery struct {
 //
 // Returns:
 //   - LoanDetail: A struct containing the detailed information about the current loan.
-//   - error: An error if any occurred during the process. It returns entity.ErrLoanNotFound if no ongoing loan is found.
+//   - error: An error if any occurred during the process. It returns entity.ErrLoanNotFound if no open loan is found.
 func (s *Impl) GetCurrentLoan(ctx context.Context, in GetCurrentLoanQuery) (LoanDetail, error) {
 	loan, err := s.repo.GetLatestLoan(ctx, in.UserID)
 	if err != nil {
 		return LoanDetail{}, ensureBusinessError(err)
 	}
-	if loan == nil || loan.Status != entity.LoanStatusOngoing {
+	if loan == nil || !loan.Status.IsOpen() {
 		return LoanDetail{}, entity.ErrLoanNotFound
 	}
 
-	now := time.Now()
+	now := s.clock.Now()
 	paidAmount, err := s.repo.GetLoanPaidAmount(ctx, loan.ID)
 	if err != nil {
 		return LoanDetail{}, ensureBusinessError(err)
 	}
 
-	return parseLoanDetail(
-		parseLoan(loan),
-		loan.OutstandingAmount(paidAmount),
-		loan.CurrentBillAmount(now, paidAmount),
-		loan.IsDelinquent(now, paidAmount),
-	), nil
+	creditBalance, err := s.repo.GetUserCreditBalance(ctx, loan.UserID)
+	if err != nil {
+		return LoanDetail{}, ensureBusinessError(err)
+	}
+
+	billing, err := loan.Bill(now, paidAmount, creditBalance)
+	if err != nil {
+		return LoanDetail{}, ensureBusinessError(err)
+	}
+
+	detail := parseLoanDetail(loan, now, paidAmount.Add(billing.CreditAmount()), s.config.DelinquencyBuckets)
+	detail.CreditBalance = creditBalance.Sub(billing.CreditAmount())
+
+	return detail, nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/shopspring/decimal"
 
 	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
 	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
 )
 
@@ -17,22 +18,70 @@ func TestImpl_GetCurrentLoan(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
 	defer cancel()
 
-	ongoingLoan, err := entity.CreateLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5)
+	ongoingLoan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	paidLoan, err := entity.CreateLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5)
+	paidLoan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow)
 	if err != nil {
 		t.Fatal(err)
 	}
 	paidLoan.Status = entity.LoanStatusPaid
 
+	pendingLoan, err := entity.CreateLoan(testProduct, uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rejectedLoan, err := entity.CreateLoan(testProduct, uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rejectedLoan.Status = entity.LoanStatusRejected
+
+	newOverdueLoan := func() *entity.Loan {
+		loan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -21))
+		if err != nil {
+			t.Fatal(err)
+		}
+		loan.LateFeePolicy = entity.LateFeePolicy{
+			Method: entity.LateFeeMethodFixedPerMissedInstallment,
+			Amount: decimal.NewFromInt(50_000),
+		}
+
+		return loan
+	}
+
+	// the loan is disbursed five weeks before the test time under a default period of two weeks, and nothing is paid
+	newDefaultingLoan := func() *entity.Loan {
+		loan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -35))
+		if err != nil {
+			t.Fatal(err)
+		}
+		loan.DefaultAfterWeeks = 2
+
+		return loan
+	}
+
+	writtenOffLoan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writtenOffLoan.Status = entity.LoanStatusWrittenOff
+
+	billedLoan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -7))
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	tests := []struct {
-		name      string
-		cmd       GetCurrentLoanQuery
-		setupMock func(*repository.MockRepository)
-		wantErr   error
+		name              string
+		cmd               GetCurrentLoanQuery
+		setupMock         func(*repository.MockRepository)
+		wantDefault       bool
+		wantCreditBalance decimal.Decimal
+		wantErr           error
 	}{
 		{
 			name: "loan not found",
@@ -58,6 +107,33 @@ func TestImpl_GetCurrentLoan(t *testing.T) {
 			},
 			wantErr: entity.ErrLoanNotFound,
 		},
+		{
+			name: "rejected loan",
+			cmd:  GetCurrentLoanQuery{UserID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLatestLoan(gomock.Any(), gomock.Any()).Return(rejectedLoan, nil)
+			},
+			wantErr: entity.ErrLoanNotFound,
+		},
+		{
+			name: "written-off loan",
+			cmd:  GetCurrentLoanQuery{UserID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLatestLoan(gomock.Any(), gomock.Any()).Return(writtenOffLoan, nil)
+			},
+			wantErr: entity.ErrLoanNotFound,
+		},
+		{
+			name: "loan pending approval",
+			cmd:  GetCurrentLoanQuery{UserID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLatestLoan(gomock.Any(), gomock.Any()).Return(pendingLoan, nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), gomock.Any()).Return(decimal.NewFromInt(100_000), nil)
+			},
+			wantCreditBalance: decimal.NewFromInt(100_000),
+			wantErr:           nil,
+		},
 		{
 			name: "get loan unexpected error",
 			cmd:  GetCurrentLoanQuery{UserID: uuid.New()},
@@ -67,14 +143,58 @@ func TestImpl_GetCurrentLoan(t *testing.T) {
 			},
 			wantErr: UnexpectedError,
 		},
+		{
+			name: "late fees accrued",
+			cmd:  GetCurrentLoanQuery{UserID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLatestLoan(gomock.Any(), gomock.Any()).Return(newOverdueLoan(), nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "loan past its default period",
+			cmd:  GetCurrentLoanQuery{UserID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLatestLoan(gomock.Any(), gomock.Any()).Return(newDefaultingLoan(), nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil)
+			},
+			wantDefault: true,
+			wantErr:     nil,
+		},
+		{
+			name: "get user credit balance unexpected error",
+			cmd:  GetCurrentLoanQuery{UserID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLatestLoan(gomock.Any(), gomock.Any()).Return(ongoingLoan, nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), gomock.Any()).Return(decimal.Zero, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name: "credit balance",
+			cmd:  GetCurrentLoanQuery{UserID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLatestLoan(gomock.Any(), gomock.Any()).Return(billedLoan, nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), gomock.Any()).Return(decimal.NewFromInt(500_000), nil)
+			},
+			wantCreditBalance: decimal.Zero,
+			wantErr:           nil,
+		},
 		{
 			name: "normal case",
 			cmd:  GetCurrentLoanQuery{UserID: uuid.New()},
 			setupMock: func(mockRepo *repository.MockRepository) {
 				mockRepo.EXPECT().GetLatestLoan(gomock.Any(), gomock.Any()).Return(ongoingLoan, nil)
 				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), gomock.Any()).Return(decimal.NewFromInt(100_000), nil)
 			},
-			wantErr: nil,
+			wantCreditBalance: decimal.NewFromInt(100_000),
+			wantErr:           nil,
 		},
 	}
 
@@ -86,13 +206,119 @@ func TestImpl_GetCurrentLoan(t *testing.T) {
 			mockRepo := repository.NewMockRepository(ctrl)
 			test.setupMock(mockRepo)
 
-			s := NewService(mockRepo)
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
 
-			_, err := s.GetCurrentLoan(ctx, test.cmd)
+			got, err := s.GetCurrentLoan(ctx, test.cmd)
 			if !errors.Is(err, test.wantErr) {
 				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
 				return
 			}
+			if err == nil && (got.Loan.Status == LoanStatusDefaulted) != test.wantDefault {
+				t.Fatalf("expecting defaulted %v, got status %v", test.wantDefault, got.Loan.Status)
+			}
+			if err == nil && !got.CreditBalance.Equal(test.wantCreditBalance) {
+				t.Fatalf("expecting credit balance %v, got %v", test.wantCreditBalance, got.CreditBalance)
+			}
 		})
 	}
 }
+
+func TestImpl_GetCurrentLoan_fastForward(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var createdLoan *entity.Loan
+	mockRepo := repository.NewMockRepository(ctrl)
+	mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(testProduct, nil)
+	mockRepo.EXPECT().
+		CreateLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, loan *entity.Loan, _ func(*entity.Loan) error) error {
+			createdLoan = loan
+			return nil
+		})
+	mockRepo.EXPECT().GetLatestLoan(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, uuid.UUID) (*entity.Loan, error) {
+			return createdLoan, nil
+		}).
+		AnyTimes()
+	mockRepo.EXPECT().TransitionLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, transitionFn func(*entity.Loan) error) (*entity.Loan, error) {
+			if err := transitionFn(createdLoan); err != nil {
+				return nil, err
+			}
+			return createdLoan, nil
+		}).
+		Times(2)
+	mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil).AnyTimes()
+	mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil).AnyTimes()
+
+	fakeClock := clock.NewFakeClock(testNow)
+	s := NewService(mockRepo, fakeClock, Config{})
+
+	userID := uuid.New()
+	loan, err := s.CreateLoan(ctx, CreateLoanCommand{
+		UserID:           userID,
+		ProductID:        testProduct.ID,
+		Amount:           decimal.NewFromInt(5_000_000),
+		InstallmentCount: 5,
+	})
+	if err != nil {
+		t.Fatalf("expecting no error creating the loan, got %v", err)
+	}
+	if _, err = s.ApproveLoan(ctx, ApproveLoanCommand{LoanID: loan.ID}); err != nil {
+		t.Fatalf("expecting no error approving the loan, got %v", err)
+	}
+	if _, err = s.DisburseLoan(ctx, DisburseLoanCommand{LoanID: loan.ID}); err != nil {
+		t.Fatalf("expecting no error disbursing the loan, got %v", err)
+	}
+
+	steps := []struct {
+		name           string
+		advanceWeeks   int
+		wantBillAmount decimal.Decimal
+		wantDelinquent bool
+	}{
+		{
+			name:           "loan disbursement week",
+			advanceWeeks:   0,
+			wantBillAmount: decimal.Zero,
+			wantDelinquent: false,
+		},
+		{
+			name:           "one week later",
+			advanceWeeks:   1,
+			wantBillAmount: decimal.NewFromInt(1_100_000),
+			wantDelinquent: false,
+		},
+		{
+			name:           "three weeks later",
+			advanceWeeks:   2,
+			wantBillAmount: decimal.NewFromInt(3_300_000),
+			wantDelinquent: true,
+		},
+		{
+			name:           "ten weeks later",
+			advanceWeeks:   7,
+			wantBillAmount: decimal.NewFromInt(5_500_000),
+			wantDelinquent: true,
+		},
+	}
+
+	for _, step := range steps {
+		fakeClock.AdvanceDays(7 * step.advanceWeeks)
+
+		got, err := s.GetCurrentLoan(ctx, GetCurrentLoanQuery{UserID: userID})
+		if err != nil {
+			t.Fatalf("%s: expecting no error, got %v", step.name, err)
+		}
+		if !got.CurrentBillAmount.Equal(step.wantBillAmount) {
+			t.Fatalf("%s: expecting current bill amount %s, got %s", step.name, step.wantBillAmount, got.CurrentBillAmount)
+		}
+		if got.IsDelinquent != step.wantDelinquent {
+			t.Fatalf("%s: expecting delinquent %v, got %v", step.name, step.wantDelinquent, got.IsDelinquent)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+)
+
+// GetLoanQuery represents a query to retrieve a loan by its ID.
+type GetLoanQuery struct {
+	// LoanID is the unique identifier of the loan being queried.
+	LoanID uuid.UUID
+}
+
+// GetLoan retrieves the details of a loan regardless of its status.
+//
+// It fetches the loan and bills it up to now, showing the current bill paid from the user's credit balance, the late
+// fees of the installments still missed and the loan defaulted if it is past its default period, then calculates the outstanding amount, current bill amount, and checks if the loan
+// is delinquent. Nothing is stored: the billing is stored by the next write to the loan.
+//
+// Parameters:
+//   - ctx: The context for the function call, which can be used for cancellation or passing request-scoped values.
+//   - in: A GetLoanQuery struct containing the necessary information to retrieve the loan details.
+//
+// Returns:
+//   - LoanDetail: A struct containing the detailed information about the loan.
+//   - error: An error if any occurred during the process. It returns entity.ErrLoanNotFound if the loan does not exist.
+func (s *Impl) GetLoan(ctx context.Context, in GetLoanQuery) (LoanDetail, error) {
+	loan, err := s.repo.GetLoan(ctx, in.LoanID)
+	if err != nil {
+		return LoanDetail{}, ensureBusinessError(err)
+	}
+	if loan == nil {
+		return LoanDetail{}, entity.ErrLoanNotFound
+	}
+
+	now := s.clock.Now()
+	paidAmount, err := s.repo.GetLoanPaidAmount(ctx, loan.ID)
+	if err != nil {
+		return LoanDetail{}, ensureBusinessError(err)
+	}
+
+	creditBalance, err := s.repo.GetUserCreditBalance(ctx, loan.UserID)
+	if err != nil {
+		return LoanDetail{}, ensureBusinessError(err)
+	}
+
+	billing, err := loan.Bill(now, paidAmount, creditBalance)
+	if err != nil {
+		return LoanDetail{}, ensureBusinessError(err)
+	}
+
+	detail := parseLoanDetail(loan, now, paidAmount.Add(billing.CreditAmount()), s.config.DelinquencyBuckets)
+	detail.CreditBalance = creditBalance.Sub(billing.CreditAmount())
+
+	return detail, nil
+}
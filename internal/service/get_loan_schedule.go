@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+)
+
+// GetLoanScheduleQuery represents a query to retrieve the repayment schedule of a loan.
+type GetLoanScheduleQuery struct {
+	// LoanID is the unique identifier of the loan whose schedule is being queried.
+	LoanID uuid.UUID
+}
+
+// GetLoanSchedule retrieves the repayment schedule of a loan.
+//
+// Parameters:
+//   - ctx: The context for the function call, which can be used for cancellation or passing request-scoped values.
+//   - in: A GetLoanScheduleQuery struct containing the necessary information to retrieve the schedule.
+//
+// Returns:
+//   - []LoanInstallment: The installments of the loan, ordered by their number.
+//   - error: An error if any occurred during the process. It returns entity.ErrLoanNotFound if the loan has no schedule.
+func (s *Impl) GetLoanSchedule(ctx context.Context, in GetLoanScheduleQuery) ([]LoanInstallment, error) {
+	installments, err := s.repo.GetLoanInstallments(ctx, in.LoanID)
+	if err != nil {
+		return nil, ensureBusinessError(err)
+	}
+	if len(installments) == 0 {
+		return nil, entity.ErrLoanNotFound
+	}
+
+	res := make([]LoanInstallment, 0, len(installments))
+	for _, installment := range installments {
+		res = append(res, parseLoanInstallment(installment))
+	}
+
+	return res, nil
+}
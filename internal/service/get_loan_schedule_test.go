@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
+	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
+)
+
+func TestImpl_GetLoanSchedule(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockLoan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		query     GetLoanScheduleQuery
+		setupMock func(*repository.MockRepository)
+		wantLen   int
+		wantErr   error
+	}{
+		{
+			name:  "repo unexpected error",
+			query: GetLoanScheduleQuery{LoanID: mockLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanInstallments(gomock.Any(), gomock.Any()).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name:  "loan not found",
+			query: GetLoanScheduleQuery{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanInstallments(gomock.Any(), gomock.Any()).Return([]*entity.LoanInstallment{}, nil)
+			},
+			wantErr: entity.ErrLoanNotFound,
+		},
+		{
+			name:  "normal case",
+			query: GetLoanScheduleQuery{LoanID: mockLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanInstallments(gomock.Any(), mockLoan.ID).Return(mockLoan.Installments, nil)
+			},
+			wantLen: 5,
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repository.NewMockRepository(ctrl)
+			test.setupMock(mockRepo)
+
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
+
+			got, err := s.GetLoanSchedule(ctx, test.query)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+			if len(got) != test.wantLen {
+				t.Fatalf("expecting %d installments, got %d", test.wantLen, len(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
+	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
+)
+
+func TestImpl_GetLoan(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	ongoingLoan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newPaidLoan := func() *entity.Loan {
+		loan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -70))
+		if err != nil {
+			t.Fatal(err)
+		}
+		loan.Status = entity.LoanStatusPaid
+		loan.LateFeePolicy = entity.LateFeePolicy{
+			Method: entity.LateFeeMethodFixedPerMissedInstallment,
+			Amount: decimal.NewFromInt(50_000),
+		}
+
+		return loan
+	}
+
+	newOverdueLoan := func() *entity.Loan {
+		loan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -21))
+		if err != nil {
+			t.Fatal(err)
+		}
+		loan.LateFeePolicy = entity.LateFeePolicy{
+			Method: entity.LateFeeMethodFixedPerMissedInstallment,
+			Amount: decimal.NewFromInt(50_000),
+		}
+
+		return loan
+	}
+
+	billedLoan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -7))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name              string
+		query             GetLoanQuery
+		setupMock         func(*repository.MockRepository)
+		wantStatus        LoanStatus
+		wantOutstanding   decimal.Decimal
+		wantCreditBalance decimal.Decimal
+		wantErr           error
+	}{
+		{
+			name:  "get loan unexpected error",
+			query: GetLoanQuery{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoan(gomock.Any(), gomock.Any()).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name:  "loan not found",
+			query: GetLoanQuery{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoan(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			wantErr: entity.ErrLoanNotFound,
+		},
+		{
+			name:  "get loan paid amount unexpected error",
+			query: GetLoanQuery{LoanID: ongoingLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoan(gomock.Any(), ongoingLoan.ID).Return(ongoingLoan, nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), ongoingLoan.ID).Return(decimal.Zero, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name:  "late fees accrued",
+			query: GetLoanQuery{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoan(gomock.Any(), gomock.Any()).Return(newOverdueLoan(), nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil)
+			},
+			wantStatus:      LoanStatusOngoing,
+			wantOutstanding: decimal.NewFromInt(5_650_000),
+			wantErr:         nil,
+		},
+		{
+			name:  "paid loan",
+			query: GetLoanQuery{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoan(gomock.Any(), gomock.Any()).Return(newPaidLoan(), nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), gomock.Any()).Return(decimal.NewFromInt(5_500_000), nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), gomock.Any()).Return(decimal.NewFromInt(200_000), nil)
+			},
+			wantStatus:        LoanStatusPaid,
+			wantOutstanding:   decimal.Zero,
+			wantCreditBalance: decimal.NewFromInt(200_000),
+			wantErr:           nil,
+		},
+		{
+			name:  "get user credit balance unexpected error",
+			query: GetLoanQuery{LoanID: billedLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoan(gomock.Any(), billedLoan.ID).Return(billedLoan, nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), billedLoan.ID).Return(decimal.Zero, nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), billedLoan.UserID).Return(decimal.Zero, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name:  "loan past its default period",
+			query: GetLoanQuery{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				loan := newOverdueLoan()
+				loan.DefaultAfterWeeks = 2
+				mockRepo.EXPECT().GetLoan(gomock.Any(), gomock.Any()).Return(loan, nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil)
+			},
+			wantStatus:      LoanStatusDefaulted,
+			wantOutstanding: decimal.NewFromInt(5_650_000),
+			wantErr:         nil,
+		},
+		{
+			name:  "credit balance",
+			query: GetLoanQuery{LoanID: billedLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoan(gomock.Any(), billedLoan.ID).Return(billedLoan, nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), billedLoan.ID).Return(decimal.Zero, nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), billedLoan.UserID).Return(decimal.NewFromInt(1_500_000), nil)
+			},
+			wantStatus:        LoanStatusOngoing,
+			wantOutstanding:   decimal.NewFromInt(4_400_000),
+			wantCreditBalance: decimal.NewFromInt(400_000),
+			wantErr:           nil,
+		},
+		{
+			name:  "ongoing loan",
+			query: GetLoanQuery{LoanID: ongoingLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoan(gomock.Any(), ongoingLoan.ID).Return(ongoingLoan, nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), ongoingLoan.ID).Return(decimal.Zero, nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), ongoingLoan.UserID).Return(decimal.Zero, nil)
+			},
+			wantStatus:      LoanStatusOngoing,
+			wantOutstanding: decimal.NewFromInt(5_500_000),
+			wantErr:         nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repository.NewMockRepository(ctrl)
+			test.setupMock(mockRepo)
+
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
+
+			got, err := s.GetLoan(ctx, test.query)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if got.Loan.Status != test.wantStatus {
+				t.Fatalf("expecting status %v, got %v", test.wantStatus, got.Loan.Status)
+			}
+			if !got.OutstandingAmount.Equal(test.wantOutstanding) {
+				t.Fatalf("expecting outstanding amount %v, got %v", test.wantOutstanding, got.OutstandingAmount)
+			}
+			if !got.CreditBalance.Equal(test.wantCreditBalance) {
+				t.Fatalf("expecting credit balance %v, got %v", test.wantCreditBalance, got.CreditBalance)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+)
+
+// GetPayoffQuoteQuery represents a query to retrieve the amount needed to close a loan.
+type GetPayoffQuoteQuery struct {
+	// LoanID is the unique identifier of the loan whose payoff quote is being queried.
+	LoanID uuid.UUID
+}
+
+// GetPayoffQuote retrieves the amount needed to close a loan today.
+//
+// It fetches the loan and its paid amount, and calculates the outstanding amount
+// minus the interest rebated for the early settlement according to the loan's product.
+//
+// Parameters:
+//   - ctx: The context for the function call, which can be used for cancellation or passing request-scoped values.
+//   - in: A GetPayoffQuoteQuery struct containing the necessary information to retrieve the payoff quote.
+//
+// Returns:
+//   - PayoffQuote: A struct containing the outstanding, rebate and payoff amounts of the loan.
+//   - error: An error if any occurred during the process. It returns entity.ErrLoanNotFound if the loan does not exist,
+//     and entity.ErrLoanAlreadyPaid if the loan is already paid.
+func (s *Impl) GetPayoffQuote(ctx context.Context, in GetPayoffQuoteQuery) (PayoffQuote, error) {
+	loan, err := s.repo.GetLoan(ctx, in.LoanID)
+	if err != nil {
+		return PayoffQuote{}, ensureBusinessError(err)
+	}
+	if loan == nil {
+		return PayoffQuote{}, entity.ErrLoanNotFound
+	}
+	if loan.Status == entity.LoanStatusPaid {
+		return PayoffQuote{}, entity.ErrLoanAlreadyPaid
+	}
+
+	now := s.clock.Now().UTC()
+	paidAmount, err := s.repo.GetLoanPaidAmount(ctx, loan.ID)
+	if err != nil {
+		return PayoffQuote{}, ensureBusinessError(err)
+	}
+
+	return parsePayoffQuote(loan, now, paidAmount), nil
+}
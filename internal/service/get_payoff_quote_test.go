@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
+	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
+)
+
+func TestImpl_GetPayoffQuote(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockLoan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	paidLoan := *mockLoan
+	paidLoan.Status = entity.LoanStatusPaid
+
+	tests := []struct {
+		name      string
+		query     GetPayoffQuoteQuery
+		setupMock func(*repository.MockRepository)
+		want      PayoffQuote
+		wantErr   error
+	}{
+		{
+			name:  "get loan unexpected error",
+			query: GetPayoffQuoteQuery{LoanID: mockLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoan(gomock.Any(), gomock.Any()).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name:  "loan not found",
+			query: GetPayoffQuoteQuery{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoan(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			wantErr: entity.ErrLoanNotFound,
+		},
+		{
+			name:  "loan already paid",
+			query: GetPayoffQuoteQuery{LoanID: paidLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoan(gomock.Any(), gomock.Any()).Return(&paidLoan, nil)
+			},
+			wantErr: entity.ErrLoanAlreadyPaid,
+		},
+		{
+			name:  "get paid amount unexpected error",
+			query: GetPayoffQuoteQuery{LoanID: mockLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoan(gomock.Any(), gomock.Any()).Return(mockLoan, nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), gomock.Any()).Return(decimal.Zero, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name:  "normal case",
+			query: GetPayoffQuoteQuery{LoanID: mockLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoan(gomock.Any(), mockLoan.ID).Return(mockLoan, nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), mockLoan.ID).Return(decimal.NewFromInt(1_100_000), nil)
+			},
+			want: PayoffQuote{
+				LoanID:            mockLoan.ID,
+				OutstandingAmount: decimal.NewFromInt(4_400_000),
+				RebateAmount:      decimal.Zero,
+				PayoffAmount:      decimal.NewFromInt(4_400_000),
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repository.NewMockRepository(ctrl)
+			test.setupMock(mockRepo)
+
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
+
+			got, err := s.GetPayoffQuote(ctx, test.query)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if got.LoanID != test.want.LoanID ||
+				!got.OutstandingAmount.Equal(test.want.OutstandingAmount) ||
+				!got.RebateAmount.Equal(test.want.RebateAmount) ||
+				!got.PayoffAmount.Equal(test.want.PayoffAmount) {
+				t.Fatalf("expecting payoff quote %+v, got %+v", test.want, got)
+			}
+			if got.QuotedAt.IsZero() {
+				t.Fatalf("expecting quoted at to be set")
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+)
+
+// GetProductQuery represents a query to retrieve a loan product.
+type GetProductQuery struct {
+	// ProductID is the unique identifier of the loan product being queried.
+	ProductID uuid.UUID
+}
+
+// GetProduct retrieves a loan product by its ID.
+//
+// Parameters:
+//   - ctx: The context for the function call, which can be used for cancellation or passing request-scoped values.
+//   - in: A GetProductQuery struct containing the necessary information to retrieve the loan product.
+//
+// Returns:
+//   - LoanProduct: A struct containing the loan product information.
+//   - error: An error if any occurred during the process. It returns entity.ErrLoanProductNotFound if the product does not exist.
+func (s *Impl) GetProduct(ctx context.Context, in GetProductQuery) (LoanProduct, error) {
+	product, err := s.repo.GetLoanProduct(ctx, in.ProductID)
+	if err != nil {
+		return LoanProduct{}, ensureBusinessError(err)
+	}
+	if product == nil {
+		return LoanProduct{}, entity.ErrLoanProductNotFound
+	}
+
+	return parseLoanProduct(product), nil
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
+	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
+)
+
+func TestImpl_GetProduct(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	tests := []struct {
+		name      string
+		query     GetProductQuery
+		setupMock func(*repository.MockRepository)
+		wantErr   error
+	}{
+		{
+			name:  "repo unexpected error",
+			query: GetProductQuery{ProductID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name:  "product not found",
+			query: GetProductQuery{ProductID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			wantErr: entity.ErrLoanProductNotFound,
+		},
+		{
+			name:  "normal case",
+			query: GetProductQuery{ProductID: testProduct.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanProduct(gomock.Any(), testProduct.ID).Return(testProduct, nil)
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repository.NewMockRepository(ctrl)
+			test.setupMock(mockRepo)
+
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
+
+			_, err := s.GetProduct(ctx, test.query)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
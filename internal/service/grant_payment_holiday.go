@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+)
+
+// GrantPaymentHolidayCommand represents the input data required to grant a payment holiday on a loan.
+type GrantPaymentHolidayCommand struct {
+	// LoanID is the unique identifier of the loan the payment holiday is granted on.
+	LoanID uuid.UUID
+
+	// Periods is the number of billing periods skipped by the payment holiday, weeks for a weekly loan.
+	Periods int32
+
+	// Reason is the explanation of why the payment holiday is granted.
+	Reason string
+}
+
+// GrantPaymentHoliday grants a payment holiday on a loan being repaid, skipping the given number of billing periods
+// from the next billing period.
+//
+// The installments not due yet are deferred by the skipped periods, which pushes the maturity date of the loan back.
+// During the payment holiday, the current bill amount of the loan does not grow, and its days past due are frozen.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - in: A GrantPaymentHolidayCommand struct containing the loan and the payment holiday to be granted.
+//
+// Returns:
+//   - PaymentHoliday: A struct containing the granted payment holiday.
+//   - error: An error if the operation fails, or nil if successful. Possible errors include entity.ErrLoanNotFound,
+//     entity.ErrLoanNotDeferrable, entity.ErrPaymentHolidayInvalidPeriods, entity.ErrPaymentHolidayInvalidReason
+//     and entity.ErrLoanOnPaymentHoliday.
+func (s *Impl) GrantPaymentHoliday(ctx context.Context, in GrantPaymentHolidayCommand) (PaymentHoliday, error) {
+	if err := entity.ValidatePaymentHoliday(in.Periods, in.Reason); err != nil {
+		return PaymentHoliday{}, err
+	}
+
+	now := s.clock.Now()
+
+	var holiday *entity.PaymentHoliday
+	_, err := s.repo.GrantPaymentHoliday(ctx, in.LoanID, func(loan *entity.Loan) (*entity.PaymentHoliday, error) {
+		var err error
+		holiday, err = loan.GrantPaymentHoliday(now, in.Periods, in.Reason)
+		return holiday, err
+	})
+	if err != nil {
+		return PaymentHoliday{}, ensureBusinessError(err)
+	}
+
+	return parsePaymentHoliday(holiday), nil
+}
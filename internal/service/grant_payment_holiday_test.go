@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
+	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
+)
+
+func TestImpl_GrantPaymentHoliday(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	// the loan is disbursed three weeks before the test time, so its first three installments are due
+	newLoan := func(status entity.LoanStatus) *entity.Loan {
+		loan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -21))
+		if err != nil {
+			t.Fatal(err)
+		}
+		loan.Status = status
+
+		return loan
+	}
+
+	// grantPaymentHoliday grants the payment holiday on the given loan, like the repository does on the stored loan
+	grantPaymentHoliday := func(loan *entity.Loan) func(context.Context, uuid.UUID, func(*entity.Loan) (*entity.PaymentHoliday, error)) (*entity.Loan, error) {
+		return func(_ context.Context, _ uuid.UUID, grantFn func(*entity.Loan) (*entity.PaymentHoliday, error)) (*entity.Loan, error) {
+			if _, err := grantFn(loan); err != nil {
+				return nil, err
+			}
+			return loan, nil
+		}
+	}
+
+	tests := []struct {
+		name      string
+		cmd       GrantPaymentHolidayCommand
+		setupMock func(*repository.MockRepository)
+		wantErr   error
+	}{
+		{
+			name:      "invalid periods",
+			cmd:       GrantPaymentHolidayCommand{LoanID: uuid.New(), Periods: 0, Reason: "flood relief"},
+			setupMock: func(mockRepo *repository.MockRepository) {},
+			wantErr:   entity.ErrPaymentHolidayInvalidPeriods,
+		},
+		{
+			name:      "invalid reason",
+			cmd:       GrantPaymentHolidayCommand{LoanID: uuid.New(), Periods: 2},
+			setupMock: func(mockRepo *repository.MockRepository) {},
+			wantErr:   entity.ErrPaymentHolidayInvalidReason,
+		},
+		{
+			name: "loan not found",
+			cmd:  GrantPaymentHolidayCommand{LoanID: uuid.New(), Periods: 2, Reason: "flood relief"},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GrantPaymentHoliday(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, entity.ErrLoanNotFound)
+			},
+			wantErr: entity.ErrLoanNotFound,
+		},
+		{
+			name: "repository unexpected error",
+			cmd:  GrantPaymentHolidayCommand{LoanID: uuid.New(), Periods: 2, Reason: "flood relief"},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GrantPaymentHoliday(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name: "loan not being repaid",
+			cmd:  GrantPaymentHolidayCommand{LoanID: uuid.New(), Periods: 2, Reason: "flood relief"},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GrantPaymentHoliday(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(grantPaymentHoliday(newLoan(entity.LoanStatusPaid)))
+			},
+			wantErr: entity.ErrLoanNotDeferrable,
+		},
+		{
+			name: "success",
+			cmd:  GrantPaymentHolidayCommand{LoanID: uuid.New(), Periods: 2, Reason: "flood relief"},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GrantPaymentHoliday(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(grantPaymentHoliday(newLoan(entity.LoanStatusOngoing)))
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repository.NewMockRepository(ctrl)
+			test.setupMock(mockRepo)
+
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
+
+			got, err := s.GrantPaymentHoliday(ctx, test.cmd)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			// the two weeks after the current one are skipped, deferring the installments from the fourth one
+			wantStartsAt := time.Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC)
+			wantEndsAt := time.Date(2024, time.January, 22, 0, 0, 0, 0, time.UTC)
+			if got.ID == uuid.Nil || got.FirstInstallmentNumber != 4 || got.Periods != 2 || got.Reason != test.cmd.Reason {
+				t.Fatalf("expecting payment holiday of 2 periods deferring installments from 4, got %+v", got)
+			}
+			if !got.StartsAt.Equal(wantStartsAt) || !got.EndsAt.Equal(wantEndsAt) || !got.CreatedAt.Equal(testNow) {
+				t.Fatalf("expecting payment holiday from %v to %v created at %v, got %+v", wantStartsAt, wantEndsAt, testNow, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+)
+
+// ListLoanPaymentsQuery represents a query to retrieve the payments made towards a loan.
+type ListLoanPaymentsQuery struct {
+	// LoanID is the unique identifier of the loan whose payments are being queried.
+	LoanID uuid.UUID
+
+	// PageToken is the token of the page to be retrieved, as returned by the previous page, or empty for the first page.
+	PageToken string
+
+	// PageSize is the maximum number of payments to be retrieved, defaulting to 20 and capped at 100.
+	PageSize int32
+}
+
+// LoanPaymentPage represents a page of the payments made towards a loan.
+type LoanPaymentPage struct {
+	// Payments is the list of the payments in the page, newest first.
+	Payments []LoanPayment
+
+	// NextPageToken is the token to retrieve the next page with, or empty if this is the last page.
+	NextPageToken string
+}
+
+// ListLoanPayments retrieves a page of the payments made towards a loan, newest first.
+//
+// One more payment than the page size is fetched to find out whether there is a next page,
+// in which case the token of the next page points after the last payment of the page.
+//
+// Parameters:
+//   - ctx: The context for the function call, which can be used for cancellation or passing request-scoped values.
+//   - in: A ListLoanPaymentsQuery struct containing the necessary information to retrieve the payments.
+//
+// Returns:
+//   - LoanPaymentPage: A struct containing the payments in the page and the token of the next page.
+//   - error: An error if any occurred during the process. It returns ErrInvalidPageToken if the page token is malformed,
+//     and entity.ErrLoanNotFound if the first page is empty because the loan does not exist.
+func (s *Impl) ListLoanPayments(ctx context.Context, in ListLoanPaymentsQuery) (LoanPaymentPage, error) {
+	beforeID, err := decodePageToken(in.PageToken)
+	if err != nil {
+		return LoanPaymentPage{}, err
+	}
+
+	limit := pageLimit(in.PageSize)
+	payments, err := s.repo.ListLoanPayments(ctx, in.LoanID, beforeID, limit+1)
+	if err != nil {
+		return LoanPaymentPage{}, ensureBusinessError(err)
+	}
+
+	if len(payments) == 0 && beforeID == uuid.Nil {
+		loan, err := s.repo.GetLoan(ctx, in.LoanID)
+		if err != nil {
+			return LoanPaymentPage{}, ensureBusinessError(err)
+		}
+		if loan == nil {
+			return LoanPaymentPage{}, entity.ErrLoanNotFound
+		}
+	}
+
+	var nextPageToken string
+	if len(payments) > limit {
+		payments = payments[:limit]
+		nextPageToken = encodePageToken(payments[limit-1].ID)
+	}
+
+	res := make([]LoanPayment, 0, len(payments))
+	for _, payment := range payments {
+		res = append(res, parseLoanPayment(payment))
+	}
+
+	return LoanPaymentPage{Payments: res, NextPageToken: nextPageToken}, nil
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
+	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
+)
+
+func TestImpl_ListLoanPayments(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockLoan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockPayments := make([]*entity.LoanPayment, 0, 3)
+	for range 3 {
+		payment, err := entity.CreateLoanPayment(mockLoan.ID, decimal.NewFromInt(1_100_000), entity.PaymentDetails{}, testNow)
+		if err != nil {
+			t.Fatal(err)
+		}
+		payment.Allocations = []entity.InstallmentAllocation{{InstallmentNumber: 1, Amount: decimal.NewFromInt(1_100_000)}}
+		mockPayments = append([]*entity.LoanPayment{payment}, mockPayments...)
+	}
+
+	tests := []struct {
+		name          string
+		query         ListLoanPaymentsQuery
+		setupMock     func(*repository.MockRepository)
+		wantLen       int
+		wantNextToken string
+		wantErr       error
+	}{
+		{
+			name:      "invalid page token",
+			query:     ListLoanPaymentsQuery{LoanID: mockLoan.ID, PageToken: "not a token"},
+			setupMock: nil,
+			wantErr:   ErrInvalidPageToken,
+		},
+		{
+			name:  "repo unexpected error",
+			query: ListLoanPaymentsQuery{LoanID: mockLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListLoanPayments(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name:  "loan not found",
+			query: ListLoanPaymentsQuery{LoanID: mockLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListLoanPayments(gomock.Any(), mockLoan.ID, uuid.Nil, defaultPageSize+1).
+					Return([]*entity.LoanPayment{}, nil)
+				mockRepo.EXPECT().GetLoan(gomock.Any(), mockLoan.ID).Return(nil, nil)
+			},
+			wantErr: entity.ErrLoanNotFound,
+		},
+		{
+			name:  "loan without payments",
+			query: ListLoanPaymentsQuery{LoanID: mockLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListLoanPayments(gomock.Any(), mockLoan.ID, uuid.Nil, defaultPageSize+1).
+					Return([]*entity.LoanPayment{}, nil)
+				mockRepo.EXPECT().GetLoan(gomock.Any(), mockLoan.ID).Return(mockLoan, nil)
+			},
+			wantLen: 0,
+			wantErr: nil,
+		},
+		{
+			name:  "first page with next page",
+			query: ListLoanPaymentsQuery{LoanID: mockLoan.ID, PageSize: 2},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListLoanPayments(gomock.Any(), mockLoan.ID, uuid.Nil, 3).
+					Return(mockPayments, nil)
+			},
+			wantLen:       2,
+			wantNextToken: encodePageToken(mockPayments[1].ID),
+			wantErr:       nil,
+		},
+		{
+			name:  "last page",
+			query: ListLoanPaymentsQuery{LoanID: mockLoan.ID, PageSize: 2, PageToken: encodePageToken(mockPayments[1].ID)},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListLoanPayments(gomock.Any(), mockLoan.ID, mockPayments[1].ID, 3).
+					Return(mockPayments[2:], nil)
+			},
+			wantLen: 1,
+			wantErr: nil,
+		},
+		{
+			name:  "page size capped",
+			query: ListLoanPaymentsQuery{LoanID: mockLoan.ID, PageSize: 1000},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListLoanPayments(gomock.Any(), mockLoan.ID, uuid.Nil, maxPageSize+1).
+					Return(mockPayments, nil)
+			},
+			wantLen: 3,
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repository.NewMockRepository(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockRepo)
+			}
+
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
+
+			got, err := s.ListLoanPayments(ctx, test.query)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+			if len(got.Payments) != test.wantLen {
+				t.Fatalf("expecting %d payments, got %d", test.wantLen, len(got.Payments))
+			}
+			if got.NextPageToken != test.wantNextToken {
+				t.Fatalf("expecting next page token %q, got %q", test.wantNextToken, got.NextPageToken)
+			}
+			for _, payment := range got.Payments {
+				if len(payment.Allocations) != 1 {
+					t.Fatalf("expecting payment %v to have 1 allocation, got %d", payment.ID, len(payment.Allocations))
+				}
+			}
+		})
+	}
+}
+
+func TestDecodePageToken(t *testing.T) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		want    uuid.UUID
+		wantErr error
+	}{
+		{
+			name:  "empty token",
+			token: "",
+			want:  uuid.Nil,
+		},
+		{
+			name:  "encoded token",
+			token: encodePageToken(id),
+			want:  id,
+		},
+		{
+			name:    "malformed token",
+			token:   "!!!",
+			wantErr: ErrInvalidPageToken,
+		},
+		{
+			name:    "token of wrong length",
+			token:   "AAAA",
+			wantErr: ErrInvalidPageToken,
+		},
+		{
+			name:    "nil id",
+			token:   encodePageToken(uuid.Nil),
+			wantErr: ErrInvalidPageToken,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := decodePageToken(test.token)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+			if got != test.want {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
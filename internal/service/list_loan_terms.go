@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+)
+
+// ListLoanTermsQuery represents a query to retrieve the versions of the terms of a loan.
+type ListLoanTermsQuery struct {
+	// LoanID is the unique identifier of the loan whose terms are being queried.
+	LoanID uuid.UUID
+}
+
+// ListLoanTerms retrieves every version of the terms of a loan, from the terms it was created with to its latest restructure.
+//
+// Parameters:
+//   - ctx: The context for the function call, which can be used for cancellation or passing request-scoped values.
+//   - in: A ListLoanTermsQuery struct containing the necessary information to retrieve the terms.
+//
+// Returns:
+//   - []LoanTerms: The versions of the terms of the loan, ordered by their version.
+//   - error: An error if any occurred during the process. It returns entity.ErrLoanNotFound if the loan has no terms.
+func (s *Impl) ListLoanTerms(ctx context.Context, in ListLoanTermsQuery) ([]LoanTerms, error) {
+	terms, err := s.repo.ListLoanTerms(ctx, in.LoanID)
+	if err != nil {
+		return nil, ensureBusinessError(err)
+	}
+	if len(terms) == 0 {
+		return nil, entity.ErrLoanNotFound
+	}
+
+	res := make([]LoanTerms, 0, len(terms))
+	for _, version := range terms {
+		res = append(res, parseLoanTerms(version))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
+	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
+)
+
+func TestImpl_ListLoanTerms(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockLoan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -21))
+	if err != nil {
+		t.Fatal(err)
+	}
+	initialTerms := mockLoan.InitialTerms()
+	restructuredTerms, err := mockLoan.Restructure(testNow, decimal.Zero, 10, nil, "income reduced")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		query     ListLoanTermsQuery
+		setupMock func(*repository.MockRepository)
+		want      []int32
+		wantErr   error
+	}{
+		{
+			name:  "repo unexpected error",
+			query: ListLoanTermsQuery{LoanID: mockLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListLoanTerms(gomock.Any(), gomock.Any()).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name:  "loan not found",
+			query: ListLoanTermsQuery{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListLoanTerms(gomock.Any(), gomock.Any()).Return([]*entity.LoanTerms{}, nil)
+			},
+			wantErr: entity.ErrLoanNotFound,
+		},
+		{
+			name:  "original and restructured terms",
+			query: ListLoanTermsQuery{LoanID: mockLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListLoanTerms(gomock.Any(), mockLoan.ID).
+					Return([]*entity.LoanTerms{initialTerms, restructuredTerms}, nil)
+			},
+			want:    []int32{1, 2},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repository.NewMockRepository(ctrl)
+			test.setupMock(mockRepo)
+
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
+
+			got, err := s.ListLoanTerms(ctx, test.query)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+			if len(got) != len(test.want) {
+				t.Fatalf("expecting %d versions of the terms, got %d", len(test.want), len(got))
+			}
+			for i, terms := range got {
+				if terms.Version != test.want[i] || terms.LoanID != mockLoan.ID {
+					t.Fatalf("expecting version %d of the terms of loan %v, got version %d of loan %v", test.want[i], mockLoan.ID, terms.Version, terms.LoanID)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+)
+
+// ListPaymentHolidaysQuery represents a query to retrieve the payment holidays granted on a loan.
+type ListPaymentHolidaysQuery struct {
+	// LoanID is the unique identifier of the loan whose payment holidays are being queried.
+	LoanID uuid.UUID
+}
+
+// ListPaymentHolidays retrieves the payment holidays granted on a loan, oldest first.
+//
+// Parameters:
+//   - ctx: The context for the function call, which can be used for cancellation or passing request-scoped values.
+//   - in: A ListPaymentHolidaysQuery struct containing the necessary information to retrieve the payment holidays.
+//
+// Returns:
+//   - []PaymentHoliday: The payment holidays granted on the loan, empty if there is none.
+//   - error: An error if any occurred during the process. It returns entity.ErrLoanNotFound if the loan does not exist.
+func (s *Impl) ListPaymentHolidays(ctx context.Context, in ListPaymentHolidaysQuery) ([]PaymentHoliday, error) {
+	holidays, err := s.repo.ListPaymentHolidays(ctx, in.LoanID)
+	if err != nil {
+		return nil, ensureBusinessError(err)
+	}
+
+	if len(holidays) == 0 {
+		loan, err := s.repo.GetLoan(ctx, in.LoanID)
+		if err != nil {
+			return nil, ensureBusinessError(err)
+		}
+		if loan == nil {
+			return nil, entity.ErrLoanNotFound
+		}
+	}
+
+	res := make([]PaymentHoliday, 0, len(holidays))
+	for _, holiday := range holidays {
+		res = append(res, parsePaymentHoliday(holiday))
+	}
+
+	return res, nil
+}
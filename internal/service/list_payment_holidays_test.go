@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
+	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
+)
+
+func TestImpl_ListPaymentHolidays(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	mockLoan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -21))
+	if err != nil {
+		t.Fatal(err)
+	}
+	holiday, err := mockLoan.GrantPaymentHoliday(testNow, 2, "flood relief")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		query     ListPaymentHolidaysQuery
+		setupMock func(*repository.MockRepository)
+		want      []uuid.UUID
+		wantErr   error
+	}{
+		{
+			name:  "repo unexpected error",
+			query: ListPaymentHolidaysQuery{LoanID: mockLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListPaymentHolidays(gomock.Any(), gomock.Any()).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name:  "loan not found",
+			query: ListPaymentHolidaysQuery{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListPaymentHolidays(gomock.Any(), gomock.Any()).Return([]*entity.PaymentHoliday{}, nil)
+				mockRepo.EXPECT().GetLoan(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			wantErr: entity.ErrLoanNotFound,
+		},
+		{
+			name:  "no payment holidays",
+			query: ListPaymentHolidaysQuery{LoanID: mockLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListPaymentHolidays(gomock.Any(), mockLoan.ID).Return([]*entity.PaymentHoliday{}, nil)
+				mockRepo.EXPECT().GetLoan(gomock.Any(), mockLoan.ID).Return(mockLoan, nil)
+			},
+			want:    []uuid.UUID{},
+			wantErr: nil,
+		},
+		{
+			name:  "granted payment holiday",
+			query: ListPaymentHolidaysQuery{LoanID: mockLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListPaymentHolidays(gomock.Any(), mockLoan.ID).Return([]*entity.PaymentHoliday{holiday}, nil)
+			},
+			want:    []uuid.UUID{holiday.ID},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repository.NewMockRepository(ctrl)
+			test.setupMock(mockRepo)
+
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
+
+			got, err := s.ListPaymentHolidays(ctx, test.query)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+			if len(got) != len(test.want) {
+				t.Fatalf("expecting %d payment holidays, got %d", len(test.want), len(got))
+			}
+			for i, holiday := range got {
+				if holiday.ID != test.want[i] || holiday.LoanID != mockLoan.ID {
+					t.Fatalf("expecting payment holiday %v of loan %v, got %v of loan %v", test.want[i], mockLoan.ID, holiday.ID, holiday.LoanID)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+)
+
+// ListProductsQuery represents a query to retrieve the available loan products.
+type ListProductsQuery struct{}
+
+// ListProducts retrieves all the available loan products.
+//
+// Parameters:
+//   - ctx: The context for the function call, which can be used for cancellation or passing request-scoped values.
+//   - in: A ListProductsQuery struct containing the necessary information to retrieve the loan products.
+//
+// Returns:
+//   - []LoanProduct: A slice containing the available loan products.
+//   - error: An error if any occurred during the process.
+func (s *Impl) ListProducts(ctx context.Context, _ ListProductsQuery) ([]LoanProduct, error) {
+	products, err := s.repo.ListLoanProducts(ctx)
+	if err != nil {
+		return nil, ensureBusinessError(err)
+	}
+
+	res := make([]LoanProduct, 0, len(products))
+	for _, product := range products {
+		res = append(res, parseLoanProduct(product))
+	}
+
+	return res, nil
+}
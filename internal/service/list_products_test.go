@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
+	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
+)
+
+func TestImpl_ListProducts(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	tests := []struct {
+		name      string
+		setupMock func(*repository.MockRepository)
+		want      []LoanProduct
+		wantErr   error
+	}{
+		{
+			name: "repo unexpected error",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListLoanProducts(gomock.Any()).Return(nil, errors.New("unexpected error"))
+			},
+			want:    nil,
+			wantErr: UnexpectedError,
+		},
+		{
+			name: "no products",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListLoanProducts(gomock.Any()).Return([]*entity.LoanProduct{}, nil)
+			},
+			want:    []LoanProduct{},
+			wantErr: nil,
+		},
+		{
+			name: "normal case",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListLoanProducts(gomock.Any()).Return([]*entity.LoanProduct{testProduct}, nil)
+			},
+			want:    []LoanProduct{parseLoanProduct(testProduct)},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repository.NewMockRepository(ctrl)
+			test.setupMock(mockRepo)
+
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
+
+			got, err := s.ListProducts(ctx, ListProductsQuery{})
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Fatalf("ListProducts() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+)
+
+// ListUserLoansQuery represents a query to retrieve the loans of a user.
+type ListUserLoansQuery struct {
+	// UserID is the unique identifier of the user whose loans are being queried.
+	UserID uuid.UUID
+
+	// Statuses is the list of the statuses of the loans to be retrieved, or empty to retrieve the loans of any status.
+	Statuses []LoanStatus
+
+	// PageToken is the token of the page to be retrieved, as returned by the previous page, or empty for the first page.
+	PageToken string
+
+	// PageSize is the maximum number of loans to be retrieved, defaulting to 20 and capped at 100.
+	PageSize int32
+}
+
+// LoanSummary represents a loan along with the total amount paid towards it.
+type LoanSummary struct {
+	// Loan is the basic loan information.
+	Loan Loan
+
+	// PaidAmount is the total amount that has been paid towards the loan.
+	PaidAmount decimal.Decimal
+}
+
+// LoanSummaryPage represents a page of the loans of a user.
+type LoanSummaryPage struct {
+	// Loans is the list of the loans in the page, newest first.
+	Loans []LoanSummary
+
+	// NextPageToken is the token to retrieve the next page with, or empty if this is the last page.
+	NextPageToken string
+}
+
+// ListUserLoans retrieves a page of the loans of a user of any status, newest first, along with
+// the total amount paid towards each loan.
+//
+// The loans are listed with their current status: an ongoing loan with a default period is billed up to now as
+// GetLoan does, without storing anything, so that it is listed and filtered as defaulted once it is past its default
+// period even though its defaulted status is only stored by the next write to it. The ongoing loans are therefore
+// also fetched for a filter of defaulted loans, and an ongoing loan past its default period is left out of a filter
+// of ongoing loans.
+//
+// One more loan than the page size is fetched to find out whether there is a next page,
+// in which case the token of the next page points after the last loan of the page.
+//
+// Parameters:
+//   - ctx: The context for the function call, which can be used for cancellation or passing request-scoped values.
+//   - in: A ListUserLoansQuery struct containing the necessary information to retrieve the loans.
+//
+// Returns:
+//   - LoanSummaryPage: A struct containing the loans in the page and the token of the next page.
+//   - error: An error if any occurred during the process. It returns ErrInvalidPageToken if the page token is malformed,
+//     and entity.ErrLoanInvalidStatus if a status of the filter is unknown.
+func (s *Impl) ListUserLoans(ctx context.Context, in ListUserLoansQuery) (LoanSummaryPage, error) {
+	beforeID, err := decodePageToken(in.PageToken)
+	if err != nil {
+		return LoanSummaryPage{}, err
+	}
+
+	statuses := make([]entity.LoanStatus, 0, len(in.Statuses))
+	for _, status := range in.Statuses {
+		entityStatus := toEntityLoanStatus(status)
+		if !entityStatus.IsValid() {
+			return LoanSummaryPage{}, entity.ErrLoanInvalidStatus
+		}
+		statuses = append(statuses, entityStatus)
+	}
+
+	storedStatuses := statuses
+	if slices.Contains(statuses, entity.LoanStatusDefaulted) && !slices.Contains(statuses, entity.LoanStatusOngoing) {
+		storedStatuses = append(slices.Clone(statuses), entity.LoanStatusOngoing)
+	}
+
+	now := s.clock.Now()
+	limit := pageLimit(in.PageSize)
+	res := make([]LoanSummary, 0, limit+1)
+	for len(res) <= limit {
+		fetchLimit := limit + 1 - len(res)
+		loans, paidAmounts, err := s.repo.ListUserLoans(ctx, in.UserID, storedStatuses, beforeID, fetchLimit)
+		if err != nil {
+			return LoanSummaryPage{}, ensureBusinessError(err)
+		}
+
+		for _, loan := range loans {
+			paidAmount, ok := paidAmounts[loan.ID]
+			if !ok {
+				paidAmount = decimal.Zero
+			}
+
+			if loan.CanBeDefaulted() {
+				loan, paidAmount, err = s.billListedLoan(ctx, loan.ID, paidAmount, now)
+				if err != nil {
+					return LoanSummaryPage{}, ensureBusinessError(err)
+				}
+			}
+			if len(statuses) > 0 && !slices.Contains(statuses, loan.Status) {
+				continue
+			}
+
+			res = append(res, LoanSummary{
+				Loan:       parseLoan(loan),
+				PaidAmount: paidAmount,
+			})
+		}
+
+		if len(loans) < fetchLimit {
+			break
+		}
+		beforeID = loans[len(loans)-1].ID
+	}
+
+	var nextPageToken string
+	if len(res) > limit {
+		res = res[:limit]
+		nextPageToken = encodePageToken(res[limit-1].Loan.ID)
+	}
+
+	return LoanSummaryPage{Loans: res, NextPageToken: nextPageToken}, nil
+}
+
+// billListedLoan bills a listed loan up to now without storing anything, as GetLoan does.
+//
+// Parameters:
+//   - ctx: The context for the function call.
+//   - loanID: The unique identifier of the listed loan.
+//   - paidAmount: The total amount that has been paid towards the loan.
+//   - now: The time the loan is billed at.
+//
+// Returns:
+//   - *entity.Loan: The loan billed up to now, with its installments and charges.
+//   - decimal.Decimal: The paid amount of the loan, including the current bill paid from the user's credit balance.
+//   - error: An error if the loan cannot be retrieved or billed.
+func (s *Impl) billListedLoan(ctx context.Context, loanID uuid.UUID, paidAmount decimal.Decimal, now time.Time) (*entity.Loan, decimal.Decimal, error) {
+	loan, err := s.repo.GetLoan(ctx, loanID)
+	if err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+	if loan == nil {
+		return nil, decimal.Decimal{}, entity.ErrLoanNotFound
+	}
+
+	creditBalance, err := s.repo.GetUserCreditBalance(ctx, loan.UserID)
+	if err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+
+	billing, err := loan.Bill(now, paidAmount, creditBalance)
+	if err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+
+	return loan, paidAmount.Add(billing.CreditAmount()), nil
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
+	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
+)
+
+func TestImpl_ListUserLoans(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	userID := uuid.New()
+	mockLoans := make([]*entity.Loan, 0, 3)
+	for i := range 3 {
+		loan, err := newOngoingLoan(userID, decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -7*i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		mockLoans = append(mockLoans, loan)
+	}
+	mockLoans[1].Status = entity.LoanStatusPaid
+	mockLoans[2].Status = entity.LoanStatusPaid
+
+	paidAmounts := map[uuid.UUID]decimal.Decimal{
+		mockLoans[1].ID: decimal.NewFromInt(5_500_000),
+		mockLoans[2].ID: decimal.NewFromInt(5_500_000),
+	}
+
+	// the loan is defaulted after a week past due, and its first installment has been due for four weeks
+	newDefaultingLoan := func() *entity.Loan {
+		loan, err := newOngoingLoan(userID, decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -35))
+		if err != nil {
+			t.Fatal(err)
+		}
+		loan.DefaultAfterWeeks = 1
+
+		return loan
+	}
+
+	tests := []struct {
+		name            string
+		query           ListUserLoansQuery
+		setupMock       func(*repository.MockRepository)
+		wantPaidAmounts []decimal.Decimal
+		wantStatuses    []LoanStatus
+		wantNextToken   string
+		wantErr         error
+	}{
+		{
+			name:      "invalid page token",
+			query:     ListUserLoansQuery{UserID: userID, PageToken: "not a token"},
+			setupMock: nil,
+			wantErr:   ErrInvalidPageToken,
+		},
+		{
+			name:      "invalid status filter",
+			query:     ListUserLoansQuery{UserID: userID, Statuses: []LoanStatus{LoanStatus(999)}},
+			setupMock: nil,
+			wantErr:   entity.ErrLoanInvalidStatus,
+		},
+		{
+			name:  "repo unexpected error",
+			query: ListUserLoansQuery{UserID: userID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListUserLoans(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name:  "user without loans",
+			query: ListUserLoansQuery{UserID: userID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListUserLoans(gomock.Any(), userID, []entity.LoanStatus{}, uuid.Nil, defaultPageSize+1).
+					Return([]*entity.Loan{}, map[uuid.UUID]decimal.Decimal{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "first page with next page",
+			query: ListUserLoansQuery{UserID: userID, PageSize: 2},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListUserLoans(gomock.Any(), userID, []entity.LoanStatus{}, uuid.Nil, 3).
+					Return(mockLoans, paidAmounts, nil)
+			},
+			wantPaidAmounts: []decimal.Decimal{decimal.Zero, decimal.NewFromInt(5_500_000)},
+			wantNextToken:   encodePageToken(mockLoans[1].ID),
+			wantErr:         nil,
+		},
+		{
+			name:  "filtered by status",
+			query: ListUserLoansQuery{UserID: userID, Statuses: []LoanStatus{LoanStatusPaid}, PageToken: encodePageToken(mockLoans[0].ID)},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ListUserLoans(gomock.Any(), userID, []entity.LoanStatus{entity.LoanStatusPaid}, mockLoans[0].ID, defaultPageSize+1).
+					Return(mockLoans[1:], paidAmounts, nil)
+			},
+			wantPaidAmounts: []decimal.Decimal{decimal.NewFromInt(5_500_000), decimal.NewFromInt(5_500_000)},
+			wantErr:         nil,
+		},
+		{
+			name:  "ongoing loan past its default period listed as defaulted",
+			query: ListUserLoansQuery{UserID: userID, Statuses: []LoanStatus{LoanStatusDefaulted}},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				loan := newDefaultingLoan()
+				statuses := []entity.LoanStatus{entity.LoanStatusDefaulted, entity.LoanStatusOngoing}
+				mockRepo.EXPECT().ListUserLoans(gomock.Any(), userID, statuses, uuid.Nil, defaultPageSize+1).
+					Return([]*entity.Loan{loan, mockLoans[0]}, map[uuid.UUID]decimal.Decimal{}, nil)
+				mockRepo.EXPECT().GetLoan(gomock.Any(), loan.ID).Return(loan, nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), userID).Return(decimal.Zero, nil)
+			},
+			wantPaidAmounts: []decimal.Decimal{decimal.Zero},
+			wantStatuses:    []LoanStatus{LoanStatusDefaulted},
+			wantErr:         nil,
+		},
+		{
+			name:  "ongoing loan past its default period left out of the ongoing loans",
+			query: ListUserLoansQuery{UserID: userID, Statuses: []LoanStatus{LoanStatusOngoing}, PageSize: 1},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				loan := newDefaultingLoan()
+				statuses := []entity.LoanStatus{entity.LoanStatusOngoing}
+				gomock.InOrder(
+					mockRepo.EXPECT().ListUserLoans(gomock.Any(), userID, statuses, uuid.Nil, 2).
+						Return([]*entity.Loan{loan, mockLoans[0]}, map[uuid.UUID]decimal.Decimal{}, nil),
+					mockRepo.EXPECT().ListUserLoans(gomock.Any(), userID, statuses, mockLoans[0].ID, 1).
+						Return([]*entity.Loan{}, map[uuid.UUID]decimal.Decimal{}, nil),
+				)
+				mockRepo.EXPECT().GetLoan(gomock.Any(), loan.ID).Return(loan, nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), userID).Return(decimal.Zero, nil)
+			},
+			wantPaidAmounts: []decimal.Decimal{decimal.Zero},
+			wantStatuses:    []LoanStatus{LoanStatusOngoing},
+			wantErr:         nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repository.NewMockRepository(ctrl)
+			if test.setupMock != nil {
+				test.setupMock(mockRepo)
+			}
+
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
+
+			got, err := s.ListUserLoans(ctx, test.query)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+			if len(got.Loans) != len(test.wantPaidAmounts) {
+				t.Fatalf("expecting %d loans, got %d", len(test.wantPaidAmounts), len(got.Loans))
+			}
+			for i, loan := range got.Loans {
+				if !loan.PaidAmount.Equal(test.wantPaidAmounts[i]) {
+					t.Fatalf("expecting loan %d paid amount %v, got %v", i, test.wantPaidAmounts[i], loan.PaidAmount)
+				}
+				if test.wantStatuses != nil && loan.Loan.Status != test.wantStatuses[i] {
+					t.Fatalf("expecting loan %d status %v, got %v", i, test.wantStatuses[i], loan.Loan.Status)
+				}
+			}
+			if got.NextPageToken != test.wantNextToken {
+				t.Fatalf("expecting next page token %q, got %q", test.wantNextToken, got.NextPageToken)
+			}
+		})
+	}
+}
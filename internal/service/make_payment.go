@@ -2,12 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 
 	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/repository"
 )
 
 // MakePaymentCommand represents the input data required to process a loan payment.
@@ -17,12 +19,55 @@ type MakePaymentCommand struct {
 
 	// PaymentAmount is the decimal amount of the payment being made towards the loan.
 	PaymentAmount decimal.Decimal
+
+	// Mode is the purpose of the payment, which determines the payment amounts accepted.
+	Mode PaymentMode
+
+	// IdempotencyKey is the client-supplied key identifying the request across retries, empty if the request is not idempotent.
+	IdempotencyKey string
+
+	// ExternalReference is the reference of the payment in its channel, such as a bank transaction ID.
+	// It must be unique per channel, and can be empty.
+	ExternalReference string
+
+	// Channel is the channel the payment was received through.
+	Channel PaymentChannel
+
+	// PaidAt is the value date of the payment in its channel, the time the payment is recorded at when it is zero.
+	PaidAt time.Time
+
+	// Metadata is free-form information about the payment.
+	Metadata map[string]string
+}
+
+// paymentDetails returns the details of the payment reported by its channel.
+//
+// Returns:
+//   - entity.PaymentDetails: The external reference, channel, value date and metadata of the payment.
+func (c MakePaymentCommand) paymentDetails() entity.PaymentDetails {
+	return entity.PaymentDetails{
+		ExternalReference: c.ExternalReference,
+		Channel:           toEntityPaymentChannel(c.Channel),
+		PaidAt:            c.PaidAt,
+		Metadata:          c.Metadata,
+	}
 }
 
 // MakePayment processes a payment for a loan.
 //
-// It updates the loan's payment status, calculates the new paid amount,
-// and returns the updated loan details.
+// It bills the loan first: the current bill is paid from the user's credit balance, then the late fees of the
+// installments still missed are accrued, and the loan is defaulted if it is past its default period. The payment
+// is then made towards what remains of the bill, so a regular payment is rejected when the credit balance has paid
+// the whole bill. It updates the loan's payment status, calculates the new paid amount,
+// and returns the updated loan details. A payoff payment closes the loan
+// in the same transaction as the payment is recorded.
+//
+// A payment exceeding the amount accepted towards the loan is handled according to the loan's overpayment policy:
+// it is rejected, its excess is held as the user's credit balance, or its excess is marked to be refunded.
+//
+// When an idempotency key is given and a payment has already been made towards the loan with it, including by
+// a concurrent retry of the request, no payment is made and the current loan details are returned instead
+// if the request payload is the same, or entity.ErrIdempotencyKeyConflict if it is different.
 //
 // Parameters:
 //   - ctx: The context for the operation.
@@ -32,23 +77,86 @@ type MakePaymentCommand struct {
 //   - LoanDetail: A struct containing the updated loan information.
 //   - error: An error if the payment process fails, or nil if successful.
 func (s *Impl) MakePayment(ctx context.Context, in MakePaymentCommand) (LoanDetail, error) {
-	now := time.Now().UTC()
+	if err := entity.ValidateIdempotencyKey(in.IdempotencyKey); err != nil {
+		return LoanDetail{}, err
+	}
+
+	if in.IdempotencyKey != "" {
+		if detail, replayed, err := s.replayPayment(ctx, in); replayed || err != nil {
+			return detail, err
+		}
+	}
+
+	now := s.clock.Now().UTC()
 
 	loan, newPaidAmount, err := s.repo.MakePayment(
-		ctx, in.LoanID, in.PaymentAmount,
-		func(loan *entity.Loan, currPaidAmount decimal.Decimal) (payment *entity.LoanPayment, shouldUpdateLoan bool, err error) {
-			return loan.MakePayment(now, currPaidAmount, in.PaymentAmount)
+		ctx, in.LoanID, in.PaymentAmount, in.IdempotencyKey,
+		func(loan *entity.Loan, currPaidAmount, creditBalance decimal.Decimal) (billing *entity.LoanBilling, payment *entity.LoanPayment, shouldUpdateLoan bool, err error) {
+			billing, err = loan.Bill(now, currPaidAmount, creditBalance)
+			if err != nil {
+				return nil, nil, false, err
+			}
+
+			paidAmount := currPaidAmount.Add(billing.CreditAmount())
+			payment, shouldUpdateLoan, err = loan.MakePayment(now, paidAmount, in.PaymentAmount, toEntityPaymentMode(in.Mode), in.paymentDetails())
+			if err != nil {
+				return nil, nil, false, err
+			}
+			payment.IdempotencyKey = in.IdempotencyKey
+
+			return billing, payment, shouldUpdateLoan || billing.ShouldUpdateLoan, nil
 		},
 	)
 
+	if errors.Is(err, repository.ErrIdempotencyKeyUsed) {
+		// a concurrent request with the same idempotency key has made the payment first
+		if detail, replayed, replayErr := s.replayPayment(ctx, in); replayed || replayErr != nil {
+			return detail, replayErr
+		}
+	}
 	if err != nil {
 		return LoanDetail{}, ensureBusinessError(err)
 	}
 
-	return parseLoanDetail(
-		parseLoan(loan),
-		loan.OutstandingAmount(newPaidAmount),
-		loan.CurrentBillAmount(now, newPaidAmount),
-		loan.IsDelinquent(now, newPaidAmount),
-	), nil
+	creditBalance, err := s.repo.GetUserCreditBalance(ctx, loan.UserID)
+	if err != nil {
+		return LoanDetail{}, ensureBusinessError(err)
+	}
+
+	detail := parseLoanDetail(loan, now, newPaidAmount, s.config.DelinquencyBuckets)
+	detail.CreditBalance = creditBalance
+
+	return detail, nil
+}
+
+// replayPayment returns the result of a payment replayed with its idempotency key, if a payment has already been made
+// towards the loan with it.
+//
+// The replay returns the current details of the loan, as GetLoan does, rather than the details as of the original
+// payment: the loan may have been billed, paid or reversed since, and its current state is what the client acts on.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - in: The MakePaymentCommand of the replayed request.
+//
+// Returns:
+//   - LoanDetail: The current loan details, if the payment is replayed.
+//   - bool: A boolean indicating whether a payment has already been made with the idempotency key.
+//   - error: entity.ErrIdempotencyKeyConflict if the request payload is different from the original one,
+//     or an error if the payment or loan retrieval fails.
+func (s *Impl) replayPayment(ctx context.Context, in MakePaymentCommand) (LoanDetail, bool, error) {
+	originalPayment, err := s.repo.GetLoanPaymentByIdempotencyKey(ctx, in.LoanID, in.IdempotencyKey)
+	if err != nil {
+		return LoanDetail{}, false, ensureBusinessError(err)
+	}
+	if originalPayment == nil {
+		return LoanDetail{}, false, nil
+	}
+
+	if err = originalPayment.ValidateReplay(in.LoanID, in.PaymentAmount, toEntityPaymentMode(in.Mode), in.paymentDetails()); err != nil {
+		return LoanDetail{}, true, err
+	}
+
+	detail, err := s.GetLoan(ctx, GetLoanQuery{LoanID: in.LoanID})
+	return detail, true, err
 }
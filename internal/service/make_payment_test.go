@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -10,17 +11,29 @@ import (
 	"github.com/shopspring/decimal"
 
 	"github.com/axopadyani/billing-engine/internal/entity"
+	repo "github.com/axopadyani/billing-engine/internal/repository"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
 	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
 )
 
 func TestImpl_MakePayment(t *testing.T) {
 	ctx := context.Background()
 
-	mockLoan, err := entity.CreateLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5)
+	mockLoan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow)
 	if err != nil {
 		t.Fatal(err)
 	}
 
+	originalPayment := &entity.LoanPayment{
+		ID:             uuid.New(),
+		LoanID:         mockLoan.ID,
+		Amount:         decimal.NewFromInt(1000),
+		Mode:           entity.PaymentModeRegular,
+		IdempotencyKey: "payment-1",
+		CreatedAt:      testNow,
+		UpdatedAt:      testNow,
+	}
+
 	testCases := []struct {
 		name      string
 		setupMock func(mockRepo *repository.MockRepository)
@@ -30,8 +43,9 @@ func TestImpl_MakePayment(t *testing.T) {
 		{
 			name: "normal case",
 			setupMock: func(mockRepo *repository.MockRepository) {
-				mockRepo.EXPECT().MakePayment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				mockRepo.EXPECT().MakePayment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(mockLoan, decimal.NewFromInt(2000), nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), mockLoan.UserID).Return(decimal.Zero, nil)
 			},
 			cmd: MakePaymentCommand{
 				LoanID:        mockLoan.ID,
@@ -39,14 +53,241 @@ func TestImpl_MakePayment(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "loan defaulted before the payment",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				// the loan is disbursed five weeks ago under a default period of two weeks, and nothing is paid
+				loan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -35))
+				if err != nil {
+					t.Fatal(err)
+				}
+				loan.DefaultAfterWeeks = 2
+
+				mockRepo.EXPECT().MakePayment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						_ uuid.UUID,
+						_ decimal.Decimal,
+						_ string,
+						makePaymentFn func(*entity.Loan, decimal.Decimal, decimal.Decimal) (*entity.LoanBilling, *entity.LoanPayment, bool, error),
+					) (*entity.Loan, decimal.Decimal, error) {
+						_, payment, shouldUpdateLoan, err := makePaymentFn(loan, decimal.Zero, decimal.Zero)
+						if err != nil {
+							return nil, decimal.Zero, err
+						}
+						if !shouldUpdateLoan || loan.Status != entity.LoanStatusDefaulted {
+							t.Errorf("expecting the loan to be defaulted and updated, got status %v and shouldUpdateLoan %v", loan.Status, shouldUpdateLoan)
+						}
+
+						return loan, payment.Amount, nil
+					})
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil)
+			},
+			cmd: MakePaymentCommand{
+				LoanID:        uuid.New(),
+				PaymentAmount: decimal.NewFromInt(1000),
+				Mode:          PaymentModePrepayment,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "credit balance applied before the payment",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				// the loan is disbursed five weeks ago under a default period of two weeks, and its missed installments
+				// are paid from the credit balance before the loan is billed any further
+				loan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(10_000_000), 10, testNow.AddDate(0, 0, -35))
+				if err != nil {
+					t.Fatal(err)
+				}
+				loan.DefaultAfterWeeks = 2
+				loan.LateFeePolicy = entity.LateFeePolicy{
+					Method: entity.LateFeeMethodFixedPerMissedInstallment,
+					Amount: decimal.NewFromInt(50_000),
+				}
+
+				mockRepo.EXPECT().MakePayment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						_ uuid.UUID,
+						_ decimal.Decimal,
+						_ string,
+						makePaymentFn func(*entity.Loan, decimal.Decimal, decimal.Decimal) (*entity.LoanBilling, *entity.LoanPayment, bool, error),
+					) (*entity.Loan, decimal.Decimal, error) {
+						billing, payment, _, err := makePaymentFn(loan, decimal.Zero, decimal.NewFromInt(10_000_000))
+						if err != nil {
+							return nil, decimal.Zero, err
+						}
+						if billing.CreditPayment == nil || billing.CreditPayment.Mode != entity.PaymentModeCredit {
+							t.Fatalf("expecting the current bill to be paid from the credit balance, got %+v", billing.CreditPayment)
+						}
+						if len(billing.Charges) != 0 || loan.Status != entity.LoanStatusOngoing {
+							t.Errorf("expecting no late fee and the loan not to be defaulted, got %d charges and status %v", len(billing.Charges), loan.Status)
+						}
+
+						return loan, billing.CreditAmount().Add(payment.Amount), nil
+					})
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil)
+			},
+			cmd: MakePaymentCommand{
+				LoanID:        uuid.New(),
+				PaymentAmount: decimal.NewFromInt(1000),
+				Mode:          PaymentModePrepayment,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "get user credit balance unexpected error",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().MakePayment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(mockLoan, decimal.NewFromInt(2000), nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), mockLoan.UserID).Return(decimal.Zero, errors.New("unknown error"))
+			},
+			cmd: MakePaymentCommand{
+				LoanID:        mockLoan.ID,
+				PaymentAmount: decimal.NewFromInt(1000),
+			},
+			wantErr: UnexpectedError,
+		},
 		{
 			name: "repository expected error",
 			setupMock: func(mockRepo *repository.MockRepository) {
-				mockRepo.EXPECT().MakePayment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				mockRepo.EXPECT().MakePayment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, decimal.Zero, entity.ErrLoanNotFound)
 			},
 			wantErr: entity.ErrLoanNotFound,
 		},
+		{
+			name:      "idempotency key too long",
+			setupMock: nil,
+			cmd: MakePaymentCommand{
+				LoanID:         mockLoan.ID,
+				PaymentAmount:  decimal.NewFromInt(1000),
+				IdempotencyKey: strings.Repeat("k", 256),
+			},
+			wantErr: entity.ErrInvalidIdempotencyKey,
+		},
+		{
+			name: "get payment by idempotency key unexpected error",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanPaymentByIdempotencyKey(gomock.Any(), mockLoan.ID, "payment-1").
+					Return(nil, errors.New("unknown error"))
+			},
+			cmd: MakePaymentCommand{
+				LoanID:         mockLoan.ID,
+				PaymentAmount:  decimal.NewFromInt(1000),
+				IdempotencyKey: "payment-1",
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name: "first request with idempotency key",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanPaymentByIdempotencyKey(gomock.Any(), mockLoan.ID, "payment-1").
+					Return(nil, nil)
+				mockRepo.EXPECT().MakePayment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(mockLoan, decimal.NewFromInt(2000), nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), mockLoan.UserID).Return(decimal.Zero, nil)
+			},
+			cmd: MakePaymentCommand{
+				LoanID:         mockLoan.ID,
+				PaymentAmount:  decimal.NewFromInt(1000),
+				IdempotencyKey: "payment-1",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "replayed request",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanPaymentByIdempotencyKey(gomock.Any(), mockLoan.ID, "payment-1").
+					Return(originalPayment, nil)
+				mockRepo.EXPECT().GetLoan(gomock.Any(), mockLoan.ID).Return(mockLoan, nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), mockLoan.ID).Return(decimal.NewFromInt(1000), nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), mockLoan.UserID).Return(decimal.Zero, nil)
+			},
+			cmd: MakePaymentCommand{
+				LoanID:         mockLoan.ID,
+				PaymentAmount:  decimal.NewFromInt(1000),
+				IdempotencyKey: "payment-1",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "concurrent request with the same idempotency key",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				gomock.InOrder(
+					mockRepo.EXPECT().GetLoanPaymentByIdempotencyKey(gomock.Any(), mockLoan.ID, "payment-1").Return(nil, nil),
+					mockRepo.EXPECT().MakePayment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(nil, decimal.Zero, repo.ErrIdempotencyKeyUsed),
+					mockRepo.EXPECT().GetLoanPaymentByIdempotencyKey(gomock.Any(), mockLoan.ID, "payment-1").Return(originalPayment, nil),
+				)
+				mockRepo.EXPECT().GetLoan(gomock.Any(), mockLoan.ID).Return(mockLoan, nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), mockLoan.ID).Return(decimal.NewFromInt(1000), nil)
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), mockLoan.UserID).Return(decimal.Zero, nil)
+			},
+			cmd: MakePaymentCommand{
+				LoanID:         mockLoan.ID,
+				PaymentAmount:  decimal.NewFromInt(1000),
+				IdempotencyKey: "payment-1",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "replayed request with different payload",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanPaymentByIdempotencyKey(gomock.Any(), mockLoan.ID, "payment-1").
+					Return(originalPayment, nil)
+			},
+			cmd: MakePaymentCommand{
+				LoanID:         mockLoan.ID,
+				PaymentAmount:  decimal.NewFromInt(2000),
+				IdempotencyKey: "payment-1",
+			},
+			wantErr: entity.ErrIdempotencyKeyConflict,
+		},
+		{
+			name: "replayed request with different external reference",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanPaymentByIdempotencyKey(gomock.Any(), mockLoan.ID, "payment-1").
+					Return(originalPayment, nil)
+			},
+			cmd: MakePaymentCommand{
+				LoanID:            mockLoan.ID,
+				PaymentAmount:     decimal.NewFromInt(1000),
+				IdempotencyKey:    "payment-1",
+				ExternalReference: "TRX-2",
+				Channel:           PaymentChannelBankTransfer,
+			},
+			wantErr: entity.ErrIdempotencyKeyConflict,
+		},
+		{
+			name: "duplicate external reference",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().MakePayment(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, decimal.Zero, entity.ErrLoanPaymentDuplicateExternalReference)
+			},
+			cmd: MakePaymentCommand{
+				LoanID:            mockLoan.ID,
+				PaymentAmount:     decimal.NewFromInt(1000),
+				ExternalReference: "TRX-1",
+				Channel:           PaymentChannelBankTransfer,
+			},
+			wantErr: entity.ErrLoanPaymentDuplicateExternalReference,
+		},
+		{
+			name: "replayed request get paid amount unexpected error",
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().GetLoanPaymentByIdempotencyKey(gomock.Any(), mockLoan.ID, "payment-1").
+					Return(originalPayment, nil)
+				mockRepo.EXPECT().GetLoan(gomock.Any(), mockLoan.ID).Return(mockLoan, nil)
+				mockRepo.EXPECT().GetLoanPaidAmount(gomock.Any(), mockLoan.ID).Return(decimal.Zero, errors.New("unknown error"))
+			},
+			cmd: MakePaymentCommand{
+				LoanID:         mockLoan.ID,
+				PaymentAmount:  decimal.NewFromInt(1000),
+				IdempotencyKey: "payment-1",
+			},
+			wantErr: UnexpectedError,
+		},
 	}
 
 	for _, test := range testCases {
@@ -59,7 +300,7 @@ func TestImpl_MakePayment(t *testing.T) {
 				test.setupMock(mockRepo)
 			}
 
-			svc := NewService(mockRepo)
+			svc := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
 
 			_, err := svc.MakePayment(ctx, test.cmd)
 
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"encoding/base64"
+
+	"github.com/google/uuid"
+
+	"github.com/axopadyani/billing-engine/internal/common/businesserror"
+)
+
+const (
+	// defaultPageSize is the number of items returned in a page when the page size is not given.
+	defaultPageSize = 20
+
+	// maxPageSize is the maximum number of items returned in a page.
+	maxPageSize = 100
+)
+
+var ErrInvalidPageToken = businesserror.New("invalid page token", businesserror.KindBadRequest)
+
+// pageLimit returns the number of items to be returned in a page of the requested size.
+//
+// Parameters:
+//   - pageSize: The requested page size, zero or negative if it is not given.
+//
+// Returns:
+//   - int: The page size, defaulting to defaultPageSize and capped at maxPageSize.
+func pageLimit(pageSize int32) int {
+	if pageSize <= 0 {
+		return defaultPageSize
+	}
+
+	return min(int(pageSize), maxPageSize)
+}
+
+// encodePageToken encodes the ID of the last item of a page into an opaque token for the next page.
+//
+// Parameters:
+//   - id: The UUID of the last item of the page.
+//
+// Returns:
+//   - string: The page token, which is URL-safe.
+func encodePageToken(id uuid.UUID) string {
+	return base64.RawURLEncoding.EncodeToString(id[:])
+}
+
+// decodePageToken decodes a page token into the ID of the last item of the previous page.
+//
+// Parameters:
+//   - token: The page token, empty for the first page.
+//
+// Returns:
+//   - uuid.UUID: The UUID of the last item of the previous page, or uuid.Nil for the first page.
+//   - error: ErrInvalidPageToken if the token was not issued by encodePageToken, nil otherwise.
+func decodePageToken(token string) (uuid.UUID, error) {
+	if token == "" {
+		return uuid.Nil, nil
+	}
+
+	bytes, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return uuid.Nil, ErrInvalidPageToken
+	}
+
+	id, err := uuid.FromBytes(bytes)
+	if err != nil || id == uuid.Nil {
+		return uuid.Nil, ErrInvalidPageToken
+	}
+
+	return id, nil
+}
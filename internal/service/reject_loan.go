@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+)
+
+// RejectLoanCommand represents the input data required to reject a loan.
+type RejectLoanCommand struct {
+	// LoanID is the unique identifier of the loan being rejected.
+	LoanID uuid.UUID
+}
+
+// RejectLoan rejects a loan that has not been disbursed yet, closing it.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - in: A RejectLoanCommand struct containing the loan being rejected.
+//
+// Returns:
+//   - Loan: A struct containing the updated loan information.
+//   - error: An error if the operation fails, or nil if successful. It returns entity.ErrLoanNotFound if the loan
+//     does not exist, and entity.ErrLoanIllegalStatusTransition if the loan is neither pending approval nor approved.
+func (s *Impl) RejectLoan(ctx context.Context, in RejectLoanCommand) (Loan, error) {
+	now := s.clock.Now()
+
+	loan, err := s.repo.TransitionLoan(ctx, in.LoanID, func(loan *entity.Loan) error {
+		return loan.Reject(now)
+	})
+	if err != nil {
+		return Loan{}, ensureBusinessError(err)
+	}
+
+	return parseLoan(loan), nil
+}
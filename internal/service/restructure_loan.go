@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+)
+
+// RestructureLoanCommand represents the input data required to restructure a loan.
+type RestructureLoanCommand struct {
+	// LoanID is the unique identifier of the loan being restructured.
+	LoanID uuid.UUID
+
+	// InstallmentCount is the number of installments the outstanding amount of the loan is repaid in.
+	InstallmentCount int32
+
+	// InterestRate is the interest rate of the new terms, the current interest rate of the loan is kept when it is nil.
+	InterestRate *decimal.Decimal
+
+	// Reason is the explanation of why the loan is restructured.
+	Reason string
+}
+
+// RestructureLoan repays the outstanding amount of a loan being repaid in a new schedule under new terms.
+//
+// The loan is billed up to now first, so that the user's credit balance is applied to the current bill and the late fees
+// of the installments still missed are charged before they are capitalized. The installments that are due or paid
+// ahead are kept, and the outstanding amount of the loan, including its
+// outstanding charges, is repaid in the given number of installments starting from the next billing period,
+// at the given interest rate or the current one. The terms of the loan are versioned, so that its original terms
+// and every restructure remain available through ListLoanTerms.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - in: A RestructureLoanCommand struct containing the loan and its new terms.
+//
+// Returns:
+//   - Loan: A struct containing the updated loan information.
+//   - error: An error if the operation fails, or nil if successful. Possible errors include entity.ErrLoanNotFound,
+//     entity.ErrLoanNotRestructurable, entity.ErrLoanInvalidInstallmentCount, entity.ErrLoanInvalidInterestRate,
+//     entity.ErrLoanInvalidRestructureReason and entity.ErrLoanAlreadyPaid.
+func (s *Impl) RestructureLoan(ctx context.Context, in RestructureLoanCommand) (Loan, error) {
+	if err := entity.ValidateRestructureReason(in.Reason); err != nil {
+		return Loan{}, err
+	}
+
+	now := s.clock.Now()
+
+	loan, err := s.repo.RestructureLoan(
+		ctx, in.LoanID,
+		func(loan *entity.Loan, currPaidAmount, creditBalance decimal.Decimal) (*entity.LoanBilling, *entity.LoanTerms, error) {
+			billing, err := loan.Bill(now, currPaidAmount, creditBalance)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			terms, err := loan.Restructure(now, currPaidAmount.Add(billing.CreditAmount()), in.InstallmentCount, in.InterestRate, in.Reason)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return billing, terms, nil
+		},
+	)
+	if err != nil {
+		return Loan{}, ensureBusinessError(err)
+	}
+
+	return parseLoan(loan), nil
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
+	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
+)
+
+func TestImpl_RestructureLoan(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	// the loan is disbursed three weeks before the test time, so its first three installments are due,
+	// and nothing has been paid towards it
+	newLoan := func(status entity.LoanStatus) *entity.Loan {
+		loan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -21))
+		if err != nil {
+			t.Fatal(err)
+		}
+		loan.Status = status
+
+		return loan
+	}
+
+	// restructureLoan applies the restructure to the given loan, like the repository does to the stored loan
+	restructureLoan := func(loan *entity.Loan) func(context.Context, uuid.UUID, func(*entity.Loan, decimal.Decimal, decimal.Decimal) (*entity.LoanBilling, *entity.LoanTerms, error)) (*entity.Loan, error) {
+		return func(_ context.Context, _ uuid.UUID, restructureFn func(*entity.Loan, decimal.Decimal, decimal.Decimal) (*entity.LoanBilling, *entity.LoanTerms, error)) (*entity.Loan, error) {
+			if _, _, err := restructureFn(loan, decimal.Zero, decimal.Zero); err != nil {
+				return nil, err
+			}
+			return loan, nil
+		}
+	}
+
+	zeroRate := decimal.Zero
+
+	tests := []struct {
+		name      string
+		cmd       RestructureLoanCommand
+		setupMock func(*repository.MockRepository)
+		wantErr   error
+	}{
+		{
+			name:      "invalid reason",
+			cmd:       RestructureLoanCommand{LoanID: uuid.New(), InstallmentCount: 10},
+			setupMock: func(mockRepo *repository.MockRepository) {},
+			wantErr:   entity.ErrLoanInvalidRestructureReason,
+		},
+		{
+			name: "loan not found",
+			cmd:  RestructureLoanCommand{LoanID: uuid.New(), InstallmentCount: 10, Reason: "income reduced"},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().RestructureLoan(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, entity.ErrLoanNotFound)
+			},
+			wantErr: entity.ErrLoanNotFound,
+		},
+		{
+			name: "repository unexpected error",
+			cmd:  RestructureLoanCommand{LoanID: uuid.New(), InstallmentCount: 10, Reason: "income reduced"},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().RestructureLoan(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name: "loan not being repaid",
+			cmd:  RestructureLoanCommand{LoanID: uuid.New(), InstallmentCount: 10, Reason: "income reduced"},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().RestructureLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(restructureLoan(newLoan(entity.LoanStatusWrittenOff)))
+			},
+			wantErr: entity.ErrLoanNotRestructurable,
+		},
+		{
+			name: "invalid installment count",
+			cmd:  RestructureLoanCommand{LoanID: uuid.New(), InstallmentCount: 0, Reason: "income reduced"},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().RestructureLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(restructureLoan(newLoan(entity.LoanStatusOngoing)))
+			},
+			wantErr: entity.ErrLoanInvalidInstallmentCount,
+		},
+		{
+			name: "success",
+			cmd:  RestructureLoanCommand{LoanID: uuid.New(), InstallmentCount: 10, InterestRate: &zeroRate, Reason: "income reduced"},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().RestructureLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(restructureLoan(newLoan(entity.LoanStatusOngoing)))
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repository.NewMockRepository(ctrl)
+			test.setupMock(mockRepo)
+
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
+
+			got, err := s.RestructureLoan(ctx, test.cmd)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			// the three due installments are kept, and the outstanding 5,500,000 is repaid in 10 more installments
+			if got.TermsVersion != 2 || got.InstallmentCount != 13 || got.RestructuredFrom != 4 {
+				t.Fatalf("expecting terms version 2 and 13 installments restructured from 4, got %d, %d and %d",
+					got.TermsVersion, got.InstallmentCount, got.RestructuredFrom)
+			}
+			if !got.RestructuredPrincipal.Equal(decimal.NewFromInt(5_500_000)) || !got.PaymentAmount.Equal(decimal.NewFromInt(5_500_000)) {
+				t.Fatalf("expecting restructured principal and payment amount of 5500000, got %s and %s", got.RestructuredPrincipal, got.PaymentAmount)
+			}
+			if !got.InterestRate.IsZero() {
+				t.Fatalf("expecting zero interest rate, got %s", got.InterestRate)
+			}
+		})
+	}
+}
+
+func TestImpl_RestructureLoan_unstoredLateFees(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// the loan is disbursed three weeks before the test time and nothing has been paid towards it, so late fees
+	// are due on its missed installments, but none of them has been stored yet
+	loan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -21))
+	if err != nil {
+		t.Fatal(err)
+	}
+	loan.LateFeePolicy = entity.LateFeePolicy{
+		Method: entity.LateFeeMethodFixedPerMissedInstallment,
+		Amount: decimal.NewFromInt(50_000),
+	}
+
+	var (
+		billing *entity.LoanBilling
+		terms   *entity.LoanTerms
+	)
+	mockRepo := repository.NewMockRepository(ctrl)
+	mockRepo.EXPECT().RestructureLoan(gomock.Any(), loan.ID, gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			_ uuid.UUID,
+			restructureFn func(*entity.Loan, decimal.Decimal, decimal.Decimal) (*entity.LoanBilling, *entity.LoanTerms, error),
+		) (*entity.Loan, error) {
+			billing, terms, err = restructureFn(loan, decimal.Zero, decimal.Zero)
+			if err != nil {
+				return nil, err
+			}
+			return loan, nil
+		})
+
+	s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
+
+	if _, err = s.RestructureLoan(ctx, RestructureLoanCommand{LoanID: loan.ID, InstallmentCount: 10, Reason: "income reduced"}); err != nil {
+		t.Fatalf("expecting no error, got %v", err)
+	}
+
+	if len(billing.Charges) == 0 {
+		t.Fatalf("expecting the late fees of the missed installments to be charged before the restructure")
+	}
+	chargedAmount := decimal.Zero
+	for _, charge := range billing.Charges {
+		if !charge.CapitalizedAmount.Equal(charge.Amount) {
+			t.Errorf("expecting the late fee of installment %d to be capitalized, got %s of %s",
+				charge.InstallmentNumber, charge.CapitalizedAmount, charge.Amount)
+		}
+		chargedAmount = chargedAmount.Add(charge.Amount)
+	}
+	if !terms.CapitalizedCharges.Equal(chargedAmount) {
+		t.Errorf("expecting capitalized charges of %s, got %s", chargedAmount, terms.CapitalizedCharges)
+	}
+}
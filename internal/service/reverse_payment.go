@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+)
+
+// ReversePaymentCommand represents the input data required to reverse a loan payment.
+type ReversePaymentCommand struct {
+	// PaymentID is the unique identifier of the payment being reversed.
+	PaymentID uuid.UUID
+
+	// Reason is the explanation of why the payment is reversed, such as a bounced transfer or a chargeback.
+	Reason string
+}
+
+// ReversePayment reverses a payment made towards a loan.
+//
+// The payment is kept and marked as reversed, and a reversal entry with the reason is recorded. The amounts
+// the payment applied to the installments and charges are taken back, so the paid amount of the loan no longer
+// includes it, and a paid loan is reopened if it has an outstanding amount again. The credit balance the payment
+// added to or deducted from the user's credit balance is taken back as well, so a payment whose credited excess has
+// already been spent on the user's bills cannot be reversed.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - in: A ReversePaymentCommand struct containing the payment to be reversed and the reason.
+//
+// Returns:
+//   - LoanDetail: A struct containing the updated loan information.
+//   - error: An error if the reversal fails, or nil if successful. Possible errors include
+//     entity.ErrLoanPaymentNotFound, entity.ErrLoanPaymentAlreadyReversed, entity.ErrLoanPaymentInvalidReason
+//     entity.ErrLoanPaymentRestructured and entity.ErrLoanPaymentCreditSpent.
+func (s *Impl) ReversePayment(ctx context.Context, in ReversePaymentCommand) (LoanDetail, error) {
+	if err := entity.ValidateReversalReason(in.Reason); err != nil {
+		return LoanDetail{}, err
+	}
+
+	now := s.clock.Now().UTC()
+
+	loan, newPaidAmount, err := s.repo.ReversePayment(
+		ctx, in.PaymentID,
+		func(loan *entity.Loan, payment *entity.LoanPayment, currPaidAmount, creditBalance decimal.Decimal) (*entity.LoanPaymentReversal, error) {
+			return loan.ReversePayment(payment, currPaidAmount, creditBalance, in.Reason, now)
+		},
+	)
+	if err != nil {
+		return LoanDetail{}, ensureBusinessError(err)
+	}
+
+	creditBalance, err := s.repo.GetUserCreditBalance(ctx, loan.UserID)
+	if err != nil {
+		return LoanDetail{}, ensureBusinessError(err)
+	}
+
+	detail := parseLoanDetail(loan, now, newPaidAmount, s.config.DelinquencyBuckets)
+	detail.CreditBalance = creditBalance
+
+	return detail, nil
+}
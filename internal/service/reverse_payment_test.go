@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
+	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
+)
+
+func TestImpl_ReversePayment(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	// the loan is paid off by a single payment of its whole payment amount
+	newPaidLoan := func() (*entity.Loan, *entity.LoanPayment) {
+		loan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -7))
+		if err != nil {
+			t.Fatal(err)
+		}
+		loan.Status = entity.LoanStatusPaid
+
+		payment := &entity.LoanPayment{
+			ID:     uuid.New(),
+			LoanID: loan.ID,
+			Amount: loan.PaymentAmount,
+			Mode:   entity.PaymentModePrepayment,
+		}
+		for _, installment := range loan.Installments {
+			installment.AmountPaid = installment.AmountDue
+			installment.Status = entity.LoanInstallmentStatusPaid
+			payment.Allocations = append(payment.Allocations, entity.InstallmentAllocation{
+				InstallmentNumber: installment.Number,
+				Amount:            installment.AmountDue,
+			})
+		}
+
+		return loan, payment
+	}
+
+	tests := []struct {
+		name            string
+		cmd             ReversePaymentCommand
+		setupMock       func(*repository.MockRepository)
+		wantStatus      LoanStatus
+		wantOutstanding decimal.Decimal
+		wantErr         error
+	}{
+		{
+			name:      "empty reason",
+			cmd:       ReversePaymentCommand{PaymentID: uuid.New(), Reason: ""},
+			setupMock: func(mockRepo *repository.MockRepository) {},
+			wantErr:   entity.ErrLoanPaymentInvalidReason,
+		},
+		{
+			name: "payment not found",
+			cmd:  ReversePaymentCommand{PaymentID: uuid.New(), Reason: "bounced transfer"},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ReversePayment(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, decimal.Zero, entity.ErrLoanPaymentNotFound)
+			},
+			wantErr: entity.ErrLoanPaymentNotFound,
+		},
+		{
+			name: "repository unexpected error",
+			cmd:  ReversePaymentCommand{PaymentID: uuid.New(), Reason: "bounced transfer"},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().ReversePayment(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, decimal.Zero, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name: "payment already reversed",
+			cmd:  ReversePaymentCommand{PaymentID: uuid.New(), Reason: "bounced transfer"},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				loan, payment := newPaidLoan()
+				payment.ReversedAt = testNow
+
+				mockRepo.EXPECT().ReversePayment(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, reversePaymentFn func(*entity.Loan, *entity.LoanPayment, decimal.Decimal, decimal.Decimal) (*entity.LoanPaymentReversal, error)) (*entity.Loan, decimal.Decimal, error) {
+						if _, err := reversePaymentFn(loan, payment, decimal.Zero, decimal.Zero); err != nil {
+							return nil, decimal.Zero, err
+						}
+						return loan, decimal.Zero, nil
+					})
+			},
+			wantErr: entity.ErrLoanPaymentAlreadyReversed,
+		},
+		{
+			name: "credited excess already spent",
+			cmd:  ReversePaymentCommand{PaymentID: uuid.New(), Reason: "bounced transfer"},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				loan, payment := newPaidLoan()
+				payment.Amount = payment.Amount.Add(decimal.NewFromInt(100_000))
+				payment.ExcessAmount = decimal.NewFromInt(100_000)
+				payment.ExcessHandling = entity.OverpaymentPolicyCredit
+
+				mockRepo.EXPECT().ReversePayment(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, reversePaymentFn func(*entity.Loan, *entity.LoanPayment, decimal.Decimal, decimal.Decimal) (*entity.LoanPaymentReversal, error)) (*entity.Loan, decimal.Decimal, error) {
+						if _, err := reversePaymentFn(loan, payment, loan.PaymentAmount, decimal.NewFromInt(40_000)); err != nil {
+							return nil, decimal.Zero, err
+						}
+						return loan, decimal.Zero, nil
+					})
+			},
+			wantErr: entity.ErrLoanPaymentCreditSpent,
+		},
+		{
+			name: "paid loan is reopened",
+			cmd:  ReversePaymentCommand{PaymentID: uuid.New(), Reason: "chargeback"},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				loan, payment := newPaidLoan()
+
+				mockRepo.EXPECT().ReversePayment(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, reversePaymentFn func(*entity.Loan, *entity.LoanPayment, decimal.Decimal, decimal.Decimal) (*entity.LoanPaymentReversal, error)) (*entity.Loan, decimal.Decimal, error) {
+						reversal, err := reversePaymentFn(loan, payment, payment.Amount, decimal.Zero)
+						if err != nil {
+							return nil, decimal.Zero, err
+						}
+						if reversal.Reason != "chargeback" || !reversal.CreatedAt.Equal(testNow) {
+							t.Errorf("unexpected reversal %+v", reversal)
+						}
+						return loan, decimal.Zero, nil
+					})
+				mockRepo.EXPECT().GetUserCreditBalance(gomock.Any(), gomock.Any()).Return(decimal.Zero, nil)
+			},
+			wantStatus:      LoanStatusOngoing,
+			wantOutstanding: decimal.NewFromInt(5_500_000),
+			wantErr:         nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repository.NewMockRepository(ctrl)
+			test.setupMock(mockRepo)
+
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
+
+			got, err := s.ReversePayment(ctx, test.cmd)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if got.Loan.Status != test.wantStatus {
+				t.Fatalf("expecting status %v, got %v", test.wantStatus, got.Loan.Status)
+			}
+			if !got.OutstandingAmount.Equal(test.wantOutstanding) {
+				t.Fatalf("expecting outstanding amount %v, got %v", test.wantOutstanding, got.OutstandingAmount)
+			}
+		})
+	}
+}
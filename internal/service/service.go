@@ -5,6 +5,8 @@ import (
 	"errors"
 
 	"github.com/axopadyani/billing-engine/internal/common/businesserror"
+	"github.com/axopadyani/billing-engine/internal/common/clock"
+	"github.com/axopadyani/billing-engine/internal/entity"
 	"github.com/axopadyani/billing-engine/internal/repository"
 )
 
@@ -25,6 +27,83 @@ type Service interface {
 	//   - error: An error if the operation fails, or nil if successful.
 	CreateLoan(ctx context.Context, cmd CreateLoanCommand) (Loan, error)
 
+	// ApproveLoan approves a loan that is pending approval.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - cmd: The ApproveLoanCommand containing the loan to be approved.
+	//
+	// Returns:
+	//   - Loan: The approved loan information.
+	//   - error: An error if the operation fails, or nil if successful.
+	ApproveLoan(ctx context.Context, cmd ApproveLoanCommand) (Loan, error)
+
+	// DisburseLoan records the disbursement of an approved loan, starting its billing.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - cmd: The DisburseLoanCommand containing the loan to be disbursed.
+	//
+	// Returns:
+	//   - Loan: The disbursed loan information.
+	//   - error: An error if the operation fails, or nil if successful.
+	DisburseLoan(ctx context.Context, cmd DisburseLoanCommand) (Loan, error)
+
+	// RejectLoan rejects a loan that has not been disbursed yet.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - cmd: The RejectLoanCommand containing the loan to be rejected.
+	//
+	// Returns:
+	//   - Loan: The rejected loan information.
+	//   - error: An error if the operation fails, or nil if successful.
+	RejectLoan(ctx context.Context, cmd RejectLoanCommand) (Loan, error)
+
+	// CancelLoan cancels a loan at the request of its user within the cooling-off period.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - cmd: The CancelLoanCommand containing the loan to be cancelled.
+	//
+	// Returns:
+	//   - Loan: The cancelled loan information.
+	//   - error: An error if the operation fails, or nil if successful.
+	CancelLoan(ctx context.Context, cmd CancelLoanCommand) (Loan, error)
+
+	// WriteOffLoan writes the outstanding amount of a defaulted loan off as a loss.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - cmd: The WriteOffLoanCommand containing the loan to be written off.
+	//
+	// Returns:
+	//   - Loan: The written-off loan information.
+	//   - error: An error if the operation fails, or nil if successful.
+	WriteOffLoan(ctx context.Context, cmd WriteOffLoanCommand) (Loan, error)
+
+	// RestructureLoan repays the outstanding amount of a loan in a new schedule under new terms.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - cmd: The RestructureLoanCommand containing the loan and its new terms.
+	//
+	// Returns:
+	//   - Loan: The restructured loan information.
+	//   - error: An error if the operation fails, or nil if successful.
+	RestructureLoan(ctx context.Context, cmd RestructureLoanCommand) (Loan, error)
+
+	// GrantPaymentHoliday grants a payment holiday on a loan, deferring its installments not due yet.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - cmd: The GrantPaymentHolidayCommand containing the loan and the payment holiday to be granted.
+	//
+	// Returns:
+	//   - PaymentHoliday: The granted payment holiday.
+	//   - error: An error if the operation fails, or nil if successful.
+	GrantPaymentHoliday(ctx context.Context, cmd GrantPaymentHolidayCommand) (PaymentHoliday, error)
+
 	// GetCurrentLoan retrieves the current loan details.
 	//
 	// Parameters:
@@ -46,25 +125,189 @@ type Service interface {
 	//   - LoanDetail: The updated loan details after the payment.
 	//   - error: An error if the operation fails, or nil if successful.
 	MakePayment(ctx context.Context, cmd MakePaymentCommand) (LoanDetail, error)
+
+	// ReversePayment reverses a payment made towards a loan, recording a reversal entry.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - cmd: The ReversePaymentCommand containing the payment and the reason of the reversal.
+	//
+	// Returns:
+	//   - LoanDetail: The updated loan details after the reversal.
+	//   - error: An error if the operation fails, or nil if successful.
+	ReversePayment(ctx context.Context, cmd ReversePaymentCommand) (LoanDetail, error)
+
+	// GetLoan retrieves the details of a loan regardless of its status.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - query: The GetLoanQuery containing the query parameters.
+	//
+	// Returns:
+	//   - LoanDetail: The details of the loan.
+	//   - error: An error if the operation fails, or nil if successful.
+	GetLoan(ctx context.Context, query GetLoanQuery) (LoanDetail, error)
+
+	// ListProducts retrieves all the available loan products.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - query: The ListProductsQuery containing the query parameters.
+	//
+	// Returns:
+	//   - []LoanProduct: The available loan products.
+	//   - error: An error if the operation fails, or nil if successful.
+	ListProducts(ctx context.Context, query ListProductsQuery) ([]LoanProduct, error)
+
+	// GetProduct retrieves a loan product.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - query: The GetProductQuery containing the query parameters.
+	//
+	// Returns:
+	//   - LoanProduct: The requested loan product.
+	//   - error: An error if the operation fails, or nil if successful.
+	GetProduct(ctx context.Context, query GetProductQuery) (LoanProduct, error)
+
+	// GetLoanSchedule retrieves the repayment schedule of a loan.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - query: The GetLoanScheduleQuery containing the query parameters.
+	//
+	// Returns:
+	//   - []LoanInstallment: The installments of the loan, ordered by their number.
+	//   - error: An error if the operation fails, or nil if successful.
+	GetLoanSchedule(ctx context.Context, query GetLoanScheduleQuery) ([]LoanInstallment, error)
+
+	// GetPayoffQuote retrieves the amount needed to close a loan today.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - query: The GetPayoffQuoteQuery containing the query parameters.
+	//
+	// Returns:
+	//   - PayoffQuote: The outstanding, rebate and payoff amounts of the loan.
+	//   - error: An error if the operation fails, or nil if successful.
+	GetPayoffQuote(ctx context.Context, query GetPayoffQuoteQuery) (PayoffQuote, error)
+
+	// ListLoanPayments retrieves a page of the payments made towards a loan.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - query: The ListLoanPaymentsQuery containing the query parameters.
+	//
+	// Returns:
+	//   - LoanPaymentPage: The payments in the page, newest first, and the token of the next page.
+	//   - error: An error if the operation fails, or nil if successful.
+	ListLoanPayments(ctx context.Context, query ListLoanPaymentsQuery) (LoanPaymentPage, error)
+
+	// ListLoanTerms retrieves every version of the terms of a loan, from its original terms to its latest restructure.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - query: The ListLoanTermsQuery containing the query parameters.
+	//
+	// Returns:
+	//   - []LoanTerms: The versions of the terms of the loan, ordered by their version.
+	//   - error: An error if the operation fails, or nil if successful.
+	ListLoanTerms(ctx context.Context, query ListLoanTermsQuery) ([]LoanTerms, error)
+
+	// ListPaymentHolidays retrieves the payment holidays granted on a loan.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - query: The ListPaymentHolidaysQuery containing the query parameters.
+	//
+	// Returns:
+	//   - []PaymentHoliday: The payment holidays granted on the loan, oldest first.
+	//   - error: An error if the operation fails, or nil if successful.
+	ListPaymentHolidays(ctx context.Context, query ListPaymentHolidaysQuery) ([]PaymentHoliday, error)
+
+	// ListUserLoans retrieves a page of the loans of a user.
+	//
+	// Parameters:
+	//   - ctx: The context for the operation.
+	//   - query: The ListUserLoansQuery containing the query parameters.
+	//
+	// Returns:
+	//   - LoanSummaryPage: The loans in the page, newest first, with their paid amounts, and the token of the next page.
+	//   - error: An error if the operation fails, or nil if successful.
+	ListUserLoans(ctx context.Context, query ListUserLoansQuery) (LoanSummaryPage, error)
+}
+
+// Config represents the settings of the deployment the service runs in.
+type Config struct {
+	// BillingCalendar is the billing calendar of the loans created by the service whose product does not define one.
+	// entity.DefaultBillingCalendar is used when it is nil.
+	BillingCalendar *entity.BillingCalendar
+
+	// DelinquencyBuckets is the delinquency buckets the loans are classified in by their days past due.
+	// entity.DefaultDelinquencyBuckets is used when it is empty.
+	DelinquencyBuckets entity.DelinquencyBuckets
+
+	// DelinquencyRule is the rule the loans created by the service are considered delinquent by, taking precedence
+	// over the delinquency rule of their product. The product's rule is used when it is nil.
+	DelinquencyRule *entity.DelinquencyRule
 }
 
 // Impl represents the implementation of the Service interface.
 type Impl struct {
 	// repo is the repository interface used for data storage and retrieval operations.
 	repo repository.Repository
+
+	// clock is the source of the current time the loans are billed at.
+	clock clock.Clock
+
+	// config is the settings of the deployment the service runs in.
+	config Config
 }
 
 // NewService creates and returns a new instance of the Service implementation.
 //
-// It initializes the Impl struct with the provided repository.
+// It initializes the Impl struct with the provided repository, clock and configuration, filling in the default
+// delinquency buckets if the configuration does not set them.
 //
 // Parameters:
 //   - repo: A repository.Repository interface implementation used for data storage and retrieval operations.
+//   - clk: A clock.Clock interface implementation used as the source of the current time.
+//   - cfg: The settings of the deployment the service runs in.
 //
 // Returns:
 //   - *Impl: The newly created Impl struct, which implements the Service interface.
-func NewService(repo repository.Repository) *Impl {
-	return &Impl{repo: repo}
+func NewService(repo repository.Repository, clk clock.Clock, cfg Config) *Impl {
+	if len(cfg.DelinquencyBuckets) == 0 {
+		cfg.DelinquencyBuckets = entity.DefaultDelinquencyBuckets()
+	}
+
+	return &Impl{repo: repo, clock: clk, config: cfg}
+}
+
+// deploymentProduct applies the settings of the deployment to a loan product the service creates a loan of.
+//
+// The product is copied rather than changed, since it may be shared with the repository.
+//
+// Parameters:
+//   - product: The loan product the loan is created of.
+//
+// Returns:
+//   - *entity.LoanProduct: The product with the deployment's billing calendar if it does not define one, and the
+//     deployment's delinquency rule if there is one, or nil if the product is nil.
+func (c Config) deploymentProduct(product *entity.LoanProduct) *entity.LoanProduct {
+	if product == nil {
+		return nil
+	}
+
+	deploymentProduct := *product
+	if deploymentProduct.BillingCalendar == nil {
+		deploymentProduct.BillingCalendar = c.BillingCalendar
+	}
+	if c.DelinquencyRule != nil {
+		deploymentProduct.DelinquencyRule = c.DelinquencyRule
+	}
+
+	return &deploymentProduct
 }
 
 // ensureBusinessError wraps non-business errors with a generic UnexpectedError.
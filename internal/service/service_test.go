@@ -5,11 +5,29 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
 	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
 )
 
-var testTimeout = 10 * time.Second
+var (
+	testTimeout = 10 * time.Second
+
+	// testNow is the time the fake clock of the tested service starts at, a Wednesday in the middle of a billing week.
+	testNow = time.Date(2024, time.January, 3, 10, 0, 0, 0, time.UTC)
+
+	testProduct = &entity.LoanProduct{
+		ID:            uuid.New(),
+		Name:          "Standard Weekly Loan",
+		MinPrincipal:  decimal.NewFromInt(1_000_000),
+		MaxPrincipal:  decimal.NewFromInt(10_000_000),
+		InterestModel: entity.InterestModelFlat,
+		InterestRate:  decimal.NewFromFloat(0.1),
+	}
+)
 
 func TestNewService(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -17,8 +35,26 @@ func TestNewService(t *testing.T) {
 
 	mockRepo := repository.NewMockRepository(ctrl)
 
-	svc := NewService(mockRepo)
+	svc := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
 	if svc == nil {
 		t.Error("expecting service to be created")
 	}
 }
+
+// newOngoingLoan creates a loan that is approved and disbursed at its creation time, and moved to ongoing
+// as if its first payment has been made.
+func newOngoingLoan(userID uuid.UUID, amount decimal.Decimal, installmentCount int32, createdAt time.Time) (*entity.Loan, error) {
+	loan, err := entity.CreateLoan(testProduct, userID, amount, installmentCount, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	if err = loan.Approve(createdAt); err != nil {
+		return nil, err
+	}
+	if err = loan.Disburse(createdAt); err != nil {
+		return nil, err
+	}
+	loan.Status = entity.LoanStatusOngoing
+
+	return loan, nil
+}
@@ -18,6 +18,27 @@ const (
 
 	// LoanStatusPaid indicates that the loan has been fully paid off.
 	LoanStatusPaid
+
+	// LoanStatusPendingApproval indicates that the loan has been requested and is waiting to be approved.
+	LoanStatusPendingApproval
+
+	// LoanStatusApproved indicates that the loan has been approved and is waiting to be disbursed.
+	LoanStatusApproved
+
+	// LoanStatusDisbursed indicates that the loan amount has been disbursed and no payment has been made yet.
+	LoanStatusDisbursed
+
+	// LoanStatusRejected indicates that the loan has been rejected before being disbursed.
+	LoanStatusRejected
+
+	// LoanStatusCancelled indicates that the loan has been cancelled by its user within the cooling-off period.
+	LoanStatusCancelled
+
+	// LoanStatusDefaulted indicates that the loan has been past due for longer than its default period.
+	LoanStatusDefaulted
+
+	// LoanStatusWrittenOff indicates that the outstanding amount of the defaulted loan has been written off as a loss.
+	LoanStatusWrittenOff
 )
 
 // parseLoanStatus converts an entity.LoanStatus to a service.LoanStatus.
@@ -34,21 +55,481 @@ func parseLoanStatus(entityStatus entity.LoanStatus) LoanStatus {
 		res = LoanStatusOngoing
 	case entity.LoanStatusPaid:
 		res = LoanStatusPaid
+	case entity.LoanStatusPendingApproval:
+		res = LoanStatusPendingApproval
+	case entity.LoanStatusApproved:
+		res = LoanStatusApproved
+	case entity.LoanStatusDisbursed:
+		res = LoanStatusDisbursed
+	case entity.LoanStatusRejected:
+		res = LoanStatusRejected
+	case entity.LoanStatusCancelled:
+		res = LoanStatusCancelled
+	case entity.LoanStatusDefaulted:
+		res = LoanStatusDefaulted
+	case entity.LoanStatusWrittenOff:
+		res = LoanStatusWrittenOff
+	}
+
+	return res
+}
+
+// toEntityLoanStatus converts a service.LoanStatus to an entity.LoanStatus.
+//
+// Parameters:
+//   - status: The loan status from the service package.
+//
+// Returns:
+//   - An entity.LoanStatus corresponding to the input loan status, which is invalid if the status is unknown.
+func toEntityLoanStatus(status LoanStatus) entity.LoanStatus {
+	switch status {
+	case LoanStatusOngoing:
+		return entity.LoanStatusOngoing
+	case LoanStatusPaid:
+		return entity.LoanStatusPaid
+	case LoanStatusPendingApproval:
+		return entity.LoanStatusPendingApproval
+	case LoanStatusApproved:
+		return entity.LoanStatusApproved
+	case LoanStatusDisbursed:
+		return entity.LoanStatusDisbursed
+	case LoanStatusRejected:
+		return entity.LoanStatusRejected
+	case LoanStatusCancelled:
+		return entity.LoanStatusCancelled
+	case LoanStatusDefaulted:
+		return entity.LoanStatusDefaulted
+	case LoanStatusWrittenOff:
+		return entity.LoanStatusWrittenOff
+	}
+
+	return entity.LoanStatus(-1)
+}
+
+// InterestModel represents the method used to calculate the interest of a loan.
+type InterestModel int
+
+const (
+	// InterestModelFlat charges the interest rate once on the principal amount.
+	InterestModelFlat InterestModel = iota
+
+	// InterestModelDecliningBalance charges the interest rate on the outstanding principal every installment period.
+	InterestModelDecliningBalance
+
+	// InterestModelZeroInterest charges no interest at all.
+	InterestModelZeroInterest
+)
+
+// parseInterestModel converts an entity.InterestModel to a service.InterestModel.
+//
+// Parameters:
+//   - entityModel: The interest model from the entity package.
+//
+// Returns:
+//   - An InterestModel corresponding to the input entity interest model.
+func parseInterestModel(entityModel entity.InterestModel) InterestModel {
+	var res InterestModel
+	switch entityModel {
+	case entity.InterestModelFlat:
+		res = InterestModelFlat
+	case entity.InterestModelDecliningBalance:
+		res = InterestModelDecliningBalance
+	case entity.InterestModelZeroInterest:
+		res = InterestModelZeroInterest
+	}
+
+	return res
+}
+
+// RoundingMethod represents how an amount is split into installments that cannot be divided evenly.
+type RoundingMethod int
+
+const (
+	// RoundingMethodRemainderOnLast adds the rounding remainder to the last installment.
+	RoundingMethodRemainderOnLast RoundingMethod = iota
+
+	// RoundingMethodRemainderOnFirst adds the rounding remainder to the first installment.
+	RoundingMethodRemainderOnFirst
+
+	// RoundingMethodBankers rounds half to even and settles the difference on the last installment.
+	RoundingMethodBankers
+)
+
+// RoundingPolicy represents how installment amounts are rounded.
+type RoundingPolicy struct {
+	Method    RoundingMethod
+	Precision int32
+}
+
+// parseRoundingPolicy converts an entity.RoundingPolicy to a service.RoundingPolicy.
+//
+// Parameters:
+//   - entityPolicy: The rounding policy from the entity package.
+//
+// Returns:
+//   - A RoundingPolicy corresponding to the input entity rounding policy.
+func parseRoundingPolicy(entityPolicy entity.RoundingPolicy) RoundingPolicy {
+	var method RoundingMethod
+	switch entityPolicy.Method {
+	case entity.RoundingMethodRemainderOnLast:
+		method = RoundingMethodRemainderOnLast
+	case entity.RoundingMethodRemainderOnFirst:
+		method = RoundingMethodRemainderOnFirst
+	case entity.RoundingMethodBankers:
+		method = RoundingMethodBankers
+	}
+
+	return RoundingPolicy{
+		Method:    method,
+		Precision: entityPolicy.Precision,
+	}
+}
+
+// PaymentAcceptanceMode represents which payment amounts are accepted towards a loan's current bill.
+type PaymentAcceptanceMode int
+
+const (
+	// PaymentAcceptanceModeExact only accepts payments matching the current bill amount exactly.
+	PaymentAcceptanceModeExact PaymentAcceptanceMode = iota
+
+	// PaymentAcceptanceModePartial also accepts payments lower than the current bill amount.
+	PaymentAcceptanceModePartial
+)
+
+// parsePaymentAcceptanceMode converts an entity.PaymentAcceptanceMode to a service.PaymentAcceptanceMode.
+//
+// Parameters:
+//   - entityMode: The payment acceptance mode from the entity package.
+//
+// Returns:
+//   - A PaymentAcceptanceMode corresponding to the input entity acceptance mode.
+func parsePaymentAcceptanceMode(entityMode entity.PaymentAcceptanceMode) PaymentAcceptanceMode {
+	var res PaymentAcceptanceMode
+	switch entityMode {
+	case entity.PaymentAcceptanceModeExact:
+		res = PaymentAcceptanceModeExact
+	case entity.PaymentAcceptanceModePartial:
+		res = PaymentAcceptanceModePartial
+	}
+
+	return res
+}
+
+// OverpaymentPolicy represents how a payment exceeding the amount accepted towards a loan is handled.
+type OverpaymentPolicy int
+
+const (
+	// OverpaymentPolicyReject rejects the payments exceeding the amount accepted towards the loan.
+	OverpaymentPolicyReject OverpaymentPolicy = iota
+
+	// OverpaymentPolicyCredit holds the excess as a credit balance of the user, applied to the next installments due.
+	OverpaymentPolicyCredit
+
+	// OverpaymentPolicyRefund marks the excess to be refunded to the payer.
+	OverpaymentPolicyRefund
+)
+
+// parseOverpaymentPolicy converts an entity.OverpaymentPolicy to a service.OverpaymentPolicy.
+//
+// Parameters:
+//   - entityPolicy: The overpayment policy from the entity package.
+//
+// Returns:
+//   - An OverpaymentPolicy corresponding to the input entity overpayment policy.
+func parseOverpaymentPolicy(entityPolicy entity.OverpaymentPolicy) OverpaymentPolicy {
+	var res OverpaymentPolicy
+	switch entityPolicy {
+	case entity.OverpaymentPolicyReject:
+		res = OverpaymentPolicyReject
+	case entity.OverpaymentPolicyCredit:
+		res = OverpaymentPolicyCredit
+	case entity.OverpaymentPolicyRefund:
+		res = OverpaymentPolicyRefund
+	}
+
+	return res
+}
+
+// LateFeeMethod represents how the late fee of a missed installment is calculated.
+type LateFeeMethod int
+
+const (
+	// LateFeeMethodNone charges no late fee at all.
+	LateFeeMethodNone LateFeeMethod = iota
+
+	// LateFeeMethodFixedPerMissedInstallment charges a fixed fee for every missed installment.
+	LateFeeMethodFixedPerMissedInstallment
+
+	// LateFeeMethodPercentageOfOverdue charges a rate of the overdue amount of every missed installment.
+	LateFeeMethodPercentageOfOverdue
+)
+
+// LateFeePolicy represents the late fees charged for the missed installments of a loan.
+type LateFeePolicy struct {
+	Method LateFeeMethod
+	Amount decimal.Decimal
+	Cap    decimal.Decimal
+}
+
+// parseLateFeePolicy converts an entity.LateFeePolicy to a service.LateFeePolicy.
+//
+// Parameters:
+//   - entityPolicy: The late fee policy from the entity package.
+//
+// Returns:
+//   - A LateFeePolicy corresponding to the input entity late fee policy.
+func parseLateFeePolicy(entityPolicy entity.LateFeePolicy) LateFeePolicy {
+	var method LateFeeMethod
+	switch entityPolicy.Method {
+	case entity.LateFeeMethodNone:
+		method = LateFeeMethodNone
+	case entity.LateFeeMethodFixedPerMissedInstallment:
+		method = LateFeeMethodFixedPerMissedInstallment
+	case entity.LateFeeMethodPercentageOfOverdue:
+		method = LateFeeMethodPercentageOfOverdue
+	}
+
+	return LateFeePolicy{
+		Method: method,
+		Amount: entityPolicy.Amount,
+		Cap:    entityPolicy.Cap,
+	}
+}
+
+// BillingCalendar represents the timezone and week start day the billing weeks of a loan are calculated in.
+type BillingCalendar struct {
+	Timezone     string
+	WeekStartDay time.Weekday
+}
+
+// parseBillingCalendar converts an entity.BillingCalendar to a service.BillingCalendar.
+//
+// Parameters:
+//   - entityCalendar: A pointer to the billing calendar from the entity package.
+//
+// Returns:
+//   - A pointer to a BillingCalendar corresponding to the input entity billing calendar,
+//     or nil if entityCalendar is nil.
+func parseBillingCalendar(entityCalendar *entity.BillingCalendar) *BillingCalendar {
+	if entityCalendar == nil {
+		return nil
+	}
+
+	return &BillingCalendar{
+		Timezone:     entityCalendar.Timezone,
+		WeekStartDay: entityCalendar.WeekStartDay,
+	}
+}
+
+// PartialPaymentRule represents how an overdue installment that is partially paid is counted for delinquency.
+type PartialPaymentRule int
+
+const (
+	// PartialPaymentCountsAsMissed counts a partially paid installment as a missed installment.
+	PartialPaymentCountsAsMissed PartialPaymentRule = iota
+
+	// PartialPaymentCountsAsPaid does not count a partially paid installment as a missed installment.
+	PartialPaymentCountsAsPaid
+)
+
+// DelinquencyRule represents the rule a loan is considered delinquent by.
+type DelinquencyRule struct {
+	MissedInstallmentThreshold int32
+	PartialPaymentRule         PartialPaymentRule
+}
+
+// parseDelinquencyRule converts an entity.DelinquencyRule to a service.DelinquencyRule.
+//
+// Parameters:
+//   - entityRule: A pointer to the delinquency rule from the entity package.
+//
+// Returns:
+//   - A pointer to a DelinquencyRule corresponding to the input entity delinquency rule,
+//     or nil if entityRule is nil.
+func parseDelinquencyRule(entityRule *entity.DelinquencyRule) *DelinquencyRule {
+	if entityRule == nil {
+		return nil
+	}
+
+	var partialPaymentRule PartialPaymentRule
+	switch entityRule.PartialPaymentRule {
+	case entity.PartialPaymentCountsAsMissed:
+		partialPaymentRule = PartialPaymentCountsAsMissed
+	case entity.PartialPaymentCountsAsPaid:
+		partialPaymentRule = PartialPaymentCountsAsPaid
+	}
+
+	return &DelinquencyRule{
+		MissedInstallmentThreshold: entityRule.MissedInstallmentThreshold,
+		PartialPaymentRule:         partialPaymentRule,
+	}
+}
+
+// BillingFrequency represents how often the installments of a loan are due.
+type BillingFrequency int
+
+const (
+	// BillingFrequencyWeekly bills an installment every week.
+	BillingFrequencyWeekly BillingFrequency = iota
+
+	// BillingFrequencyDaily bills an installment every day.
+	BillingFrequencyDaily
+
+	// BillingFrequencyBiWeekly bills an installment every two weeks.
+	BillingFrequencyBiWeekly
+
+	// BillingFrequencyMonthly bills an installment every calendar month.
+	BillingFrequencyMonthly
+)
+
+// parseBillingFrequency converts an entity.BillingFrequency to a service.BillingFrequency.
+//
+// Parameters:
+//   - entityFrequency: The billing frequency from the entity package.
+//
+// Returns:
+//   - A BillingFrequency corresponding to the input entity billing frequency.
+func parseBillingFrequency(entityFrequency entity.BillingFrequency) BillingFrequency {
+	var res BillingFrequency
+	switch entityFrequency {
+	case entity.BillingFrequencyWeekly:
+		res = BillingFrequencyWeekly
+	case entity.BillingFrequencyDaily:
+		res = BillingFrequencyDaily
+	case entity.BillingFrequencyBiWeekly:
+		res = BillingFrequencyBiWeekly
+	case entity.BillingFrequencyMonthly:
+		res = BillingFrequencyMonthly
 	}
 
 	return res
 }
 
+// PaymentMode represents the purpose of a payment made towards a loan.
+type PaymentMode int
+
+const (
+	// PaymentModeRegular pays the current bill of the loan.
+	PaymentModeRegular PaymentMode = iota
+
+	// PaymentModePrepayment pays the loan ahead of the installments' due dates.
+	PaymentModePrepayment
+
+	// PaymentModePayoff settles the loan early by paying the payoff amount.
+	PaymentModePayoff
+
+	// PaymentModeCredit pays the current bill of the loan from the credit balance of its user.
+	// It is only made by the billing engine and cannot be requested.
+	PaymentModeCredit
+
+	// PaymentModeRecovery recovers part of the written-off amount of a written-off loan.
+	PaymentModeRecovery
+)
+
+// toEntityPaymentMode converts a service.PaymentMode to an entity.PaymentMode.
+//
+// Parameters:
+//   - mode: The payment mode from the service package.
+//
+// Returns:
+//   - An entity.PaymentMode corresponding to the input payment mode, which is invalid if the mode is unknown.
+func toEntityPaymentMode(mode PaymentMode) entity.PaymentMode {
+	switch mode {
+	case PaymentModeRegular:
+		return entity.PaymentModeRegular
+	case PaymentModePrepayment:
+		return entity.PaymentModePrepayment
+	case PaymentModePayoff:
+		return entity.PaymentModePayoff
+	case PaymentModeRecovery:
+		return entity.PaymentModeRecovery
+	}
+
+	return entity.PaymentMode(-1)
+}
+
+// PaymentChannel represents the channel a payment was received through.
+type PaymentChannel int
+
+const (
+	// PaymentChannelUnspecified is used for the payments whose channel is not known.
+	PaymentChannelUnspecified PaymentChannel = iota
+
+	// PaymentChannelBankTransfer is a payment received by a bank transfer.
+	PaymentChannelBankTransfer
+
+	// PaymentChannelVirtualAccount is a payment received through a virtual account.
+	PaymentChannelVirtualAccount
+
+	// PaymentChannelCard is a payment received by a debit or credit card.
+	PaymentChannelCard
+
+	// PaymentChannelCashAgent is a payment received in cash by an agent.
+	PaymentChannelCashAgent
+)
+
+// toEntityPaymentChannel converts a service.PaymentChannel to an entity.PaymentChannel.
+//
+// Parameters:
+//   - channel: The payment channel from the service package.
+//
+// Returns:
+//   - An entity.PaymentChannel corresponding to the input payment channel, which is invalid if the channel is unknown.
+func toEntityPaymentChannel(channel PaymentChannel) entity.PaymentChannel {
+	switch channel {
+	case PaymentChannelUnspecified:
+		return entity.PaymentChannelUnspecified
+	case PaymentChannelBankTransfer:
+		return entity.PaymentChannelBankTransfer
+	case PaymentChannelVirtualAccount:
+		return entity.PaymentChannelVirtualAccount
+	case PaymentChannelCard:
+		return entity.PaymentChannelCard
+	case PaymentChannelCashAgent:
+		return entity.PaymentChannelCashAgent
+	}
+
+	return entity.PaymentChannel(-1)
+}
+
 // Loan represents a loan in the service layer.
 type Loan struct {
-	ID                   uuid.UUID
-	UserID               uuid.UUID
-	Amount               decimal.Decimal
-	PaymentDurationWeeks int32
-	PaymentAmount        decimal.Decimal
-	Status               LoanStatus
-	CreatedAt            time.Time
-	UpdatedAt            time.Time
+	ID                        uuid.UUID
+	UserID                    uuid.UUID
+	ProductID                 uuid.UUID
+	Amount                    decimal.Decimal
+	BillingFrequency          BillingFrequency
+	InstallmentCount          int32
+	PaymentDurationWeeks      int32
+	InterestModel             InterestModel
+	InterestRate              decimal.Decimal
+	PaymentAmount             decimal.Decimal
+	RoundingPolicy            RoundingPolicy
+	PaymentAcceptanceMode     PaymentAcceptanceMode
+	OverpaymentPolicy         OverpaymentPolicy
+	OriginationFee            decimal.Decimal
+	LateFeePolicy             LateFeePolicy
+	EarlySettlementRebateRate decimal.Decimal
+	RebateAmount              decimal.Decimal
+	GracePeriodDays           int32
+	CoolingOffDays            int32
+	DefaultAfterWeeks         int32
+	BillingCalendar           *BillingCalendar
+	DelinquencyRule           *DelinquencyRule
+	Status                    LoanStatus
+	CreatedAt                 time.Time
+	UpdatedAt                 time.Time
+	ApprovedAt                time.Time
+	DisbursedAt               time.Time
+	DefaultedAt               time.Time
+	WrittenOffAt              time.Time
+	WrittenOffPrincipal       decimal.Decimal
+	WrittenOffInterest        decimal.Decimal
+	WrittenOffCharges         decimal.Decimal
+	RecoveredAmount           decimal.Decimal
+	TermsVersion              int32
+	RestructuredPrincipal     decimal.Decimal
+	RestructuredFrom          int32
 }
 
 // parseLoan converts an entity.Loan to a service.Loan.
@@ -65,40 +546,544 @@ func parseLoan(entityLoan *entity.Loan) Loan {
 	}
 
 	return Loan{
-		ID:                   entityLoan.ID,
-		UserID:               entityLoan.UserID,
-		Amount:               entityLoan.Amount,
-		PaymentDurationWeeks: entityLoan.PaymentDurationWeeks,
-		PaymentAmount:        entityLoan.PaymentAmount,
-		Status:               parseLoanStatus(entityLoan.Status),
-		CreatedAt:            entityLoan.CreatedAt,
-		UpdatedAt:            entityLoan.UpdatedAt,
+		ID:                        entityLoan.ID,
+		UserID:                    entityLoan.UserID,
+		ProductID:                 entityLoan.ProductID,
+		Amount:                    entityLoan.Amount,
+		BillingFrequency:          parseBillingFrequency(entityLoan.BillingFrequency),
+		InstallmentCount:          entityLoan.InstallmentCount,
+		PaymentDurationWeeks:      entityLoan.PaymentDurationWeeks(),
+		InterestModel:             parseInterestModel(entityLoan.InterestModel),
+		InterestRate:              entityLoan.InterestRate,
+		PaymentAmount:             entityLoan.PaymentAmount,
+		RoundingPolicy:            parseRoundingPolicy(entityLoan.RoundingPolicy),
+		PaymentAcceptanceMode:     parsePaymentAcceptanceMode(entityLoan.PaymentAcceptanceMode),
+		OverpaymentPolicy:         parseOverpaymentPolicy(entityLoan.OverpaymentPolicy),
+		OriginationFee:            entityLoan.OriginationFee,
+		LateFeePolicy:             parseLateFeePolicy(entityLoan.LateFeePolicy),
+		EarlySettlementRebateRate: entityLoan.EarlySettlementRebateRate,
+		RebateAmount:              entityLoan.RebateAmount,
+		GracePeriodDays:           entityLoan.GracePeriodDays,
+		CoolingOffDays:            entityLoan.CoolingOffDays,
+		DefaultAfterWeeks:         entityLoan.DefaultAfterWeeks,
+		BillingCalendar:           parseBillingCalendar(entityLoan.BillingCalendar),
+		DelinquencyRule:           parseDelinquencyRule(entityLoan.DelinquencyRule),
+		Status:                    parseLoanStatus(entityLoan.Status),
+		CreatedAt:                 entityLoan.CreatedAt,
+		UpdatedAt:                 entityLoan.UpdatedAt,
+		ApprovedAt:                entityLoan.ApprovedAt,
+		DisbursedAt:               entityLoan.DisbursedAt,
+		DefaultedAt:               entityLoan.DefaultedAt,
+		WrittenOffAt:              entityLoan.WrittenOffAt,
+		WrittenOffPrincipal:       entityLoan.WrittenOffPrincipal,
+		WrittenOffInterest:        entityLoan.WrittenOffInterest,
+		WrittenOffCharges:         entityLoan.WrittenOffCharges,
+		RecoveredAmount:           entityLoan.RecoveredAmount,
+		TermsVersion:              entityLoan.TermsVersion,
+		RestructuredPrincipal:     entityLoan.RestructuredPrincipal,
+		RestructuredFrom:          entityLoan.RestructuredFromInstallment,
+	}
+}
+
+// FeeSchedule represents the fees charged for loans of a loan product.
+type FeeSchedule struct {
+	OriginationFeeRate  decimal.Decimal
+	OriginationFeeFixed decimal.Decimal
+	LateFeePolicy       LateFeePolicy
+}
+
+// LoanProduct represents a loan product in the service layer.
+type LoanProduct struct {
+	ID                        uuid.UUID
+	Name                      string
+	MinPrincipal              decimal.Decimal
+	MaxPrincipal              decimal.Decimal
+	BillingFrequency          BillingFrequency
+	AllowedInstallmentCounts  []int32
+	InterestModel             InterestModel
+	InterestRate              decimal.Decimal
+	RoundingPolicy            RoundingPolicy
+	PaymentAcceptanceMode     PaymentAcceptanceMode
+	OverpaymentPolicy         OverpaymentPolicy
+	FeeSchedule               FeeSchedule
+	EarlySettlementRebateRate decimal.Decimal
+	GracePeriodDays           int32
+	CoolingOffDays            int32
+	DefaultAfterWeeks         int32
+	BillingCalendar           *BillingCalendar
+	DelinquencyRule           *DelinquencyRule
+	CreatedAt                 time.Time
+	UpdatedAt                 time.Time
+}
+
+// parseLoanProduct converts an entity.LoanProduct to a service.LoanProduct.
+//
+// Parameters:
+//   - entityProduct: A pointer to the loan product entity to be converted.
+//
+// Returns:
+//   - A LoanProduct struct populated with data from the entity loan product.
+//     If entityProduct is nil, an empty LoanProduct struct is returned.
+func parseLoanProduct(entityProduct *entity.LoanProduct) LoanProduct {
+	if entityProduct == nil {
+		return LoanProduct{}
+	}
+
+	return LoanProduct{
+		ID:                       entityProduct.ID,
+		Name:                     entityProduct.Name,
+		MinPrincipal:             entityProduct.MinPrincipal,
+		MaxPrincipal:             entityProduct.MaxPrincipal,
+		BillingFrequency:         parseBillingFrequency(entityProduct.BillingFrequency),
+		AllowedInstallmentCounts: entityProduct.AllowedInstallmentCounts,
+		InterestModel:            parseInterestModel(entityProduct.InterestModel),
+		InterestRate:             entityProduct.InterestRate,
+		RoundingPolicy:           parseRoundingPolicy(entityProduct.RoundingPolicy),
+		PaymentAcceptanceMode:    parsePaymentAcceptanceMode(entityProduct.PaymentAcceptanceMode),
+		OverpaymentPolicy:        parseOverpaymentPolicy(entityProduct.OverpaymentPolicy),
+		FeeSchedule: FeeSchedule{
+			OriginationFeeRate:  entityProduct.FeeSchedule.OriginationFeeRate,
+			OriginationFeeFixed: entityProduct.FeeSchedule.OriginationFeeFixed,
+			LateFeePolicy:       parseLateFeePolicy(entityProduct.FeeSchedule.LateFeePolicy),
+		},
+		EarlySettlementRebateRate: entityProduct.EarlySettlementRebateRate,
+		GracePeriodDays:           entityProduct.GracePeriodDays,
+		CoolingOffDays:            entityProduct.CoolingOffDays,
+		DefaultAfterWeeks:         entityProduct.DefaultAfterWeeks,
+		BillingCalendar:           parseBillingCalendar(entityProduct.BillingCalendar),
+		DelinquencyRule:           parseDelinquencyRule(entityProduct.DelinquencyRule),
+		CreatedAt:                 entityProduct.CreatedAt,
+		UpdatedAt:                 entityProduct.UpdatedAt,
+	}
+}
+
+// LoanInstallmentStatus represents the status of a loan installment.
+type LoanInstallmentStatus int
+
+const (
+	// LoanInstallmentStatusUnpaid indicates that the installment has not been fully paid.
+	LoanInstallmentStatusUnpaid LoanInstallmentStatus = iota
+
+	// LoanInstallmentStatusPaid indicates that the installment has been fully paid.
+	LoanInstallmentStatusPaid
+
+	// LoanInstallmentStatusPartiallyPaid indicates that only part of the installment has been paid.
+	LoanInstallmentStatusPartiallyPaid
+
+	// LoanInstallmentStatusRestructured indicates that the amount the installment still owed was moved to a new schedule
+	// when the loan was restructured.
+	LoanInstallmentStatusRestructured
+)
+
+// parseLoanInstallmentStatus converts an entity.LoanInstallmentStatus to a service.LoanInstallmentStatus.
+//
+// Parameters:
+//   - entityStatus: The loan installment status from the entity package.
+//
+// Returns:
+//   - A LoanInstallmentStatus corresponding to the input entity status.
+func parseLoanInstallmentStatus(entityStatus entity.LoanInstallmentStatus) LoanInstallmentStatus {
+	var res LoanInstallmentStatus
+	switch entityStatus {
+	case entity.LoanInstallmentStatusUnpaid:
+		res = LoanInstallmentStatusUnpaid
+	case entity.LoanInstallmentStatusPaid:
+		res = LoanInstallmentStatusPaid
+	case entity.LoanInstallmentStatusPartiallyPaid:
+		res = LoanInstallmentStatusPartiallyPaid
+	case entity.LoanInstallmentStatusRestructured:
+		res = LoanInstallmentStatusRestructured
+	}
+
+	return res
+}
+
+// LoanInstallment represents an installment of a loan's repayment schedule in the service layer.
+type LoanInstallment struct {
+	Number          int32
+	DueDate         time.Time
+	PrincipalAmount decimal.Decimal
+	InterestAmount  decimal.Decimal
+	AmountDue       decimal.Decimal
+	AmountPaid      decimal.Decimal
+	Status          LoanInstallmentStatus
+}
+
+// parseLoanInstallment converts an entity.LoanInstallment to a service.LoanInstallment.
+//
+// Parameters:
+//   - entityInstallment: A pointer to the loan installment entity to be converted.
+//
+// Returns:
+//   - A LoanInstallment struct populated with data from the entity loan installment.
+//     If entityInstallment is nil, an empty LoanInstallment struct is returned.
+func parseLoanInstallment(entityInstallment *entity.LoanInstallment) LoanInstallment {
+	if entityInstallment == nil {
+		return LoanInstallment{}
+	}
+
+	return LoanInstallment{
+		Number:          entityInstallment.Number,
+		DueDate:         entityInstallment.DueDate,
+		PrincipalAmount: entityInstallment.PrincipalAmount,
+		InterestAmount:  entityInstallment.InterestAmount,
+		AmountDue:       entityInstallment.AmountDue,
+		AmountPaid:      entityInstallment.AmountPaid,
+		Status:          parseLoanInstallmentStatus(entityInstallment.Status),
+	}
+}
+
+// LoanTerms represents a version of the terms a loan is repaid under in the service layer.
+type LoanTerms struct {
+	LoanID                 uuid.UUID
+	Version                int32
+	Principal              decimal.Decimal
+	InterestRate           decimal.Decimal
+	InstallmentCount       int32
+	PaymentAmount          decimal.Decimal
+	FirstInstallmentNumber int32
+	CapitalizedCharges     decimal.Decimal
+	Reason                 string
+	CreatedAt              time.Time
+}
+
+// parseLoanTerms converts an entity.LoanTerms to a service.LoanTerms.
+//
+// Parameters:
+//   - entityTerms: A pointer to the loan terms entity to be converted.
+//
+// Returns:
+//   - A LoanTerms struct populated with data from the entity loan terms.
+//     If entityTerms is nil, an empty LoanTerms struct is returned.
+func parseLoanTerms(entityTerms *entity.LoanTerms) LoanTerms {
+	if entityTerms == nil {
+		return LoanTerms{}
+	}
+
+	return LoanTerms{
+		LoanID:                 entityTerms.LoanID,
+		Version:                entityTerms.Version,
+		Principal:              entityTerms.Principal,
+		InterestRate:           entityTerms.InterestRate,
+		InstallmentCount:       entityTerms.InstallmentCount,
+		PaymentAmount:          entityTerms.PaymentAmount,
+		FirstInstallmentNumber: entityTerms.FirstInstallmentNumber,
+		CapitalizedCharges:     entityTerms.CapitalizedCharges,
+		Reason:                 entityTerms.Reason,
+		CreatedAt:              entityTerms.CreatedAt,
+	}
+}
+
+// PaymentHoliday represents a payment holiday granted on a loan in the service layer.
+type PaymentHoliday struct {
+	ID                     uuid.UUID
+	LoanID                 uuid.UUID
+	FirstInstallmentNumber int32
+	Periods                int32
+	StartsAt               time.Time
+	EndsAt                 time.Time
+	Reason                 string
+	CreatedAt              time.Time
+}
+
+// parsePaymentHoliday converts an entity.PaymentHoliday to a service.PaymentHoliday.
+//
+// Parameters:
+//   - entityHoliday: A pointer to the payment holiday entity to be converted.
+//
+// Returns:
+//   - A PaymentHoliday struct populated with data from the entity payment holiday.
+//     If entityHoliday is nil, an empty PaymentHoliday struct is returned.
+func parsePaymentHoliday(entityHoliday *entity.PaymentHoliday) PaymentHoliday {
+	if entityHoliday == nil {
+		return PaymentHoliday{}
+	}
+
+	return PaymentHoliday{
+		ID:                     entityHoliday.ID,
+		LoanID:                 entityHoliday.LoanID,
+		FirstInstallmentNumber: entityHoliday.FirstInstallmentNumber,
+		Periods:                entityHoliday.Periods,
+		StartsAt:               entityHoliday.StartsAt,
+		EndsAt:                 entityHoliday.EndsAt,
+		Reason:                 entityHoliday.Reason,
+		CreatedAt:              entityHoliday.CreatedAt,
+	}
+}
+
+// LoanChargeType represents the kind of a charge applied to a loan.
+type LoanChargeType int
+
+const (
+	// LoanChargeTypeLateFee is a fee charged for a missed installment.
+	LoanChargeTypeLateFee LoanChargeType = iota
+)
+
+// parseLoanChargeType converts an entity.LoanChargeType to a service.LoanChargeType.
+//
+// Parameters:
+//   - entityType: The loan charge type from the entity package.
+//
+// Returns:
+//   - A LoanChargeType corresponding to the input entity charge type.
+func parseLoanChargeType(entityType entity.LoanChargeType) LoanChargeType {
+	var res LoanChargeType
+	switch entityType {
+	case entity.LoanChargeTypeLateFee:
+		res = LoanChargeTypeLateFee
+	}
+
+	return res
+}
+
+// LoanCharge represents a charge applied to a loan in the service layer.
+type LoanCharge struct {
+	ID                uuid.UUID
+	Type              LoanChargeType
+	InstallmentNumber int32
+	Amount            decimal.Decimal
+	AmountPaid        decimal.Decimal
+	CapitalizedAmount decimal.Decimal
+	CreatedAt         time.Time
+}
+
+// parseLoanCharge converts an entity.LoanCharge to a service.LoanCharge.
+//
+// Parameters:
+//   - entityCharge: A pointer to the loan charge entity to be converted.
+//
+// Returns:
+//   - A LoanCharge struct populated with data from the entity loan charge.
+//     If entityCharge is nil, an empty LoanCharge struct is returned.
+func parseLoanCharge(entityCharge *entity.LoanCharge) LoanCharge {
+	if entityCharge == nil {
+		return LoanCharge{}
+	}
+
+	return LoanCharge{
+		ID:                entityCharge.ID,
+		Type:              parseLoanChargeType(entityCharge.Type),
+		InstallmentNumber: entityCharge.InstallmentNumber,
+		Amount:            entityCharge.Amount,
+		AmountPaid:        entityCharge.AmountPaid,
+		CapitalizedAmount: entityCharge.CapitalizedAmount,
+		CreatedAt:         entityCharge.CreatedAt,
 	}
 }
 
 // LoanDetail represents detailed information about a loan.
 type LoanDetail struct {
-	Loan              Loan
-	OutstandingAmount decimal.Decimal
-	CurrentBillAmount decimal.Decimal
-	IsDelinquent      bool
+	Loan                        Loan
+	OutstandingAmount           decimal.Decimal
+	CurrentBillAmount           decimal.Decimal
+	IsDelinquent                bool
+	DaysPastDue                 int32
+	OldestUnpaidInstallmentDate time.Time
+	DelinquencyBucket           string
+	PaidAmount                  decimal.Decimal
+	RemainingDueAmount          decimal.Decimal
+	DueBy                       time.Time
+	MaturityDate                time.Time
+	Charges                     []LoanCharge
+	OutstandingChargeAmount     decimal.Decimal
+	CreditBalance               decimal.Decimal
 }
 
-// parseLoanDetail creates a LoanDetail struct from individual components.
+// parseLoanDetail creates a LoanDetail struct from a loan entity and its paid amount,
+// including the breakdown of the charges applied to the loan.
 //
 // Parameters:
-//   - loan: The base Loan struct.
-//   - outstandingAmount: The remaining amount to be paid on the loan.
-//   - currentBillAmount: The amount due in the current billing cycle.
-//   - isDelinquent: A boolean indicating whether the loan is past due.
+//   - entityLoan: A pointer to the loan entity.
+//   - now: The current time used to calculate the bill amount, due by time, days past due and delinquency.
+//   - paidAmount: The total amount that has been paid towards the loan.
+//   - delinquencyBuckets: The delinquency buckets the loan is classified in by its days past due.
 //
 // Returns:
-//   - A LoanDetail struct populated with the provided information.
-func parseLoanDetail(loan Loan, outstandingAmount, currentBillAmount decimal.Decimal, isDelinquent bool) LoanDetail {
+//   - A LoanDetail struct populated with the loan and its computed payment details.
+func parseLoanDetail(
+	entityLoan *entity.Loan, now time.Time, paidAmount decimal.Decimal, delinquencyBuckets entity.DelinquencyBuckets,
+) LoanDetail {
+	var charges []LoanCharge
+	outstandingChargeAmount := decimal.Zero
+	if entityLoan != nil {
+		for _, charge := range entityLoan.Charges {
+			charges = append(charges, parseLoanCharge(charge))
+			outstandingChargeAmount = outstandingChargeAmount.Add(charge.OutstandingAmount())
+		}
+	}
+
 	return LoanDetail{
-		Loan:              loan,
-		OutstandingAmount: outstandingAmount,
-		CurrentBillAmount: currentBillAmount,
-		IsDelinquent:      isDelinquent,
+		Loan:                        parseLoan(entityLoan),
+		OutstandingAmount:           entityLoan.OutstandingAmount(paidAmount),
+		CurrentBillAmount:           entityLoan.CurrentBillAmount(now, paidAmount),
+		IsDelinquent:                entityLoan.IsDelinquent(now),
+		DaysPastDue:                 entityLoan.DaysPastDue(now),
+		OldestUnpaidInstallmentDate: entityLoan.OldestUnpaidInstallmentDate(now),
+		DelinquencyBucket:           delinquencyBuckets.Bucket(entityLoan.DaysPastDue(now)),
+		PaidAmount:                  paidAmount,
+		RemainingDueAmount:          entityLoan.RemainingDueAmount(),
+		DueBy:                       entityLoan.DueBy(now),
+		MaturityDate:                entityLoan.MaturityDate(),
+		Charges:                     charges,
+		OutstandingChargeAmount:     outstandingChargeAmount,
+	}
+}
+
+// PayoffQuote represents the amount needed to close a loan at a point in time.
+type PayoffQuote struct {
+	LoanID            uuid.UUID
+	OutstandingAmount decimal.Decimal
+	RebateAmount      decimal.Decimal
+	PayoffAmount      decimal.Decimal
+	QuotedAt          time.Time
+}
+
+// parsePayoffQuote creates a PayoffQuote struct from a loan entity and its paid amount.
+//
+// Parameters:
+//   - entityLoan: A pointer to the loan entity.
+//   - now: The current time the quote is calculated at.
+//   - paidAmount: The total amount that has been paid towards the loan.
+//
+// Returns:
+//   - A PayoffQuote struct populated with the amounts needed to close the loan.
+func parsePayoffQuote(entityLoan *entity.Loan, now time.Time, paidAmount decimal.Decimal) PayoffQuote {
+	quote := entityLoan.PayoffQuote(now, paidAmount)
+
+	return PayoffQuote{
+		LoanID:            parseLoan(entityLoan).ID,
+		OutstandingAmount: quote.OutstandingAmount,
+		RebateAmount:      quote.RebateAmount,
+		PayoffAmount:      quote.PayoffAmount,
+		QuotedAt:          now,
+	}
+}
+
+// parsePaymentMode converts an entity.PaymentMode to a service.PaymentMode.
+//
+// Parameters:
+//   - entityMode: The payment mode from the entity package.
+//
+// Returns:
+//   - A PaymentMode corresponding to the input entity payment mode.
+func parsePaymentMode(entityMode entity.PaymentMode) PaymentMode {
+	var res PaymentMode
+	switch entityMode {
+	case entity.PaymentModeRegular:
+		res = PaymentModeRegular
+	case entity.PaymentModePrepayment:
+		res = PaymentModePrepayment
+	case entity.PaymentModePayoff:
+		res = PaymentModePayoff
+	case entity.PaymentModeCredit:
+		res = PaymentModeCredit
+	case entity.PaymentModeRecovery:
+		res = PaymentModeRecovery
+	}
+
+	return res
+}
+
+// parsePaymentChannel converts an entity.PaymentChannel to a service.PaymentChannel.
+//
+// Parameters:
+//   - entityChannel: The payment channel from the entity package.
+//
+// Returns:
+//   - A PaymentChannel corresponding to the input entity payment channel.
+func parsePaymentChannel(entityChannel entity.PaymentChannel) PaymentChannel {
+	var res PaymentChannel
+	switch entityChannel {
+	case entity.PaymentChannelUnspecified:
+		res = PaymentChannelUnspecified
+	case entity.PaymentChannelBankTransfer:
+		res = PaymentChannelBankTransfer
+	case entity.PaymentChannelVirtualAccount:
+		res = PaymentChannelVirtualAccount
+	case entity.PaymentChannelCard:
+		res = PaymentChannelCard
+	case entity.PaymentChannelCashAgent:
+		res = PaymentChannelCashAgent
+	}
+
+	return res
+}
+
+// InstallmentAllocation represents the portion of a payment applied to a loan installment in the service layer.
+type InstallmentAllocation struct {
+	InstallmentNumber int32
+	Amount            decimal.Decimal
+}
+
+// ChargeAllocation represents the portion of a payment applied to a loan charge in the service layer.
+type ChargeAllocation struct {
+	ChargeID uuid.UUID
+	Amount   decimal.Decimal
+}
+
+// LoanPayment represents a payment made towards a loan in the service layer.
+type LoanPayment struct {
+	ID                uuid.UUID
+	LoanID            uuid.UUID
+	Amount            decimal.Decimal
+	ExcessAmount      decimal.Decimal
+	ExcessHandling    OverpaymentPolicy
+	Mode              PaymentMode
+	ExternalReference string
+	Channel           PaymentChannel
+	PaidAt            time.Time
+	Metadata          map[string]string
+	Allocations       []InstallmentAllocation
+	ChargeAllocations []ChargeAllocation
+	ReversedAt        time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// parseLoanPayment converts an entity.LoanPayment to a service.LoanPayment.
+//
+// Parameters:
+//   - entityPayment: A pointer to the loan payment entity to be converted.
+//
+// Returns:
+//   - A LoanPayment struct populated with data from the entity loan payment, including its allocations.
+//     If entityPayment is nil, an empty LoanPayment struct is returned.
+func parseLoanPayment(entityPayment *entity.LoanPayment) LoanPayment {
+	if entityPayment == nil {
+		return LoanPayment{}
+	}
+
+	var allocations []InstallmentAllocation
+	for _, allocation := range entityPayment.Allocations {
+		allocations = append(allocations, InstallmentAllocation{
+			InstallmentNumber: allocation.InstallmentNumber,
+			Amount:            allocation.Amount,
+		})
+	}
+
+	var chargeAllocations []ChargeAllocation
+	for _, allocation := range entityPayment.ChargeAllocations {
+		chargeAllocations = append(chargeAllocations, ChargeAllocation{
+			ChargeID: allocation.ChargeID,
+			Amount:   allocation.Amount,
+		})
+	}
+
+	return LoanPayment{
+		ID:                entityPayment.ID,
+		LoanID:            entityPayment.LoanID,
+		Amount:            entityPayment.Amount,
+		ExcessAmount:      entityPayment.ExcessAmount,
+		ExcessHandling:    parseOverpaymentPolicy(entityPayment.ExcessHandling),
+		Mode:              parsePaymentMode(entityPayment.Mode),
+		ExternalReference: entityPayment.Details.ExternalReference,
+		Channel:           parsePaymentChannel(entityPayment.Details.Channel),
+		PaidAt:            entityPayment.Details.PaidAt,
+		Metadata:          entityPayment.Details.Metadata,
+		Allocations:       allocations,
+		ChargeAllocations: chargeAllocations,
+		ReversedAt:        entityPayment.ReversedAt,
+		CreatedAt:         entityPayment.CreatedAt,
+		UpdatedAt:         entityPayment.UpdatedAt,
 	}
 }
@@ -27,6 +27,41 @@ func TestParseLoanStatus(t *testing.T) {
 			entityStatus: entity.LoanStatusPaid,
 			want:         LoanStatusPaid,
 		},
+		{
+			name:         "pending approval",
+			entityStatus: entity.LoanStatusPendingApproval,
+			want:         LoanStatusPendingApproval,
+		},
+		{
+			name:         "approved",
+			entityStatus: entity.LoanStatusApproved,
+			want:         LoanStatusApproved,
+		},
+		{
+			name:         "disbursed",
+			entityStatus: entity.LoanStatusDisbursed,
+			want:         LoanStatusDisbursed,
+		},
+		{
+			name:         "rejected",
+			entityStatus: entity.LoanStatusRejected,
+			want:         LoanStatusRejected,
+		},
+		{
+			name:         "cancelled",
+			entityStatus: entity.LoanStatusCancelled,
+			want:         LoanStatusCancelled,
+		},
+		{
+			name:         "defaulted",
+			entityStatus: entity.LoanStatusDefaulted,
+			want:         LoanStatusDefaulted,
+		},
+		{
+			name:         "written off",
+			entityStatus: entity.LoanStatusWrittenOff,
+			want:         LoanStatusWrittenOff,
+		},
 		{
 			name:         "unknown",
 			entityStatus: entity.LoanStatus(999),
@@ -43,8 +78,244 @@ func TestParseLoanStatus(t *testing.T) {
 	}
 }
 
+func TestParseInterestModel(t *testing.T) {
+	tests := []struct {
+		name        string
+		entityModel entity.InterestModel
+		want        InterestModel
+	}{
+		{
+			name:        "flat",
+			entityModel: entity.InterestModelFlat,
+			want:        InterestModelFlat,
+		},
+		{
+			name:        "declining balance",
+			entityModel: entity.InterestModelDecliningBalance,
+			want:        InterestModelDecliningBalance,
+		},
+		{
+			name:        "zero interest",
+			entityModel: entity.InterestModelZeroInterest,
+			want:        InterestModelZeroInterest,
+		},
+		{
+			name:        "unknown",
+			entityModel: entity.InterestModel(999),
+			want:        InterestModel(0),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := parseInterestModel(test.entityModel); got != test.want {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseRoundingPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		entityPolicy entity.RoundingPolicy
+		want         RoundingPolicy
+	}{
+		{
+			name:         "remainder on last",
+			entityPolicy: entity.RoundingPolicy{Method: entity.RoundingMethodRemainderOnLast},
+			want:         RoundingPolicy{Method: RoundingMethodRemainderOnLast},
+		},
+		{
+			name:         "remainder on first",
+			entityPolicy: entity.RoundingPolicy{Method: entity.RoundingMethodRemainderOnFirst},
+			want:         RoundingPolicy{Method: RoundingMethodRemainderOnFirst},
+		},
+		{
+			name:         "banker's rounding",
+			entityPolicy: entity.RoundingPolicy{Method: entity.RoundingMethodBankers, Precision: 2},
+			want:         RoundingPolicy{Method: RoundingMethodBankers, Precision: 2},
+		},
+		{
+			name:         "unknown",
+			entityPolicy: entity.RoundingPolicy{Method: entity.RoundingMethod(999)},
+			want:         RoundingPolicy{Method: RoundingMethod(0)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := parseRoundingPolicy(test.entityPolicy); got != test.want {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseLateFeePolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		entityPolicy entity.LateFeePolicy
+		want         LateFeePolicy
+	}{
+		{
+			name:         "none",
+			entityPolicy: entity.LateFeePolicy{Method: entity.LateFeeMethodNone},
+			want:         LateFeePolicy{Method: LateFeeMethodNone},
+		},
+		{
+			name: "fixed per missed installment",
+			entityPolicy: entity.LateFeePolicy{
+				Method: entity.LateFeeMethodFixedPerMissedInstallment,
+				Amount: decimal.NewFromInt(50_000),
+				Cap:    decimal.NewFromInt(200_000),
+			},
+			want: LateFeePolicy{
+				Method: LateFeeMethodFixedPerMissedInstallment,
+				Amount: decimal.NewFromInt(50_000),
+				Cap:    decimal.NewFromInt(200_000),
+			},
+		},
+		{
+			name: "percentage of overdue",
+			entityPolicy: entity.LateFeePolicy{
+				Method: entity.LateFeeMethodPercentageOfOverdue,
+				Amount: decimal.RequireFromString("0.05"),
+			},
+			want: LateFeePolicy{
+				Method: LateFeeMethodPercentageOfOverdue,
+				Amount: decimal.RequireFromString("0.05"),
+			},
+		},
+		{
+			name:         "unknown",
+			entityPolicy: entity.LateFeePolicy{Method: entity.LateFeeMethod(999)},
+			want:         LateFeePolicy{Method: LateFeeMethod(0)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parseLateFeePolicy(test.entityPolicy)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Fatalf("parseLateFeePolicy() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseLoanCharge(t *testing.T) {
+	now := time.Now()
+	chargeID := uuid.New()
+
+	tests := []struct {
+		name         string
+		entityCharge *entity.LoanCharge
+		want         LoanCharge
+	}{
+		{
+			name:         "nil charge",
+			entityCharge: nil,
+			want:         LoanCharge{},
+		},
+		{
+			name: "late fee",
+			entityCharge: &entity.LoanCharge{
+				ID:                chargeID,
+				LoanID:            uuid.New(),
+				Type:              entity.LoanChargeTypeLateFee,
+				InstallmentNumber: 2,
+				Amount:            decimal.NewFromInt(50_000),
+				AmountPaid:        decimal.NewFromInt(20_000),
+				CapitalizedAmount: decimal.NewFromInt(10_000),
+				CreatedAt:         now,
+				UpdatedAt:         now,
+			},
+			want: LoanCharge{
+				ID:                chargeID,
+				Type:              LoanChargeTypeLateFee,
+				InstallmentNumber: 2,
+				Amount:            decimal.NewFromInt(50_000),
+				AmountPaid:        decimal.NewFromInt(20_000),
+				CapitalizedAmount: decimal.NewFromInt(10_000),
+				CreatedAt:         now,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parseLoanCharge(test.entityCharge)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Fatalf("parseLoanCharge() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseBillingCalendar(t *testing.T) {
+	tests := []struct {
+		name           string
+		entityCalendar *entity.BillingCalendar
+		want           *BillingCalendar
+	}{
+		{
+			name:           "nil calendar",
+			entityCalendar: nil,
+			want:           nil,
+		},
+		{
+			name:           "normal case",
+			entityCalendar: &entity.BillingCalendar{Timezone: "Asia/Jakarta", WeekStartDay: time.Sunday},
+			want:           &BillingCalendar{Timezone: "Asia/Jakarta", WeekStartDay: time.Sunday},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parseBillingCalendar(test.entityCalendar)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Fatalf("parseBillingCalendar() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseDelinquencyRule(t *testing.T) {
+	tests := []struct {
+		name       string
+		entityRule *entity.DelinquencyRule
+		want       *DelinquencyRule
+	}{
+		{
+			name:       "nil rule",
+			entityRule: nil,
+			want:       nil,
+		},
+		{
+			name:       "partial payment counts as missed",
+			entityRule: &entity.DelinquencyRule{MissedInstallmentThreshold: 2, PartialPaymentRule: entity.PartialPaymentCountsAsMissed},
+			want:       &DelinquencyRule{MissedInstallmentThreshold: 2, PartialPaymentRule: PartialPaymentCountsAsMissed},
+		},
+		{
+			name:       "partial payment counts as paid",
+			entityRule: &entity.DelinquencyRule{MissedInstallmentThreshold: 4, PartialPaymentRule: entity.PartialPaymentCountsAsPaid},
+			want:       &DelinquencyRule{MissedInstallmentThreshold: 4, PartialPaymentRule: PartialPaymentCountsAsPaid},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parseDelinquencyRule(test.entityRule)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Fatalf("parseDelinquencyRule() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestParseLoan(t *testing.T) {
-	mockLoan, err := entity.CreateLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5)
+	mockLoan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -63,14 +334,27 @@ func TestParseLoan(t *testing.T) {
 			name:       "normal case",
 			entityLoan: mockLoan,
 			want: Loan{
-				ID:                   mockLoan.ID,
-				UserID:               mockLoan.UserID,
-				Amount:               mockLoan.Amount,
-				PaymentDurationWeeks: mockLoan.PaymentDurationWeeks,
-				PaymentAmount:        mockLoan.PaymentAmount,
-				Status:               parseLoanStatus(mockLoan.Status),
-				CreatedAt:            mockLoan.CreatedAt,
-				UpdatedAt:            mockLoan.UpdatedAt,
+				ID:                    mockLoan.ID,
+				UserID:                mockLoan.UserID,
+				ProductID:             mockLoan.ProductID,
+				Amount:                mockLoan.Amount,
+				BillingFrequency:      BillingFrequencyWeekly,
+				InstallmentCount:      mockLoan.InstallmentCount,
+				PaymentDurationWeeks:  mockLoan.InstallmentCount,
+				InterestModel:         parseInterestModel(mockLoan.InterestModel),
+				InterestRate:          mockLoan.InterestRate,
+				PaymentAmount:         mockLoan.PaymentAmount,
+				RoundingPolicy:        parseRoundingPolicy(mockLoan.RoundingPolicy),
+				PaymentAcceptanceMode: parsePaymentAcceptanceMode(mockLoan.PaymentAcceptanceMode),
+				OriginationFee:        mockLoan.OriginationFee,
+				BillingCalendar:       &BillingCalendar{Timezone: "UTC", WeekStartDay: time.Monday},
+				DelinquencyRule:       &DelinquencyRule{MissedInstallmentThreshold: 2, PartialPaymentRule: PartialPaymentCountsAsMissed},
+				Status:                parseLoanStatus(mockLoan.Status),
+				CreatedAt:             mockLoan.CreatedAt,
+				UpdatedAt:             mockLoan.UpdatedAt,
+				ApprovedAt:            mockLoan.ApprovedAt,
+				DisbursedAt:           mockLoan.DisbursedAt,
+				TermsVersion:          1,
 			},
 		},
 	}
@@ -85,47 +369,599 @@ func TestParseLoan(t *testing.T) {
 	}
 }
 
-func TestParseLoanDetail(t *testing.T) {
-	mockLoan := Loan{
-		ID:                   uuid.New(),
-		UserID:               uuid.New(),
-		Amount:               decimal.NewFromInt(1000000),
-		PaymentDurationWeeks: 10,
-		PaymentAmount:        decimal.NewFromInt(110000),
-		Status:               LoanStatusOngoing,
-		CreatedAt:            time.Now(),
-		UpdatedAt:            time.Now(),
+func TestParseLoanProduct(t *testing.T) {
+	tests := []struct {
+		name          string
+		entityProduct *entity.LoanProduct
+		want          LoanProduct
+	}{
+		{
+			name:          "nil entity loan product",
+			entityProduct: nil,
+			want:          LoanProduct{},
+		},
+		{
+			name:          "normal case",
+			entityProduct: testProduct,
+			want: LoanProduct{
+				ID:                       testProduct.ID,
+				Name:                     testProduct.Name,
+				MinPrincipal:             testProduct.MinPrincipal,
+				MaxPrincipal:             testProduct.MaxPrincipal,
+				BillingFrequency:         BillingFrequencyWeekly,
+				AllowedInstallmentCounts: testProduct.AllowedInstallmentCounts,
+				InterestModel:            InterestModelFlat,
+				InterestRate:             testProduct.InterestRate,
+				FeeSchedule: FeeSchedule{
+					OriginationFeeRate:  testProduct.FeeSchedule.OriginationFeeRate,
+					OriginationFeeFixed: testProduct.FeeSchedule.OriginationFeeFixed,
+				},
+				CreatedAt: testProduct.CreatedAt,
+				UpdatedAt: testProduct.UpdatedAt,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parseLoanProduct(test.entityProduct)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Fatalf("parseLoanProduct() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseLoanInstallmentStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		entityStatus entity.LoanInstallmentStatus
+		want         LoanInstallmentStatus
+	}{
+		{
+			name:         "unpaid",
+			entityStatus: entity.LoanInstallmentStatusUnpaid,
+			want:         LoanInstallmentStatusUnpaid,
+		},
+		{
+			name:         "paid",
+			entityStatus: entity.LoanInstallmentStatusPaid,
+			want:         LoanInstallmentStatusPaid,
+		},
+		{
+			name:         "partially paid",
+			entityStatus: entity.LoanInstallmentStatusPartiallyPaid,
+			want:         LoanInstallmentStatusPartiallyPaid,
+		},
+		{
+			name:         "restructured",
+			entityStatus: entity.LoanInstallmentStatusRestructured,
+			want:         LoanInstallmentStatusRestructured,
+		},
+		{
+			name:         "unknown",
+			entityStatus: entity.LoanInstallmentStatus(999),
+			want:         LoanInstallmentStatus(0),
+		},
 	}
 
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := parseLoanInstallmentStatus(test.entityStatus); got != test.want {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseLoanInstallment(t *testing.T) {
+	dueDate := time.Now()
+
 	tests := []struct {
 		name              string
-		loan              Loan
-		outstandingAmount decimal.Decimal
-		currentBillAmount decimal.Decimal
-		isDelinquent      bool
-		wantLoanDetail    LoanDetail
-	}{
-		{
-			name:              "normal case",
-			loan:              mockLoan,
-			outstandingAmount: decimal.NewFromInt(500000),
-			currentBillAmount: decimal.NewFromInt(110000),
-			isDelinquent:      false,
+		entityInstallment *entity.LoanInstallment
+		want              LoanInstallment
+	}{
+		{
+			name:              "nil entity installment",
+			entityInstallment: nil,
+			want:              LoanInstallment{},
+		},
+		{
+			name: "normal case",
+			entityInstallment: &entity.LoanInstallment{
+				LoanID:          uuid.New(),
+				Number:          3,
+				DueDate:         dueDate,
+				PrincipalAmount: decimal.NewFromInt(100_000),
+				InterestAmount:  decimal.NewFromInt(10_000),
+				AmountDue:       decimal.NewFromInt(110_000),
+				AmountPaid:      decimal.NewFromInt(110_000),
+				Status:          entity.LoanInstallmentStatusPaid,
+			},
+			want: LoanInstallment{
+				Number:          3,
+				DueDate:         dueDate,
+				PrincipalAmount: decimal.NewFromInt(100_000),
+				InterestAmount:  decimal.NewFromInt(10_000),
+				AmountDue:       decimal.NewFromInt(110_000),
+				AmountPaid:      decimal.NewFromInt(110_000),
+				Status:          LoanInstallmentStatusPaid,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parseLoanInstallment(test.entityInstallment)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Fatalf("parseLoanInstallment() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseLoanTerms(t *testing.T) {
+	loanID := uuid.New()
+
+	tests := []struct {
+		name        string
+		entityTerms *entity.LoanTerms
+		want        LoanTerms
+	}{
+		{
+			name:        "nil entity terms",
+			entityTerms: nil,
+			want:        LoanTerms{},
+		},
+		{
+			name: "normal case",
+			entityTerms: &entity.LoanTerms{
+				LoanID:                 loanID,
+				Version:                2,
+				Principal:              decimal.NewFromInt(3_300_000),
+				InterestRate:           decimal.NewFromFloat(0.05),
+				InstallmentCount:       10,
+				PaymentAmount:          decimal.NewFromInt(3_465_000),
+				FirstInstallmentNumber: 3,
+				CapitalizedCharges:     decimal.NewFromInt(50_000),
+				Reason:                 "income reduced",
+				CreatedAt:              testNow,
+			},
+			want: LoanTerms{
+				LoanID:                 loanID,
+				Version:                2,
+				Principal:              decimal.NewFromInt(3_300_000),
+				InterestRate:           decimal.NewFromFloat(0.05),
+				InstallmentCount:       10,
+				PaymentAmount:          decimal.NewFromInt(3_465_000),
+				FirstInstallmentNumber: 3,
+				CapitalizedCharges:     decimal.NewFromInt(50_000),
+				Reason:                 "income reduced",
+				CreatedAt:              testNow,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parseLoanTerms(test.entityTerms)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Fatalf("parseLoanTerms() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParsePaymentHoliday(t *testing.T) {
+	holidayID := uuid.New()
+	loanID := uuid.New()
+	startsAt := time.Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2024, time.January, 22, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		entityHoliday *entity.PaymentHoliday
+		want          PaymentHoliday
+	}{
+		{
+			name:          "nil entity payment holiday",
+			entityHoliday: nil,
+			want:          PaymentHoliday{},
+		},
+		{
+			name: "normal case",
+			entityHoliday: &entity.PaymentHoliday{
+				ID:                     holidayID,
+				LoanID:                 loanID,
+				FirstInstallmentNumber: 4,
+				StartPeriod:            4,
+				Periods:                2,
+				StartsAt:               startsAt,
+				EndsAt:                 endsAt,
+				Reason:                 "flood relief",
+				CreatedAt:              testNow,
+			},
+			want: PaymentHoliday{
+				ID:                     holidayID,
+				LoanID:                 loanID,
+				FirstInstallmentNumber: 4,
+				Periods:                2,
+				StartsAt:               startsAt,
+				EndsAt:                 endsAt,
+				Reason:                 "flood relief",
+				CreatedAt:              testNow,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parsePaymentHoliday(test.entityHoliday)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Fatalf("parsePaymentHoliday() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParsePaymentAcceptanceMode(t *testing.T) {
+	tests := []struct {
+		name       string
+		entityMode entity.PaymentAcceptanceMode
+		want       PaymentAcceptanceMode
+	}{
+		{
+			name:       "exact",
+			entityMode: entity.PaymentAcceptanceModeExact,
+			want:       PaymentAcceptanceModeExact,
+		},
+		{
+			name:       "partial",
+			entityMode: entity.PaymentAcceptanceModePartial,
+			want:       PaymentAcceptanceModePartial,
+		},
+		{
+			name:       "unknown",
+			entityMode: entity.PaymentAcceptanceMode(999),
+			want:       PaymentAcceptanceMode(0),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := parsePaymentAcceptanceMode(test.entityMode); got != test.want {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseOverpaymentPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		entityPolicy entity.OverpaymentPolicy
+		want         OverpaymentPolicy
+	}{
+		{
+			name:         "reject",
+			entityPolicy: entity.OverpaymentPolicyReject,
+			want:         OverpaymentPolicyReject,
+		},
+		{
+			name:         "credit",
+			entityPolicy: entity.OverpaymentPolicyCredit,
+			want:         OverpaymentPolicyCredit,
+		},
+		{
+			name:         "refund",
+			entityPolicy: entity.OverpaymentPolicyRefund,
+			want:         OverpaymentPolicyRefund,
+		},
+		{
+			name:         "unknown",
+			entityPolicy: entity.OverpaymentPolicy(999),
+			want:         OverpaymentPolicy(0),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := parseOverpaymentPolicy(test.entityPolicy); got != test.want {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseLoanDetail(t *testing.T) {
+	mockLoan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := mockLoan.CreatedAt.AddDate(0, 0, 14)
+	if _, _, err = mockLoan.MakePayment(now, decimal.Zero, decimal.NewFromInt(1_500_000), entity.PaymentModePrepayment, entity.PaymentDetails{}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		loan           *entity.Loan
+		paidAmount     decimal.Decimal
+		buckets        entity.DelinquencyBuckets
+		wantLoanDetail LoanDetail
+	}{
+		{
+			name:       "normal case",
+			loan:       mockLoan,
+			paidAmount: decimal.NewFromInt(1_500_000),
+			buckets:    entity.DefaultDelinquencyBuckets(),
 			wantLoanDetail: LoanDetail{
-				Loan:              mockLoan,
-				OutstandingAmount: decimal.NewFromInt(500000),
-				CurrentBillAmount: decimal.NewFromInt(110000),
-				IsDelinquent:      false,
+				Loan:                        parseLoan(mockLoan),
+				OutstandingAmount:           decimal.NewFromInt(4_000_000),
+				CurrentBillAmount:           decimal.NewFromInt(700_000),
+				IsDelinquent:                false,
+				DaysPastDue:                 2,
+				OldestUnpaidInstallmentDate: mockLoan.Installments[1].DueDate,
+				DelinquencyBucket:           "1-7",
+				PaidAmount:                  decimal.NewFromInt(1_500_000),
+				RemainingDueAmount:          decimal.NewFromInt(700_000),
+				DueBy:                       mockLoan.Installments[1].DueDate,
+				MaturityDate:                mockLoan.Installments[4].DueDate,
+			},
+		},
+		{
+			name:       "configured delinquency buckets",
+			loan:       mockLoan,
+			paidAmount: decimal.NewFromInt(1_500_000),
+			buckets:    entity.DelinquencyBuckets{0, 1, 30},
+			wantLoanDetail: LoanDetail{
+				Loan:                        parseLoan(mockLoan),
+				OutstandingAmount:           decimal.NewFromInt(4_000_000),
+				CurrentBillAmount:           decimal.NewFromInt(700_000),
+				IsDelinquent:                false,
+				DaysPastDue:                 2,
+				OldestUnpaidInstallmentDate: mockLoan.Installments[1].DueDate,
+				DelinquencyBucket:           "2-30",
+				PaidAmount:                  decimal.NewFromInt(1_500_000),
+				RemainingDueAmount:          decimal.NewFromInt(700_000),
+				DueBy:                       mockLoan.Installments[1].DueDate,
+				MaturityDate:                mockLoan.Installments[4].DueDate,
 			},
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := parseLoanDetail(test.loan, test.outstandingAmount, test.currentBillAmount, test.isDelinquent)
+			got := parseLoanDetail(test.loan, now, test.paidAmount, test.buckets)
 			if diff := cmp.Diff(test.wantLoanDetail, got); diff != "" {
 				t.Fatalf("parseLoanDetail() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
+
+func TestToEntityPaymentMode(t *testing.T) {
+	tests := []struct {
+		name string
+		mode PaymentMode
+		want entity.PaymentMode
+	}{
+		{
+			name: "regular",
+			mode: PaymentModeRegular,
+			want: entity.PaymentModeRegular,
+		},
+		{
+			name: "prepayment",
+			mode: PaymentModePrepayment,
+			want: entity.PaymentModePrepayment,
+		},
+		{
+			name: "payoff",
+			mode: PaymentModePayoff,
+			want: entity.PaymentModePayoff,
+		},
+		{
+			name: "credit",
+			mode: PaymentModeCredit,
+			want: entity.PaymentMode(-1),
+		},
+		{
+			name: "recovery",
+			mode: PaymentModeRecovery,
+			want: entity.PaymentModeRecovery,
+		},
+		{
+			name: "unknown",
+			mode: PaymentMode(999),
+			want: entity.PaymentMode(-1),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := toEntityPaymentMode(test.mode); got != test.want {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseLoanPayment(t *testing.T) {
+	now := time.Now()
+	paymentID := uuid.New()
+	loanID := uuid.New()
+	chargeID := uuid.New()
+
+	tests := []struct {
+		name          string
+		entityPayment *entity.LoanPayment
+		want          LoanPayment
+	}{
+		{
+			name:          "nil payment",
+			entityPayment: nil,
+			want:          LoanPayment{},
+		},
+		{
+			name: "payment with allocations and details",
+			entityPayment: &entity.LoanPayment{
+				ID:     paymentID,
+				LoanID: loanID,
+				Amount: decimal.NewFromInt(1_150_000),
+				Allocations: []entity.InstallmentAllocation{
+					{InstallmentNumber: 1, Amount: decimal.NewFromInt(1_100_000)},
+				},
+				ChargeAllocations: []entity.ChargeAllocation{
+					{ChargeID: chargeID, Amount: decimal.NewFromInt(50_000)},
+				},
+				Mode: entity.PaymentModePrepayment,
+				Details: entity.PaymentDetails{
+					ExternalReference: "TRX-1",
+					Channel:           entity.PaymentChannelVirtualAccount,
+					PaidAt:            now.Add(-time.Hour),
+					Metadata:          map[string]string{"bank": "BCA"},
+				},
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+			want: LoanPayment{
+				ID:                paymentID,
+				LoanID:            loanID,
+				Amount:            decimal.NewFromInt(1_150_000),
+				Mode:              PaymentModePrepayment,
+				ExternalReference: "TRX-1",
+				Channel:           PaymentChannelVirtualAccount,
+				PaidAt:            now.Add(-time.Hour),
+				Metadata:          map[string]string{"bank": "BCA"},
+				Allocations: []InstallmentAllocation{
+					{InstallmentNumber: 1, Amount: decimal.NewFromInt(1_100_000)},
+				},
+				ChargeAllocations: []ChargeAllocation{
+					{ChargeID: chargeID, Amount: decimal.NewFromInt(50_000)},
+				},
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+		{
+			name: "payment with excess marked for refund",
+			entityPayment: &entity.LoanPayment{
+				ID:             paymentID,
+				LoanID:         loanID,
+				Amount:         decimal.NewFromInt(1_100_000),
+				ExcessAmount:   decimal.NewFromInt(100_000),
+				ExcessHandling: entity.OverpaymentPolicyRefund,
+				Mode:           entity.PaymentModeRegular,
+				CreatedAt:      now,
+				UpdatedAt:      now,
+			},
+			want: LoanPayment{
+				ID:             paymentID,
+				LoanID:         loanID,
+				Amount:         decimal.NewFromInt(1_100_000),
+				ExcessAmount:   decimal.NewFromInt(100_000),
+				ExcessHandling: OverpaymentPolicyRefund,
+				Mode:           PaymentModeRegular,
+				CreatedAt:      now,
+				UpdatedAt:      now,
+			},
+		},
+		{
+			name: "payment made from credit",
+			entityPayment: &entity.LoanPayment{
+				ID:        paymentID,
+				LoanID:    loanID,
+				Amount:    decimal.NewFromInt(300_000),
+				Mode:      entity.PaymentModeCredit,
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+			want: LoanPayment{
+				ID:        paymentID,
+				LoanID:    loanID,
+				Amount:    decimal.NewFromInt(300_000),
+				Mode:      PaymentModeCredit,
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parseLoanPayment(test.entityPayment)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Fatalf("parseLoanPayment() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestToEntityLoanStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		status LoanStatus
+		want   entity.LoanStatus
+	}{
+		{
+			name:   "ongoing",
+			status: LoanStatusOngoing,
+			want:   entity.LoanStatusOngoing,
+		},
+		{
+			name:   "paid",
+			status: LoanStatusPaid,
+			want:   entity.LoanStatusPaid,
+		},
+		{
+			name:   "pending approval",
+			status: LoanStatusPendingApproval,
+			want:   entity.LoanStatusPendingApproval,
+		},
+		{
+			name:   "approved",
+			status: LoanStatusApproved,
+			want:   entity.LoanStatusApproved,
+		},
+		{
+			name:   "disbursed",
+			status: LoanStatusDisbursed,
+			want:   entity.LoanStatusDisbursed,
+		},
+		{
+			name:   "rejected",
+			status: LoanStatusRejected,
+			want:   entity.LoanStatusRejected,
+		},
+		{
+			name:   "cancelled",
+			status: LoanStatusCancelled,
+			want:   entity.LoanStatusCancelled,
+		},
+		{
+			name:   "defaulted",
+			status: LoanStatusDefaulted,
+			want:   entity.LoanStatusDefaulted,
+		},
+		{
+			name:   "written off",
+			status: LoanStatusWrittenOff,
+			want:   entity.LoanStatusWrittenOff,
+		},
+		{
+			name:   "unknown",
+			status: LoanStatus(999),
+			want:   entity.LoanStatus(-1),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := toEntityLoanStatus(test.status); got != test.want {
+				t.Fatalf("expecting %v, got %v", test.want, got)
+			}
+		})
+	}
+}
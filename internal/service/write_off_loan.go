@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+)
+
+// WriteOffLoanCommand represents the input data required to write a loan off.
+type WriteOffLoanCommand struct {
+	// LoanID is the unique identifier of the loan being written off.
+	LoanID uuid.UUID
+}
+
+// WriteOffLoan writes the outstanding amount of a defaulted loan off as a loss.
+//
+// The loan is billed up to now first, so that the user's credit balance is applied to the current bill, the late fees
+// of the installments still missed are charged, and a loan past its default period is defaulted. The principal, interest and charges outstanding on the loan are recorded
+// as its written-off amounts, and the loan is closed, so that it does not block its user from applying for a new loan.
+// Only recovery payments, tracked in the recovered amount of the loan rather than its paid amount, are accepted
+// towards the loan afterwards.
+//
+// Parameters:
+//   - ctx: The context for the operation.
+//   - in: A WriteOffLoanCommand struct containing the loan being written off.
+//
+// Returns:
+//   - Loan: A struct containing the updated loan information.
+//   - error: An error if the operation fails, or nil if successful. Possible errors include entity.ErrLoanNotFound
+//     and entity.ErrLoanIllegalStatusTransition if the loan is not defaulted or past its default period.
+func (s *Impl) WriteOffLoan(ctx context.Context, in WriteOffLoanCommand) (Loan, error) {
+	now := s.clock.Now()
+
+	loan, err := s.repo.BillLoan(ctx, in.LoanID, func(loan *entity.Loan, currPaidAmount, creditBalance decimal.Decimal) (*entity.LoanBilling, error) {
+		billing, err := loan.Bill(now, currPaidAmount, creditBalance)
+		if err != nil {
+			return nil, err
+		}
+		if err = loan.WriteOff(now); err != nil {
+			return nil, err
+		}
+		billing.ShouldUpdateLoan = true
+
+		return billing, nil
+	})
+	if err != nil {
+		return Loan{}, ensureBusinessError(err)
+	}
+
+	return parseLoan(loan), nil
+}
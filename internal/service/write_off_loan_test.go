@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/axopadyani/billing-engine/internal/entity"
+	"github.com/axopadyani/billing-engine/internal/test/clock"
+	"github.com/axopadyani/billing-engine/internal/test/mock/repository"
+)
+
+func TestImpl_WriteOffLoan(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	// the loan is disbursed ten weeks before the test time, and nothing has been paid towards it
+	newLoan := func(status entity.LoanStatus) *entity.Loan {
+		loan, err := newOngoingLoan(uuid.New(), decimal.NewFromInt(5_000_000), 5, testNow.AddDate(0, 0, -70))
+		if err != nil {
+			t.Fatal(err)
+		}
+		loan.Status = status
+
+		return loan
+	}
+
+	// pastDefaultPeriodLoan is an ongoing loan that has been past due for longer than its default period of two weeks,
+	// and is charged a late fee for each of its missed installments
+	pastDefaultPeriodLoan := newLoan(entity.LoanStatusOngoing)
+	pastDefaultPeriodLoan.DefaultAfterWeeks = 2
+	pastDefaultPeriodLoan.LateFeePolicy = entity.LateFeePolicy{
+		Method: entity.LateFeeMethodFixedPerMissedInstallment,
+		Amount: decimal.NewFromInt(50_000),
+	}
+
+	// billLoan bills the given loan, like the repository does to the stored loan
+	billLoan := func(loan *entity.Loan) func(context.Context, uuid.UUID, func(*entity.Loan, decimal.Decimal, decimal.Decimal) (*entity.LoanBilling, error)) (*entity.Loan, error) {
+		return func(_ context.Context, _ uuid.UUID, billFn func(*entity.Loan, decimal.Decimal, decimal.Decimal) (*entity.LoanBilling, error)) (*entity.Loan, error) {
+			billing, err := billFn(loan, decimal.Zero, decimal.Zero)
+			if err != nil {
+				return nil, err
+			}
+			if !billing.ShouldUpdateLoan {
+				t.Errorf("expecting the written-off loan to be updated")
+			}
+			return loan, nil
+		}
+	}
+
+	tests := []struct {
+		name      string
+		cmd       WriteOffLoanCommand
+		setupMock func(*repository.MockRepository)
+		wantErr   error
+	}{
+		{
+			name: "loan not found",
+			cmd:  WriteOffLoanCommand{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().BillLoan(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, entity.ErrLoanNotFound)
+			},
+			wantErr: entity.ErrLoanNotFound,
+		},
+		{
+			name: "repository unexpected error",
+			cmd:  WriteOffLoanCommand{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().BillLoan(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr: UnexpectedError,
+		},
+		{
+			name: "loan not defaulted",
+			cmd:  WriteOffLoanCommand{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().BillLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(billLoan(newLoan(entity.LoanStatusOngoing)))
+			},
+			wantErr: entity.ErrLoanIllegalStatusTransition,
+		},
+		{
+			name: "loan past its default period",
+			cmd:  WriteOffLoanCommand{LoanID: pastDefaultPeriodLoan.ID},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().BillLoan(gomock.Any(), pastDefaultPeriodLoan.ID, gomock.Any()).
+					DoAndReturn(billLoan(pastDefaultPeriodLoan))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success",
+			cmd:  WriteOffLoanCommand{LoanID: uuid.New()},
+			setupMock: func(mockRepo *repository.MockRepository) {
+				mockRepo.EXPECT().BillLoan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(billLoan(newLoan(entity.LoanStatusDefaulted)))
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := repository.NewMockRepository(ctrl)
+			test.setupMock(mockRepo)
+
+			s := NewService(mockRepo, clock.NewFakeClock(testNow), Config{})
+
+			got, err := s.WriteOffLoan(ctx, test.cmd)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("expecting error to be %v, got %v", test.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if got.Status != LoanStatusWrittenOff {
+				t.Fatalf("expecting status %v, got %v", LoanStatusWrittenOff, got.Status)
+			}
+			if !got.WrittenOffAt.Equal(testNow) {
+				t.Fatalf("expecting written off at to be %v, got %v", testNow, got.WrittenOffAt)
+			}
+			if got.WrittenOffPrincipal.Add(got.WrittenOffInterest).IsZero() {
+				t.Fatalf("expecting the unpaid installments to be written off, got principal %s and interest %s", got.WrittenOffPrincipal, got.WrittenOffInterest)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// FakeClock is a controllable clock for tests, which only moves when it is set or advanced.
+type FakeClock struct {
+	mu  sync.RWMutex
+	now time.Time
+}
+
+// NewFakeClock creates and returns a new FakeClock stopped at the given time.
+//
+// Parameters:
+//   - now: The time the clock starts at.
+//
+// Returns:
+//   - *FakeClock: The newly created fake clock.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the current time of the clock.
+//
+// Returns:
+//   - time.Time: The time the clock was last set or advanced to.
+func (c *FakeClock) Now() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.now
+}
+
+// Set moves the clock to the given time.
+//
+// Parameters:
+//   - now: The time to move the clock to.
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+}
+
+// Advance moves the clock forward by the given duration.
+//
+// Parameters:
+//   - d: The duration to move the clock forward by.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// AdvanceDays moves the clock forward by the given number of calendar days, keeping the time of day
+// in the clock's location.
+//
+// Parameters:
+//   - days: The number of days to move the clock forward by.
+func (c *FakeClock) AdvanceDays(days int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.AddDate(0, 0, days)
+}
@@ -37,6 +37,21 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// BillLoan mocks base method.
+func (m *MockRepository) BillLoan(ctx context.Context, loanID uuid.UUID, billFn func(*entity.Loan, decimal.Decimal, decimal.Decimal) (*entity.LoanBilling, error)) (*entity.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BillLoan", ctx, loanID, billFn)
+	ret0, _ := ret[0].(*entity.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BillLoan indicates an expected call of BillLoan.
+func (mr *MockRepositoryMockRecorder) BillLoan(ctx, loanID, billFn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BillLoan", reflect.TypeOf((*MockRepository)(nil).BillLoan), ctx, loanID, billFn)
+}
+
 // CreateLoan mocks base method.
 func (m *MockRepository) CreateLoan(ctx context.Context, loan *entity.Loan, validateFn func(*entity.Loan) error) error {
 	m.ctrl.T.Helper()
@@ -66,6 +81,51 @@ func (mr *MockRepositoryMockRecorder) GetLatestLoan(ctx, userID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestLoan", reflect.TypeOf((*MockRepository)(nil).GetLatestLoan), ctx, userID)
 }
 
+// GetLoan mocks base method.
+func (m *MockRepository) GetLoan(ctx context.Context, loanID uuid.UUID) (*entity.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoan", ctx, loanID)
+	ret0, _ := ret[0].(*entity.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoan indicates an expected call of GetLoan.
+func (mr *MockRepositoryMockRecorder) GetLoan(ctx, loanID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoan", reflect.TypeOf((*MockRepository)(nil).GetLoan), ctx, loanID)
+}
+
+// GetLoanByIdempotencyKey mocks base method.
+func (m *MockRepository) GetLoanByIdempotencyKey(ctx context.Context, userID uuid.UUID, idempotencyKey string) (*entity.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoanByIdempotencyKey", ctx, userID, idempotencyKey)
+	ret0, _ := ret[0].(*entity.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoanByIdempotencyKey indicates an expected call of GetLoanByIdempotencyKey.
+func (mr *MockRepositoryMockRecorder) GetLoanByIdempotencyKey(ctx, userID, idempotencyKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoanByIdempotencyKey", reflect.TypeOf((*MockRepository)(nil).GetLoanByIdempotencyKey), ctx, userID, idempotencyKey)
+}
+
+// GetLoanInstallments mocks base method.
+func (m *MockRepository) GetLoanInstallments(ctx context.Context, loanID uuid.UUID) ([]*entity.LoanInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoanInstallments", ctx, loanID)
+	ret0, _ := ret[0].([]*entity.LoanInstallment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoanInstallments indicates an expected call of GetLoanInstallments.
+func (mr *MockRepositoryMockRecorder) GetLoanInstallments(ctx, loanID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoanInstallments", reflect.TypeOf((*MockRepository)(nil).GetLoanInstallments), ctx, loanID)
+}
+
 // GetLoanPaidAmount mocks base method.
 func (m *MockRepository) GetLoanPaidAmount(ctx context.Context, loanID uuid.UUID) (decimal.Decimal, error) {
 	m.ctrl.T.Helper()
@@ -81,10 +141,146 @@ func (mr *MockRepositoryMockRecorder) GetLoanPaidAmount(ctx, loanID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoanPaidAmount", reflect.TypeOf((*MockRepository)(nil).GetLoanPaidAmount), ctx, loanID)
 }
 
+// GetLoanPaymentByIdempotencyKey mocks base method.
+func (m *MockRepository) GetLoanPaymentByIdempotencyKey(ctx context.Context, loanID uuid.UUID, idempotencyKey string) (*entity.LoanPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoanPaymentByIdempotencyKey", ctx, loanID, idempotencyKey)
+	ret0, _ := ret[0].(*entity.LoanPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoanPaymentByIdempotencyKey indicates an expected call of GetLoanPaymentByIdempotencyKey.
+func (mr *MockRepositoryMockRecorder) GetLoanPaymentByIdempotencyKey(ctx, loanID, idempotencyKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoanPaymentByIdempotencyKey", reflect.TypeOf((*MockRepository)(nil).GetLoanPaymentByIdempotencyKey), ctx, loanID, idempotencyKey)
+}
+
+// GetLoanProduct mocks base method.
+func (m *MockRepository) GetLoanProduct(ctx context.Context, productID uuid.UUID) (*entity.LoanProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoanProduct", ctx, productID)
+	ret0, _ := ret[0].(*entity.LoanProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoanProduct indicates an expected call of GetLoanProduct.
+func (mr *MockRepositoryMockRecorder) GetLoanProduct(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoanProduct", reflect.TypeOf((*MockRepository)(nil).GetLoanProduct), ctx, productID)
+}
+
+// GetUserCreditBalance mocks base method.
+func (m *MockRepository) GetUserCreditBalance(ctx context.Context, userID uuid.UUID) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserCreditBalance", ctx, userID)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserCreditBalance indicates an expected call of GetUserCreditBalance.
+func (mr *MockRepositoryMockRecorder) GetUserCreditBalance(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCreditBalance", reflect.TypeOf((*MockRepository)(nil).GetUserCreditBalance), ctx, userID)
+}
+
+// GrantPaymentHoliday mocks base method.
+func (m *MockRepository) GrantPaymentHoliday(ctx context.Context, loanID uuid.UUID, grantFn func(*entity.Loan) (*entity.PaymentHoliday, error)) (*entity.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantPaymentHoliday", ctx, loanID, grantFn)
+	ret0, _ := ret[0].(*entity.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantPaymentHoliday indicates an expected call of GrantPaymentHoliday.
+func (mr *MockRepositoryMockRecorder) GrantPaymentHoliday(ctx, loanID, grantFn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantPaymentHoliday", reflect.TypeOf((*MockRepository)(nil).GrantPaymentHoliday), ctx, loanID, grantFn)
+}
+
+// ListLoanPayments mocks base method.
+func (m *MockRepository) ListLoanPayments(ctx context.Context, loanID, beforeID uuid.UUID, limit int) ([]*entity.LoanPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoanPayments", ctx, loanID, beforeID, limit)
+	ret0, _ := ret[0].([]*entity.LoanPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoanPayments indicates an expected call of ListLoanPayments.
+func (mr *MockRepositoryMockRecorder) ListLoanPayments(ctx, loanID, beforeID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoanPayments", reflect.TypeOf((*MockRepository)(nil).ListLoanPayments), ctx, loanID, beforeID, limit)
+}
+
+// ListLoanProducts mocks base method.
+func (m *MockRepository) ListLoanProducts(ctx context.Context) ([]*entity.LoanProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoanProducts", ctx)
+	ret0, _ := ret[0].([]*entity.LoanProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoanProducts indicates an expected call of ListLoanProducts.
+func (mr *MockRepositoryMockRecorder) ListLoanProducts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoanProducts", reflect.TypeOf((*MockRepository)(nil).ListLoanProducts), ctx)
+}
+
+// ListLoanTerms mocks base method.
+func (m *MockRepository) ListLoanTerms(ctx context.Context, loanID uuid.UUID) ([]*entity.LoanTerms, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoanTerms", ctx, loanID)
+	ret0, _ := ret[0].([]*entity.LoanTerms)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoanTerms indicates an expected call of ListLoanTerms.
+func (mr *MockRepositoryMockRecorder) ListLoanTerms(ctx, loanID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoanTerms", reflect.TypeOf((*MockRepository)(nil).ListLoanTerms), ctx, loanID)
+}
+
+// ListPaymentHolidays mocks base method.
+func (m *MockRepository) ListPaymentHolidays(ctx context.Context, loanID uuid.UUID) ([]*entity.PaymentHoliday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentHolidays", ctx, loanID)
+	ret0, _ := ret[0].([]*entity.PaymentHoliday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentHolidays indicates an expected call of ListPaymentHolidays.
+func (mr *MockRepositoryMockRecorder) ListPaymentHolidays(ctx, loanID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentHolidays", reflect.TypeOf((*MockRepository)(nil).ListPaymentHolidays), ctx, loanID)
+}
+
+// ListUserLoans mocks base method.
+func (m *MockRepository) ListUserLoans(ctx context.Context, userID uuid.UUID, statuses []entity.LoanStatus, beforeID uuid.UUID, limit int) ([]*entity.Loan, map[uuid.UUID]decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserLoans", ctx, userID, statuses, beforeID, limit)
+	ret0, _ := ret[0].([]*entity.Loan)
+	ret1, _ := ret[1].(map[uuid.UUID]decimal.Decimal)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUserLoans indicates an expected call of ListUserLoans.
+func (mr *MockRepositoryMockRecorder) ListUserLoans(ctx, userID, statuses, beforeID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserLoans", reflect.TypeOf((*MockRepository)(nil).ListUserLoans), ctx, userID, statuses, beforeID, limit)
+}
+
 // MakePayment mocks base method.
-func (m *MockRepository) MakePayment(ctx context.Context, loanID uuid.UUID, paymentAmount decimal.Decimal, makePaymentFn func(*entity.Loan, decimal.Decimal) (*entity.LoanPayment, bool, error)) (*entity.Loan, decimal.Decimal, error) {
+func (m *MockRepository) MakePayment(ctx context.Context, loanID uuid.UUID, paymentAmount decimal.Decimal, idempotencyKey string, makePaymentFn func(*entity.Loan, decimal.Decimal, decimal.Decimal) (*entity.LoanBilling, *entity.LoanPayment, bool, error)) (*entity.Loan, decimal.Decimal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MakePayment", ctx, loanID, paymentAmount, makePaymentFn)
+	ret := m.ctrl.Call(m, "MakePayment", ctx, loanID, paymentAmount, idempotencyKey, makePaymentFn)
 	ret0, _ := ret[0].(*entity.Loan)
 	ret1, _ := ret[1].(decimal.Decimal)
 	ret2, _ := ret[2].(error)
@@ -92,7 +288,53 @@ func (m *MockRepository) MakePayment(ctx context.Context, loanID uuid.UUID, paym
 }
 
 // MakePayment indicates an expected call of MakePayment.
-func (mr *MockRepositoryMockRecorder) MakePayment(ctx, loanID, paymentAmount, makePaymentFn interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) MakePayment(ctx, loanID, paymentAmount, idempotencyKey, makePaymentFn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakePayment", reflect.TypeOf((*MockRepository)(nil).MakePayment), ctx, loanID, paymentAmount, idempotencyKey, makePaymentFn)
+}
+
+// RestructureLoan mocks base method.
+func (m *MockRepository) RestructureLoan(ctx context.Context, loanID uuid.UUID, restructureFn func(*entity.Loan, decimal.Decimal, decimal.Decimal) (*entity.LoanBilling, *entity.LoanTerms, error)) (*entity.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestructureLoan", ctx, loanID, restructureFn)
+	ret0, _ := ret[0].(*entity.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestructureLoan indicates an expected call of RestructureLoan.
+func (mr *MockRepositoryMockRecorder) RestructureLoan(ctx, loanID, restructureFn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestructureLoan", reflect.TypeOf((*MockRepository)(nil).RestructureLoan), ctx, loanID, restructureFn)
+}
+
+// ReversePayment mocks base method.
+func (m *MockRepository) ReversePayment(ctx context.Context, paymentID uuid.UUID, reversePaymentFn func(*entity.Loan, *entity.LoanPayment, decimal.Decimal, decimal.Decimal) (*entity.LoanPaymentReversal, error)) (*entity.Loan, decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReversePayment", ctx, paymentID, reversePaymentFn)
+	ret0, _ := ret[0].(*entity.Loan)
+	ret1, _ := ret[1].(decimal.Decimal)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReversePayment indicates an expected call of ReversePayment.
+func (mr *MockRepositoryMockRecorder) ReversePayment(ctx, paymentID, reversePaymentFn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReversePayment", reflect.TypeOf((*MockRepository)(nil).ReversePayment), ctx, paymentID, reversePaymentFn)
+}
+
+// TransitionLoan mocks base method.
+func (m *MockRepository) TransitionLoan(ctx context.Context, loanID uuid.UUID, transitionFn func(*entity.Loan) error) (*entity.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionLoan", ctx, loanID, transitionFn)
+	ret0, _ := ret[0].(*entity.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionLoan indicates an expected call of TransitionLoan.
+func (mr *MockRepositoryMockRecorder) TransitionLoan(ctx, loanID, transitionFn interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakePayment", reflect.TypeOf((*MockRepository)(nil).MakePayment), ctx, loanID, paymentAmount, makePaymentFn)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionLoan", reflect.TypeOf((*MockRepository)(nil).TransitionLoan), ctx, loanID, transitionFn)
 }
@@ -35,6 +35,36 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// ApproveLoan mocks base method.
+func (m *MockService) ApproveLoan(ctx context.Context, cmd service.ApproveLoanCommand) (service.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveLoan", ctx, cmd)
+	ret0, _ := ret[0].(service.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveLoan indicates an expected call of ApproveLoan.
+func (mr *MockServiceMockRecorder) ApproveLoan(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveLoan", reflect.TypeOf((*MockService)(nil).ApproveLoan), ctx, cmd)
+}
+
+// CancelLoan mocks base method.
+func (m *MockService) CancelLoan(ctx context.Context, cmd service.CancelLoanCommand) (service.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelLoan", ctx, cmd)
+	ret0, _ := ret[0].(service.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelLoan indicates an expected call of CancelLoan.
+func (mr *MockServiceMockRecorder) CancelLoan(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelLoan", reflect.TypeOf((*MockService)(nil).CancelLoan), ctx, cmd)
+}
+
 // CreateLoan mocks base method.
 func (m *MockService) CreateLoan(ctx context.Context, cmd service.CreateLoanCommand) (service.Loan, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +80,21 @@ func (mr *MockServiceMockRecorder) CreateLoan(ctx, cmd interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoan", reflect.TypeOf((*MockService)(nil).CreateLoan), ctx, cmd)
 }
 
+// DisburseLoan mocks base method.
+func (m *MockService) DisburseLoan(ctx context.Context, cmd service.DisburseLoanCommand) (service.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisburseLoan", ctx, cmd)
+	ret0, _ := ret[0].(service.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisburseLoan indicates an expected call of DisburseLoan.
+func (mr *MockServiceMockRecorder) DisburseLoan(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisburseLoan", reflect.TypeOf((*MockService)(nil).DisburseLoan), ctx, cmd)
+}
+
 // GetCurrentLoan mocks base method.
 func (m *MockService) GetCurrentLoan(ctx context.Context, query service.GetCurrentLoanQuery) (service.LoanDetail, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +110,156 @@ func (mr *MockServiceMockRecorder) GetCurrentLoan(ctx, query interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentLoan", reflect.TypeOf((*MockService)(nil).GetCurrentLoan), ctx, query)
 }
 
+// GetLoan mocks base method.
+func (m *MockService) GetLoan(ctx context.Context, query service.GetLoanQuery) (service.LoanDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoan", ctx, query)
+	ret0, _ := ret[0].(service.LoanDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoan indicates an expected call of GetLoan.
+func (mr *MockServiceMockRecorder) GetLoan(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoan", reflect.TypeOf((*MockService)(nil).GetLoan), ctx, query)
+}
+
+// GetLoanSchedule mocks base method.
+func (m *MockService) GetLoanSchedule(ctx context.Context, query service.GetLoanScheduleQuery) ([]service.LoanInstallment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoanSchedule", ctx, query)
+	ret0, _ := ret[0].([]service.LoanInstallment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoanSchedule indicates an expected call of GetLoanSchedule.
+func (mr *MockServiceMockRecorder) GetLoanSchedule(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoanSchedule", reflect.TypeOf((*MockService)(nil).GetLoanSchedule), ctx, query)
+}
+
+// GetPayoffQuote mocks base method.
+func (m *MockService) GetPayoffQuote(ctx context.Context, query service.GetPayoffQuoteQuery) (service.PayoffQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayoffQuote", ctx, query)
+	ret0, _ := ret[0].(service.PayoffQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayoffQuote indicates an expected call of GetPayoffQuote.
+func (mr *MockServiceMockRecorder) GetPayoffQuote(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayoffQuote", reflect.TypeOf((*MockService)(nil).GetPayoffQuote), ctx, query)
+}
+
+// GetProduct mocks base method.
+func (m *MockService) GetProduct(ctx context.Context, query service.GetProductQuery) (service.LoanProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProduct", ctx, query)
+	ret0, _ := ret[0].(service.LoanProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProduct indicates an expected call of GetProduct.
+func (mr *MockServiceMockRecorder) GetProduct(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProduct", reflect.TypeOf((*MockService)(nil).GetProduct), ctx, query)
+}
+
+// GrantPaymentHoliday mocks base method.
+func (m *MockService) GrantPaymentHoliday(ctx context.Context, cmd service.GrantPaymentHolidayCommand) (service.PaymentHoliday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantPaymentHoliday", ctx, cmd)
+	ret0, _ := ret[0].(service.PaymentHoliday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantPaymentHoliday indicates an expected call of GrantPaymentHoliday.
+func (mr *MockServiceMockRecorder) GrantPaymentHoliday(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantPaymentHoliday", reflect.TypeOf((*MockService)(nil).GrantPaymentHoliday), ctx, cmd)
+}
+
+// ListLoanPayments mocks base method.
+func (m *MockService) ListLoanPayments(ctx context.Context, query service.ListLoanPaymentsQuery) (service.LoanPaymentPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoanPayments", ctx, query)
+	ret0, _ := ret[0].(service.LoanPaymentPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoanPayments indicates an expected call of ListLoanPayments.
+func (mr *MockServiceMockRecorder) ListLoanPayments(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoanPayments", reflect.TypeOf((*MockService)(nil).ListLoanPayments), ctx, query)
+}
+
+// ListLoanTerms mocks base method.
+func (m *MockService) ListLoanTerms(ctx context.Context, query service.ListLoanTermsQuery) ([]service.LoanTerms, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoanTerms", ctx, query)
+	ret0, _ := ret[0].([]service.LoanTerms)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoanTerms indicates an expected call of ListLoanTerms.
+func (mr *MockServiceMockRecorder) ListLoanTerms(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoanTerms", reflect.TypeOf((*MockService)(nil).ListLoanTerms), ctx, query)
+}
+
+// ListPaymentHolidays mocks base method.
+func (m *MockService) ListPaymentHolidays(ctx context.Context, query service.ListPaymentHolidaysQuery) ([]service.PaymentHoliday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentHolidays", ctx, query)
+	ret0, _ := ret[0].([]service.PaymentHoliday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentHolidays indicates an expected call of ListPaymentHolidays.
+func (mr *MockServiceMockRecorder) ListPaymentHolidays(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentHolidays", reflect.TypeOf((*MockService)(nil).ListPaymentHolidays), ctx, query)
+}
+
+// ListProducts mocks base method.
+func (m *MockService) ListProducts(ctx context.Context, query service.ListProductsQuery) ([]service.LoanProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProducts", ctx, query)
+	ret0, _ := ret[0].([]service.LoanProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProducts indicates an expected call of ListProducts.
+func (mr *MockServiceMockRecorder) ListProducts(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProducts", reflect.TypeOf((*MockService)(nil).ListProducts), ctx, query)
+}
+
+// ListUserLoans mocks base method.
+func (m *MockService) ListUserLoans(ctx context.Context, query service.ListUserLoansQuery) (service.LoanSummaryPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserLoans", ctx, query)
+	ret0, _ := ret[0].(service.LoanSummaryPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserLoans indicates an expected call of ListUserLoans.
+func (mr *MockServiceMockRecorder) ListUserLoans(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserLoans", reflect.TypeOf((*MockService)(nil).ListUserLoans), ctx, query)
+}
+
 // MakePayment mocks base method.
 func (m *MockService) MakePayment(ctx context.Context, cmd service.MakePaymentCommand) (service.LoanDetail, error) {
 	m.ctrl.T.Helper()
@@ -79,3 +274,63 @@ func (mr *MockServiceMockRecorder) MakePayment(ctx, cmd interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakePayment", reflect.TypeOf((*MockService)(nil).MakePayment), ctx, cmd)
 }
+
+// RejectLoan mocks base method.
+func (m *MockService) RejectLoan(ctx context.Context, cmd service.RejectLoanCommand) (service.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectLoan", ctx, cmd)
+	ret0, _ := ret[0].(service.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectLoan indicates an expected call of RejectLoan.
+func (mr *MockServiceMockRecorder) RejectLoan(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectLoan", reflect.TypeOf((*MockService)(nil).RejectLoan), ctx, cmd)
+}
+
+// RestructureLoan mocks base method.
+func (m *MockService) RestructureLoan(ctx context.Context, cmd service.RestructureLoanCommand) (service.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestructureLoan", ctx, cmd)
+	ret0, _ := ret[0].(service.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestructureLoan indicates an expected call of RestructureLoan.
+func (mr *MockServiceMockRecorder) RestructureLoan(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestructureLoan", reflect.TypeOf((*MockService)(nil).RestructureLoan), ctx, cmd)
+}
+
+// ReversePayment mocks base method.
+func (m *MockService) ReversePayment(ctx context.Context, cmd service.ReversePaymentCommand) (service.LoanDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReversePayment", ctx, cmd)
+	ret0, _ := ret[0].(service.LoanDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReversePayment indicates an expected call of ReversePayment.
+func (mr *MockServiceMockRecorder) ReversePayment(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReversePayment", reflect.TypeOf((*MockService)(nil).ReversePayment), ctx, cmd)
+}
+
+// WriteOffLoan mocks base method.
+func (m *MockService) WriteOffLoan(ctx context.Context, cmd service.WriteOffLoanCommand) (service.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteOffLoan", ctx, cmd)
+	ret0, _ := ret[0].(service.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteOffLoan indicates an expected call of WriteOffLoan.
+func (mr *MockServiceMockRecorder) WriteOffLoan(ctx, cmd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteOffLoan", reflect.TypeOf((*MockService)(nil).WriteOffLoan), ctx, cmd)
+}
@@ -0,0 +1,5 @@
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS grace_period_days;
+
+ALTER TABLE loan_products
+    DROP COLUMN IF EXISTS grace_period_days;
@@ -0,0 +1,5 @@
+ALTER TABLE loan_products
+    ADD COLUMN IF NOT EXISTS grace_period_days SMALLINT NOT NULL DEFAULT 0;
+
+ALTER TABLE loans
+    ADD COLUMN IF NOT EXISTS grace_period_days SMALLINT NOT NULL DEFAULT 0;
@@ -0,0 +1,7 @@
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS week_start_day,
+    DROP COLUMN IF EXISTS billing_timezone;
+
+ALTER TABLE loan_products
+    DROP COLUMN IF EXISTS week_start_day,
+    DROP COLUMN IF EXISTS billing_timezone;
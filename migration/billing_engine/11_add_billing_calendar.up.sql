@@ -0,0 +1,7 @@
+ALTER TABLE loan_products
+    ADD COLUMN IF NOT EXISTS billing_timezone TEXT,
+    ADD COLUMN IF NOT EXISTS week_start_day SMALLINT;
+
+ALTER TABLE loans
+    ADD COLUMN IF NOT EXISTS billing_timezone TEXT NOT NULL DEFAULT 'UTC',
+    ADD COLUMN IF NOT EXISTS week_start_day SMALLINT NOT NULL DEFAULT 1;
@@ -0,0 +1,11 @@
+ALTER TABLE loans
+    RENAME COLUMN installment_count TO payment_duration_weeks;
+
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS billing_frequency;
+
+ALTER TABLE loan_products
+    RENAME COLUMN allowed_installment_counts TO allowed_duration_weeks;
+
+ALTER TABLE loan_products
+    DROP COLUMN IF EXISTS billing_frequency;
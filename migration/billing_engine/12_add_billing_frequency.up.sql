@@ -0,0 +1,11 @@
+ALTER TABLE loan_products
+    ADD COLUMN IF NOT EXISTS billing_frequency SMALLINT NOT NULL DEFAULT 0;
+
+ALTER TABLE loan_products
+    RENAME COLUMN allowed_duration_weeks TO allowed_installment_counts;
+
+ALTER TABLE loans
+    ADD COLUMN IF NOT EXISTS billing_frequency SMALLINT NOT NULL DEFAULT 0;
+
+ALTER TABLE loans
+    RENAME COLUMN payment_duration_weeks TO installment_count;
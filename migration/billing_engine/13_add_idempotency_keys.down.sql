@@ -0,0 +1,8 @@
+ALTER TABLE loan_payments
+    DROP CONSTRAINT IF EXISTS loan_payments_loan_id_idempotency_key_key,
+    DROP COLUMN IF EXISTS idempotency_key,
+    DROP COLUMN IF EXISTS payment_mode;
+
+ALTER TABLE loans
+    DROP CONSTRAINT IF EXISTS loans_user_id_idempotency_key_key,
+    DROP COLUMN IF EXISTS idempotency_key;
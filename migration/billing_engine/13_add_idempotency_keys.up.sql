@@ -0,0 +1,8 @@
+ALTER TABLE loans
+    ADD COLUMN IF NOT EXISTS idempotency_key TEXT,
+    ADD CONSTRAINT loans_user_id_idempotency_key_key UNIQUE (user_id, idempotency_key);
+
+ALTER TABLE loan_payments
+    ADD COLUMN IF NOT EXISTS payment_mode SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS idempotency_key TEXT,
+    ADD CONSTRAINT loan_payments_loan_id_idempotency_key_key UNIQUE (loan_id, idempotency_key);
@@ -0,0 +1,6 @@
+ALTER TABLE loan_payments
+    DROP CONSTRAINT IF EXISTS loan_payments_channel_external_reference_key,
+    DROP COLUMN IF EXISTS metadata,
+    DROP COLUMN IF EXISTS paid_at,
+    DROP COLUMN IF EXISTS channel,
+    DROP COLUMN IF EXISTS external_reference;
@@ -0,0 +1,11 @@
+ALTER TABLE loan_payments
+    ADD COLUMN IF NOT EXISTS external_reference TEXT,
+    ADD COLUMN IF NOT EXISTS channel SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS paid_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}',
+    ADD CONSTRAINT loan_payments_channel_external_reference_key UNIQUE (channel, external_reference);
+
+UPDATE loan_payments SET paid_at = created_at WHERE paid_at IS NULL;
+
+ALTER TABLE loan_payments
+    ALTER COLUMN paid_at SET NOT NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS loan_payments_loan_id_id_idx;
+
+DROP TABLE IF EXISTS loan_payment_charge_allocations;
+
+DROP TABLE IF EXISTS loan_payment_allocations;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS loan_payment_allocations (
+    payment_id UUID NOT NULL,
+    installment_number INTEGER NOT NULL,
+    amount NUMERIC NOT NULL,
+    PRIMARY KEY (payment_id, installment_number),
+    FOREIGN KEY (payment_id) REFERENCES loan_payments(id)
+);
+
+CREATE TABLE IF NOT EXISTS loan_payment_charge_allocations (
+    payment_id UUID NOT NULL,
+    charge_id UUID NOT NULL,
+    amount NUMERIC NOT NULL,
+    PRIMARY KEY (payment_id, charge_id),
+    FOREIGN KEY (payment_id) REFERENCES loan_payments(id),
+    FOREIGN KEY (charge_id) REFERENCES loan_charges(id)
+);
+
+-- the payment history is paged by the payment ids of a loan
+CREATE INDEX IF NOT EXISTS loan_payments_loan_id_id_idx ON loan_payments (loan_id, id);
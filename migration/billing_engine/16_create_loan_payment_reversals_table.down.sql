@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS loan_payment_reversals;
+
+ALTER TABLE loan_payments
+    DROP COLUMN IF EXISTS reversed_at;
@@ -0,0 +1,13 @@
+ALTER TABLE loan_payments
+    ADD COLUMN IF NOT EXISTS reversed_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS loan_payment_reversals (
+    id UUID PRIMARY KEY,
+    payment_id UUID NOT NULL UNIQUE,
+    loan_id UUID NOT NULL,
+    amount NUMERIC NOT NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (payment_id) REFERENCES loan_payments(id),
+    FOREIGN KEY (loan_id) REFERENCES loans(id)
+);
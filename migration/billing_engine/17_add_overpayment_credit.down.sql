@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS user_credit_entries_user_id_idx;
+
+DROP TABLE IF EXISTS user_credit_entries;
+
+ALTER TABLE loan_payments
+    DROP COLUMN IF EXISTS excess_handling,
+    DROP COLUMN IF EXISTS excess_amount;
+
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS overpayment_policy;
+
+ALTER TABLE loan_products
+    DROP COLUMN IF EXISTS overpayment_policy;
@@ -0,0 +1,24 @@
+ALTER TABLE loan_products
+    ADD COLUMN IF NOT EXISTS overpayment_policy SMALLINT NOT NULL DEFAULT 0;
+
+ALTER TABLE loans
+    ADD COLUMN IF NOT EXISTS overpayment_policy SMALLINT NOT NULL DEFAULT 0;
+
+ALTER TABLE loan_payments
+    ADD COLUMN IF NOT EXISTS excess_amount NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS excess_handling SMALLINT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS user_credit_entries (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    loan_id UUID NOT NULL,
+    payment_id UUID NOT NULL,
+    entry_type SMALLINT NOT NULL,
+    amount NUMERIC NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (loan_id) REFERENCES loans(id),
+    FOREIGN KEY (payment_id) REFERENCES loan_payments(id)
+);
+
+-- the credit balance of a user is the sum of their credit entries
+CREATE INDEX IF NOT EXISTS user_credit_entries_user_id_idx ON user_credit_entries (user_id);
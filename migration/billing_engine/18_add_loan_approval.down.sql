@@ -0,0 +1,3 @@
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS disbursed_at,
+    DROP COLUMN IF EXISTS approved_at;
@@ -0,0 +1,6 @@
+ALTER TABLE loans
+    ADD COLUMN IF NOT EXISTS approved_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS disbursed_at TIMESTAMPTZ;
+
+-- the loans created before the approval flow were disbursed when they were created
+UPDATE loans SET approved_at = created_at, disbursed_at = created_at WHERE disbursed_at IS NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS cooling_off_days;
+
+ALTER TABLE loan_products
+    DROP COLUMN IF EXISTS cooling_off_days;
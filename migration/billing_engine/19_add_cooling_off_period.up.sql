@@ -0,0 +1,5 @@
+ALTER TABLE loan_products
+    ADD COLUMN IF NOT EXISTS cooling_off_days SMALLINT NOT NULL DEFAULT 0;
+
+ALTER TABLE loans
+    ADD COLUMN IF NOT EXISTS cooling_off_days SMALLINT NOT NULL DEFAULT 0;
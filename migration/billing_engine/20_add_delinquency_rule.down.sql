@@ -0,0 +1,7 @@
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS delinquency_threshold,
+    DROP COLUMN IF EXISTS delinquency_partial_payment_rule;
+
+ALTER TABLE loan_products
+    DROP COLUMN IF EXISTS delinquency_threshold,
+    DROP COLUMN IF EXISTS delinquency_partial_payment_rule;
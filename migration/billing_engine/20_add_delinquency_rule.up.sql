@@ -0,0 +1,7 @@
+ALTER TABLE loan_products
+    ADD COLUMN IF NOT EXISTS delinquency_threshold SMALLINT,
+    ADD COLUMN IF NOT EXISTS delinquency_partial_payment_rule SMALLINT;
+
+ALTER TABLE loans
+    ADD COLUMN IF NOT EXISTS delinquency_threshold SMALLINT NOT NULL DEFAULT 2,
+    ADD COLUMN IF NOT EXISTS delinquency_partial_payment_rule SMALLINT NOT NULL DEFAULT 0;
@@ -0,0 +1,11 @@
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS recovered_amount,
+    DROP COLUMN IF EXISTS written_off_charges,
+    DROP COLUMN IF EXISTS written_off_interest,
+    DROP COLUMN IF EXISTS written_off_principal,
+    DROP COLUMN IF EXISTS written_off_at,
+    DROP COLUMN IF EXISTS defaulted_at,
+    DROP COLUMN IF EXISTS default_after_weeks;
+
+ALTER TABLE loan_products
+    DROP COLUMN IF EXISTS default_after_weeks;
@@ -0,0 +1,11 @@
+ALTER TABLE loan_products
+    ADD COLUMN IF NOT EXISTS default_after_weeks SMALLINT NOT NULL DEFAULT 0;
+
+ALTER TABLE loans
+    ADD COLUMN IF NOT EXISTS default_after_weeks SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS defaulted_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS written_off_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS written_off_principal NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS written_off_interest NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS written_off_charges NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS recovered_amount NUMERIC NOT NULL DEFAULT 0;
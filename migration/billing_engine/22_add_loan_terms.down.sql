@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS loan_terms;
+
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS restructured_from_installment,
+    DROP COLUMN IF EXISTS restructured_principal,
+    DROP COLUMN IF EXISTS terms_version;
@@ -0,0 +1,24 @@
+ALTER TABLE loans
+    ADD COLUMN IF NOT EXISTS terms_version INTEGER NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS restructured_principal NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS restructured_from_installment INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS loan_terms (
+    loan_id UUID NOT NULL,
+    version INTEGER NOT NULL,
+    principal NUMERIC NOT NULL,
+    interest_rate NUMERIC NOT NULL,
+    installment_count INTEGER NOT NULL,
+    payment_amount NUMERIC NOT NULL,
+    first_installment_number INTEGER NOT NULL,
+    capitalized_charges NUMERIC NOT NULL DEFAULT 0,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (loan_id, version),
+    FOREIGN KEY (loan_id) REFERENCES loans(id)
+);
+
+INSERT INTO loan_terms (loan_id, version, principal, interest_rate, installment_count, payment_amount, first_installment_number, created_at)
+SELECT id, 1, amount, interest_rate, installment_count, payment_amount, 1, created_at
+FROM loans
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS payment_holidays (
+    id UUID PRIMARY KEY,
+    loan_id UUID NOT NULL,
+    first_installment_number INTEGER NOT NULL,
+    start_period INTEGER NOT NULL,
+    periods INTEGER NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (loan_id) REFERENCES loans(id)
+);
+
+CREATE INDEX IF NOT EXISTS payment_holidays_loan_id_idx ON payment_holidays (loan_id);
@@ -0,0 +1 @@
+ALTER TABLE loan_charges DROP COLUMN IF EXISTS capitalized_amount;
@@ -0,0 +1 @@
+ALTER TABLE loan_charges ADD COLUMN IF NOT EXISTS capitalized_amount NUMERIC NOT NULL DEFAULT 0;
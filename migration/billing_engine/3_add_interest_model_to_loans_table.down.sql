@@ -0,0 +1,3 @@
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS interest_model,
+    DROP COLUMN IF EXISTS interest_rate;
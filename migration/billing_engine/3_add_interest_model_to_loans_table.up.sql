@@ -0,0 +1,3 @@
+ALTER TABLE loans
+    ADD COLUMN IF NOT EXISTS interest_model SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS interest_rate NUMERIC NOT NULL DEFAULT 0.1;
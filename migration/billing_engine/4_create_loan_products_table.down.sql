@@ -0,0 +1,5 @@
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS product_id,
+    DROP COLUMN IF EXISTS origination_fee;
+
+DROP TABLE IF EXISTS loan_products;
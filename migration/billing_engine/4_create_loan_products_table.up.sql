@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS loan_products (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    min_principal NUMERIC NOT NULL,
+    max_principal NUMERIC NOT NULL,
+    allowed_duration_weeks INTEGER[] NOT NULL DEFAULT '{}',
+    interest_model SMALLINT NOT NULL,
+    interest_rate NUMERIC NOT NULL,
+    origination_fee_rate NUMERIC NOT NULL DEFAULT 0,
+    origination_fee_fixed NUMERIC NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- the standard product keeps the terms every loan was created with before products were introduced
+INSERT INTO loan_products (id, name, min_principal, max_principal, interest_model, interest_rate, created_at, updated_at)
+VALUES ('01945a1c-0000-7000-8000-000000000001', 'Standard Weekly Loan', 1, 100000000, 0, 0.1, NOW(), NOW())
+ON CONFLICT (id) DO NOTHING;
+
+ALTER TABLE loans
+    ADD COLUMN IF NOT EXISTS product_id UUID REFERENCES loan_products(id),
+    ADD COLUMN IF NOT EXISTS origination_fee NUMERIC NOT NULL DEFAULT 0;
+
+UPDATE loans SET product_id = '01945a1c-0000-7000-8000-000000000001' WHERE product_id IS NULL;
+
+ALTER TABLE loans ALTER COLUMN product_id SET NOT NULL;
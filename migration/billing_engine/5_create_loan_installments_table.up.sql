@@ -0,0 +1,56 @@
+CREATE TABLE IF NOT EXISTS loan_installments (
+    loan_id UUID NOT NULL,
+    installment_number INTEGER NOT NULL,
+    due_date TIMESTAMPTZ NOT NULL,
+    principal_amount NUMERIC NOT NULL,
+    interest_amount NUMERIC NOT NULL,
+    amount_due NUMERIC NOT NULL,
+    amount_paid NUMERIC NOT NULL DEFAULT 0,
+    status SMALLINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (loan_id, installment_number),
+    FOREIGN KEY (loan_id) REFERENCES loans(id)
+);
+
+-- backfill the schedule of the existing loans the same way they have been billed so far:
+-- the weekly amount is rounded down with the remainder on the last installment, the principal is split evenly,
+-- and the amount already paid is allocated to the oldest installments first
+INSERT INTO loan_installments (
+    loan_id, installment_number, due_date, principal_amount, interest_amount,
+    amount_due, amount_paid, status, created_at, updated_at
+)
+SELECT
+    s.loan_id,
+    s.installment_number,
+    s.due_date,
+    s.principal_amount,
+    s.amount_due - s.principal_amount,
+    s.amount_due,
+    LEAST(s.amount_due, GREATEST(0, s.paid_amount - (s.cumulative_due - s.amount_due))),
+    CASE WHEN s.paid_amount >= s.cumulative_due THEN 1 ELSE 0 END,
+    NOW(),
+    NOW()
+FROM (
+    SELECT
+        i.*,
+        SUM(i.amount_due) OVER (PARTITION BY i.loan_id ORDER BY i.installment_number) AS cumulative_due
+    FROM (
+        SELECT
+            l.id AS loan_id,
+            n AS installment_number,
+            (date_trunc('week', l.created_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC') + make_interval(weeks => n) AS due_date,
+            CASE WHEN n = l.payment_duration_weeks
+                THEN l.payment_amount - FLOOR(l.payment_amount / l.payment_duration_weeks) * (n - 1)
+                ELSE FLOOR(l.payment_amount / l.payment_duration_weeks)
+            END AS amount_due,
+            CASE WHEN n = l.payment_duration_weeks
+                THEN l.amount - FLOOR(l.amount / l.payment_duration_weeks) * (n - 1)
+                ELSE FLOOR(l.amount / l.payment_duration_weeks)
+            END AS principal_amount,
+            COALESCE((SELECT SUM(p.amount) FROM loan_payments p WHERE p.loan_id = l.id), 0) AS paid_amount
+        FROM loans l
+        CROSS JOIN LATERAL generate_series(1, l.payment_duration_weeks) AS n
+    ) i
+) s
+ON CONFLICT (loan_id, installment_number) DO NOTHING;
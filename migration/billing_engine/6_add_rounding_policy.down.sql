@@ -0,0 +1,7 @@
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS rounding_method,
+    DROP COLUMN IF EXISTS rounding_precision;
+
+ALTER TABLE loan_products
+    DROP COLUMN IF EXISTS rounding_method,
+    DROP COLUMN IF EXISTS rounding_precision;
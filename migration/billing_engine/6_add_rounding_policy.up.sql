@@ -0,0 +1,7 @@
+ALTER TABLE loan_products
+    ADD COLUMN IF NOT EXISTS rounding_method SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rounding_precision SMALLINT NOT NULL DEFAULT 0;
+
+ALTER TABLE loans
+    ADD COLUMN IF NOT EXISTS rounding_method SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rounding_precision SMALLINT NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS payment_acceptance_mode;
+
+ALTER TABLE loan_products
+    DROP COLUMN IF EXISTS payment_acceptance_mode;
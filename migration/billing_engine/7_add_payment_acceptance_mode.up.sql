@@ -0,0 +1,5 @@
+ALTER TABLE loan_products
+    ADD COLUMN IF NOT EXISTS payment_acceptance_mode SMALLINT NOT NULL DEFAULT 0;
+
+ALTER TABLE loans
+    ADD COLUMN IF NOT EXISTS payment_acceptance_mode SMALLINT NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS rebate_amount,
+    DROP COLUMN IF EXISTS early_settlement_rebate_rate;
+
+ALTER TABLE loan_products
+    DROP COLUMN IF EXISTS early_settlement_rebate_rate;
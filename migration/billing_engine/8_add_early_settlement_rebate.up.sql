@@ -0,0 +1,6 @@
+ALTER TABLE loan_products
+    ADD COLUMN IF NOT EXISTS early_settlement_rebate_rate NUMERIC NOT NULL DEFAULT 0;
+
+ALTER TABLE loans
+    ADD COLUMN IF NOT EXISTS early_settlement_rebate_rate NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rebate_amount NUMERIC NOT NULL DEFAULT 0;
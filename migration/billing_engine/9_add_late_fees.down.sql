@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS loan_charges;
+
+ALTER TABLE loans
+    DROP COLUMN IF EXISTS late_fee_cap,
+    DROP COLUMN IF EXISTS late_fee_amount,
+    DROP COLUMN IF EXISTS late_fee_method;
+
+ALTER TABLE loan_products
+    DROP COLUMN IF EXISTS late_fee_cap,
+    DROP COLUMN IF EXISTS late_fee_amount,
+    DROP COLUMN IF EXISTS late_fee_method;
@@ -0,0 +1,22 @@
+ALTER TABLE loan_products
+    ADD COLUMN IF NOT EXISTS late_fee_method SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS late_fee_amount NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS late_fee_cap NUMERIC NOT NULL DEFAULT 0;
+
+ALTER TABLE loans
+    ADD COLUMN IF NOT EXISTS late_fee_method SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS late_fee_amount NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS late_fee_cap NUMERIC NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS loan_charges (
+    id UUID PRIMARY KEY,
+    loan_id UUID NOT NULL,
+    charge_type SMALLINT NOT NULL,
+    installment_number INTEGER NOT NULL,
+    amount NUMERIC NOT NULL,
+    amount_paid NUMERIC NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (loan_id, charge_type, installment_number),
+    FOREIGN KEY (loan_id) REFERENCES loans(id)
+);
@@ -29,6 +29,20 @@ const (
 	LoanStatus_ONGOING LoanStatus = 0
 	// PAID indicates that the loan has been fully repaid.
 	LoanStatus_PAID LoanStatus = 1
+	// PENDING_APPROVAL indicates that the loan has been requested and is waiting to be approved.
+	LoanStatus_PENDING_APPROVAL LoanStatus = 2
+	// APPROVED indicates that the loan has been approved and is waiting to be disbursed.
+	LoanStatus_APPROVED LoanStatus = 3
+	// DISBURSED indicates that the loan amount has been disbursed and no payment has been made yet.
+	LoanStatus_DISBURSED LoanStatus = 4
+	// REJECTED indicates that the loan has been rejected before being disbursed.
+	LoanStatus_REJECTED LoanStatus = 5
+	// CANCELLED indicates that the loan has been cancelled by its user within the cooling-off period.
+	LoanStatus_CANCELLED LoanStatus = 6
+	// DEFAULTED indicates that the loan has been past due for longer than its default period.
+	LoanStatus_DEFAULTED LoanStatus = 7
+	// WRITTEN_OFF indicates that the outstanding amount of the defaulted loan has been written off as a loss.
+	LoanStatus_WRITTEN_OFF LoanStatus = 8
 )
 
 // Enum value maps for LoanStatus.
@@ -36,10 +50,24 @@ var (
 	LoanStatus_name = map[int32]string{
 		0: "ONGOING",
 		1: "PAID",
+		2: "PENDING_APPROVAL",
+		3: "APPROVED",
+		4: "DISBURSED",
+		5: "REJECTED",
+		6: "CANCELLED",
+		7: "DEFAULTED",
+		8: "WRITTEN_OFF",
 	}
 	LoanStatus_value = map[string]int32{
-		"ONGOING": 0,
-		"PAID":    1,
+		"ONGOING":          0,
+		"PAID":             1,
+		"PENDING_APPROVAL": 2,
+		"APPROVED":         3,
+		"DISBURSED":        4,
+		"REJECTED":         5,
+		"CANCELLED":        6,
+		"DEFAULTED":        7,
+		"WRITTEN_OFF":      8,
 	}
 )
 
@@ -70,342 +98,767 @@ func (LoanStatus) EnumDescriptor() ([]byte, []int) {
 	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{0}
 }
 
-// Loan represents the details of a loan.
-type Loan struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// InterestModel represents the method used to calculate the interest of a loan.
+type InterestModel int32
 
-	// id is the unique identifier for the loan.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// user_id is the identifier of the user who took the loan.
-	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// amount is the total amount of the loan.
-	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	// payment_duration_weeks is the duration for the loan should be paid in weeks.
-	PaymentDurationWeeks int32 `protobuf:"varint,4,opt,name=payment_duration_weeks,json=paymentDurationWeeks,proto3" json:"payment_duration_weeks,omitempty"`
-	// payment_amount is the amount to be paid for the loan.
-	PaymentAmount string `protobuf:"bytes,5,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount,omitempty"`
-	// status represents the current status of the loan.
-	Status LoanStatus `protobuf:"varint,6,opt,name=status,proto3,enum=loan_service.v1.LoanStatus" json:"status,omitempty"`
-	// created_at is the timestamp when the loan was created.
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// updated_at is the timestamp when the loan was last updated.
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-}
+const (
+	// FLAT charges the interest rate once on the principal amount, regardless of the loan duration.
+	InterestModel_FLAT InterestModel = 0
+	// DECLINING_BALANCE charges the interest rate on the outstanding principal every installment period.
+	InterestModel_DECLINING_BALANCE InterestModel = 1
+	// ZERO_INTEREST charges no interest at all, e.g. for promotional loans.
+	InterestModel_ZERO_INTEREST InterestModel = 2
+)
 
-func (x *Loan) Reset() {
-	*x = Loan{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_billing_engine_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for InterestModel.
+var (
+	InterestModel_name = map[int32]string{
+		0: "FLAT",
+		1: "DECLINING_BALANCE",
+		2: "ZERO_INTEREST",
 	}
-}
+	InterestModel_value = map[string]int32{
+		"FLAT":              0,
+		"DECLINING_BALANCE": 1,
+		"ZERO_INTEREST":     2,
+	}
+)
 
-func (x *Loan) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x InterestModel) Enum() *InterestModel {
+	p := new(InterestModel)
+	*p = x
+	return p
 }
 
-func (*Loan) ProtoMessage() {}
+func (x InterestModel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *Loan) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_billing_engine_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (InterestModel) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_billing_engine_proto_enumTypes[1].Descriptor()
 }
 
-// Deprecated: Use Loan.ProtoReflect.Descriptor instead.
-func (*Loan) Descriptor() ([]byte, []int) {
-	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{0}
+func (InterestModel) Type() protoreflect.EnumType {
+	return &file_proto_v1_billing_engine_proto_enumTypes[1]
 }
 
-func (x *Loan) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+func (x InterestModel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Loan) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use InterestModel.Descriptor instead.
+func (InterestModel) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Loan) GetAmount() string {
-	if x != nil {
-		return x.Amount
+// RoundingMethod represents how an amount is split into installments that cannot be divided evenly.
+type RoundingMethod int32
+
+const (
+	// REMAINDER_ON_LAST rounds every installment down and adds the remainder to the last installment.
+	RoundingMethod_REMAINDER_ON_LAST RoundingMethod = 0
+	// REMAINDER_ON_FIRST rounds every installment down and adds the remainder to the first installment.
+	RoundingMethod_REMAINDER_ON_FIRST RoundingMethod = 1
+	// BANKERS rounds every installment half to even and settles the difference on the last installment.
+	RoundingMethod_BANKERS RoundingMethod = 2
+)
+
+// Enum value maps for RoundingMethod.
+var (
+	RoundingMethod_name = map[int32]string{
+		0: "REMAINDER_ON_LAST",
+		1: "REMAINDER_ON_FIRST",
+		2: "BANKERS",
 	}
-	return ""
+	RoundingMethod_value = map[string]int32{
+		"REMAINDER_ON_LAST":  0,
+		"REMAINDER_ON_FIRST": 1,
+		"BANKERS":            2,
+	}
+)
+
+func (x RoundingMethod) Enum() *RoundingMethod {
+	p := new(RoundingMethod)
+	*p = x
+	return p
 }
 
-func (x *Loan) GetPaymentDurationWeeks() int32 {
-	if x != nil {
-		return x.PaymentDurationWeeks
-	}
-	return 0
+func (x RoundingMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Loan) GetPaymentAmount() string {
-	if x != nil {
-		return x.PaymentAmount
-	}
-	return ""
+func (RoundingMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_billing_engine_proto_enumTypes[2].Descriptor()
 }
 
-func (x *Loan) GetStatus() LoanStatus {
-	if x != nil {
-		return x.Status
-	}
-	return LoanStatus_ONGOING
+func (RoundingMethod) Type() protoreflect.EnumType {
+	return &file_proto_v1_billing_engine_proto_enumTypes[2]
 }
 
-func (x *Loan) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (x RoundingMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Loan) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+// Deprecated: Use RoundingMethod.Descriptor instead.
+func (RoundingMethod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{2}
 }
 
-// LoanDetail represents detailed information about a loan, including its current status and payment details.
-type LoanDetail struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// PaymentAcceptanceMode represents which payment amounts are accepted towards a loan's current bill.
+type PaymentAcceptanceMode int32
 
-	// loan is the basic loan information.
-	Loan *Loan `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
-	// outstanding_amount is the remaining amount to be paid on the loan.
-	OutstandingAmount string `protobuf:"bytes,2,opt,name=outstanding_amount,json=outstandingAmount,proto3" json:"outstanding_amount,omitempty"`
-	// current_bill_amount is the amount due for the current billing cycle.
-	CurrentBillAmount string `protobuf:"bytes,3,opt,name=current_bill_amount,json=currentBillAmount,proto3" json:"current_bill_amount,omitempty"`
-	// is_delinquent indicates whether the loan is delinquent or not.
-	IsDelinquent bool `protobuf:"varint,4,opt,name=is_delinquent,json=isDelinquent,proto3" json:"is_delinquent,omitempty"`
-}
+const (
+	// EXACT only accepts payments matching the current bill amount exactly.
+	PaymentAcceptanceMode_EXACT PaymentAcceptanceMode = 0
+	// PARTIAL also accepts payments lower than the current bill amount,
+	// which are allocated to the oldest outstanding installment first.
+	PaymentAcceptanceMode_PARTIAL PaymentAcceptanceMode = 1
+)
 
-func (x *LoanDetail) Reset() {
-	*x = LoanDetail{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_billing_engine_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for PaymentAcceptanceMode.
+var (
+	PaymentAcceptanceMode_name = map[int32]string{
+		0: "EXACT",
+		1: "PARTIAL",
 	}
-}
+	PaymentAcceptanceMode_value = map[string]int32{
+		"EXACT":   0,
+		"PARTIAL": 1,
+	}
+)
 
-func (x *LoanDetail) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x PaymentAcceptanceMode) Enum() *PaymentAcceptanceMode {
+	p := new(PaymentAcceptanceMode)
+	*p = x
+	return p
 }
 
-func (*LoanDetail) ProtoMessage() {}
+func (x PaymentAcceptanceMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *LoanDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_billing_engine_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (PaymentAcceptanceMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_billing_engine_proto_enumTypes[3].Descriptor()
 }
 
-// Deprecated: Use LoanDetail.ProtoReflect.Descriptor instead.
-func (*LoanDetail) Descriptor() ([]byte, []int) {
-	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{1}
+func (PaymentAcceptanceMode) Type() protoreflect.EnumType {
+	return &file_proto_v1_billing_engine_proto_enumTypes[3]
 }
 
-func (x *LoanDetail) GetLoan() *Loan {
-	if x != nil {
-		return x.Loan
-	}
-	return nil
+func (x PaymentAcceptanceMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LoanDetail) GetOutstandingAmount() string {
-	if x != nil {
-		return x.OutstandingAmount
-	}
-	return ""
+// Deprecated: Use PaymentAcceptanceMode.Descriptor instead.
+func (PaymentAcceptanceMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *LoanDetail) GetCurrentBillAmount() string {
-	if x != nil {
-		return x.CurrentBillAmount
+// OverpaymentPolicy represents how a payment exceeding the amount accepted towards a loan is handled.
+type OverpaymentPolicy int32
+
+const (
+	// OVERPAYMENT_REJECT rejects the payments exceeding the amount accepted towards the loan.
+	OverpaymentPolicy_OVERPAYMENT_REJECT OverpaymentPolicy = 0
+	// OVERPAYMENT_CREDIT accepts the payment and holds the excess as a credit balance of the user,
+	// which is applied to the next installments due on the loan or on the user's next loan.
+	OverpaymentPolicy_OVERPAYMENT_CREDIT OverpaymentPolicy = 1
+	// OVERPAYMENT_REFUND accepts the payment and marks the excess to be refunded to the payer.
+	OverpaymentPolicy_OVERPAYMENT_REFUND OverpaymentPolicy = 2
+)
+
+// Enum value maps for OverpaymentPolicy.
+var (
+	OverpaymentPolicy_name = map[int32]string{
+		0: "OVERPAYMENT_REJECT",
+		1: "OVERPAYMENT_CREDIT",
+		2: "OVERPAYMENT_REFUND",
 	}
-	return ""
+	OverpaymentPolicy_value = map[string]int32{
+		"OVERPAYMENT_REJECT": 0,
+		"OVERPAYMENT_CREDIT": 1,
+		"OVERPAYMENT_REFUND": 2,
+	}
+)
+
+func (x OverpaymentPolicy) Enum() *OverpaymentPolicy {
+	p := new(OverpaymentPolicy)
+	*p = x
+	return p
 }
 
-func (x *LoanDetail) GetIsDelinquent() bool {
-	if x != nil {
-		return x.IsDelinquent
-	}
-	return false
+func (x OverpaymentPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// CreateLoanRequest represents the request structure for creating a new loan.
-type CreateLoanRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (OverpaymentPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_billing_engine_proto_enumTypes[4].Descriptor()
+}
 
-	// user_id is the unique identifier of the user requesting the loan.
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// amount is the total loan amount requested by the user.
-	// It should be a string representation of a decimal number.
-	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	// payment_duration_weeks specifies the loan repayment period in weeks.
-	// It determines how long the user has to repay the loan.
-	PaymentDurationWeeks int32 `protobuf:"varint,3,opt,name=payment_duration_weeks,json=paymentDurationWeeks,proto3" json:"payment_duration_weeks,omitempty"`
+func (OverpaymentPolicy) Type() protoreflect.EnumType {
+	return &file_proto_v1_billing_engine_proto_enumTypes[4]
 }
 
-func (x *CreateLoanRequest) Reset() {
-	*x = CreateLoanRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_billing_engine_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x OverpaymentPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreateLoanRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use OverpaymentPolicy.Descriptor instead.
+func (OverpaymentPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{4}
 }
 
-func (*CreateLoanRequest) ProtoMessage() {}
+// BillingFrequency represents how often the installments of a loan are due.
+type BillingFrequency int32
 
-func (x *CreateLoanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_billing_engine_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	// FREQUENCY_WEEKLY bills an installment every week, at the start of the billing week.
+	BillingFrequency_FREQUENCY_WEEKLY BillingFrequency = 0
+	// FREQUENCY_DAILY bills an installment every day, at midnight.
+	BillingFrequency_FREQUENCY_DAILY BillingFrequency = 1
+	// FREQUENCY_BI_WEEKLY bills an installment every two weeks, at the start of the billing week.
+	BillingFrequency_FREQUENCY_BI_WEEKLY BillingFrequency = 2
+	// FREQUENCY_MONTHLY bills an installment every calendar month, on the day of the month the loan was
+	// created on, or on the last day of the month for the months that are shorter.
+	BillingFrequency_FREQUENCY_MONTHLY BillingFrequency = 3
+)
+
+// Enum value maps for BillingFrequency.
+var (
+	BillingFrequency_name = map[int32]string{
+		0: "FREQUENCY_WEEKLY",
+		1: "FREQUENCY_DAILY",
+		2: "FREQUENCY_BI_WEEKLY",
+		3: "FREQUENCY_MONTHLY",
 	}
-	return mi.MessageOf(x)
+	BillingFrequency_value = map[string]int32{
+		"FREQUENCY_WEEKLY":    0,
+		"FREQUENCY_DAILY":     1,
+		"FREQUENCY_BI_WEEKLY": 2,
+		"FREQUENCY_MONTHLY":   3,
+	}
+)
+
+func (x BillingFrequency) Enum() *BillingFrequency {
+	p := new(BillingFrequency)
+	*p = x
+	return p
 }
 
-// Deprecated: Use CreateLoanRequest.ProtoReflect.Descriptor instead.
-func (*CreateLoanRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{2}
+func (x BillingFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *CreateLoanRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+func (BillingFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_billing_engine_proto_enumTypes[5].Descriptor()
 }
 
-func (x *CreateLoanRequest) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+func (BillingFrequency) Type() protoreflect.EnumType {
+	return &file_proto_v1_billing_engine_proto_enumTypes[5]
 }
 
-func (x *CreateLoanRequest) GetPaymentDurationWeeks() int32 {
-	if x != nil {
-		return x.PaymentDurationWeeks
-	}
-	return 0
+func (x BillingFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// GetCurrentLoanRequest represents the request structure for retrieving the current loan of a user.
-type GetCurrentLoanRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Deprecated: Use BillingFrequency.Descriptor instead.
+func (BillingFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{5}
+}
 
-	// user_id is the unique identifier of the user whose current loan is being requested.
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+// Weekday represents a day of the week.
+type Weekday int32
+
+const (
+	Weekday_SUNDAY    Weekday = 0
+	Weekday_MONDAY    Weekday = 1
+	Weekday_TUESDAY   Weekday = 2
+	Weekday_WEDNESDAY Weekday = 3
+	Weekday_THURSDAY  Weekday = 4
+	Weekday_FRIDAY    Weekday = 5
+	Weekday_SATURDAY  Weekday = 6
+)
+
+// Enum value maps for Weekday.
+var (
+	Weekday_name = map[int32]string{
+		0: "SUNDAY",
+		1: "MONDAY",
+		2: "TUESDAY",
+		3: "WEDNESDAY",
+		4: "THURSDAY",
+		5: "FRIDAY",
+		6: "SATURDAY",
+	}
+	Weekday_value = map[string]int32{
+		"SUNDAY":    0,
+		"MONDAY":    1,
+		"TUESDAY":   2,
+		"WEDNESDAY": 3,
+		"THURSDAY":  4,
+		"FRIDAY":    5,
+		"SATURDAY":  6,
+	}
+)
+
+func (x Weekday) Enum() *Weekday {
+	p := new(Weekday)
+	*p = x
+	return p
 }
 
-func (x *GetCurrentLoanRequest) Reset() {
-	*x = GetCurrentLoanRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_billing_engine_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x Weekday) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Weekday) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_billing_engine_proto_enumTypes[6].Descriptor()
+}
+
+func (Weekday) Type() protoreflect.EnumType {
+	return &file_proto_v1_billing_engine_proto_enumTypes[6]
+}
+
+func (x Weekday) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Weekday.Descriptor instead.
+func (Weekday) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{6}
+}
+
+// PartialPaymentRule represents how an overdue installment that is partially paid is counted for delinquency.
+type PartialPaymentRule int32
+
+const (
+	// PARTIAL_PAYMENT_COUNTS_AS_MISSED counts a partially paid installment as a missed installment.
+	PartialPaymentRule_PARTIAL_PAYMENT_COUNTS_AS_MISSED PartialPaymentRule = 0
+	// PARTIAL_PAYMENT_COUNTS_AS_PAID does not count a partially paid installment as a missed installment.
+	PartialPaymentRule_PARTIAL_PAYMENT_COUNTS_AS_PAID PartialPaymentRule = 1
+)
+
+// Enum value maps for PartialPaymentRule.
+var (
+	PartialPaymentRule_name = map[int32]string{
+		0: "PARTIAL_PAYMENT_COUNTS_AS_MISSED",
+		1: "PARTIAL_PAYMENT_COUNTS_AS_PAID",
+	}
+	PartialPaymentRule_value = map[string]int32{
+		"PARTIAL_PAYMENT_COUNTS_AS_MISSED": 0,
+		"PARTIAL_PAYMENT_COUNTS_AS_PAID":   1,
 	}
+)
+
+func (x PartialPaymentRule) Enum() *PartialPaymentRule {
+	p := new(PartialPaymentRule)
+	*p = x
+	return p
 }
 
-func (x *GetCurrentLoanRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x PartialPaymentRule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetCurrentLoanRequest) ProtoMessage() {}
+func (PartialPaymentRule) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_billing_engine_proto_enumTypes[7].Descriptor()
+}
 
-func (x *GetCurrentLoanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_billing_engine_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (PartialPaymentRule) Type() protoreflect.EnumType {
+	return &file_proto_v1_billing_engine_proto_enumTypes[7]
+}
+
+func (x PartialPaymentRule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartialPaymentRule.Descriptor instead.
+func (PartialPaymentRule) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{7}
+}
+
+// LoanInstallmentStatus represents the current status of a loan installment.
+type LoanInstallmentStatus int32
+
+const (
+	// INSTALLMENT_UNPAID indicates that the installment has not been fully paid.
+	LoanInstallmentStatus_INSTALLMENT_UNPAID LoanInstallmentStatus = 0
+	// INSTALLMENT_PAID indicates that the installment has been fully paid.
+	LoanInstallmentStatus_INSTALLMENT_PAID LoanInstallmentStatus = 1
+	// INSTALLMENT_PARTIALLY_PAID indicates that only part of the installment has been paid.
+	LoanInstallmentStatus_INSTALLMENT_PARTIALLY_PAID LoanInstallmentStatus = 2
+	// INSTALLMENT_RESTRUCTURED indicates that the amount the installment still owed was moved to a new schedule
+	// when the loan was restructured.
+	LoanInstallmentStatus_INSTALLMENT_RESTRUCTURED LoanInstallmentStatus = 3
+)
+
+// Enum value maps for LoanInstallmentStatus.
+var (
+	LoanInstallmentStatus_name = map[int32]string{
+		0: "INSTALLMENT_UNPAID",
+		1: "INSTALLMENT_PAID",
+		2: "INSTALLMENT_PARTIALLY_PAID",
+		3: "INSTALLMENT_RESTRUCTURED",
 	}
-	return mi.MessageOf(x)
+	LoanInstallmentStatus_value = map[string]int32{
+		"INSTALLMENT_UNPAID":         0,
+		"INSTALLMENT_PAID":           1,
+		"INSTALLMENT_PARTIALLY_PAID": 2,
+		"INSTALLMENT_RESTRUCTURED":   3,
+	}
+)
+
+func (x LoanInstallmentStatus) Enum() *LoanInstallmentStatus {
+	p := new(LoanInstallmentStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use GetCurrentLoanRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrentLoanRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{3}
+func (x LoanInstallmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *GetCurrentLoanRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
+func (LoanInstallmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_billing_engine_proto_enumTypes[8].Descriptor()
+}
+
+func (LoanInstallmentStatus) Type() protoreflect.EnumType {
+	return &file_proto_v1_billing_engine_proto_enumTypes[8]
+}
+
+func (x LoanInstallmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoanInstallmentStatus.Descriptor instead.
+func (LoanInstallmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{8}
+}
+
+// LateFeeMethod represents how the late fee of a missed installment is calculated.
+type LateFeeMethod int32
+
+const (
+	// LATE_FEE_NONE charges no late fee at all.
+	LateFeeMethod_LATE_FEE_NONE LateFeeMethod = 0
+	// LATE_FEE_FIXED_PER_MISSED_INSTALLMENT charges a fixed fee for every missed installment.
+	LateFeeMethod_LATE_FEE_FIXED_PER_MISSED_INSTALLMENT LateFeeMethod = 1
+	// LATE_FEE_PERCENTAGE_OF_OVERDUE charges a rate of the overdue amount of every missed installment.
+	LateFeeMethod_LATE_FEE_PERCENTAGE_OF_OVERDUE LateFeeMethod = 2
+)
+
+// Enum value maps for LateFeeMethod.
+var (
+	LateFeeMethod_name = map[int32]string{
+		0: "LATE_FEE_NONE",
+		1: "LATE_FEE_FIXED_PER_MISSED_INSTALLMENT",
+		2: "LATE_FEE_PERCENTAGE_OF_OVERDUE",
 	}
-	return ""
+	LateFeeMethod_value = map[string]int32{
+		"LATE_FEE_NONE":                         0,
+		"LATE_FEE_FIXED_PER_MISSED_INSTALLMENT": 1,
+		"LATE_FEE_PERCENTAGE_OF_OVERDUE":        2,
+	}
+)
+
+func (x LateFeeMethod) Enum() *LateFeeMethod {
+	p := new(LateFeeMethod)
+	*p = x
+	return p
 }
 
-// MakePaymentRequest represents the request structure for making a payment on a loan.
-type MakePaymentRequest struct {
+func (x LateFeeMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LateFeeMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_billing_engine_proto_enumTypes[9].Descriptor()
+}
+
+func (LateFeeMethod) Type() protoreflect.EnumType {
+	return &file_proto_v1_billing_engine_proto_enumTypes[9]
+}
+
+func (x LateFeeMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LateFeeMethod.Descriptor instead.
+func (LateFeeMethod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{9}
+}
+
+// LoanChargeType represents the kind of a charge applied to a loan.
+type LoanChargeType int32
+
+const (
+	// CHARGE_LATE_FEE is a fee charged for a missed installment.
+	LoanChargeType_CHARGE_LATE_FEE LoanChargeType = 0
+)
+
+// Enum value maps for LoanChargeType.
+var (
+	LoanChargeType_name = map[int32]string{
+		0: "CHARGE_LATE_FEE",
+	}
+	LoanChargeType_value = map[string]int32{
+		"CHARGE_LATE_FEE": 0,
+	}
+)
+
+func (x LoanChargeType) Enum() *LoanChargeType {
+	p := new(LoanChargeType)
+	*p = x
+	return p
+}
+
+func (x LoanChargeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoanChargeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_billing_engine_proto_enumTypes[10].Descriptor()
+}
+
+func (LoanChargeType) Type() protoreflect.EnumType {
+	return &file_proto_v1_billing_engine_proto_enumTypes[10]
+}
+
+func (x LoanChargeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoanChargeType.Descriptor instead.
+func (LoanChargeType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{10}
+}
+
+// PaymentChannel represents the channel a payment was received through.
+type PaymentChannel int32
+
+const (
+	// CHANNEL_UNSPECIFIED is used for the payments whose channel is not known.
+	PaymentChannel_CHANNEL_UNSPECIFIED PaymentChannel = 0
+	// CHANNEL_BANK_TRANSFER is a payment received by a bank transfer.
+	PaymentChannel_CHANNEL_BANK_TRANSFER PaymentChannel = 1
+	// CHANNEL_VIRTUAL_ACCOUNT is a payment received through a virtual account.
+	PaymentChannel_CHANNEL_VIRTUAL_ACCOUNT PaymentChannel = 2
+	// CHANNEL_CARD is a payment received by a debit or credit card.
+	PaymentChannel_CHANNEL_CARD PaymentChannel = 3
+	// CHANNEL_CASH_AGENT is a payment received in cash by an agent.
+	PaymentChannel_CHANNEL_CASH_AGENT PaymentChannel = 4
+)
+
+// Enum value maps for PaymentChannel.
+var (
+	PaymentChannel_name = map[int32]string{
+		0: "CHANNEL_UNSPECIFIED",
+		1: "CHANNEL_BANK_TRANSFER",
+		2: "CHANNEL_VIRTUAL_ACCOUNT",
+		3: "CHANNEL_CARD",
+		4: "CHANNEL_CASH_AGENT",
+	}
+	PaymentChannel_value = map[string]int32{
+		"CHANNEL_UNSPECIFIED":     0,
+		"CHANNEL_BANK_TRANSFER":   1,
+		"CHANNEL_VIRTUAL_ACCOUNT": 2,
+		"CHANNEL_CARD":            3,
+		"CHANNEL_CASH_AGENT":      4,
+	}
+)
+
+func (x PaymentChannel) Enum() *PaymentChannel {
+	p := new(PaymentChannel)
+	*p = x
+	return p
+}
+
+func (x PaymentChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_billing_engine_proto_enumTypes[11].Descriptor()
+}
+
+func (PaymentChannel) Type() protoreflect.EnumType {
+	return &file_proto_v1_billing_engine_proto_enumTypes[11]
+}
+
+func (x PaymentChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentChannel.Descriptor instead.
+func (PaymentChannel) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{11}
+}
+
+// PaymentMode represents the purpose of a payment made towards a loan.
+type PaymentMode int32
+
+const (
+	// REGULAR pays the current bill of the loan.
+	PaymentMode_REGULAR PaymentMode = 0
+	// PREPAYMENT pays the loan ahead of the installments' due dates, up to the outstanding amount.
+	PaymentMode_PREPAYMENT PaymentMode = 1
+	// PAYOFF settles the loan early by paying the quoted payoff amount, closing the loan.
+	PaymentMode_PAYOFF PaymentMode = 2
+	// CREDIT pays the current bill of the loan from the credit balance of its user.
+	// It is only made by the billing engine and cannot be requested.
+	PaymentMode_CREDIT PaymentMode = 3
+	// RECOVERY recovers part of the written-off amount of a written-off loan, the only payment it accepts.
+	PaymentMode_RECOVERY PaymentMode = 4
+)
+
+// Enum value maps for PaymentMode.
+var (
+	PaymentMode_name = map[int32]string{
+		0: "REGULAR",
+		1: "PREPAYMENT",
+		2: "PAYOFF",
+		3: "CREDIT",
+		4: "RECOVERY",
+	}
+	PaymentMode_value = map[string]int32{
+		"REGULAR":    0,
+		"PREPAYMENT": 1,
+		"PAYOFF":     2,
+		"CREDIT":     3,
+		"RECOVERY":   4,
+	}
+)
+
+func (x PaymentMode) Enum() *PaymentMode {
+	p := new(PaymentMode)
+	*p = x
+	return p
+}
+
+func (x PaymentMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_billing_engine_proto_enumTypes[12].Descriptor()
+}
+
+func (PaymentMode) Type() protoreflect.EnumType {
+	return &file_proto_v1_billing_engine_proto_enumTypes[12]
+}
+
+func (x PaymentMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentMode.Descriptor instead.
+func (PaymentMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{12}
+}
+
+// Loan represents the details of a loan.
+type Loan struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// loan_id is the unique identifier of the loan on which the payment is being made.
-	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
-	// payment_amount is the amount being paid towards the loan.
-	// It should be a string representation of a decimal number.
-	PaymentAmount string `protobuf:"bytes,2,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount,omitempty"`
+	// id is the unique identifier for the loan.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// user_id is the identifier of the user who took the loan.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// amount is the total amount of the loan.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// payment_duration_weeks is the duration for the loan should be paid in weeks.
+	// It is kept for backward compatibility and is 0 when the loan is not billed weekly or bi-weekly.
+	PaymentDurationWeeks int32 `protobuf:"varint,4,opt,name=payment_duration_weeks,json=paymentDurationWeeks,proto3" json:"payment_duration_weeks,omitempty"`
+	// payment_amount is the amount to be paid for the loan.
+	PaymentAmount string `protobuf:"bytes,5,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount,omitempty"`
+	// status represents the current status of the loan.
+	Status LoanStatus `protobuf:"varint,6,opt,name=status,proto3,enum=loan_service.v1.LoanStatus" json:"status,omitempty"`
+	// created_at is the timestamp when the loan was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is the timestamp when the loan was last updated.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// interest_model is the method used to calculate the interest of the loan.
+	InterestModel InterestModel `protobuf:"varint,9,opt,name=interest_model,json=interestModel,proto3,enum=loan_service.v1.InterestModel" json:"interest_model,omitempty"`
+	// interest_rate is the interest rate of the loan, interpreted according to the interest model.
+	InterestRate string `protobuf:"bytes,10,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	// product_id is the identifier of the loan product the loan was created under.
+	ProductId string `protobuf:"bytes,11,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// origination_fee is the fee charged for the loan creation.
+	OriginationFee string `protobuf:"bytes,12,opt,name=origination_fee,json=originationFee,proto3" json:"origination_fee,omitempty"`
+	// rounding_policy is how the payment amount is split into installment amounts.
+	RoundingPolicy *RoundingPolicy `protobuf:"bytes,13,opt,name=rounding_policy,json=roundingPolicy,proto3" json:"rounding_policy,omitempty"`
+	// payment_acceptance_mode is which payment amounts are accepted towards the current bill.
+	PaymentAcceptanceMode PaymentAcceptanceMode `protobuf:"varint,14,opt,name=payment_acceptance_mode,json=paymentAcceptanceMode,proto3,enum=loan_service.v1.PaymentAcceptanceMode" json:"payment_acceptance_mode,omitempty"`
+	// early_settlement_rebate_rate is the portion of the unearned interest rebated when the loan is paid off early.
+	EarlySettlementRebateRate string `protobuf:"bytes,15,opt,name=early_settlement_rebate_rate,json=earlySettlementRebateRate,proto3" json:"early_settlement_rebate_rate,omitempty"`
+	// rebate_amount is the interest rebated when the loan was paid off early.
+	RebateAmount string `protobuf:"bytes,16,opt,name=rebate_amount,json=rebateAmount,proto3" json:"rebate_amount,omitempty"`
+	// late_fee_policy is the late fees charged for the missed installments of the loan.
+	LateFeePolicy *LateFeePolicy `protobuf:"bytes,17,opt,name=late_fee_policy,json=lateFeePolicy,proto3" json:"late_fee_policy,omitempty"`
+	// grace_period_days is the number of days after an installment's due date before an unpaid installment
+	// counts toward delinquency and late fees.
+	GracePeriodDays int32 `protobuf:"varint,18,opt,name=grace_period_days,json=gracePeriodDays,proto3" json:"grace_period_days,omitempty"`
+	// billing_calendar is the timezone and week start day the billing weeks of the loan are calculated in.
+	BillingCalendar *BillingCalendar `protobuf:"bytes,19,opt,name=billing_calendar,json=billingCalendar,proto3" json:"billing_calendar,omitempty"`
+	// billing_frequency is how often the installments of the loan are due.
+	BillingFrequency BillingFrequency `protobuf:"varint,20,opt,name=billing_frequency,json=billingFrequency,proto3,enum=loan_service.v1.BillingFrequency" json:"billing_frequency,omitempty"`
+	// installment_count is the number of installments the loan is repaid in.
+	InstallmentCount int32 `protobuf:"varint,21,opt,name=installment_count,json=installmentCount,proto3" json:"installment_count,omitempty"`
+	// overpayment_policy is how a payment exceeding the amount accepted towards the loan is handled.
+	OverpaymentPolicy OverpaymentPolicy `protobuf:"varint,22,opt,name=overpayment_policy,json=overpaymentPolicy,proto3,enum=loan_service.v1.OverpaymentPolicy" json:"overpayment_policy,omitempty"`
+	// approved_at is the timestamp when the loan was approved, not set if the loan is not approved yet.
+	ApprovedAt *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	// disbursed_at is the timestamp when the loan was disbursed, from which its billing periods are counted.
+	// It is not set if the loan is not disbursed yet.
+	DisbursedAt *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=disbursed_at,json=disbursedAt,proto3" json:"disbursed_at,omitempty"`
+	// cooling_off_days is the number of days after the loan creation during which its user can cancel the loan.
+	// The loan cannot be cancelled when it is 0.
+	CoolingOffDays int32 `protobuf:"varint,25,opt,name=cooling_off_days,json=coolingOffDays,proto3" json:"cooling_off_days,omitempty"`
+	// delinquency_rule is the rule the loan is considered delinquent by, recorded when the loan was created.
+	DelinquencyRule *DelinquencyRule `protobuf:"bytes,26,opt,name=delinquency_rule,json=delinquencyRule,proto3" json:"delinquency_rule,omitempty"`
+	// default_after_weeks is the number of weeks past due after which the loan is defaulted.
+	// The loan is never defaulted when it is 0.
+	DefaultAfterWeeks int32 `protobuf:"varint,27,opt,name=default_after_weeks,json=defaultAfterWeeks,proto3" json:"default_after_weeks,omitempty"`
+	// defaulted_at is the timestamp when the loan was defaulted, not set if the loan has not been defaulted.
+	DefaultedAt *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=defaulted_at,json=defaultedAt,proto3" json:"defaulted_at,omitempty"`
+	// written_off_at is the timestamp when the loan was written off, not set if the loan has not been written off.
+	WrittenOffAt *timestamppb.Timestamp `protobuf:"bytes,29,opt,name=written_off_at,json=writtenOffAt,proto3" json:"written_off_at,omitempty"`
+	// written_off_principal is the principal amount outstanding when the loan was written off.
+	WrittenOffPrincipal string `protobuf:"bytes,30,opt,name=written_off_principal,json=writtenOffPrincipal,proto3" json:"written_off_principal,omitempty"`
+	// written_off_interest is the interest amount outstanding when the loan was written off.
+	WrittenOffInterest string `protobuf:"bytes,31,opt,name=written_off_interest,json=writtenOffInterest,proto3" json:"written_off_interest,omitempty"`
+	// written_off_charges is the amount of the charges outstanding when the loan was written off.
+	WrittenOffCharges string `protobuf:"bytes,32,opt,name=written_off_charges,json=writtenOffCharges,proto3" json:"written_off_charges,omitempty"`
+	// recovered_amount is the total amount of the recovery payments made after the loan was written off.
+	RecoveredAmount string `protobuf:"bytes,33,opt,name=recovered_amount,json=recoveredAmount,proto3" json:"recovered_amount,omitempty"`
+	// terms_version is the version of the terms the loan is repaid under, 1 until the loan is restructured.
+	TermsVersion int32 `protobuf:"varint,34,opt,name=terms_version,json=termsVersion,proto3" json:"terms_version,omitempty"`
+	// restructured_principal is the outstanding amount repaid under the latest restructure, zero if the loan has not been restructured.
+	RestructuredPrincipal string `protobuf:"bytes,35,opt,name=restructured_principal,json=restructuredPrincipal,proto3" json:"restructured_principal,omitempty"`
+	// restructured_from_installment is the number of the first installment of the latest restructure,
+	// zero if the loan has not been restructured.
+	RestructuredFromInstallment int32 `protobuf:"varint,36,opt,name=restructured_from_installment,json=restructuredFromInstallment,proto3" json:"restructured_from_installment,omitempty"`
 }
 
-func (x *MakePaymentRequest) Reset() {
-	*x = MakePaymentRequest{}
+func (x *Loan) Reset() {
+	*x = Loan{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_billing_engine_proto_msgTypes[4]
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MakePaymentRequest) String() string {
+func (x *Loan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakePaymentRequest) ProtoMessage() {}
+func (*Loan) ProtoMessage() {}
 
-func (x *MakePaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_billing_engine_proto_msgTypes[4]
+func (x *Loan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,39 +869,3415 @@ func (x *MakePaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MakePaymentRequest.ProtoReflect.Descriptor instead.
-func (*MakePaymentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Loan.ProtoReflect.Descriptor instead.
+func (*Loan) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *MakePaymentRequest) GetLoanId() string {
+func (x *Loan) GetId() string {
 	if x != nil {
-		return x.LoanId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *MakePaymentRequest) GetPaymentAmount() string {
+func (x *Loan) GetUserId() string {
 	if x != nil {
-		return x.PaymentAmount
+		return x.UserId
 	}
 	return ""
 }
 
-var File_proto_v1_billing_engine_proto protoreflect.FileDescriptor
+func (x *Loan) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
 
-var file_proto_v1_billing_engine_proto_rawDesc = []byte{
-	0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x0f, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
-	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xcf, 0x02, 0x0a, 0x04, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+func (x *Loan) GetPaymentDurationWeeks() int32 {
+	if x != nil {
+		return x.PaymentDurationWeeks
+	}
+	return 0
+}
+
+func (x *Loan) GetPaymentAmount() string {
+	if x != nil {
+		return x.PaymentAmount
+	}
+	return ""
+}
+
+func (x *Loan) GetStatus() LoanStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LoanStatus_ONGOING
+}
+
+func (x *Loan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Loan) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Loan) GetInterestModel() InterestModel {
+	if x != nil {
+		return x.InterestModel
+	}
+	return InterestModel_FLAT
+}
+
+func (x *Loan) GetInterestRate() string {
+	if x != nil {
+		return x.InterestRate
+	}
+	return ""
+}
+
+func (x *Loan) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Loan) GetOriginationFee() string {
+	if x != nil {
+		return x.OriginationFee
+	}
+	return ""
+}
+
+func (x *Loan) GetRoundingPolicy() *RoundingPolicy {
+	if x != nil {
+		return x.RoundingPolicy
+	}
+	return nil
+}
+
+func (x *Loan) GetPaymentAcceptanceMode() PaymentAcceptanceMode {
+	if x != nil {
+		return x.PaymentAcceptanceMode
+	}
+	return PaymentAcceptanceMode_EXACT
+}
+
+func (x *Loan) GetEarlySettlementRebateRate() string {
+	if x != nil {
+		return x.EarlySettlementRebateRate
+	}
+	return ""
+}
+
+func (x *Loan) GetRebateAmount() string {
+	if x != nil {
+		return x.RebateAmount
+	}
+	return ""
+}
+
+func (x *Loan) GetLateFeePolicy() *LateFeePolicy {
+	if x != nil {
+		return x.LateFeePolicy
+	}
+	return nil
+}
+
+func (x *Loan) GetGracePeriodDays() int32 {
+	if x != nil {
+		return x.GracePeriodDays
+	}
+	return 0
+}
+
+func (x *Loan) GetBillingCalendar() *BillingCalendar {
+	if x != nil {
+		return x.BillingCalendar
+	}
+	return nil
+}
+
+func (x *Loan) GetBillingFrequency() BillingFrequency {
+	if x != nil {
+		return x.BillingFrequency
+	}
+	return BillingFrequency_FREQUENCY_WEEKLY
+}
+
+func (x *Loan) GetInstallmentCount() int32 {
+	if x != nil {
+		return x.InstallmentCount
+	}
+	return 0
+}
+
+func (x *Loan) GetOverpaymentPolicy() OverpaymentPolicy {
+	if x != nil {
+		return x.OverpaymentPolicy
+	}
+	return OverpaymentPolicy_OVERPAYMENT_REJECT
+}
+
+func (x *Loan) GetApprovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return nil
+}
+
+func (x *Loan) GetDisbursedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisbursedAt
+	}
+	return nil
+}
+
+func (x *Loan) GetCoolingOffDays() int32 {
+	if x != nil {
+		return x.CoolingOffDays
+	}
+	return 0
+}
+
+func (x *Loan) GetDelinquencyRule() *DelinquencyRule {
+	if x != nil {
+		return x.DelinquencyRule
+	}
+	return nil
+}
+
+func (x *Loan) GetDefaultAfterWeeks() int32 {
+	if x != nil {
+		return x.DefaultAfterWeeks
+	}
+	return 0
+}
+
+func (x *Loan) GetDefaultedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DefaultedAt
+	}
+	return nil
+}
+
+func (x *Loan) GetWrittenOffAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WrittenOffAt
+	}
+	return nil
+}
+
+func (x *Loan) GetWrittenOffPrincipal() string {
+	if x != nil {
+		return x.WrittenOffPrincipal
+	}
+	return ""
+}
+
+func (x *Loan) GetWrittenOffInterest() string {
+	if x != nil {
+		return x.WrittenOffInterest
+	}
+	return ""
+}
+
+func (x *Loan) GetWrittenOffCharges() string {
+	if x != nil {
+		return x.WrittenOffCharges
+	}
+	return ""
+}
+
+func (x *Loan) GetRecoveredAmount() string {
+	if x != nil {
+		return x.RecoveredAmount
+	}
+	return ""
+}
+
+func (x *Loan) GetTermsVersion() int32 {
+	if x != nil {
+		return x.TermsVersion
+	}
+	return 0
+}
+
+func (x *Loan) GetRestructuredPrincipal() string {
+	if x != nil {
+		return x.RestructuredPrincipal
+	}
+	return ""
+}
+
+func (x *Loan) GetRestructuredFromInstallment() int32 {
+	if x != nil {
+		return x.RestructuredFromInstallment
+	}
+	return 0
+}
+
+// RoundingPolicy represents how installment amounts are rounded.
+type RoundingPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// method is the method used to round the installment amounts.
+	Method RoundingMethod `protobuf:"varint,1,opt,name=method,proto3,enum=loan_service.v1.RoundingMethod" json:"method,omitempty"`
+	// precision is the number of decimal places of the currency minor unit the amounts are rounded to.
+	Precision int32 `protobuf:"varint,2,opt,name=precision,proto3" json:"precision,omitempty"`
+}
+
+func (x *RoundingPolicy) Reset() {
+	*x = RoundingPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoundingPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundingPolicy) ProtoMessage() {}
+
+func (x *RoundingPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundingPolicy.ProtoReflect.Descriptor instead.
+func (*RoundingPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RoundingPolicy) GetMethod() RoundingMethod {
+	if x != nil {
+		return x.Method
+	}
+	return RoundingMethod_REMAINDER_ON_LAST
+}
+
+func (x *RoundingPolicy) GetPrecision() int32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+// BillingCalendar represents the calendar the billing weeks of a loan are calculated in.
+type BillingCalendar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// timezone is the IANA name of the timezone the billing weeks start in, e.g. "Asia/Jakarta".
+	Timezone string `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// week_start_day is the day of the week the billing weeks start on, at midnight in the timezone.
+	WeekStartDay Weekday `protobuf:"varint,2,opt,name=week_start_day,json=weekStartDay,proto3,enum=loan_service.v1.Weekday" json:"week_start_day,omitempty"`
+}
+
+func (x *BillingCalendar) Reset() {
+	*x = BillingCalendar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BillingCalendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillingCalendar) ProtoMessage() {}
+
+func (x *BillingCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillingCalendar.ProtoReflect.Descriptor instead.
+func (*BillingCalendar) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BillingCalendar) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *BillingCalendar) GetWeekStartDay() Weekday {
+	if x != nil {
+		return x.WeekStartDay
+	}
+	return Weekday_SUNDAY
+}
+
+// DelinquencyRule represents the rule a loan is considered delinquent by.
+type DelinquencyRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// missed_installment_threshold is the number of missed installments a loan can have without being delinquent.
+	MissedInstallmentThreshold int32 `protobuf:"varint,1,opt,name=missed_installment_threshold,json=missedInstallmentThreshold,proto3" json:"missed_installment_threshold,omitempty"`
+	// partial_payment_rule is how an overdue installment that is partially paid is counted.
+	PartialPaymentRule PartialPaymentRule `protobuf:"varint,2,opt,name=partial_payment_rule,json=partialPaymentRule,proto3,enum=loan_service.v1.PartialPaymentRule" json:"partial_payment_rule,omitempty"`
+}
+
+func (x *DelinquencyRule) Reset() {
+	*x = DelinquencyRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelinquencyRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelinquencyRule) ProtoMessage() {}
+
+func (x *DelinquencyRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelinquencyRule.ProtoReflect.Descriptor instead.
+func (*DelinquencyRule) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DelinquencyRule) GetMissedInstallmentThreshold() int32 {
+	if x != nil {
+		return x.MissedInstallmentThreshold
+	}
+	return 0
+}
+
+func (x *DelinquencyRule) GetPartialPaymentRule() PartialPaymentRule {
+	if x != nil {
+		return x.PartialPaymentRule
+	}
+	return PartialPaymentRule_PARTIAL_PAYMENT_COUNTS_AS_MISSED
+}
+
+// LoanInstallment represents an installment of a loan's repayment schedule.
+type LoanInstallment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// installment_number is the 1-based position of the installment in the schedule.
+	InstallmentNumber int32 `protobuf:"varint,1,opt,name=installment_number,json=installmentNumber,proto3" json:"installment_number,omitempty"`
+	// due_date is the timestamp when the installment becomes due.
+	DueDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// principal_amount is the portion of the installment that repays the principal amount.
+	PrincipalAmount string `protobuf:"bytes,3,opt,name=principal_amount,json=principalAmount,proto3" json:"principal_amount,omitempty"`
+	// interest_amount is the portion of the installment that pays the interest.
+	InterestAmount string `protobuf:"bytes,4,opt,name=interest_amount,json=interestAmount,proto3" json:"interest_amount,omitempty"`
+	// amount_due is the total amount to be paid for the installment.
+	AmountDue string `protobuf:"bytes,5,opt,name=amount_due,json=amountDue,proto3" json:"amount_due,omitempty"`
+	// amount_paid is the amount that has been paid towards the installment so far.
+	AmountPaid string `protobuf:"bytes,6,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"`
+	// status represents the current status of the installment.
+	Status LoanInstallmentStatus `protobuf:"varint,7,opt,name=status,proto3,enum=loan_service.v1.LoanInstallmentStatus" json:"status,omitempty"`
+}
+
+func (x *LoanInstallment) Reset() {
+	*x = LoanInstallment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoanInstallment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanInstallment) ProtoMessage() {}
+
+func (x *LoanInstallment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanInstallment.ProtoReflect.Descriptor instead.
+func (*LoanInstallment) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LoanInstallment) GetInstallmentNumber() int32 {
+	if x != nil {
+		return x.InstallmentNumber
+	}
+	return 0
+}
+
+func (x *LoanInstallment) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *LoanInstallment) GetPrincipalAmount() string {
+	if x != nil {
+		return x.PrincipalAmount
+	}
+	return ""
+}
+
+func (x *LoanInstallment) GetInterestAmount() string {
+	if x != nil {
+		return x.InterestAmount
+	}
+	return ""
+}
+
+func (x *LoanInstallment) GetAmountDue() string {
+	if x != nil {
+		return x.AmountDue
+	}
+	return ""
+}
+
+func (x *LoanInstallment) GetAmountPaid() string {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return ""
+}
+
+func (x *LoanInstallment) GetStatus() LoanInstallmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LoanInstallmentStatus_INSTALLMENT_UNPAID
+}
+
+// LateFeePolicy represents the late fees charged for the missed installments of a loan.
+type LateFeePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// method is the method used to calculate the late fee of a missed installment.
+	Method LateFeeMethod `protobuf:"varint,1,opt,name=method,proto3,enum=loan_service.v1.LateFeeMethod" json:"method,omitempty"`
+	// amount is the fixed fee of a missed installment for LATE_FEE_FIXED_PER_MISSED_INSTALLMENT,
+	// or the rate of the overdue amount for LATE_FEE_PERCENTAGE_OF_OVERDUE.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// cap is the maximum total late fees charged on a loan. There is no cap when it is zero.
+	Cap string `protobuf:"bytes,3,opt,name=cap,proto3" json:"cap,omitempty"`
+}
+
+func (x *LateFeePolicy) Reset() {
+	*x = LateFeePolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LateFeePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LateFeePolicy) ProtoMessage() {}
+
+func (x *LateFeePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LateFeePolicy.ProtoReflect.Descriptor instead.
+func (*LateFeePolicy) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LateFeePolicy) GetMethod() LateFeeMethod {
+	if x != nil {
+		return x.Method
+	}
+	return LateFeeMethod_LATE_FEE_NONE
+}
+
+func (x *LateFeePolicy) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *LateFeePolicy) GetCap() string {
+	if x != nil {
+		return x.Cap
+	}
+	return ""
+}
+
+// LoanCharge represents a charge applied to a loan on top of its repayment schedule.
+type LoanCharge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique identifier for the loan charge.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// charge_type is the kind of the charge.
+	ChargeType LoanChargeType `protobuf:"varint,2,opt,name=charge_type,json=chargeType,proto3,enum=loan_service.v1.LoanChargeType" json:"charge_type,omitempty"`
+	// installment_number is the number of the installment the charge is applied for.
+	InstallmentNumber int32 `protobuf:"varint,3,opt,name=installment_number,json=installmentNumber,proto3" json:"installment_number,omitempty"`
+	// amount is the amount charged.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// amount_paid is the amount that has been paid towards the charge so far.
+	AmountPaid string `protobuf:"bytes,5,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"`
+	// created_at is the timestamp when the charge was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// capitalized_amount is the part of the amount charged that was still outstanding when the loan was restructured,
+	// and is repaid in the principal of its new terms instead.
+	CapitalizedAmount string `protobuf:"bytes,7,opt,name=capitalized_amount,json=capitalizedAmount,proto3" json:"capitalized_amount,omitempty"`
+}
+
+func (x *LoanCharge) Reset() {
+	*x = LoanCharge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoanCharge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanCharge) ProtoMessage() {}
+
+func (x *LoanCharge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanCharge.ProtoReflect.Descriptor instead.
+func (*LoanCharge) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LoanCharge) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LoanCharge) GetChargeType() LoanChargeType {
+	if x != nil {
+		return x.ChargeType
+	}
+	return LoanChargeType_CHARGE_LATE_FEE
+}
+
+func (x *LoanCharge) GetInstallmentNumber() int32 {
+	if x != nil {
+		return x.InstallmentNumber
+	}
+	return 0
+}
+
+func (x *LoanCharge) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *LoanCharge) GetAmountPaid() string {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return ""
+}
+
+func (x *LoanCharge) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LoanCharge) GetCapitalizedAmount() string {
+	if x != nil {
+		return x.CapitalizedAmount
+	}
+	return ""
+}
+
+// FeeSchedule represents the fees charged for the loans of a loan product.
+type FeeSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// origination_fee_rate is the origination fee charged as a rate of the principal amount.
+	OriginationFeeRate string `protobuf:"bytes,1,opt,name=origination_fee_rate,json=originationFeeRate,proto3" json:"origination_fee_rate,omitempty"`
+	// origination_fee_fixed is the fixed origination fee charged on top of the rated fee.
+	OriginationFeeFixed string `protobuf:"bytes,2,opt,name=origination_fee_fixed,json=originationFeeFixed,proto3" json:"origination_fee_fixed,omitempty"`
+	// late_fee_policy is the late fees charged for the missed installments of the loans.
+	LateFeePolicy *LateFeePolicy `protobuf:"bytes,3,opt,name=late_fee_policy,json=lateFeePolicy,proto3" json:"late_fee_policy,omitempty"`
+}
+
+func (x *FeeSchedule) Reset() {
+	*x = FeeSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeSchedule) ProtoMessage() {}
+
+func (x *FeeSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeSchedule.ProtoReflect.Descriptor instead.
+func (*FeeSchedule) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FeeSchedule) GetOriginationFeeRate() string {
+	if x != nil {
+		return x.OriginationFeeRate
+	}
+	return ""
+}
+
+func (x *FeeSchedule) GetOriginationFeeFixed() string {
+	if x != nil {
+		return x.OriginationFeeFixed
+	}
+	return ""
+}
+
+func (x *FeeSchedule) GetLateFeePolicy() *LateFeePolicy {
+	if x != nil {
+		return x.LateFeePolicy
+	}
+	return nil
+}
+
+// LoanProduct represents the details of a loan product.
+type LoanProduct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique identifier for the loan product.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the display name of the loan product.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// min_principal is the minimum principal amount of a loan of this product.
+	MinPrincipal string `protobuf:"bytes,3,opt,name=min_principal,json=minPrincipal,proto3" json:"min_principal,omitempty"`
+	// max_principal is the maximum principal amount of a loan of this product.
+	MaxPrincipal string `protobuf:"bytes,4,opt,name=max_principal,json=maxPrincipal,proto3" json:"max_principal,omitempty"`
+	// allowed_duration_weeks lists the payment durations in weeks allowed for a loan of this product.
+	// Any duration is allowed when it is empty. It is kept for backward compatibility and is only
+	// populated for weekly products, see allowed_installment_counts.
+	AllowedDurationWeeks []int32 `protobuf:"varint,5,rep,packed,name=allowed_duration_weeks,json=allowedDurationWeeks,proto3" json:"allowed_duration_weeks,omitempty"`
+	// interest_model is the method used to calculate the interest of the loans of this product.
+	InterestModel InterestModel `protobuf:"varint,6,opt,name=interest_model,json=interestModel,proto3,enum=loan_service.v1.InterestModel" json:"interest_model,omitempty"`
+	// interest_rate is the interest rate of the loans of this product.
+	InterestRate string `protobuf:"bytes,7,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	// fee_schedule is the fees charged for the loans of this product.
+	FeeSchedule *FeeSchedule `protobuf:"bytes,8,opt,name=fee_schedule,json=feeSchedule,proto3" json:"fee_schedule,omitempty"`
+	// created_at is the timestamp when the loan product was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is the timestamp when the loan product was last updated.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// rounding_policy is how the payment amount of the loans of this product is split into installment amounts.
+	RoundingPolicy *RoundingPolicy `protobuf:"bytes,11,opt,name=rounding_policy,json=roundingPolicy,proto3" json:"rounding_policy,omitempty"`
+	// payment_acceptance_mode is which payment amounts are accepted towards the bill of the loans of this product.
+	PaymentAcceptanceMode PaymentAcceptanceMode `protobuf:"varint,12,opt,name=payment_acceptance_mode,json=paymentAcceptanceMode,proto3,enum=loan_service.v1.PaymentAcceptanceMode" json:"payment_acceptance_mode,omitempty"`
+	// early_settlement_rebate_rate is the portion of the unearned interest rebated when a loan of this product
+	// is paid off early.
+	EarlySettlementRebateRate string `protobuf:"bytes,13,opt,name=early_settlement_rebate_rate,json=earlySettlementRebateRate,proto3" json:"early_settlement_rebate_rate,omitempty"`
+	// grace_period_days is the number of days after an installment's due date before an unpaid installment
+	// of the loans of this product counts toward delinquency and late fees.
+	GracePeriodDays int32 `protobuf:"varint,14,opt,name=grace_period_days,json=gracePeriodDays,proto3" json:"grace_period_days,omitempty"`
+	// billing_calendar is the timezone and week start day the billing weeks of the loans of this product
+	// are calculated in. The loans use the default billing calendar when it is not set.
+	BillingCalendar *BillingCalendar `protobuf:"bytes,15,opt,name=billing_calendar,json=billingCalendar,proto3" json:"billing_calendar,omitempty"`
+	// billing_frequency is how often the installments of the loans of this product are due.
+	BillingFrequency BillingFrequency `protobuf:"varint,16,opt,name=billing_frequency,json=billingFrequency,proto3,enum=loan_service.v1.BillingFrequency" json:"billing_frequency,omitempty"`
+	// allowed_installment_counts lists the installment counts allowed for a loan of this product.
+	// Any installment count is allowed when it is empty.
+	AllowedInstallmentCounts []int32 `protobuf:"varint,17,rep,packed,name=allowed_installment_counts,json=allowedInstallmentCounts,proto3" json:"allowed_installment_counts,omitempty"`
+	// overpayment_policy is how a payment exceeding the amount accepted towards a loan of this product is handled.
+	OverpaymentPolicy OverpaymentPolicy `protobuf:"varint,18,opt,name=overpayment_policy,json=overpaymentPolicy,proto3,enum=loan_service.v1.OverpaymentPolicy" json:"overpayment_policy,omitempty"`
+	// cooling_off_days is the number of days after the creation of a loan of this product during which its user
+	// can cancel the loan. The loans of this product cannot be cancelled when it is 0.
+	CoolingOffDays int32 `protobuf:"varint,19,opt,name=cooling_off_days,json=coolingOffDays,proto3" json:"cooling_off_days,omitempty"`
+	// delinquency_rule is the rule the loans of this product are considered delinquent by.
+	// The loans use the default delinquency rule when it is not set.
+	DelinquencyRule *DelinquencyRule `protobuf:"bytes,20,opt,name=delinquency_rule,json=delinquencyRule,proto3" json:"delinquency_rule,omitempty"`
+	// default_after_weeks is the number of weeks past due after which a loan of this product is defaulted.
+	// The loans of this product are never defaulted when it is 0.
+	DefaultAfterWeeks int32 `protobuf:"varint,21,opt,name=default_after_weeks,json=defaultAfterWeeks,proto3" json:"default_after_weeks,omitempty"`
+}
+
+func (x *LoanProduct) Reset() {
+	*x = LoanProduct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoanProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanProduct) ProtoMessage() {}
+
+func (x *LoanProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanProduct.ProtoReflect.Descriptor instead.
+func (*LoanProduct) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LoanProduct) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetMinPrincipal() string {
+	if x != nil {
+		return x.MinPrincipal
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetMaxPrincipal() string {
+	if x != nil {
+		return x.MaxPrincipal
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetAllowedDurationWeeks() []int32 {
+	if x != nil {
+		return x.AllowedDurationWeeks
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetInterestModel() InterestModel {
+	if x != nil {
+		return x.InterestModel
+	}
+	return InterestModel_FLAT
+}
+
+func (x *LoanProduct) GetInterestRate() string {
+	if x != nil {
+		return x.InterestRate
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetFeeSchedule() *FeeSchedule {
+	if x != nil {
+		return x.FeeSchedule
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetRoundingPolicy() *RoundingPolicy {
+	if x != nil {
+		return x.RoundingPolicy
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetPaymentAcceptanceMode() PaymentAcceptanceMode {
+	if x != nil {
+		return x.PaymentAcceptanceMode
+	}
+	return PaymentAcceptanceMode_EXACT
+}
+
+func (x *LoanProduct) GetEarlySettlementRebateRate() string {
+	if x != nil {
+		return x.EarlySettlementRebateRate
+	}
+	return ""
+}
+
+func (x *LoanProduct) GetGracePeriodDays() int32 {
+	if x != nil {
+		return x.GracePeriodDays
+	}
+	return 0
+}
+
+func (x *LoanProduct) GetBillingCalendar() *BillingCalendar {
+	if x != nil {
+		return x.BillingCalendar
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetBillingFrequency() BillingFrequency {
+	if x != nil {
+		return x.BillingFrequency
+	}
+	return BillingFrequency_FREQUENCY_WEEKLY
+}
+
+func (x *LoanProduct) GetAllowedInstallmentCounts() []int32 {
+	if x != nil {
+		return x.AllowedInstallmentCounts
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetOverpaymentPolicy() OverpaymentPolicy {
+	if x != nil {
+		return x.OverpaymentPolicy
+	}
+	return OverpaymentPolicy_OVERPAYMENT_REJECT
+}
+
+func (x *LoanProduct) GetCoolingOffDays() int32 {
+	if x != nil {
+		return x.CoolingOffDays
+	}
+	return 0
+}
+
+func (x *LoanProduct) GetDelinquencyRule() *DelinquencyRule {
+	if x != nil {
+		return x.DelinquencyRule
+	}
+	return nil
+}
+
+func (x *LoanProduct) GetDefaultAfterWeeks() int32 {
+	if x != nil {
+		return x.DefaultAfterWeeks
+	}
+	return 0
+}
+
+// LoanDetail represents detailed information about a loan, including its current status and payment details.
+type LoanDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan is the basic loan information.
+	Loan *Loan `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	// outstanding_amount is the remaining amount to be paid on the loan.
+	OutstandingAmount string `protobuf:"bytes,2,opt,name=outstanding_amount,json=outstandingAmount,proto3" json:"outstanding_amount,omitempty"`
+	// current_bill_amount is the amount due for the current billing cycle.
+	CurrentBillAmount string `protobuf:"bytes,3,opt,name=current_bill_amount,json=currentBillAmount,proto3" json:"current_bill_amount,omitempty"`
+	// is_delinquent indicates whether the loan is delinquent or not.
+	// It is kept alongside the days past due and delinquency bucket for backward compatibility.
+	IsDelinquent bool `protobuf:"varint,4,opt,name=is_delinquent,json=isDelinquent,proto3" json:"is_delinquent,omitempty"`
+	// paid_amount is the total amount that has been paid towards the loan.
+	PaidAmount string `protobuf:"bytes,5,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+	// remaining_due_amount is the amount still owed on the installment currently being paid.
+	RemainingDueAmount string `protobuf:"bytes,6,opt,name=remaining_due_amount,json=remainingDueAmount,proto3" json:"remaining_due_amount,omitempty"`
+	// charges is the breakdown of the charges applied to the loan, such as late fees.
+	// The charges are included in the outstanding and current bill amounts.
+	Charges []*LoanCharge `protobuf:"bytes,7,rep,name=charges,proto3" json:"charges,omitempty"`
+	// outstanding_charge_amount is the remaining amount to be paid on the charges.
+	OutstandingChargeAmount string `protobuf:"bytes,8,opt,name=outstanding_charge_amount,json=outstandingChargeAmount,proto3" json:"outstanding_charge_amount,omitempty"`
+	// due_by is the timestamp when the grace period of the oldest unpaid due installment ends.
+	// It is not set when every due installment has been paid.
+	DueBy *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=due_by,json=dueBy,proto3" json:"due_by,omitempty"`
+	// credit_balance is the credit balance of the loan's user, held from the excess of their payments.
+	// It is applied to the current bill of the user's ongoing loan automatically.
+	CreditBalance string `protobuf:"bytes,10,opt,name=credit_balance,json=creditBalance,proto3" json:"credit_balance,omitempty"`
+	// days_past_due is the number of calendar days since the due date of the oldest unpaid due installment,
+	// or 0 when every due installment has been paid. The grace period is not deducted from it.
+	DaysPastDue int32 `protobuf:"varint,11,opt,name=days_past_due,json=daysPastDue,proto3" json:"days_past_due,omitempty"`
+	// oldest_unpaid_installment_date is the due date of the oldest unpaid due installment.
+	// It is not set when every due installment has been paid.
+	OldestUnpaidInstallmentDate *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=oldest_unpaid_installment_date,json=oldestUnpaidInstallmentDate,proto3" json:"oldest_unpaid_installment_date,omitempty"`
+	// delinquency_bucket is the bucket the loan is classified in by its days past due, e.g. "current", "1-7",
+	// "8-30", "31-60", "61-90" or "90+" with the default bucket boundaries.
+	DelinquencyBucket string `protobuf:"bytes,13,opt,name=delinquency_bucket,json=delinquencyBucket,proto3" json:"delinquency_bucket,omitempty"`
+	// maturity_date is the due date of the last installment of the loan, pushed back by its payment holidays.
+	MaturityDate *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=maturity_date,json=maturityDate,proto3" json:"maturity_date,omitempty"`
+}
+
+func (x *LoanDetail) Reset() {
+	*x = LoanDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoanDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanDetail) ProtoMessage() {}
+
+func (x *LoanDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanDetail.ProtoReflect.Descriptor instead.
+func (*LoanDetail) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LoanDetail) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *LoanDetail) GetOutstandingAmount() string {
+	if x != nil {
+		return x.OutstandingAmount
+	}
+	return ""
+}
+
+func (x *LoanDetail) GetCurrentBillAmount() string {
+	if x != nil {
+		return x.CurrentBillAmount
+	}
+	return ""
+}
+
+func (x *LoanDetail) GetIsDelinquent() bool {
+	if x != nil {
+		return x.IsDelinquent
+	}
+	return false
+}
+
+func (x *LoanDetail) GetPaidAmount() string {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return ""
+}
+
+func (x *LoanDetail) GetRemainingDueAmount() string {
+	if x != nil {
+		return x.RemainingDueAmount
+	}
+	return ""
+}
+
+func (x *LoanDetail) GetCharges() []*LoanCharge {
+	if x != nil {
+		return x.Charges
+	}
+	return nil
+}
+
+func (x *LoanDetail) GetOutstandingChargeAmount() string {
+	if x != nil {
+		return x.OutstandingChargeAmount
+	}
+	return ""
+}
+
+func (x *LoanDetail) GetDueBy() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueBy
+	}
+	return nil
+}
+
+func (x *LoanDetail) GetCreditBalance() string {
+	if x != nil {
+		return x.CreditBalance
+	}
+	return ""
+}
+
+func (x *LoanDetail) GetDaysPastDue() int32 {
+	if x != nil {
+		return x.DaysPastDue
+	}
+	return 0
+}
+
+func (x *LoanDetail) GetOldestUnpaidInstallmentDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OldestUnpaidInstallmentDate
+	}
+	return nil
+}
+
+func (x *LoanDetail) GetDelinquencyBucket() string {
+	if x != nil {
+		return x.DelinquencyBucket
+	}
+	return ""
+}
+
+func (x *LoanDetail) GetMaturityDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MaturityDate
+	}
+	return nil
+}
+
+// CreateLoanRequest represents the request structure for creating a new loan.
+type CreateLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user_id is the unique identifier of the user requesting the loan.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// amount is the total loan amount requested by the user.
+	// It should be a string representation of a decimal number.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// payment_duration_weeks specifies the loan repayment period in weeks.
+	// It is kept for backward compatibility and only used for weekly products when installment_count is not set.
+	PaymentDurationWeeks int32 `protobuf:"varint,3,opt,name=payment_duration_weeks,json=paymentDurationWeeks,proto3" json:"payment_duration_weeks,omitempty"`
+	// product_id is the unique identifier of the loan product the loan is requested under.
+	// The amount and installment count must be within the limits of the product.
+	ProductId string `protobuf:"bytes,6,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// installment_count is the number of installments the loan is repaid in,
+	// billed at the billing frequency of the product.
+	InstallmentCount int32 `protobuf:"varint,7,opt,name=installment_count,json=installmentCount,proto3" json:"installment_count,omitempty"`
+	// idempotency_key is an optional client-supplied key identifying the request across retries.
+	// A replay with the same key and payload returns the original loan, and a replay with the same key
+	// but a different payload fails with ABORTED.
+	IdempotencyKey string `protobuf:"bytes,8,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *CreateLoanRequest) Reset() {
+	*x = CreateLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLoanRequest) ProtoMessage() {}
+
+func (x *CreateLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLoanRequest.ProtoReflect.Descriptor instead.
+func (*CreateLoanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateLoanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateLoanRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreateLoanRequest) GetPaymentDurationWeeks() int32 {
+	if x != nil {
+		return x.PaymentDurationWeeks
+	}
+	return 0
+}
+
+func (x *CreateLoanRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateLoanRequest) GetInstallmentCount() int32 {
+	if x != nil {
+		return x.InstallmentCount
+	}
+	return 0
+}
+
+func (x *CreateLoanRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// GetCurrentLoanRequest represents the request structure for retrieving the current loan of a user.
+type GetCurrentLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user_id is the unique identifier of the user whose current loan is being requested.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetCurrentLoanRequest) Reset() {
+	*x = GetCurrentLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCurrentLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentLoanRequest) ProtoMessage() {}
+
+func (x *GetCurrentLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentLoanRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentLoanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCurrentLoanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// ApproveLoanRequest represents the request structure for approving a loan.
+type ApproveLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan being approved.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *ApproveLoanRequest) Reset() {
+	*x = ApproveLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveLoanRequest) ProtoMessage() {}
+
+func (x *ApproveLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveLoanRequest.ProtoReflect.Descriptor instead.
+func (*ApproveLoanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ApproveLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+// DisburseLoanRequest represents the request structure for recording the disbursement of a loan.
+type DisburseLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan being disbursed.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *DisburseLoanRequest) Reset() {
+	*x = DisburseLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisburseLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisburseLoanRequest) ProtoMessage() {}
+
+func (x *DisburseLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisburseLoanRequest.ProtoReflect.Descriptor instead.
+func (*DisburseLoanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DisburseLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+// RejectLoanRequest represents the request structure for rejecting a loan.
+type RejectLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan being rejected.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *RejectLoanRequest) Reset() {
+	*x = RejectLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectLoanRequest) ProtoMessage() {}
+
+func (x *RejectLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectLoanRequest.ProtoReflect.Descriptor instead.
+func (*RejectLoanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RejectLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+// CancelLoanRequest represents the request structure for cancelling a loan.
+type CancelLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan being cancelled.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *CancelLoanRequest) Reset() {
+	*x = CancelLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelLoanRequest) ProtoMessage() {}
+
+func (x *CancelLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelLoanRequest.ProtoReflect.Descriptor instead.
+func (*CancelLoanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+// WriteOffLoanRequest represents the request structure for writing a loan off.
+type WriteOffLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan being written off.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *WriteOffLoanRequest) Reset() {
+	*x = WriteOffLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteOffLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteOffLoanRequest) ProtoMessage() {}
+
+func (x *WriteOffLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteOffLoanRequest.ProtoReflect.Descriptor instead.
+func (*WriteOffLoanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WriteOffLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+// RestructureLoanRequest represents the request structure for restructuring a loan.
+type RestructureLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan being restructured.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	// installment_count is the number of installments the outstanding amount of the loan is repaid in.
+	InstallmentCount int32 `protobuf:"varint,2,opt,name=installment_count,json=installmentCount,proto3" json:"installment_count,omitempty"`
+	// interest_rate is the interest rate of the new terms, the current interest rate of the loan is kept if empty.
+	InterestRate string `protobuf:"bytes,3,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	// reason is the explanation of why the loan is restructured, between 1 and 500 characters.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RestructureLoanRequest) Reset() {
+	*x = RestructureLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestructureLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestructureLoanRequest) ProtoMessage() {}
+
+func (x *RestructureLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestructureLoanRequest.ProtoReflect.Descriptor instead.
+func (*RestructureLoanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RestructureLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *RestructureLoanRequest) GetInstallmentCount() int32 {
+	if x != nil {
+		return x.InstallmentCount
+	}
+	return 0
+}
+
+func (x *RestructureLoanRequest) GetInterestRate() string {
+	if x != nil {
+		return x.InterestRate
+	}
+	return ""
+}
+
+func (x *RestructureLoanRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// GrantPaymentHolidayRequest represents the request structure for granting a payment holiday on a loan.
+type GrantPaymentHolidayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan the payment holiday is granted on.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	// periods is the number of billing periods skipped by the payment holiday from the next billing period,
+	// weeks for a weekly loan, between 1 and 52.
+	Periods int32 `protobuf:"varint,2,opt,name=periods,proto3" json:"periods,omitempty"`
+	// reason is the explanation of why the payment holiday is granted, between 1 and 500 characters.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *GrantPaymentHolidayRequest) Reset() {
+	*x = GrantPaymentHolidayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantPaymentHolidayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantPaymentHolidayRequest) ProtoMessage() {}
+
+func (x *GrantPaymentHolidayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantPaymentHolidayRequest.ProtoReflect.Descriptor instead.
+func (*GrantPaymentHolidayRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GrantPaymentHolidayRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *GrantPaymentHolidayRequest) GetPeriods() int32 {
+	if x != nil {
+		return x.Periods
+	}
+	return 0
+}
+
+func (x *GrantPaymentHolidayRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// GetLoanRequest represents the request structure for retrieving a loan by its ID.
+type GetLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan being requested.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *GetLoanRequest) Reset() {
+	*x = GetLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanRequest) ProtoMessage() {}
+
+func (x *GetLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+// MakePaymentRequest represents the request structure for making a payment on a loan.
+type MakePaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan on which the payment is being made.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	// payment_amount is the amount being paid towards the loan.
+	// It should be a string representation of a decimal number. An amount exceeding the amount accepted
+	// towards the loan is handled according to the overpayment policy of the loan.
+	PaymentAmount string `protobuf:"bytes,2,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount,omitempty"`
+	// mode is the purpose of the payment, which determines the payment amounts accepted.
+	Mode PaymentMode `protobuf:"varint,3,opt,name=mode,proto3,enum=loan_service.v1.PaymentMode" json:"mode,omitempty"`
+	// idempotency_key is an optional client-supplied key identifying the request across retries.
+	// A replay with the same key and payload returns the loan details as of the original payment without paying again,
+	// and a replay with the same key but a different payload fails with ABORTED.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// external_reference is the optional reference of the payment in its channel, such as a bank transaction ID.
+	// It must be unique per channel, otherwise the payment fails with ALREADY_EXISTS.
+	ExternalReference string `protobuf:"bytes,5,opt,name=external_reference,json=externalReference,proto3" json:"external_reference,omitempty"`
+	// channel is the channel the payment was received through.
+	Channel PaymentChannel `protobuf:"varint,6,opt,name=channel,proto3,enum=loan_service.v1.PaymentChannel" json:"channel,omitempty"`
+	// paid_at is the value date of the payment in its channel, which cannot be in the future.
+	// The time the payment is recorded at is used when it is not set.
+	PaidAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	// metadata is free-form information about the payment, up to 20 entries.
+	Metadata map[string]string `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MakePaymentRequest) Reset() {
+	*x = MakePaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MakePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakePaymentRequest) ProtoMessage() {}
+
+func (x *MakePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakePaymentRequest.ProtoReflect.Descriptor instead.
+func (*MakePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MakePaymentRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *MakePaymentRequest) GetPaymentAmount() string {
+	if x != nil {
+		return x.PaymentAmount
+	}
+	return ""
+}
+
+func (x *MakePaymentRequest) GetMode() PaymentMode {
+	if x != nil {
+		return x.Mode
+	}
+	return PaymentMode_REGULAR
+}
+
+func (x *MakePaymentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *MakePaymentRequest) GetExternalReference() string {
+	if x != nil {
+		return x.ExternalReference
+	}
+	return ""
+}
+
+func (x *MakePaymentRequest) GetChannel() PaymentChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return PaymentChannel_CHANNEL_UNSPECIFIED
+}
+
+func (x *MakePaymentRequest) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+func (x *MakePaymentRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// ReversePaymentRequest represents the request structure for reversing a payment made towards a loan.
+// The payment is kept and marked as reversed, and a reversal entry with the reason is recorded.
+type ReversePaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// payment_id is the unique identifier of the payment being reversed.
+	PaymentId string `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	// reason is the explanation of why the payment is reversed, between 1 and 500 characters.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ReversePaymentRequest) Reset() {
+	*x = ReversePaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReversePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReversePaymentRequest) ProtoMessage() {}
+
+func (x *ReversePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReversePaymentRequest.ProtoReflect.Descriptor instead.
+func (*ReversePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReversePaymentRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *ReversePaymentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ListProductsRequest represents the request structure for retrieving the available loan products.
+type ListProductsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{22}
+}
+
+// ListProductsResponse represents the response structure containing the available loan products.
+type ListProductsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// products is the list of the available loan products.
+	Products []*LoanProduct `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListProductsResponse) GetProducts() []*LoanProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+// GetProductRequest represents the request structure for retrieving a loan product.
+type GetProductRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// product_id is the unique identifier of the loan product being requested.
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// GetLoanScheduleRequest represents the request structure for retrieving the repayment schedule of a loan.
+type GetLoanScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan whose schedule is being requested.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *GetLoanScheduleRequest) Reset() {
+	*x = GetLoanScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoanScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanScheduleRequest) ProtoMessage() {}
+
+func (x *GetLoanScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetLoanScheduleRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+// GetLoanScheduleResponse represents the response structure containing the repayment schedule of a loan.
+type GetLoanScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan the schedule belongs to.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	// installments is the list of the loan installments, ordered by their number.
+	Installments []*LoanInstallment `protobuf:"bytes,2,rep,name=installments,proto3" json:"installments,omitempty"`
+}
+
+func (x *GetLoanScheduleResponse) Reset() {
+	*x = GetLoanScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoanScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanScheduleResponse) ProtoMessage() {}
+
+func (x *GetLoanScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetLoanScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetLoanScheduleResponse) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *GetLoanScheduleResponse) GetInstallments() []*LoanInstallment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+// GetPayoffQuoteRequest represents the request structure for retrieving the payoff quote of a loan.
+type GetPayoffQuoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan whose payoff quote is being requested.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *GetPayoffQuoteRequest) Reset() {
+	*x = GetPayoffQuoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayoffQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoffQuoteRequest) ProtoMessage() {}
+
+func (x *GetPayoffQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoffQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetPayoffQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetPayoffQuoteRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+// PayoffQuote represents the amount needed to close a loan at a point in time.
+type PayoffQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan the quote belongs to.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	// outstanding_amount is the remaining amount to be paid on the loan without any rebate.
+	OutstandingAmount string `protobuf:"bytes,2,opt,name=outstanding_amount,json=outstandingAmount,proto3" json:"outstanding_amount,omitempty"`
+	// rebate_amount is the unearned interest rebated when the loan is settled early.
+	RebateAmount string `protobuf:"bytes,3,opt,name=rebate_amount,json=rebateAmount,proto3" json:"rebate_amount,omitempty"`
+	// payoff_amount is the amount to be paid with the PAYOFF payment mode to close the loan.
+	PayoffAmount string `protobuf:"bytes,4,opt,name=payoff_amount,json=payoffAmount,proto3" json:"payoff_amount,omitempty"`
+	// quoted_at is the timestamp when the quote was calculated.
+	QuotedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=quoted_at,json=quotedAt,proto3" json:"quoted_at,omitempty"`
+}
+
+func (x *PayoffQuote) Reset() {
+	*x = PayoffQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayoffQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoffQuote) ProtoMessage() {}
+
+func (x *PayoffQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoffQuote.ProtoReflect.Descriptor instead.
+func (*PayoffQuote) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PayoffQuote) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *PayoffQuote) GetOutstandingAmount() string {
+	if x != nil {
+		return x.OutstandingAmount
+	}
+	return ""
+}
+
+func (x *PayoffQuote) GetRebateAmount() string {
+	if x != nil {
+		return x.RebateAmount
+	}
+	return ""
+}
+
+func (x *PayoffQuote) GetPayoffAmount() string {
+	if x != nil {
+		return x.PayoffAmount
+	}
+	return ""
+}
+
+func (x *PayoffQuote) GetQuotedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QuotedAt
+	}
+	return nil
+}
+
+// ListLoanPaymentsRequest represents the request structure for retrieving the payments made towards a loan.
+type ListLoanPaymentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan whose payments are being requested.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	// page_token is the next_page_token of the previous page, or empty for the first page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// page_size is the maximum number of payments in the page, 20 if not set and at most 100.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListLoanPaymentsRequest) Reset() {
+	*x = ListLoanPaymentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoanPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoanPaymentsRequest) ProtoMessage() {}
+
+func (x *ListLoanPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoanPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListLoanPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListLoanPaymentsRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *ListLoanPaymentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListLoanPaymentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListLoanPaymentsResponse represents the response structure containing a page of the payments made towards a loan.
+type ListLoanPaymentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// payments is the list of the payments in the page, newest first.
+	Payments []*LoanPayment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	// next_page_token is the token to retrieve the next page with, or empty if this is the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListLoanPaymentsResponse) Reset() {
+	*x = ListLoanPaymentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoanPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoanPaymentsResponse) ProtoMessage() {}
+
+func (x *ListLoanPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoanPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListLoanPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListLoanPaymentsResponse) GetPayments() []*LoanPayment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *ListLoanPaymentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// LoanPayment represents a payment made towards a loan.
+type LoanPayment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique identifier for the payment.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// loan_id is the unique identifier of the loan the payment was made towards.
+	LoanId string `protobuf:"bytes,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	// amount is the amount paid, applied to the loan.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// mode is the purpose the payment was made for.
+	Mode PaymentMode `protobuf:"varint,4,opt,name=mode,proto3,enum=loan_service.v1.PaymentMode" json:"mode,omitempty"`
+	// external_reference is the reference of the payment in its channel, empty if none was given.
+	ExternalReference string `protobuf:"bytes,5,opt,name=external_reference,json=externalReference,proto3" json:"external_reference,omitempty"`
+	// channel is the channel the payment was received through.
+	Channel PaymentChannel `protobuf:"varint,6,opt,name=channel,proto3,enum=loan_service.v1.PaymentChannel" json:"channel,omitempty"`
+	// paid_at is the value date of the payment in its channel.
+	PaidAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	// metadata is free-form information about the payment.
+	Metadata map[string]string `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// allocations is the list of the portions of the payment applied to the loan installments.
+	Allocations []*InstallmentAllocation `protobuf:"bytes,9,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	// charge_allocations is the list of the portions of the payment applied to the loan charges.
+	ChargeAllocations []*ChargeAllocation `protobuf:"bytes,10,rep,name=charge_allocations,json=chargeAllocations,proto3" json:"charge_allocations,omitempty"`
+	// created_at is the timestamp when the payment was recorded.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is the timestamp when the payment was last updated.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// reversed_at is the timestamp when the payment was reversed, not set if the payment is not reversed.
+	ReversedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=reversed_at,json=reversedAt,proto3" json:"reversed_at,omitempty"`
+	// excess_amount is the amount received on top of the amount applied to the loan, "0" if there is none.
+	ExcessAmount string `protobuf:"bytes,14,opt,name=excess_amount,json=excessAmount,proto3" json:"excess_amount,omitempty"`
+	// excess_handling is how the excess amount was handled, either held as credit or marked for refund.
+	ExcessHandling OverpaymentPolicy `protobuf:"varint,15,opt,name=excess_handling,json=excessHandling,proto3,enum=loan_service.v1.OverpaymentPolicy" json:"excess_handling,omitempty"`
+}
+
+func (x *LoanPayment) Reset() {
+	*x = LoanPayment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoanPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanPayment) ProtoMessage() {}
+
+func (x *LoanPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanPayment.ProtoReflect.Descriptor instead.
+func (*LoanPayment) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LoanPayment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LoanPayment) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *LoanPayment) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *LoanPayment) GetMode() PaymentMode {
+	if x != nil {
+		return x.Mode
+	}
+	return PaymentMode_REGULAR
+}
+
+func (x *LoanPayment) GetExternalReference() string {
+	if x != nil {
+		return x.ExternalReference
+	}
+	return ""
+}
+
+func (x *LoanPayment) GetChannel() PaymentChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return PaymentChannel_CHANNEL_UNSPECIFIED
+}
+
+func (x *LoanPayment) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+func (x *LoanPayment) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *LoanPayment) GetAllocations() []*InstallmentAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *LoanPayment) GetChargeAllocations() []*ChargeAllocation {
+	if x != nil {
+		return x.ChargeAllocations
+	}
+	return nil
+}
+
+func (x *LoanPayment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LoanPayment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *LoanPayment) GetReversedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReversedAt
+	}
+	return nil
+}
+
+func (x *LoanPayment) GetExcessAmount() string {
+	if x != nil {
+		return x.ExcessAmount
+	}
+	return ""
+}
+
+func (x *LoanPayment) GetExcessHandling() OverpaymentPolicy {
+	if x != nil {
+		return x.ExcessHandling
+	}
+	return OverpaymentPolicy_OVERPAYMENT_REJECT
+}
+
+// InstallmentAllocation represents the portion of a payment applied to a loan installment.
+type InstallmentAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// installment_number is the number of the installment the amount is applied to.
+	InstallmentNumber int32 `protobuf:"varint,1,opt,name=installment_number,json=installmentNumber,proto3" json:"installment_number,omitempty"`
+	// amount is the portion of the payment applied to the installment.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *InstallmentAllocation) Reset() {
+	*x = InstallmentAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstallmentAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallmentAllocation) ProtoMessage() {}
+
+func (x *InstallmentAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallmentAllocation.ProtoReflect.Descriptor instead.
+func (*InstallmentAllocation) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InstallmentAllocation) GetInstallmentNumber() int32 {
+	if x != nil {
+		return x.InstallmentNumber
+	}
+	return 0
+}
+
+func (x *InstallmentAllocation) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// ChargeAllocation represents the portion of a payment applied to a loan charge.
+type ChargeAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// charge_id is the unique identifier of the charge the amount is applied to.
+	ChargeId string `protobuf:"bytes,1,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
+	// amount is the portion of the payment applied to the charge.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *ChargeAllocation) Reset() {
+	*x = ChargeAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChargeAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargeAllocation) ProtoMessage() {}
+
+func (x *ChargeAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargeAllocation.ProtoReflect.Descriptor instead.
+func (*ChargeAllocation) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ChargeAllocation) GetChargeId() string {
+	if x != nil {
+		return x.ChargeId
+	}
+	return ""
+}
+
+func (x *ChargeAllocation) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// ListUserLoansRequest represents the request structure for retrieving the loans of a user.
+type ListUserLoansRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user_id is the unique identifier of the user whose loans are being requested.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// status_filter is the list of the statuses of the loans to be returned, or empty to return the loans of any status.
+	// The loans are filtered by their current status: an ongoing loan past its default period is returned as
+	// DEFAULTED, and left out of ONGOING, before its defaulted status is stored by the next write to it.
+	StatusFilter []LoanStatus `protobuf:"varint,2,rep,packed,name=status_filter,json=statusFilter,proto3,enum=loan_service.v1.LoanStatus" json:"status_filter,omitempty"`
+	// page_token is the next_page_token of the previous page, or empty for the first page.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// page_size is the maximum number of loans in the page, 20 if not set and at most 100.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListUserLoansRequest) Reset() {
+	*x = ListUserLoansRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserLoansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserLoansRequest) ProtoMessage() {}
+
+func (x *ListUserLoansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserLoansRequest.ProtoReflect.Descriptor instead.
+func (*ListUserLoansRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListUserLoansRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListUserLoansRequest) GetStatusFilter() []LoanStatus {
+	if x != nil {
+		return x.StatusFilter
+	}
+	return nil
+}
+
+func (x *ListUserLoansRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUserLoansRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListUserLoansResponse represents the response structure containing a page of the loans of a user.
+type ListUserLoansResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loans is the list of the loans in the page, newest first.
+	Loans []*LoanSummary `protobuf:"bytes,1,rep,name=loans,proto3" json:"loans,omitempty"`
+	// next_page_token is the token to retrieve the next page with, or empty if this is the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListUserLoansResponse) Reset() {
+	*x = ListUserLoansResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserLoansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserLoansResponse) ProtoMessage() {}
+
+func (x *ListUserLoansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserLoansResponse.ProtoReflect.Descriptor instead.
+func (*ListUserLoansResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListUserLoansResponse) GetLoans() []*LoanSummary {
+	if x != nil {
+		return x.Loans
+	}
+	return nil
+}
+
+func (x *ListUserLoansResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// LoanSummary represents a loan along with the total amount paid towards it.
+type LoanSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan is the basic loan information.
+	Loan *Loan `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	// paid_amount is the total amount that has been paid towards the loan.
+	PaidAmount string `protobuf:"bytes,2,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+}
+
+func (x *LoanSummary) Reset() {
+	*x = LoanSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoanSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanSummary) ProtoMessage() {}
+
+func (x *LoanSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanSummary.ProtoReflect.Descriptor instead.
+func (*LoanSummary) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LoanSummary) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *LoanSummary) GetPaidAmount() string {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return ""
+}
+
+// LoanTerms represents a version of the terms a loan is repaid under.
+type LoanTerms struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan the terms belong to.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	// version is the 1-based version of the terms, 1 for the terms the loan was created with.
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// principal is the amount repaid under the terms: the loan amount for the first version,
+	// or the outstanding amount of the loan when it was restructured for the later versions.
+	Principal string `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	// interest_rate is the interest rate charged on the principal.
+	InterestRate string `protobuf:"bytes,4,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	// installment_count is the number of installments the principal is repaid in.
+	InstallmentCount int32 `protobuf:"varint,5,opt,name=installment_count,json=installmentCount,proto3" json:"installment_count,omitempty"`
+	// payment_amount is the total amount to be paid under the terms, including interest.
+	PaymentAmount string `protobuf:"bytes,6,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount,omitempty"`
+	// first_installment_number is the number of the first installment of the schedule of the terms.
+	FirstInstallmentNumber int32 `protobuf:"varint,7,opt,name=first_installment_number,json=firstInstallmentNumber,proto3" json:"first_installment_number,omitempty"`
+	// capitalized_charges is the amount of the outstanding charges included in the principal when the loan was restructured.
+	CapitalizedCharges string `protobuf:"bytes,8,opt,name=capitalized_charges,json=capitalizedCharges,proto3" json:"capitalized_charges,omitempty"`
+	// reason is the explanation of why the loan was restructured, empty for the first version.
+	Reason string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	// created_at is the timestamp when the terms took effect.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *LoanTerms) Reset() {
+	*x = LoanTerms{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoanTerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanTerms) ProtoMessage() {}
+
+func (x *LoanTerms) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanTerms.ProtoReflect.Descriptor instead.
+func (*LoanTerms) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LoanTerms) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *LoanTerms) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LoanTerms) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *LoanTerms) GetInterestRate() string {
+	if x != nil {
+		return x.InterestRate
+	}
+	return ""
+}
+
+func (x *LoanTerms) GetInstallmentCount() int32 {
+	if x != nil {
+		return x.InstallmentCount
+	}
+	return 0
+}
+
+func (x *LoanTerms) GetPaymentAmount() string {
+	if x != nil {
+		return x.PaymentAmount
+	}
+	return ""
+}
+
+func (x *LoanTerms) GetFirstInstallmentNumber() int32 {
+	if x != nil {
+		return x.FirstInstallmentNumber
+	}
+	return 0
+}
+
+func (x *LoanTerms) GetCapitalizedCharges() string {
+	if x != nil {
+		return x.CapitalizedCharges
+	}
+	return ""
+}
+
+func (x *LoanTerms) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LoanTerms) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListLoanTermsRequest represents the request structure for retrieving the versions of the terms of a loan.
+type ListLoanTermsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan whose terms are being requested.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *ListLoanTermsRequest) Reset() {
+	*x = ListLoanTermsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoanTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoanTermsRequest) ProtoMessage() {}
+
+func (x *ListLoanTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoanTermsRequest.ProtoReflect.Descriptor instead.
+func (*ListLoanTermsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListLoanTermsRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+// ListLoanTermsResponse represents the response structure containing the versions of the terms of a loan.
+type ListLoanTermsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan the terms belong to.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	// terms is the list of the versions of the terms of the loan, ordered by their version.
+	Terms []*LoanTerms `protobuf:"bytes,2,rep,name=terms,proto3" json:"terms,omitempty"`
+}
+
+func (x *ListLoanTermsResponse) Reset() {
+	*x = ListLoanTermsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoanTermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoanTermsResponse) ProtoMessage() {}
+
+func (x *ListLoanTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoanTermsResponse.ProtoReflect.Descriptor instead.
+func (*ListLoanTermsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListLoanTermsResponse) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *ListLoanTermsResponse) GetTerms() []*LoanTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+// PaymentHoliday represents a number of billing periods a loan is not billed for.
+// The installments not due yet when it is granted are deferred, the current bill amount and days past due
+// of the loan do not grow during it, and the maturity date of the loan is pushed back.
+type PaymentHoliday struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique identifier of the payment holiday.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// loan_id is the unique identifier of the loan the payment holiday is granted on.
+	LoanId string `protobuf:"bytes,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	// first_installment_number is the number of the first installment deferred by the payment holiday.
+	FirstInstallmentNumber int32 `protobuf:"varint,3,opt,name=first_installment_number,json=firstInstallmentNumber,proto3" json:"first_installment_number,omitempty"`
+	// periods is the number of billing periods skipped by the payment holiday.
+	Periods int32 `protobuf:"varint,4,opt,name=periods,proto3" json:"periods,omitempty"`
+	// starts_at is the timestamp when the payment holiday starts, the end of the billing period it was granted in.
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	// ends_at is the timestamp when the payment holiday ends, and the deferred installments start becoming due again.
+	EndsAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// reason is the explanation of why the payment holiday was granted.
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	// created_at is the timestamp when the payment holiday was granted.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *PaymentHoliday) Reset() {
+	*x = PaymentHoliday{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentHoliday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentHoliday) ProtoMessage() {}
+
+func (x *PaymentHoliday) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentHoliday.ProtoReflect.Descriptor instead.
+func (*PaymentHoliday) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PaymentHoliday) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PaymentHoliday) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *PaymentHoliday) GetFirstInstallmentNumber() int32 {
+	if x != nil {
+		return x.FirstInstallmentNumber
+	}
+	return 0
+}
+
+func (x *PaymentHoliday) GetPeriods() int32 {
+	if x != nil {
+		return x.Periods
+	}
+	return 0
+}
+
+func (x *PaymentHoliday) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *PaymentHoliday) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *PaymentHoliday) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PaymentHoliday) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListPaymentHolidaysRequest represents the request structure for retrieving the payment holidays of a loan.
+type ListPaymentHolidaysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan whose payment holidays are being requested.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *ListPaymentHolidaysRequest) Reset() {
+	*x = ListPaymentHolidaysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPaymentHolidaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentHolidaysRequest) ProtoMessage() {}
+
+func (x *ListPaymentHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListPaymentHolidaysRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+// ListPaymentHolidaysResponse represents the response structure containing the payment holidays of a loan.
+type ListPaymentHolidaysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loan_id is the unique identifier of the loan the payment holidays are granted on.
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	// holidays is the list of the payment holidays granted on the loan, oldest first.
+	Holidays []*PaymentHoliday `protobuf:"bytes,2,rep,name=holidays,proto3" json:"holidays,omitempty"`
+}
+
+func (x *ListPaymentHolidaysResponse) Reset() {
+	*x = ListPaymentHolidaysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_billing_engine_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPaymentHolidaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentHolidaysResponse) ProtoMessage() {}
+
+func (x *ListPaymentHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_billing_engine_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_billing_engine_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListPaymentHolidaysResponse) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *ListPaymentHolidaysResponse) GetHolidays() []*PaymentHoliday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+var File_proto_v1_billing_engine_proto protoreflect.FileDescriptor
+
+var file_proto_v1_billing_engine_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0f, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xa9, 0x0f, 0x0a, 0x04, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
 	0x77, 0x65, 0x65, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x70, 0x61, 0x79,
 	0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x65, 0x65, 0x6b,
 	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f,
@@ -464,55 +4293,803 @@ var file_proto_v1_billing_engine_proto_rawDesc = []byte{
 	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
 	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x12, 0x2d, 0x0a,
-	0x12, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x73, 0x74,
-	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d,
-	0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e,
-	0x74, 0x22, 0x7a, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x65, 0x65, 0x6b,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x65, 0x65, 0x6b, 0x73, 0x22, 0x30, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x54, 0x0a, 0x12, 0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x45, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x0d, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x5e, 0x0a, 0x17, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x15, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x3f, 0x0a, 0x1c, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x62, 0x61, 0x74, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x62, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x62, 0x61, 0x74,
+	0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x2a, 0x0a, 0x11, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x67, 0x72, 0x61, 0x63,
+	0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x44, 0x61, 0x79, 0x73, 0x12, 0x4b, 0x0a, 0x10, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x4e, 0x0a, 0x11, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x10, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x46,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x12, 0x6f, 0x76, 0x65, 0x72, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x11, 0x6f, 0x76, 0x65, 0x72, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x62, 0x75, 0x72, 0x73,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x62, 0x75, 0x72, 0x73,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x6f, 0x6f, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
+	0x6f, 0x66, 0x66, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x63, 0x6f, 0x6f, 0x6c, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x66, 0x44, 0x61, 0x79, 0x73, 0x12, 0x4b,
+	0x0a, 0x10, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x6e,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x69,
+	0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x65,
+	0x6b, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x57, 0x65, 0x65, 0x6b, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x77, 0x72,
+	0x69, 0x74, 0x74, 0x65, 0x6e, 0x5f, 0x6f, 0x66, 0x66, 0x5f, 0x61, 0x74, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c,
+	0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x4f, 0x66, 0x66, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x15,
+	0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x5f, 0x6f, 0x66, 0x66, 0x5f, 0x70, 0x72, 0x69, 0x6e,
+	0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x77, 0x72, 0x69,
+	0x74, 0x74, 0x65, 0x6e, 0x4f, 0x66, 0x66, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x12, 0x30, 0x0a, 0x14, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x5f, 0x6f, 0x66, 0x66, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x4f, 0x66, 0x66, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x5f, 0x6f, 0x66,
+	0x66, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x4f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x22,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x16, 0x72, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x23, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x15, 0x72, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64,
+	0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x42, 0x0a, 0x1d, 0x72, 0x65, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x24, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x1b, 0x72, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x46, 0x72,
+	0x6f, 0x6d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x67, 0x0a,
+	0x0e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x37, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6d, 0x0a, 0x0f, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x3e, 0x0a, 0x0e, 0x77, 0x65, 0x65, 0x6b, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x52, 0x0c, 0x77, 0x65, 0x65, 0x6b, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x79, 0x22, 0xaa, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x1c, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x1a, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x55, 0x0a, 0x14, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x12,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x22, 0xcb, 0x02, 0x0a, 0x0f, 0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x75, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x64,
+	0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x26, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x71, 0x0a, 0x0d, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x36, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x63, 0x61, 0x70, 0x22, 0xb0, 0x02, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x61, 0x70, 0x69, 0x74,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x0b, 0x46, 0x65, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x0f,
+	0x6c, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x22, 0xc0, 0x09, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f,
+	0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70,
+	0x61, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x57, 0x65, 0x65, 0x6b, 0x73, 0x12, 0x45, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
+	0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x48, 0x0a, 0x0f, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x5e, 0x0a, 0x17, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x15,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x3f, 0x0a, 0x1c, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x73,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x62, 0x61, 0x74, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x65, 0x61, 0x72,
+	0x6c, 0x79, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x62, 0x61,
+	0x74, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x44, 0x61,
+	0x79, 0x73, 0x12, 0x4b, 0x0a, 0x10, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x0f,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12,
+	0x4e, 0x0a, 0x11, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x10, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x3c, 0x0a, 0x1a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x11, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x18, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x51, 0x0a,
+	0x12, 0x6f, 0x76, 0x65, 0x72, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x76, 0x65, 0x72,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x11, 0x6f,
+	0x76, 0x65, 0x72, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x28, 0x0a, 0x10, 0x63, 0x6f, 0x6f, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x66, 0x66, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f, 0x6f, 0x6c,
+	0x69, 0x6e, 0x67, 0x4f, 0x66, 0x66, 0x44, 0x61, 0x79, 0x73, 0x12, 0x4b, 0x0a, 0x10, 0x64, 0x65,
+	0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x57, 0x65, 0x65, 0x6b, 0x73, 0x22, 0xd0, 0x05, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x6e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x04, 0x6c, 0x6f, 0x61,
+	0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f,
+	0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2e, 0x0a, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x69, 0x6c, 0x6c,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e,
+	0x71, 0x75, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x69, 0x64,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x44,
+	0x75, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12,
+	0x3a, 0x0a, 0x19, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x17, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x64,
+	0x75, 0x65, 0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x64, 0x75, 0x65, 0x42, 0x79, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x70, 0x61,
+	0x73, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61,
+	0x79, 0x73, 0x50, 0x61, 0x73, 0x74, 0x44, 0x75, 0x65, 0x12, 0x5f, 0x0a, 0x1e, 0x6f, 0x6c, 0x64,
+	0x65, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x1b, 0x6f,
+	0x6c, 0x64, 0x65, 0x73, 0x74, 0x55, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65,
+	0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x6d, 0x61, 0x74,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6d, 0x61,
+	0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x65, 0x22, 0x9a, 0x02, 0x0a, 0x11, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x14, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x57, 0x65, 0x65, 0x6b, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64,
+	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x4a, 0x04, 0x08, 0x04,
+	0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x30, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x12, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x62,
+	0x75, 0x72, 0x73, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f,
+	0x61, 0x6e, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x13, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66,
+	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f,
+	0x61, 0x6e, 0x49, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x67, 0x0a, 0x1a, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22, 0xda, 0x03, 0x0a, 0x12, 0x4d, 0x61, 0x6b, 0x65, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x06, 0x70, 0x61, 0x69, 0x64, 0x41, 0x74, 0x12, 0x4d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6b, 0x65,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x4e, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x22, 0x32, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64,
+	0x22, 0x31, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61,
+	0x6e, 0x49, 0x64, 0x22, 0x78, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x30, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22,
+	0xd8, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x73,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x62, 0x61, 0x74,
+	0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x62, 0x61, 0x74, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x70, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x37, 0x0a, 0x09, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x08, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6e, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x25,
-	0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0x23, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x4e, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x10, 0x00,
-	0x12, 0x08, 0x0a, 0x04, 0x50, 0x41, 0x49, 0x44, 0x10, 0x01, 0x32, 0x86, 0x02, 0x0a, 0x0d, 0x42,
-	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x49, 0x0a, 0x0a,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x61,
-	0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x7c, 0x0a, 0x18, 0x4c, 0x69,
+	0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe5, 0x06, 0x0a, 0x0b, 0x4c, 0x6f, 0x61,
+	0x6e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x06, 0x70, 0x61, 0x69, 0x64, 0x41, 0x74, 0x12, 0x46, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x6f, 0x61, 0x6e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x48, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x12,
+	0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x65, 0x73, 0x73,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x22, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x0e, 0x65, 0x78, 0x63, 0x65, 0x73, 0x73, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x69, 0x6e, 0x67, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x5e, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x47, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0d, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x73, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x59,
+	0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x29, 0x0a,
+	0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x61, 0x6e, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x69, 0x64,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x93, 0x03, 0x0a, 0x09, 0x4c, 0x6f,
+	0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x38, 0x0a, 0x18, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x16, 0x66, 0x69, 0x72, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x63,
+	0x61, 0x70, 0x69, 0x74, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x2f, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64,
+	0x22, 0x62, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x05, 0x74,
+	0x65, 0x72, 0x6d, 0x73, 0x22, 0xce, 0x02, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64,
+	0x12, 0x38, 0x0a, 0x18, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x16, 0x66, 0x69, 0x72, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x33, 0x0a,
+	0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73,
+	0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x35, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x73, 0x0a, 0x1b,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64,
+	0x61, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f,
+	0x61, 0x6e, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79,
+	0x73, 0x2a, 0x93, 0x01, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x4e, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x50, 0x41, 0x49, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x0c, 0x0a,
+	0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x44,
+	0x49, 0x53, 0x42, 0x55, 0x52, 0x53, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43,
+	0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x45, 0x46, 0x41, 0x55,
+	0x4c, 0x54, 0x45, 0x44, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x52, 0x49, 0x54, 0x54, 0x45,
+	0x4e, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x08, 0x2a, 0x43, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x4c, 0x41, 0x54,
+	0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x49, 0x4e, 0x47, 0x5f,
+	0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x5a, 0x45, 0x52,
+	0x4f, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x45, 0x53, 0x54, 0x10, 0x02, 0x2a, 0x4c, 0x0a, 0x0e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x15,
+	0x0a, 0x11, 0x52, 0x45, 0x4d, 0x41, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x5f, 0x4c,
+	0x41, 0x53, 0x54, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x4d, 0x41, 0x49, 0x4e, 0x44,
+	0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x42, 0x41, 0x4e, 0x4b, 0x45, 0x52, 0x53, 0x10, 0x02, 0x2a, 0x2f, 0x0a, 0x15, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x01, 0x2a, 0x5b, 0x0a, 0x11, 0x4f,
+	0x76, 0x65, 0x72, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x16, 0x0a, 0x12, 0x4f, 0x56, 0x45, 0x52, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x56, 0x45, 0x52,
+	0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x10, 0x01,
+	0x12, 0x16, 0x0a, 0x12, 0x4f, 0x56, 0x45, 0x52, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x2a, 0x6d, 0x0a, 0x10, 0x42, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x10,
+	0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59,
+	0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f,
+	0x44, 0x41, 0x49, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x42, 0x49, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x02,
+	0x12, 0x15, 0x0a, 0x11, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4d, 0x4f,
+	0x4e, 0x54, 0x48, 0x4c, 0x59, 0x10, 0x03, 0x2a, 0x65, 0x0a, 0x07, 0x57, 0x65, 0x65, 0x6b, 0x64,
+	0x61, 0x79, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x55, 0x4e, 0x44, 0x41, 0x59, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x4d, 0x4f, 0x4e, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x55,
+	0x45, 0x53, 0x44, 0x41, 0x59, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x45, 0x44, 0x4e, 0x45,
+	0x53, 0x44, 0x41, 0x59, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x48, 0x55, 0x52, 0x53, 0x44,
+	0x41, 0x59, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x52, 0x49, 0x44, 0x41, 0x59, 0x10, 0x05,
+	0x12, 0x0c, 0x0a, 0x08, 0x53, 0x41, 0x54, 0x55, 0x52, 0x44, 0x41, 0x59, 0x10, 0x06, 0x2a, 0x5e,
+	0x0a, 0x12, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f,
+	0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x53, 0x5f, 0x41,
+	0x53, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x41,
+	0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x53, 0x5f, 0x41, 0x53, 0x5f, 0x50, 0x41, 0x49, 0x44, 0x10, 0x01, 0x2a, 0x83,
+	0x01, 0x0a, 0x15, 0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x53, 0x54,
+	0x41, 0x4c, 0x4c, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x50, 0x41, 0x49, 0x44, 0x10, 0x00,
+	0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x50, 0x41, 0x49, 0x44, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c,
+	0x4c, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x4c, 0x59, 0x5f,
+	0x50, 0x41, 0x49, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c,
+	0x4c, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x55, 0x52,
+	0x45, 0x44, 0x10, 0x03, 0x2a, 0x71, 0x0a, 0x0d, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x45,
+	0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x4c, 0x41, 0x54, 0x45,
+	0x5f, 0x46, 0x45, 0x45, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x4d,
+	0x49, 0x53, 0x53, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x4d, 0x45, 0x4e,
+	0x54, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x45, 0x45, 0x5f,
+	0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x4f, 0x56,
+	0x45, 0x52, 0x44, 0x55, 0x45, 0x10, 0x02, 0x2a, 0x25, 0x0a, 0x0e, 0x4c, 0x6f, 0x61, 0x6e, 0x43,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x48, 0x41,
+	0x52, 0x47, 0x45, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x45, 0x45, 0x10, 0x00, 0x2a, 0x8b,
+	0x01, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48,
+	0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x42, 0x41, 0x4e, 0x4b, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x46, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
+	0x5f, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x43, 0x41,
+	0x52, 0x44, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
+	0x43, 0x41, 0x53, 0x48, 0x5f, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x10, 0x04, 0x2a, 0x50, 0x0a, 0x0b,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x52,
+	0x45, 0x47, 0x55, 0x4c, 0x41, 0x52, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x45, 0x50,
+	0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x59, 0x4f,
+	0x46, 0x46, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x10, 0x03,
+	0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x10, 0x04, 0x32, 0xfd,
+	0x0d, 0x0a, 0x0d, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x12, 0x49, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x22,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0b, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x23, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x62,
+	0x75, 0x72, 0x73, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x24, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x62, 0x75,
+	0x72, 0x73, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x6f,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e,
+	0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x6e,
+	0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x22, 0x00, 0x12, 0x4d, 0x0a,
+	0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x24, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0f,
+	0x52, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12,
+	0x27, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x61,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x22,
+	0x00, 0x12, 0x65, 0x0a, 0x13, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x12, 0x2b, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48,
+	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x26, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22,
+	0x00, 0x12, 0x51, 0x0a, 0x0b, 0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x23, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x12,
+	0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12,
+	0x57, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x26, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
 	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x26, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x00,
-	0x12, 0x51, 0x0a, 0x0b, 0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x23, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x22, 0x00, 0x42, 0x0b, 0x5a, 0x09, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x27, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x58, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x79, 0x6f, 0x66, 0x66, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x28, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x25, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79,
+	0x73, 0x12, 0x2b, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48,
+	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x6c, 0x69,
+	0x64, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0b,
+	0x5a, 0x09, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -527,33 +5104,181 @@ func file_proto_v1_billing_engine_proto_rawDescGZIP() []byte {
 	return file_proto_v1_billing_engine_proto_rawDescData
 }
 
-var file_proto_v1_billing_engine_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_v1_billing_engine_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_v1_billing_engine_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
+var file_proto_v1_billing_engine_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_proto_v1_billing_engine_proto_goTypes = []interface{}{
-	(LoanStatus)(0),               // 0: loan_service.v1.LoanStatus
-	(*Loan)(nil),                  // 1: loan_service.v1.Loan
-	(*LoanDetail)(nil),            // 2: loan_service.v1.LoanDetail
-	(*CreateLoanRequest)(nil),     // 3: loan_service.v1.CreateLoanRequest
-	(*GetCurrentLoanRequest)(nil), // 4: loan_service.v1.GetCurrentLoanRequest
-	(*MakePaymentRequest)(nil),    // 5: loan_service.v1.MakePaymentRequest
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(LoanStatus)(0),                     // 0: loan_service.v1.LoanStatus
+	(InterestModel)(0),                  // 1: loan_service.v1.InterestModel
+	(RoundingMethod)(0),                 // 2: loan_service.v1.RoundingMethod
+	(PaymentAcceptanceMode)(0),          // 3: loan_service.v1.PaymentAcceptanceMode
+	(OverpaymentPolicy)(0),              // 4: loan_service.v1.OverpaymentPolicy
+	(BillingFrequency)(0),               // 5: loan_service.v1.BillingFrequency
+	(Weekday)(0),                        // 6: loan_service.v1.Weekday
+	(PartialPaymentRule)(0),             // 7: loan_service.v1.PartialPaymentRule
+	(LoanInstallmentStatus)(0),          // 8: loan_service.v1.LoanInstallmentStatus
+	(LateFeeMethod)(0),                  // 9: loan_service.v1.LateFeeMethod
+	(LoanChargeType)(0),                 // 10: loan_service.v1.LoanChargeType
+	(PaymentChannel)(0),                 // 11: loan_service.v1.PaymentChannel
+	(PaymentMode)(0),                    // 12: loan_service.v1.PaymentMode
+	(*Loan)(nil),                        // 13: loan_service.v1.Loan
+	(*RoundingPolicy)(nil),              // 14: loan_service.v1.RoundingPolicy
+	(*BillingCalendar)(nil),             // 15: loan_service.v1.BillingCalendar
+	(*DelinquencyRule)(nil),             // 16: loan_service.v1.DelinquencyRule
+	(*LoanInstallment)(nil),             // 17: loan_service.v1.LoanInstallment
+	(*LateFeePolicy)(nil),               // 18: loan_service.v1.LateFeePolicy
+	(*LoanCharge)(nil),                  // 19: loan_service.v1.LoanCharge
+	(*FeeSchedule)(nil),                 // 20: loan_service.v1.FeeSchedule
+	(*LoanProduct)(nil),                 // 21: loan_service.v1.LoanProduct
+	(*LoanDetail)(nil),                  // 22: loan_service.v1.LoanDetail
+	(*CreateLoanRequest)(nil),           // 23: loan_service.v1.CreateLoanRequest
+	(*GetCurrentLoanRequest)(nil),       // 24: loan_service.v1.GetCurrentLoanRequest
+	(*ApproveLoanRequest)(nil),          // 25: loan_service.v1.ApproveLoanRequest
+	(*DisburseLoanRequest)(nil),         // 26: loan_service.v1.DisburseLoanRequest
+	(*RejectLoanRequest)(nil),           // 27: loan_service.v1.RejectLoanRequest
+	(*CancelLoanRequest)(nil),           // 28: loan_service.v1.CancelLoanRequest
+	(*WriteOffLoanRequest)(nil),         // 29: loan_service.v1.WriteOffLoanRequest
+	(*RestructureLoanRequest)(nil),      // 30: loan_service.v1.RestructureLoanRequest
+	(*GrantPaymentHolidayRequest)(nil),  // 31: loan_service.v1.GrantPaymentHolidayRequest
+	(*GetLoanRequest)(nil),              // 32: loan_service.v1.GetLoanRequest
+	(*MakePaymentRequest)(nil),          // 33: loan_service.v1.MakePaymentRequest
+	(*ReversePaymentRequest)(nil),       // 34: loan_service.v1.ReversePaymentRequest
+	(*ListProductsRequest)(nil),         // 35: loan_service.v1.ListProductsRequest
+	(*ListProductsResponse)(nil),        // 36: loan_service.v1.ListProductsResponse
+	(*GetProductRequest)(nil),           // 37: loan_service.v1.GetProductRequest
+	(*GetLoanScheduleRequest)(nil),      // 38: loan_service.v1.GetLoanScheduleRequest
+	(*GetLoanScheduleResponse)(nil),     // 39: loan_service.v1.GetLoanScheduleResponse
+	(*GetPayoffQuoteRequest)(nil),       // 40: loan_service.v1.GetPayoffQuoteRequest
+	(*PayoffQuote)(nil),                 // 41: loan_service.v1.PayoffQuote
+	(*ListLoanPaymentsRequest)(nil),     // 42: loan_service.v1.ListLoanPaymentsRequest
+	(*ListLoanPaymentsResponse)(nil),    // 43: loan_service.v1.ListLoanPaymentsResponse
+	(*LoanPayment)(nil),                 // 44: loan_service.v1.LoanPayment
+	(*InstallmentAllocation)(nil),       // 45: loan_service.v1.InstallmentAllocation
+	(*ChargeAllocation)(nil),            // 46: loan_service.v1.ChargeAllocation
+	(*ListUserLoansRequest)(nil),        // 47: loan_service.v1.ListUserLoansRequest
+	(*ListUserLoansResponse)(nil),       // 48: loan_service.v1.ListUserLoansResponse
+	(*LoanSummary)(nil),                 // 49: loan_service.v1.LoanSummary
+	(*LoanTerms)(nil),                   // 50: loan_service.v1.LoanTerms
+	(*ListLoanTermsRequest)(nil),        // 51: loan_service.v1.ListLoanTermsRequest
+	(*ListLoanTermsResponse)(nil),       // 52: loan_service.v1.ListLoanTermsResponse
+	(*PaymentHoliday)(nil),              // 53: loan_service.v1.PaymentHoliday
+	(*ListPaymentHolidaysRequest)(nil),  // 54: loan_service.v1.ListPaymentHolidaysRequest
+	(*ListPaymentHolidaysResponse)(nil), // 55: loan_service.v1.ListPaymentHolidaysResponse
+	nil,                                 // 56: loan_service.v1.MakePaymentRequest.MetadataEntry
+	nil,                                 // 57: loan_service.v1.LoanPayment.MetadataEntry
+	(*timestamppb.Timestamp)(nil),       // 58: google.protobuf.Timestamp
 }
 var file_proto_v1_billing_engine_proto_depIdxs = []int32{
-	0, // 0: loan_service.v1.Loan.status:type_name -> loan_service.v1.LoanStatus
-	6, // 1: loan_service.v1.Loan.created_at:type_name -> google.protobuf.Timestamp
-	6, // 2: loan_service.v1.Loan.updated_at:type_name -> google.protobuf.Timestamp
-	1, // 3: loan_service.v1.LoanDetail.loan:type_name -> loan_service.v1.Loan
-	3, // 4: loan_service.v1.BillingEngine.CreateLoan:input_type -> loan_service.v1.CreateLoanRequest
-	4, // 5: loan_service.v1.BillingEngine.GetCurrentLoan:input_type -> loan_service.v1.GetCurrentLoanRequest
-	5, // 6: loan_service.v1.BillingEngine.MakePayment:input_type -> loan_service.v1.MakePaymentRequest
-	1, // 7: loan_service.v1.BillingEngine.CreateLoan:output_type -> loan_service.v1.Loan
-	2, // 8: loan_service.v1.BillingEngine.GetCurrentLoan:output_type -> loan_service.v1.LoanDetail
-	2, // 9: loan_service.v1.BillingEngine.MakePayment:output_type -> loan_service.v1.LoanDetail
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: loan_service.v1.Loan.status:type_name -> loan_service.v1.LoanStatus
+	58, // 1: loan_service.v1.Loan.created_at:type_name -> google.protobuf.Timestamp
+	58, // 2: loan_service.v1.Loan.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: loan_service.v1.Loan.interest_model:type_name -> loan_service.v1.InterestModel
+	14, // 4: loan_service.v1.Loan.rounding_policy:type_name -> loan_service.v1.RoundingPolicy
+	3,  // 5: loan_service.v1.Loan.payment_acceptance_mode:type_name -> loan_service.v1.PaymentAcceptanceMode
+	18, // 6: loan_service.v1.Loan.late_fee_policy:type_name -> loan_service.v1.LateFeePolicy
+	15, // 7: loan_service.v1.Loan.billing_calendar:type_name -> loan_service.v1.BillingCalendar
+	5,  // 8: loan_service.v1.Loan.billing_frequency:type_name -> loan_service.v1.BillingFrequency
+	4,  // 9: loan_service.v1.Loan.overpayment_policy:type_name -> loan_service.v1.OverpaymentPolicy
+	58, // 10: loan_service.v1.Loan.approved_at:type_name -> google.protobuf.Timestamp
+	58, // 11: loan_service.v1.Loan.disbursed_at:type_name -> google.protobuf.Timestamp
+	16, // 12: loan_service.v1.Loan.delinquency_rule:type_name -> loan_service.v1.DelinquencyRule
+	58, // 13: loan_service.v1.Loan.defaulted_at:type_name -> google.protobuf.Timestamp
+	58, // 14: loan_service.v1.Loan.written_off_at:type_name -> google.protobuf.Timestamp
+	2,  // 15: loan_service.v1.RoundingPolicy.method:type_name -> loan_service.v1.RoundingMethod
+	6,  // 16: loan_service.v1.BillingCalendar.week_start_day:type_name -> loan_service.v1.Weekday
+	7,  // 17: loan_service.v1.DelinquencyRule.partial_payment_rule:type_name -> loan_service.v1.PartialPaymentRule
+	58, // 18: loan_service.v1.LoanInstallment.due_date:type_name -> google.protobuf.Timestamp
+	8,  // 19: loan_service.v1.LoanInstallment.status:type_name -> loan_service.v1.LoanInstallmentStatus
+	9,  // 20: loan_service.v1.LateFeePolicy.method:type_name -> loan_service.v1.LateFeeMethod
+	10, // 21: loan_service.v1.LoanCharge.charge_type:type_name -> loan_service.v1.LoanChargeType
+	58, // 22: loan_service.v1.LoanCharge.created_at:type_name -> google.protobuf.Timestamp
+	18, // 23: loan_service.v1.FeeSchedule.late_fee_policy:type_name -> loan_service.v1.LateFeePolicy
+	1,  // 24: loan_service.v1.LoanProduct.interest_model:type_name -> loan_service.v1.InterestModel
+	20, // 25: loan_service.v1.LoanProduct.fee_schedule:type_name -> loan_service.v1.FeeSchedule
+	58, // 26: loan_service.v1.LoanProduct.created_at:type_name -> google.protobuf.Timestamp
+	58, // 27: loan_service.v1.LoanProduct.updated_at:type_name -> google.protobuf.Timestamp
+	14, // 28: loan_service.v1.LoanProduct.rounding_policy:type_name -> loan_service.v1.RoundingPolicy
+	3,  // 29: loan_service.v1.LoanProduct.payment_acceptance_mode:type_name -> loan_service.v1.PaymentAcceptanceMode
+	15, // 30: loan_service.v1.LoanProduct.billing_calendar:type_name -> loan_service.v1.BillingCalendar
+	5,  // 31: loan_service.v1.LoanProduct.billing_frequency:type_name -> loan_service.v1.BillingFrequency
+	4,  // 32: loan_service.v1.LoanProduct.overpayment_policy:type_name -> loan_service.v1.OverpaymentPolicy
+	16, // 33: loan_service.v1.LoanProduct.delinquency_rule:type_name -> loan_service.v1.DelinquencyRule
+	13, // 34: loan_service.v1.LoanDetail.loan:type_name -> loan_service.v1.Loan
+	19, // 35: loan_service.v1.LoanDetail.charges:type_name -> loan_service.v1.LoanCharge
+	58, // 36: loan_service.v1.LoanDetail.due_by:type_name -> google.protobuf.Timestamp
+	58, // 37: loan_service.v1.LoanDetail.oldest_unpaid_installment_date:type_name -> google.protobuf.Timestamp
+	58, // 38: loan_service.v1.LoanDetail.maturity_date:type_name -> google.protobuf.Timestamp
+	12, // 39: loan_service.v1.MakePaymentRequest.mode:type_name -> loan_service.v1.PaymentMode
+	11, // 40: loan_service.v1.MakePaymentRequest.channel:type_name -> loan_service.v1.PaymentChannel
+	58, // 41: loan_service.v1.MakePaymentRequest.paid_at:type_name -> google.protobuf.Timestamp
+	56, // 42: loan_service.v1.MakePaymentRequest.metadata:type_name -> loan_service.v1.MakePaymentRequest.MetadataEntry
+	21, // 43: loan_service.v1.ListProductsResponse.products:type_name -> loan_service.v1.LoanProduct
+	17, // 44: loan_service.v1.GetLoanScheduleResponse.installments:type_name -> loan_service.v1.LoanInstallment
+	58, // 45: loan_service.v1.PayoffQuote.quoted_at:type_name -> google.protobuf.Timestamp
+	44, // 46: loan_service.v1.ListLoanPaymentsResponse.payments:type_name -> loan_service.v1.LoanPayment
+	12, // 47: loan_service.v1.LoanPayment.mode:type_name -> loan_service.v1.PaymentMode
+	11, // 48: loan_service.v1.LoanPayment.channel:type_name -> loan_service.v1.PaymentChannel
+	58, // 49: loan_service.v1.LoanPayment.paid_at:type_name -> google.protobuf.Timestamp
+	57, // 50: loan_service.v1.LoanPayment.metadata:type_name -> loan_service.v1.LoanPayment.MetadataEntry
+	45, // 51: loan_service.v1.LoanPayment.allocations:type_name -> loan_service.v1.InstallmentAllocation
+	46, // 52: loan_service.v1.LoanPayment.charge_allocations:type_name -> loan_service.v1.ChargeAllocation
+	58, // 53: loan_service.v1.LoanPayment.created_at:type_name -> google.protobuf.Timestamp
+	58, // 54: loan_service.v1.LoanPayment.updated_at:type_name -> google.protobuf.Timestamp
+	58, // 55: loan_service.v1.LoanPayment.reversed_at:type_name -> google.protobuf.Timestamp
+	4,  // 56: loan_service.v1.LoanPayment.excess_handling:type_name -> loan_service.v1.OverpaymentPolicy
+	0,  // 57: loan_service.v1.ListUserLoansRequest.status_filter:type_name -> loan_service.v1.LoanStatus
+	49, // 58: loan_service.v1.ListUserLoansResponse.loans:type_name -> loan_service.v1.LoanSummary
+	13, // 59: loan_service.v1.LoanSummary.loan:type_name -> loan_service.v1.Loan
+	58, // 60: loan_service.v1.LoanTerms.created_at:type_name -> google.protobuf.Timestamp
+	50, // 61: loan_service.v1.ListLoanTermsResponse.terms:type_name -> loan_service.v1.LoanTerms
+	58, // 62: loan_service.v1.PaymentHoliday.starts_at:type_name -> google.protobuf.Timestamp
+	58, // 63: loan_service.v1.PaymentHoliday.ends_at:type_name -> google.protobuf.Timestamp
+	58, // 64: loan_service.v1.PaymentHoliday.created_at:type_name -> google.protobuf.Timestamp
+	53, // 65: loan_service.v1.ListPaymentHolidaysResponse.holidays:type_name -> loan_service.v1.PaymentHoliday
+	23, // 66: loan_service.v1.BillingEngine.CreateLoan:input_type -> loan_service.v1.CreateLoanRequest
+	25, // 67: loan_service.v1.BillingEngine.ApproveLoan:input_type -> loan_service.v1.ApproveLoanRequest
+	26, // 68: loan_service.v1.BillingEngine.DisburseLoan:input_type -> loan_service.v1.DisburseLoanRequest
+	27, // 69: loan_service.v1.BillingEngine.RejectLoan:input_type -> loan_service.v1.RejectLoanRequest
+	28, // 70: loan_service.v1.BillingEngine.CancelLoan:input_type -> loan_service.v1.CancelLoanRequest
+	29, // 71: loan_service.v1.BillingEngine.WriteOffLoan:input_type -> loan_service.v1.WriteOffLoanRequest
+	30, // 72: loan_service.v1.BillingEngine.RestructureLoan:input_type -> loan_service.v1.RestructureLoanRequest
+	31, // 73: loan_service.v1.BillingEngine.GrantPaymentHoliday:input_type -> loan_service.v1.GrantPaymentHolidayRequest
+	24, // 74: loan_service.v1.BillingEngine.GetCurrentLoan:input_type -> loan_service.v1.GetCurrentLoanRequest
+	33, // 75: loan_service.v1.BillingEngine.MakePayment:input_type -> loan_service.v1.MakePaymentRequest
+	32, // 76: loan_service.v1.BillingEngine.GetLoan:input_type -> loan_service.v1.GetLoanRequest
+	34, // 77: loan_service.v1.BillingEngine.ReversePayment:input_type -> loan_service.v1.ReversePaymentRequest
+	35, // 78: loan_service.v1.BillingEngine.ListProducts:input_type -> loan_service.v1.ListProductsRequest
+	37, // 79: loan_service.v1.BillingEngine.GetProduct:input_type -> loan_service.v1.GetProductRequest
+	38, // 80: loan_service.v1.BillingEngine.GetLoanSchedule:input_type -> loan_service.v1.GetLoanScheduleRequest
+	40, // 81: loan_service.v1.BillingEngine.GetPayoffQuote:input_type -> loan_service.v1.GetPayoffQuoteRequest
+	42, // 82: loan_service.v1.BillingEngine.ListLoanPayments:input_type -> loan_service.v1.ListLoanPaymentsRequest
+	47, // 83: loan_service.v1.BillingEngine.ListUserLoans:input_type -> loan_service.v1.ListUserLoansRequest
+	51, // 84: loan_service.v1.BillingEngine.ListLoanTerms:input_type -> loan_service.v1.ListLoanTermsRequest
+	54, // 85: loan_service.v1.BillingEngine.ListPaymentHolidays:input_type -> loan_service.v1.ListPaymentHolidaysRequest
+	13, // 86: loan_service.v1.BillingEngine.CreateLoan:output_type -> loan_service.v1.Loan
+	13, // 87: loan_service.v1.BillingEngine.ApproveLoan:output_type -> loan_service.v1.Loan
+	13, // 88: loan_service.v1.BillingEngine.DisburseLoan:output_type -> loan_service.v1.Loan
+	13, // 89: loan_service.v1.BillingEngine.RejectLoan:output_type -> loan_service.v1.Loan
+	13, // 90: loan_service.v1.BillingEngine.CancelLoan:output_type -> loan_service.v1.Loan
+	13, // 91: loan_service.v1.BillingEngine.WriteOffLoan:output_type -> loan_service.v1.Loan
+	13, // 92: loan_service.v1.BillingEngine.RestructureLoan:output_type -> loan_service.v1.Loan
+	53, // 93: loan_service.v1.BillingEngine.GrantPaymentHoliday:output_type -> loan_service.v1.PaymentHoliday
+	22, // 94: loan_service.v1.BillingEngine.GetCurrentLoan:output_type -> loan_service.v1.LoanDetail
+	22, // 95: loan_service.v1.BillingEngine.MakePayment:output_type -> loan_service.v1.LoanDetail
+	22, // 96: loan_service.v1.BillingEngine.GetLoan:output_type -> loan_service.v1.LoanDetail
+	22, // 97: loan_service.v1.BillingEngine.ReversePayment:output_type -> loan_service.v1.LoanDetail
+	36, // 98: loan_service.v1.BillingEngine.ListProducts:output_type -> loan_service.v1.ListProductsResponse
+	21, // 99: loan_service.v1.BillingEngine.GetProduct:output_type -> loan_service.v1.LoanProduct
+	39, // 100: loan_service.v1.BillingEngine.GetLoanSchedule:output_type -> loan_service.v1.GetLoanScheduleResponse
+	41, // 101: loan_service.v1.BillingEngine.GetPayoffQuote:output_type -> loan_service.v1.PayoffQuote
+	43, // 102: loan_service.v1.BillingEngine.ListLoanPayments:output_type -> loan_service.v1.ListLoanPaymentsResponse
+	48, // 103: loan_service.v1.BillingEngine.ListUserLoans:output_type -> loan_service.v1.ListUserLoansResponse
+	52, // 104: loan_service.v1.BillingEngine.ListLoanTerms:output_type -> loan_service.v1.ListLoanTermsResponse
+	55, // 105: loan_service.v1.BillingEngine.ListPaymentHolidays:output_type -> loan_service.v1.ListPaymentHolidaysResponse
+	86, // [86:106] is the sub-list for method output_type
+	66, // [66:86] is the sub-list for method input_type
+	66, // [66:66] is the sub-list for extension type_name
+	66, // [66:66] is the sub-list for extension extendee
+	0,  // [0:66] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_billing_engine_proto_init() }
@@ -574,8 +5299,176 @@ func file_proto_v1_billing_engine_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_billing_engine_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoanDetail); i {
+		file_proto_v1_billing_engine_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoundingPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BillingCalendar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelinquencyRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanInstallment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LateFeePolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanCharge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanProduct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCurrentLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisburseLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelLoanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -586,8 +5479,8 @@ func file_proto_v1_billing_engine_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_billing_engine_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateLoanRequest); i {
+		file_proto_v1_billing_engine_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteOffLoanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -598,8 +5491,8 @@ func file_proto_v1_billing_engine_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_billing_engine_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCurrentLoanRequest); i {
+		file_proto_v1_billing_engine_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestructureLoanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -610,7 +5503,31 @@ func file_proto_v1_billing_engine_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_billing_engine_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_v1_billing_engine_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantPaymentHolidayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MakePaymentRequest); i {
 			case 0:
 				return &v.state
@@ -622,14 +5539,278 @@ func file_proto_v1_billing_engine_proto_init() {
 				return nil
 			}
 		}
+		file_proto_v1_billing_engine_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReversePaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProductsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProductsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProductRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoanScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoanScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPayoffQuoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoffQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoanPaymentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoanPaymentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanPayment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallmentAllocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChargeAllocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserLoansRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserLoansResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanTerms); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoanTermsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoanTermsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentHoliday); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPaymentHolidaysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_billing_engine_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPaymentHolidaysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_v1_billing_engine_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      13,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,10 +24,44 @@ const _ = grpc.SupportPackageIsVersion7
 type BillingEngineClient interface {
 	// CreateLoan creates a new loan for a user.
 	CreateLoan(ctx context.Context, in *CreateLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	// ApproveLoan approves a loan that is pending approval.
+	ApproveLoan(ctx context.Context, in *ApproveLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	// DisburseLoan records the disbursement of an approved loan, which starts its billing.
+	DisburseLoan(ctx context.Context, in *DisburseLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	// RejectLoan rejects a loan that has not been disbursed yet.
+	RejectLoan(ctx context.Context, in *RejectLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	// CancelLoan cancels a loan at the request of its user, within the cooling-off period and before it is disbursed.
+	CancelLoan(ctx context.Context, in *CancelLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	// WriteOffLoan writes the outstanding amount of a defaulted loan off as a loss, closing the loan.
+	WriteOffLoan(ctx context.Context, in *WriteOffLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	// RestructureLoan repays the outstanding amount of a loan being repaid in a new schedule under new terms.
+	RestructureLoan(ctx context.Context, in *RestructureLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	// GrantPaymentHoliday grants a payment holiday on a loan being repaid, deferring its installments not due yet.
+	GrantPaymentHoliday(ctx context.Context, in *GrantPaymentHolidayRequest, opts ...grpc.CallOption) (*PaymentHoliday, error)
 	// GetCurrentLoan retrieves the current loan details for a user.
 	GetCurrentLoan(ctx context.Context, in *GetCurrentLoanRequest, opts ...grpc.CallOption) (*LoanDetail, error)
 	// MakePayment processes a payment for a specific loan.
 	MakePayment(ctx context.Context, in *MakePaymentRequest, opts ...grpc.CallOption) (*LoanDetail, error)
+	// GetLoan retrieves the details of a specific loan regardless of its status.
+	GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*LoanDetail, error)
+	// ReversePayment reverses a payment made towards a loan, such as a bounced transfer or a chargeback.
+	ReversePayment(ctx context.Context, in *ReversePaymentRequest, opts ...grpc.CallOption) (*LoanDetail, error)
+	// ListProducts retrieves all the available loan products.
+	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	// GetProduct retrieves a specific loan product.
+	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*LoanProduct, error)
+	// GetLoanSchedule retrieves the repayment schedule of a specific loan.
+	GetLoanSchedule(ctx context.Context, in *GetLoanScheduleRequest, opts ...grpc.CallOption) (*GetLoanScheduleResponse, error)
+	// GetPayoffQuote retrieves the amount needed to close a specific loan today.
+	GetPayoffQuote(ctx context.Context, in *GetPayoffQuoteRequest, opts ...grpc.CallOption) (*PayoffQuote, error)
+	// ListLoanPayments retrieves the payments made towards a specific loan, newest first, a page at a time.
+	ListLoanPayments(ctx context.Context, in *ListLoanPaymentsRequest, opts ...grpc.CallOption) (*ListLoanPaymentsResponse, error)
+	// ListUserLoans retrieves the loans of a user of any status, newest first, a page at a time.
+	ListUserLoans(ctx context.Context, in *ListUserLoansRequest, opts ...grpc.CallOption) (*ListUserLoansResponse, error)
+	// ListLoanTerms retrieves every version of the terms of a specific loan, from its original terms to its latest restructure.
+	ListLoanTerms(ctx context.Context, in *ListLoanTermsRequest, opts ...grpc.CallOption) (*ListLoanTermsResponse, error)
+	// ListPaymentHolidays retrieves the payment holidays granted on a specific loan.
+	ListPaymentHolidays(ctx context.Context, in *ListPaymentHolidaysRequest, opts ...grpc.CallOption) (*ListPaymentHolidaysResponse, error)
 }
 
 type billingEngineClient struct {
@@ -47,6 +81,69 @@ func (c *billingEngineClient) CreateLoan(ctx context.Context, in *CreateLoanRequ
 	return out, nil
 }
 
+func (c *billingEngineClient) ApproveLoan(ctx context.Context, in *ApproveLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/ApproveLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingEngineClient) DisburseLoan(ctx context.Context, in *DisburseLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/DisburseLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingEngineClient) RejectLoan(ctx context.Context, in *RejectLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/RejectLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingEngineClient) CancelLoan(ctx context.Context, in *CancelLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/CancelLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingEngineClient) WriteOffLoan(ctx context.Context, in *WriteOffLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/WriteOffLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingEngineClient) RestructureLoan(ctx context.Context, in *RestructureLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/RestructureLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingEngineClient) GrantPaymentHoliday(ctx context.Context, in *GrantPaymentHolidayRequest, opts ...grpc.CallOption) (*PaymentHoliday, error) {
+	out := new(PaymentHoliday)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/GrantPaymentHoliday", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *billingEngineClient) GetCurrentLoan(ctx context.Context, in *GetCurrentLoanRequest, opts ...grpc.CallOption) (*LoanDetail, error) {
 	out := new(LoanDetail)
 	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/GetCurrentLoan", in, out, opts...)
@@ -65,16 +162,140 @@ func (c *billingEngineClient) MakePayment(ctx context.Context, in *MakePaymentRe
 	return out, nil
 }
 
+func (c *billingEngineClient) GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*LoanDetail, error) {
+	out := new(LoanDetail)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/GetLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingEngineClient) ReversePayment(ctx context.Context, in *ReversePaymentRequest, opts ...grpc.CallOption) (*LoanDetail, error) {
+	out := new(LoanDetail)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/ReversePayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingEngineClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error) {
+	out := new(ListProductsResponse)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/ListProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingEngineClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*LoanProduct, error) {
+	out := new(LoanProduct)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/GetProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingEngineClient) GetLoanSchedule(ctx context.Context, in *GetLoanScheduleRequest, opts ...grpc.CallOption) (*GetLoanScheduleResponse, error) {
+	out := new(GetLoanScheduleResponse)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/GetLoanSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingEngineClient) GetPayoffQuote(ctx context.Context, in *GetPayoffQuoteRequest, opts ...grpc.CallOption) (*PayoffQuote, error) {
+	out := new(PayoffQuote)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/GetPayoffQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingEngineClient) ListLoanPayments(ctx context.Context, in *ListLoanPaymentsRequest, opts ...grpc.CallOption) (*ListLoanPaymentsResponse, error) {
+	out := new(ListLoanPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/ListLoanPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingEngineClient) ListUserLoans(ctx context.Context, in *ListUserLoansRequest, opts ...grpc.CallOption) (*ListUserLoansResponse, error) {
+	out := new(ListUserLoansResponse)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/ListUserLoans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingEngineClient) ListLoanTerms(ctx context.Context, in *ListLoanTermsRequest, opts ...grpc.CallOption) (*ListLoanTermsResponse, error) {
+	out := new(ListLoanTermsResponse)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/ListLoanTerms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingEngineClient) ListPaymentHolidays(ctx context.Context, in *ListPaymentHolidaysRequest, opts ...grpc.CallOption) (*ListPaymentHolidaysResponse, error) {
+	out := new(ListPaymentHolidaysResponse)
+	err := c.cc.Invoke(ctx, "/loan_service.v1.BillingEngine/ListPaymentHolidays", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BillingEngineServer is the server API for BillingEngine service.
 // All implementations must embed UnimplementedBillingEngineServer
 // for forward compatibility
 type BillingEngineServer interface {
 	// CreateLoan creates a new loan for a user.
 	CreateLoan(context.Context, *CreateLoanRequest) (*Loan, error)
+	// ApproveLoan approves a loan that is pending approval.
+	ApproveLoan(context.Context, *ApproveLoanRequest) (*Loan, error)
+	// DisburseLoan records the disbursement of an approved loan, which starts its billing.
+	DisburseLoan(context.Context, *DisburseLoanRequest) (*Loan, error)
+	// RejectLoan rejects a loan that has not been disbursed yet.
+	RejectLoan(context.Context, *RejectLoanRequest) (*Loan, error)
+	// CancelLoan cancels a loan at the request of its user, within the cooling-off period and before it is disbursed.
+	CancelLoan(context.Context, *CancelLoanRequest) (*Loan, error)
+	// WriteOffLoan writes the outstanding amount of a defaulted loan off as a loss, closing the loan.
+	WriteOffLoan(context.Context, *WriteOffLoanRequest) (*Loan, error)
+	// RestructureLoan repays the outstanding amount of a loan being repaid in a new schedule under new terms.
+	RestructureLoan(context.Context, *RestructureLoanRequest) (*Loan, error)
+	// GrantPaymentHoliday grants a payment holiday on a loan being repaid, deferring its installments not due yet.
+	GrantPaymentHoliday(context.Context, *GrantPaymentHolidayRequest) (*PaymentHoliday, error)
 	// GetCurrentLoan retrieves the current loan details for a user.
 	GetCurrentLoan(context.Context, *GetCurrentLoanRequest) (*LoanDetail, error)
 	// MakePayment processes a payment for a specific loan.
 	MakePayment(context.Context, *MakePaymentRequest) (*LoanDetail, error)
+	// GetLoan retrieves the details of a specific loan regardless of its status.
+	GetLoan(context.Context, *GetLoanRequest) (*LoanDetail, error)
+	// ReversePayment reverses a payment made towards a loan, such as a bounced transfer or a chargeback.
+	ReversePayment(context.Context, *ReversePaymentRequest) (*LoanDetail, error)
+	// ListProducts retrieves all the available loan products.
+	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	// GetProduct retrieves a specific loan product.
+	GetProduct(context.Context, *GetProductRequest) (*LoanProduct, error)
+	// GetLoanSchedule retrieves the repayment schedule of a specific loan.
+	GetLoanSchedule(context.Context, *GetLoanScheduleRequest) (*GetLoanScheduleResponse, error)
+	// GetPayoffQuote retrieves the amount needed to close a specific loan today.
+	GetPayoffQuote(context.Context, *GetPayoffQuoteRequest) (*PayoffQuote, error)
+	// ListLoanPayments retrieves the payments made towards a specific loan, newest first, a page at a time.
+	ListLoanPayments(context.Context, *ListLoanPaymentsRequest) (*ListLoanPaymentsResponse, error)
+	// ListUserLoans retrieves the loans of a user of any status, newest first, a page at a time.
+	ListUserLoans(context.Context, *ListUserLoansRequest) (*ListUserLoansResponse, error)
+	// ListLoanTerms retrieves every version of the terms of a specific loan, from its original terms to its latest restructure.
+	ListLoanTerms(context.Context, *ListLoanTermsRequest) (*ListLoanTermsResponse, error)
+	// ListPaymentHolidays retrieves the payment holidays granted on a specific loan.
+	ListPaymentHolidays(context.Context, *ListPaymentHolidaysRequest) (*ListPaymentHolidaysResponse, error)
 	mustEmbedUnimplementedBillingEngineServer()
 }
 
@@ -85,12 +306,63 @@ type UnimplementedBillingEngineServer struct {
 func (UnimplementedBillingEngineServer) CreateLoan(context.Context, *CreateLoanRequest) (*Loan, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLoan not implemented")
 }
+func (UnimplementedBillingEngineServer) ApproveLoan(context.Context, *ApproveLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveLoan not implemented")
+}
+func (UnimplementedBillingEngineServer) DisburseLoan(context.Context, *DisburseLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisburseLoan not implemented")
+}
+func (UnimplementedBillingEngineServer) RejectLoan(context.Context, *RejectLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectLoan not implemented")
+}
+func (UnimplementedBillingEngineServer) CancelLoan(context.Context, *CancelLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLoan not implemented")
+}
+func (UnimplementedBillingEngineServer) WriteOffLoan(context.Context, *WriteOffLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteOffLoan not implemented")
+}
+func (UnimplementedBillingEngineServer) RestructureLoan(context.Context, *RestructureLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestructureLoan not implemented")
+}
+func (UnimplementedBillingEngineServer) GrantPaymentHoliday(context.Context, *GrantPaymentHolidayRequest) (*PaymentHoliday, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantPaymentHoliday not implemented")
+}
 func (UnimplementedBillingEngineServer) GetCurrentLoan(context.Context, *GetCurrentLoanRequest) (*LoanDetail, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentLoan not implemented")
 }
 func (UnimplementedBillingEngineServer) MakePayment(context.Context, *MakePaymentRequest) (*LoanDetail, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MakePayment not implemented")
 }
+func (UnimplementedBillingEngineServer) GetLoan(context.Context, *GetLoanRequest) (*LoanDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoan not implemented")
+}
+func (UnimplementedBillingEngineServer) ReversePayment(context.Context, *ReversePaymentRequest) (*LoanDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReversePayment not implemented")
+}
+func (UnimplementedBillingEngineServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedBillingEngineServer) GetProduct(context.Context, *GetProductRequest) (*LoanProduct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
+}
+func (UnimplementedBillingEngineServer) GetLoanSchedule(context.Context, *GetLoanScheduleRequest) (*GetLoanScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoanSchedule not implemented")
+}
+func (UnimplementedBillingEngineServer) GetPayoffQuote(context.Context, *GetPayoffQuoteRequest) (*PayoffQuote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayoffQuote not implemented")
+}
+func (UnimplementedBillingEngineServer) ListLoanPayments(context.Context, *ListLoanPaymentsRequest) (*ListLoanPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoanPayments not implemented")
+}
+func (UnimplementedBillingEngineServer) ListUserLoans(context.Context, *ListUserLoansRequest) (*ListUserLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserLoans not implemented")
+}
+func (UnimplementedBillingEngineServer) ListLoanTerms(context.Context, *ListLoanTermsRequest) (*ListLoanTermsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoanTerms not implemented")
+}
+func (UnimplementedBillingEngineServer) ListPaymentHolidays(context.Context, *ListPaymentHolidaysRequest) (*ListPaymentHolidaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPaymentHolidays not implemented")
+}
 func (UnimplementedBillingEngineServer) mustEmbedUnimplementedBillingEngineServer() {}
 
 // UnsafeBillingEngineServer may be embedded to opt out of forward compatibility for this service.
@@ -122,6 +394,132 @@ func _BillingEngine_CreateLoan_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BillingEngine_ApproveLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).ApproveLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/ApproveLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).ApproveLoan(ctx, req.(*ApproveLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingEngine_DisburseLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisburseLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).DisburseLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/DisburseLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).DisburseLoan(ctx, req.(*DisburseLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingEngine_RejectLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).RejectLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/RejectLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).RejectLoan(ctx, req.(*RejectLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingEngine_CancelLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).CancelLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/CancelLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).CancelLoan(ctx, req.(*CancelLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingEngine_WriteOffLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteOffLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).WriteOffLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/WriteOffLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).WriteOffLoan(ctx, req.(*WriteOffLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingEngine_RestructureLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestructureLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).RestructureLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/RestructureLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).RestructureLoan(ctx, req.(*RestructureLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingEngine_GrantPaymentHoliday_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantPaymentHolidayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).GrantPaymentHoliday(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/GrantPaymentHoliday",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).GrantPaymentHoliday(ctx, req.(*GrantPaymentHolidayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BillingEngine_GetCurrentLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCurrentLoanRequest)
 	if err := dec(in); err != nil {
@@ -158,6 +556,186 @@ func _BillingEngine_MakePayment_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BillingEngine_GetLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).GetLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/GetLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).GetLoan(ctx, req.(*GetLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingEngine_ReversePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReversePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).ReversePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/ReversePayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).ReversePayment(ctx, req.(*ReversePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingEngine_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/ListProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingEngine_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/GetProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).GetProduct(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingEngine_GetLoanSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).GetLoanSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/GetLoanSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).GetLoanSchedule(ctx, req.(*GetLoanScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingEngine_GetPayoffQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayoffQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).GetPayoffQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/GetPayoffQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).GetPayoffQuote(ctx, req.(*GetPayoffQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingEngine_ListLoanPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoanPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).ListLoanPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/ListLoanPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).ListLoanPayments(ctx, req.(*ListLoanPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingEngine_ListUserLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserLoansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).ListUserLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/ListUserLoans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).ListUserLoans(ctx, req.(*ListUserLoansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingEngine_ListLoanTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoanTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).ListLoanTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/ListLoanTerms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).ListLoanTerms(ctx, req.(*ListLoanTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingEngine_ListPaymentHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentHolidaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingEngineServer).ListPaymentHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.v1.BillingEngine/ListPaymentHolidays",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingEngineServer).ListPaymentHolidays(ctx, req.(*ListPaymentHolidaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BillingEngine_ServiceDesc is the grpc.ServiceDesc for BillingEngine service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -169,6 +747,34 @@ var BillingEngine_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateLoan",
 			Handler:    _BillingEngine_CreateLoan_Handler,
 		},
+		{
+			MethodName: "ApproveLoan",
+			Handler:    _BillingEngine_ApproveLoan_Handler,
+		},
+		{
+			MethodName: "DisburseLoan",
+			Handler:    _BillingEngine_DisburseLoan_Handler,
+		},
+		{
+			MethodName: "RejectLoan",
+			Handler:    _BillingEngine_RejectLoan_Handler,
+		},
+		{
+			MethodName: "CancelLoan",
+			Handler:    _BillingEngine_CancelLoan_Handler,
+		},
+		{
+			MethodName: "WriteOffLoan",
+			Handler:    _BillingEngine_WriteOffLoan_Handler,
+		},
+		{
+			MethodName: "RestructureLoan",
+			Handler:    _BillingEngine_RestructureLoan_Handler,
+		},
+		{
+			MethodName: "GrantPaymentHoliday",
+			Handler:    _BillingEngine_GrantPaymentHoliday_Handler,
+		},
 		{
 			MethodName: "GetCurrentLoan",
 			Handler:    _BillingEngine_GetCurrentLoan_Handler,
@@ -177,6 +783,46 @@ var BillingEngine_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MakePayment",
 			Handler:    _BillingEngine_MakePayment_Handler,
 		},
+		{
+			MethodName: "GetLoan",
+			Handler:    _BillingEngine_GetLoan_Handler,
+		},
+		{
+			MethodName: "ReversePayment",
+			Handler:    _BillingEngine_ReversePayment_Handler,
+		},
+		{
+			MethodName: "ListProducts",
+			Handler:    _BillingEngine_ListProducts_Handler,
+		},
+		{
+			MethodName: "GetProduct",
+			Handler:    _BillingEngine_GetProduct_Handler,
+		},
+		{
+			MethodName: "GetLoanSchedule",
+			Handler:    _BillingEngine_GetLoanSchedule_Handler,
+		},
+		{
+			MethodName: "GetPayoffQuote",
+			Handler:    _BillingEngine_GetPayoffQuote_Handler,
+		},
+		{
+			MethodName: "ListLoanPayments",
+			Handler:    _BillingEngine_ListLoanPayments_Handler,
+		},
+		{
+			MethodName: "ListUserLoans",
+			Handler:    _BillingEngine_ListUserLoans_Handler,
+		},
+		{
+			MethodName: "ListLoanTerms",
+			Handler:    _BillingEngine_ListLoanTerms_Handler,
+		},
+		{
+			MethodName: "ListPaymentHolidays",
+			Handler:    _BillingEngine_ListPaymentHolidays_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/v1/billing_engine.proto",